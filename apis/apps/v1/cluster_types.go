@@ -202,6 +202,38 @@ type ClusterSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="restore is immutable"
 	// +optional
 	Restore *ClusterRestore `json:"restore,omitempty"`
+
+	// Specifies the maintenance window policy of the Cluster.
+	//
+	// Disruptive operations, such as restarts, version upgrades and instance replacements, are only carried out
+	// within the maintenance windows and outside the blackout periods.
+	// OpsRequests with `force` set to true bypass the policy.
+	//
+	// +optional
+	MaintenanceWindowPolicy *MaintenanceWindowPolicy `json:"maintenanceWindowPolicy,omitempty"`
+
+	// Specifies that the Cluster is a disaster-recovery standby of another Cluster
+	// in the same Kubernetes cluster, a primary Cluster in another Kubernetes cluster is not supported.
+	//
+	// If `restore` is not specified, a standby Cluster is bootstrapped from the latest completed backup of
+	// the primary Cluster, unless it is annotated with `apps.kubeblocks.io/standby-bootstrapped`, which marks
+	// that the data is already in place, e.g. for a Cluster demoted by a `Demote` OpsRequest.
+	// The Components are then kept in sync with their counterparts in the primary Cluster
+	// through the `replicationSetup` lifecycle action.
+	//
+	// Removing the field promotes the Cluster to a primary through the `promote` lifecycle action,
+	// this is usually done by a `Promote` OpsRequest.
+	//
+	// +optional
+	Standby *ClusterStandby `json:"standby,omitempty"`
+
+	// Specifies whether the Cluster is fenced.
+	//
+	// The Components of a fenced Cluster are switched into the read-only state through the `readonly` lifecycle
+	// action, to keep an old primary from accepting writes after a standby has been promoted.
+	//
+	// +optional
+	Fenced bool `json:"fenced,omitempty"`
 }
 
 // ClusterStatus defines the observed state of the Cluster.
@@ -241,6 +273,11 @@ type ClusterStatus struct {
 	//
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Records the disaster-recovery replication status of the Cluster.
+	//
+	// +optional
+	Replication *ClusterReplicationStatus `json:"replication,omitempty"`
 }
 
 // TerminationPolicyType defines termination policy types.
@@ -389,6 +426,12 @@ type ClusterComponentSpec struct {
 	// +optional
 	PersistentVolumeClaimRetentionPolicy *PersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
 
+	// Specifies the policy to expand the volumes automatically when their space utilization crosses the threshold.
+	// The expansions are performed by VolumeExpansion OpsRequests.
+	//
+	// +optional
+	VolumeAutoExpansion *VolumeAutoExpansionPolicy `json:"volumeAutoExpansion,omitempty"`
+
 	// List of volumes to override.
 	//
 	// +optional
@@ -883,6 +926,8 @@ type ClusterRestore struct {
 	Source ClusterRestoreSource `json:"source"`
 
 	// Specifies the point-in-time recovery target. The value is opaque to apps and interpreted by the restore runtime.
+	// For a continuous Backup source, the cluster is not provisioned if the time is not within the recoverable window
+	// of the backup.
 	//
 	// +optional
 	PITR string `json:"pitr,omitempty"`
@@ -917,6 +962,165 @@ type ClusterRestoreSource struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// ClusterStandby specifies the primary Cluster that a standby Cluster replicates from.
+//
+// The primary Cluster must be managed in the same Kubernetes cluster as the standby,
+// a primary Cluster in another Kubernetes cluster is not supported.
+type ClusterStandby struct {
+	// Specifies the name of the primary Cluster.
+	//
+	// +kubebuilder:validation:Required
+	PrimaryCluster string `json:"primaryCluster"`
+
+	// Specifies the namespace of the primary Cluster. If empty, the Cluster namespace is used.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ReplicationRole defines the role of a Cluster or Component in the disaster-recovery replication.
+//
+// +enum
+// +kubebuilder:validation:Enum={Primary,Standby}
+type ReplicationRole string
+
+const (
+	PrimaryReplicationRole ReplicationRole = "Primary"
+	StandbyReplicationRole ReplicationRole = "Standby"
+)
+
+// ClusterReplicationStatus represents the observed disaster-recovery replication status of a Cluster.
+type ClusterReplicationStatus struct {
+	// The role of the Cluster.
+	//
+	// +optional
+	Role ReplicationRole `json:"role,omitempty"`
+
+	// The primary Cluster of a standby Cluster, in the format of "namespace/name".
+	//
+	// +optional
+	PrimaryCluster string `json:"primaryCluster,omitempty"`
+
+	// The maximum replication lag in seconds among the Components of a standby Cluster.
+	//
+	// +optional
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+
+	// Whether all the Components of the Cluster have been fenced.
+	//
+	// +optional
+	Fenced bool `json:"fenced,omitempty"`
+
+	// The standby Clusters replicating from a primary Cluster.
+	//
+	// +optional
+	Standbys []ClusterStandbyStatus `json:"standbys,omitempty"`
+}
+
+// ClusterStandbyStatus represents the observed status of a standby Cluster from the view of its primary.
+type ClusterStandbyStatus struct {
+	// The standby Cluster, in the format of "namespace/name".
+	//
+	// +kubebuilder:validation:Required
+	Cluster string `json:"cluster"`
+
+	// The replication lag of the standby Cluster in seconds.
+	//
+	// +optional
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+}
+
+// MaintenanceWindowPolicy defines when disruptive operations are allowed to be carried out on a Cluster.
+type MaintenanceWindowPolicy struct {
+	// Specifies the recurring weekly windows within which disruptive operations are allowed.
+	// If empty, disruptive operations are allowed at any time outside the blackout periods.
+	//
+	// +optional
+	Windows []MaintenanceWindow `json:"windows,omitempty"`
+
+	// Specifies the IANA time zone name used to interpret the windows, e.g. "Asia/Shanghai".
+	// Defaults to UTC.
+	//
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Specifies the periods during which no disruptive operation is allowed, even if they overlap with a window.
+	//
+	// +optional
+	BlackoutPeriods []BlackoutPeriod `json:"blackoutPeriods,omitempty"`
+
+	// Overrides whether an operation is considered disruptive.
+	//
+	// The name is either an OpsRequest type, such as `Restart` or `HorizontalScaling`, or one of the operations
+	// initiated by the controllers: `ReconfigureRestart` and `RolloutReplace`.
+	//
+	// By default, `Restart`, `Upgrade`, `VerticalScaling`, `Stop`, `ReconfigureRestart` and `RolloutReplace`
+	// are disruptive. `Switchover` and `RebuildInstance` are not, as they are usually requested to recover from
+	// failures, add them here to defer them to the windows as well.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Operations []MaintenanceOperation `json:"operations,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring weekly window.
+type MaintenanceWindow struct {
+	// Specifies the days of the week on which the window opens. If empty, the window opens every day.
+	//
+	// +listType=set
+	// +optional
+	DaysOfWeek []MaintenanceWeekday `json:"daysOfWeek,omitempty"`
+
+	// Specifies the time of day the window opens, in the format "HH:MM".
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// Specifies how long the window stays open, e.g. "4h".
+	//
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+}
+
+// MaintenanceWeekday defines a day of the week.
+//
+// +enum
+// +kubebuilder:validation:Enum={Sunday,Monday,Tuesday,Wednesday,Thursday,Friday,Saturday}
+type MaintenanceWeekday string
+
+// BlackoutPeriod defines a period during which no disruptive operation is allowed.
+type BlackoutPeriod struct {
+	// Specifies the start time of the period.
+	//
+	// +kubebuilder:validation:Required
+	Start metav1.Time `json:"start"`
+
+	// Specifies the end time of the period.
+	//
+	// +kubebuilder:validation:Required
+	End metav1.Time `json:"end"`
+
+	// Specifies the reason of the period, e.g. "year-end freeze".
+	//
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// MaintenanceOperation overrides the disruptive classification of an operation.
+type MaintenanceOperation struct {
+	// Specifies the name of the operation.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Specifies whether the operation is disruptive and has to wait for a maintenance window.
+	//
+	// +kubebuilder:validation:Required
+	Disruptive bool `json:"disruptive"`
+}
+
 // ClusterPhase defines the phase of the Cluster within the .status.phase field.
 //
 // +enum
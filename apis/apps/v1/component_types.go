@@ -160,6 +160,18 @@ type ComponentSpec struct {
 	// +optional
 	PersistentVolumeClaimRetentionPolicy *PersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
 
+	// Specifies the policy to expand the volumes automatically when their space utilization crosses the threshold.
+	// The expansions are performed by VolumeExpansion OpsRequests.
+	//
+	// +optional
+	VolumeAutoExpansion *VolumeAutoExpansionPolicy `json:"volumeAutoExpansion,omitempty"`
+
+	// Specifies the disaster-recovery replication of the Component, derived from the `standby` and `fenced`
+	// of the Cluster.
+	//
+	// +optional
+	Replication *ComponentReplication `json:"replication,omitempty"`
+
 	// List of volumes to override.
 	//
 	// +optional
@@ -394,6 +406,75 @@ type ComponentStatus struct {
 	//
 	// +optional
 	Message map[string]string `json:"message,omitempty"`
+
+	// Records the recent expansions of the volumes issued by the `volumeAutoExpansion` policy, the oldest first.
+	//
+	// +optional
+	VolumeExpansions []VolumeExpansionRecord `json:"volumeExpansions,omitempty"`
+
+	// Records the disaster-recovery replication status of the Component.
+	//
+	// +optional
+	Replication *ComponentReplicationStatus `json:"replication,omitempty"`
+}
+
+// ComponentReplication specifies the disaster-recovery replication of a Component.
+type ComponentReplication struct {
+	// Specifies the Component to replicate from. If not set, the Component acts as a primary.
+	//
+	// +optional
+	Source *ComponentReplicationSource `json:"source,omitempty"`
+
+	// Specifies whether the Component is switched into the read-only state.
+	//
+	// +optional
+	Fenced bool `json:"fenced,omitempty"`
+}
+
+// ComponentReplicationSource specifies the Component that a standby Component replicates from.
+type ComponentReplicationSource struct {
+	// The namespace of the source Component.
+	//
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+
+	// The name of the Cluster that the source Component belongs to.
+	//
+	// +kubebuilder:validation:Required
+	ClusterName string `json:"clusterName"`
+
+	// The name of the source Component within its Cluster.
+	//
+	// +kubebuilder:validation:Required
+	ComponentName string `json:"componentName"`
+}
+
+// ComponentReplicationStatus represents the observed disaster-recovery replication status of a Component.
+type ComponentReplicationStatus struct {
+	// The role of the Component.
+	//
+	// +optional
+	Role ReplicationRole `json:"role,omitempty"`
+
+	// The Component that a standby Component replicates from, in the format of "namespace/cluster/component".
+	//
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// Whether the Component has been switched into the read-only state.
+	//
+	// +optional
+	Fenced bool `json:"fenced,omitempty"`
+
+	// The replication lag in seconds reported by the `replicationLagProbe` lifecycle action.
+	//
+	// +optional
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+
+	// The last time the replication lag was reported.
+	//
+	// +optional
+	LastLagProbeTime *metav1.Time `json:"lastLagProbeTime,omitempty"`
 }
 
 type Sidecar struct {
@@ -526,6 +526,9 @@ type ComponentDefinitionSpec struct {
 	//   - `dataLoad`: Defines the procedure to import data into a replica.
 	//   - `reconfigure`: Defines the procedure that update a replica with new configuration file.
 	//   - `accountProvision`: Defines the procedure to generate a new database account.
+	//   - `replicationSetup`: Defines the procedure to replicate from the Component of another Cluster.
+	//   - `promote`: Defines the procedure to promote a standby Component to a primary.
+	//   - `replicationLagProbe`: Defines the procedure which is invoked regularly to assess the replication lag.
 	//
 	// This field is immutable.
 	//
@@ -1788,6 +1791,29 @@ type ComponentLifecycleActions struct {
 	// +optional
 	Reconfigure *Action `json:"reconfigure,omitempty"`
 
+	// Defines the procedure to query the live values of parameters from a replica.
+	//
+	// Use Case:
+	// This action is used to detect the drift between the rendered configuration and the values that the database
+	// engine is actually running with, e.g. the parameters changed manually, or the static parameters which are
+	// waiting for a restart to take effect.
+	//
+	// The container executing this action has access to following variables:
+	//
+	// - KB_PARAMETER_NAMES: The names of the parameters to query, separated by commas.
+	//
+	// Expected output of this action:
+	// - On Success: A JSON object that maps the names of the parameters to their live values, in the same form as
+	//   they are written in the configuration file. The parameters unknown to the engine can be omitted.
+	// - On Failure: An error message, if applicable, indicating why the action failed.
+	//
+	// The action is invoked on each replica individually, so the `targetPodSelector` should not be specified.
+	//
+	// Note: This field is immutable once it has been set.
+	//
+	// +optional
+	ParameterQuery *Action `json:"parameterQuery,omitempty"`
+
 	// Defines the procedure to generate a new database account.
 	//
 	// Use Case:
@@ -1804,6 +1830,42 @@ type ComponentLifecycleActions struct {
 	//
 	// +optional
 	AccountProvision *Action `json:"accountProvision,omitempty"`
+
+	// Defines the procedure to make a replica replicate from the Component of another Cluster,
+	// which turns the Component into a disaster-recovery standby.
+	//
+	// The action should be idempotent, it may be invoked again when the source changes.
+	//
+	// The container executing this action has access to following variables:
+	//
+	// - KB_REPLICATION_SOURCE_NAMESPACE: The namespace of the source Cluster.
+	// - KB_REPLICATION_SOURCE_CLUSTER_NAME: The name of the source Cluster.
+	// - KB_REPLICATION_SOURCE_COMP_NAME: The name of the source Component.
+	// - KB_REPLICATION_SOURCE_HOST: The host of the default Service of the source Component.
+	// - KB_REPLICATION_SOURCE_PLACEMENT: The data plane the source Cluster is placed in, if any.
+	//
+	// Note: This field is immutable once it has been set.
+	//
+	// +optional
+	ReplicationSetup *Action `json:"replicationSetup,omitempty"`
+
+	// Defines the procedure to stop replicating from the source and promote a standby Component to a primary.
+	//
+	// Note: This field is immutable once it has been set.
+	//
+	// +optional
+	Promote *Action `json:"promote,omitempty"`
+
+	// Defines the procedure which is invoked regularly to assess the replication lag of a standby Component.
+	//
+	// Expected output of this action:
+	// - On Success: The replication lag in seconds, as a non-negative integer.
+	// - On Failure: An error message, if applicable, indicating why the action failed.
+	//
+	// Note: This field is immutable once it has been set.
+	//
+	// +optional
+	ReplicationLagProbe *Probe `json:"replicationLagProbe,omitempty"`
 }
 
 // Action defines a customizable hook or procedure tailored for different database engines,
@@ -1830,6 +1892,9 @@ type ComponentLifecycleActions struct {
 //   - `dataLoad`: Defines the procedure to import data into a replica.
 //   - `reconfigure`: Defines the procedure that update a replica with new configuration.
 //   - `accountProvision`: Defines the procedure to generate a new database account.
+//   - `replicationSetup`: Defines the procedure to replicate from the Component of another Cluster.
+//   - `promote`: Defines the procedure to promote a standby Component to a primary.
+//   - `replicationLagProbe`: Defines the procedure which is invoked regularly to assess the replication lag.
 //
 // Actions can be executed in different ways:
 //
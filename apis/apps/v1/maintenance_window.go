@@ -0,0 +1,180 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// ReconfigureRestartOperation is the operation name of the restart triggered by a parameter change.
+	ReconfigureRestartOperation = "ReconfigureRestart"
+
+	// RolloutReplaceOperation is the operation name of the instance replacement carried out by a Rollout.
+	RolloutReplaceOperation = "RolloutReplace"
+)
+
+// defaultDisruptiveOperations lists the operations that are disruptive unless overridden by the policy.
+// Switchover and RebuildInstance are usually requested to recover from failures, they are not deferred by default.
+var defaultDisruptiveOperations = []string{
+	"Restart",
+	"Upgrade",
+	"VerticalScaling",
+	"Stop",
+	ReconfigureRestartOperation,
+	RolloutReplaceOperation,
+}
+
+// maxMaintenanceWindowSteps bounds the search of the next allowed time, so that overlapping
+// blackout periods and windows can not loop forever.
+const maxMaintenanceWindowSteps = 64
+
+// IsDisruptive returns whether the operation has to wait for a maintenance window.
+func (p *MaintenanceWindowPolicy) IsDisruptive(operation string) bool {
+	if p == nil {
+		return false
+	}
+	for _, op := range p.Operations {
+		if op.Name == operation {
+			return op.Disruptive
+		}
+	}
+	return slices.Contains(defaultDisruptiveOperations, operation)
+}
+
+// Allows checks whether the operation is allowed to be carried out at the given time.
+// If not, it also returns the time at which the operation will be allowed next.
+func (p *MaintenanceWindowPolicy) Allows(operation string, now time.Time) (bool, time.Time, error) {
+	if !p.IsDisruptive(operation) {
+		return true, now, nil
+	}
+	next, err := p.NextAllowedTime(now)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	return !next.After(now), next, nil
+}
+
+// NextAllowedTime returns the earliest time, not before the given time, at which disruptive operations are allowed.
+func (p *MaintenanceWindowPolicy) NextAllowedTime(now time.Time) (time.Time, error) {
+	if p == nil {
+		return now, nil
+	}
+	loc, err := time.LoadLocation(ptr.Deref(p.TimeZone, "UTC"))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid maintenance window time zone: %w", err)
+	}
+	for _, w := range p.Windows {
+		if _, _, err := w.startOfDay(); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	t := now.In(loc)
+	for i := 0; i < maxMaintenanceWindowSteps; i++ {
+		if b := p.blackoutAt(t); b != nil {
+			t = b.End.In(loc)
+			continue
+		}
+		if len(p.Windows) == 0 || p.inWindow(t) {
+			return t, nil
+		}
+		start, ok := p.nextWindowStart(t)
+		if !ok {
+			return time.Time{}, fmt.Errorf("no maintenance window will be open")
+		}
+		t = start
+	}
+	return time.Time{}, fmt.Errorf("no maintenance window is open within %d windows", maxMaintenanceWindowSteps)
+}
+
+func (p *MaintenanceWindowPolicy) blackoutAt(t time.Time) *BlackoutPeriod {
+	for i, b := range p.BlackoutPeriods {
+		if !t.Before(b.Start.Time) && t.Before(b.End.Time) {
+			return &p.BlackoutPeriods[i]
+		}
+	}
+	return nil
+}
+
+func (p *MaintenanceWindowPolicy) inWindow(t time.Time) bool {
+	for _, w := range p.Windows {
+		if w.Duration.Duration <= 0 {
+			continue
+		}
+		// a window may span multiple days, check the windows opened on the previous days as well
+		days := int(w.Duration.Duration/(24*time.Hour)) + 1
+		for k := 0; k <= days; k++ {
+			start, ok := w.startAt(t, -k)
+			if ok && !t.Before(start) && t.Before(start.Add(w.Duration.Duration)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *MaintenanceWindowPolicy) nextWindowStart(t time.Time) (time.Time, bool) {
+	var (
+		next  time.Time
+		found bool
+	)
+	for _, w := range p.Windows {
+		if w.Duration.Duration <= 0 {
+			continue
+		}
+		for k := 0; k <= 7; k++ {
+			start, ok := w.startAt(t, k)
+			if ok && start.After(t) {
+				if !found || start.Before(next) {
+					next, found = start, true
+				}
+				break
+			}
+		}
+	}
+	return next, found
+}
+
+// startAt returns the start time of the window on the day offset by the given days from t,
+// and false if the window does not open on that day.
+func (w MaintenanceWindow) startAt(t time.Time, offset int) (time.Time, bool) {
+	hour, minute, err := w.startOfDay()
+	if err != nil {
+		return time.Time{}, false
+	}
+	start := time.Date(t.Year(), t.Month(), t.Day()+offset, hour, minute, 0, 0, t.Location())
+	if len(w.DaysOfWeek) > 0 && !slices.Contains(w.DaysOfWeek, MaintenanceWeekday(start.Weekday().String())) {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+func (w MaintenanceWindow) startOfDay() (int, int, error) {
+	t, err := time.Parse("15:04", w.StartTime)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maintenance window start time %q: %w", w.StartTime, err)
+	}
+	return t.Hour(), t.Minute(), nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestMaintenanceWindowPolicyIsDisruptive(t *testing.T) {
+	policy := &MaintenanceWindowPolicy{
+		Operations: []MaintenanceOperation{
+			{Name: "Restart", Disruptive: false},
+			{Name: "HorizontalScaling", Disruptive: true},
+		},
+	}
+	tests := []struct {
+		operation string
+		want      bool
+	}{
+		{operation: "Restart", want: false},
+		{operation: "HorizontalScaling", want: true},
+		{operation: "Upgrade", want: true},
+		{operation: ReconfigureRestartOperation, want: true},
+		{operation: "Expose", want: false},
+		{operation: "Switchover", want: false},
+		{operation: "RebuildInstance", want: false},
+	}
+	for _, tt := range tests {
+		if got := policy.IsDisruptive(tt.operation); got != tt.want {
+			t.Errorf("IsDisruptive(%s) = %v, want %v", tt.operation, got, tt.want)
+		}
+	}
+
+	var nilPolicy *MaintenanceWindowPolicy
+	if nilPolicy.IsDisruptive("Restart") {
+		t.Errorf("nil policy should not treat any operation as disruptive")
+	}
+}
+
+func TestMaintenanceWindowPolicyNextAllowedTime(t *testing.T) {
+	// 2026-03-04 is a Wednesday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+	window := func(start string, d time.Duration, days ...MaintenanceWeekday) MaintenanceWindow {
+		return MaintenanceWindow{DaysOfWeek: days, StartTime: start, Duration: metav1.Duration{Duration: d}}
+	}
+	tests := []struct {
+		name    string
+		policy  *MaintenanceWindowPolicy
+		now     time.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:   "no windows",
+			policy: &MaintenanceWindowPolicy{},
+			now:    at(4, 10, 0),
+			want:   at(4, 10, 0),
+		},
+		{
+			name: "inside a daily window",
+			policy: &MaintenanceWindowPolicy{
+				Windows: []MaintenanceWindow{window("02:00", 4*time.Hour)},
+			},
+			now:  at(4, 3, 30),
+			want: at(4, 3, 30),
+		},
+		{
+			name: "after a daily window",
+			policy: &MaintenanceWindowPolicy{
+				Windows: []MaintenanceWindow{window("02:00", 4*time.Hour)},
+			},
+			now:  at(4, 10, 0),
+			want: at(5, 2, 0),
+		},
+		{
+			name: "window crossing midnight",
+			policy: &MaintenanceWindowPolicy{
+				Windows: []MaintenanceWindow{window("22:00", 4*time.Hour, "Tuesday")},
+			},
+			now:  at(4, 1, 0),
+			want: at(4, 1, 0),
+		},
+		{
+			name: "weekly window",
+			policy: &MaintenanceWindowPolicy{
+				Windows: []MaintenanceWindow{window("01:00", time.Hour, "Saturday", "Sunday")},
+			},
+			now:  at(4, 10, 0),
+			want: at(7, 1, 0),
+		},
+		{
+			name: "blackout covering the next window",
+			policy: &MaintenanceWindowPolicy{
+				Windows: []MaintenanceWindow{window("02:00", 4*time.Hour)},
+				BlackoutPeriods: []BlackoutPeriod{
+					{Start: metav1.NewTime(at(4, 0, 0)), End: metav1.NewTime(at(6, 0, 0))},
+				},
+			},
+			now:  at(4, 3, 0),
+			want: at(6, 2, 0),
+		},
+		{
+			name: "blackout ending inside a window",
+			policy: &MaintenanceWindowPolicy{
+				Windows: []MaintenanceWindow{window("02:00", 4*time.Hour)},
+				BlackoutPeriods: []BlackoutPeriod{
+					{Start: metav1.NewTime(at(4, 0, 0)), End: metav1.NewTime(at(4, 3, 0))},
+				},
+			},
+			now:  at(4, 2, 30),
+			want: at(4, 3, 0),
+		},
+		{
+			name: "time zone",
+			policy: &MaintenanceWindowPolicy{
+				Windows:  []MaintenanceWindow{window("02:00", time.Hour)},
+				TimeZone: ptr.To("Asia/Shanghai"),
+			},
+			now:  at(4, 10, 0),
+			want: at(4, 18, 0),
+		},
+		{
+			name: "invalid time zone",
+			policy: &MaintenanceWindowPolicy{
+				TimeZone: ptr.To("Nowhere/Land"),
+			},
+			now:     at(4, 10, 0),
+			wantErr: true,
+		},
+		{
+			name: "invalid start time",
+			policy: &MaintenanceWindowPolicy{
+				Windows: []MaintenanceWindow{window("25:00", time.Hour)},
+			},
+			now:     at(4, 10, 0),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.policy.NextAllowedTime(tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NextAllowedTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("NextAllowedTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowPolicyAllows(t *testing.T) {
+	policy := &MaintenanceWindowPolicy{
+		Windows: []MaintenanceWindow{{StartTime: "02:00", Duration: metav1.Duration{Duration: time.Hour}}},
+	}
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	allowed, next, err := policy.Allows("Restart", now)
+	if err != nil || allowed || !next.Equal(time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("Allows(Restart) = %v, %v, %v", allowed, next, err)
+	}
+	allowed, _, err = policy.Allows("HorizontalScaling", now)
+	if err != nil || !allowed {
+		t.Errorf("Allows(HorizontalScaling) = %v, %v", allowed, err)
+	}
+}
@@ -18,6 +18,8 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -48,6 +50,7 @@ const (
 	ConditionTypeReady               = "Ready"               // ConditionTypeReady all components and shardings are running
 	ConditionTypeAvailable           = "Available"           // ConditionTypeAvailable indicates whether the target object is available for serving.
 	ConditionTypeRestore             = "Restore"             // ConditionTypeRestore indicates whether the initial cluster restore has completed.
+	ConditionTypeBackupRepoHealthy   = "BackupRepoHealthy"   // ConditionTypeBackupRepoHealthy indicates whether the backup repository used by the cluster is healthy.
 )
 
 type ServiceRef struct {
@@ -234,6 +237,75 @@ const (
 	DeletePersistentVolumeClaimRetentionPolicyType PersistentVolumeClaimRetentionPolicyType = "Delete"
 )
 
+// VolumeAutoExpansionPolicy defines how the volumes of a Component are expanded automatically
+// when their space utilization crosses the threshold.
+type VolumeAutoExpansionPolicy struct {
+	// Specifies the names of the volumeClaimTemplates to expand automatically.
+	//
+	// Enabling the policy mounts these volumes into the kbagent container as read-only to collect
+	// the filesystem stats, which triggers a rolling update of the Pods.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Volumes []string `json:"volumes"`
+
+	// Specifies the space utilization as a percentage (1-100) to trigger an expansion.
+	//
+	// If not specified, the `highWatermark` of the volume defined in the ComponentDefinition is used,
+	// and 80 is used if neither is set.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Threshold *int32 `json:"threshold,omitempty"`
+
+	// Specifies the size to grow the volume by on each expansion.
+	// It can be an absolute quantity (e.g. "10Gi") or a percentage of the current size (e.g. "20%").
+	//
+	// +kubebuilder:validation:Pattern=`^([0-9]+%|[0-9]+(\.[0-9]+)?(Ki|Mi|Gi|Ti|Pi|Ei|k|M|G|T|P|E)?)$`
+	// +kubebuilder:default="20%"
+	// +optional
+	Step string `json:"step,omitempty"`
+
+	// Specifies the maximum size the volume can be expanded to.
+	//
+	// +kubebuilder:validation:Required
+	MaxSize resource.Quantity `json:"maxSize"`
+
+	// Specifies the minimum interval in seconds between two expansions of the same volume.
+	//
+	// Note that some cloud providers limit how often a volume can be modified.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=3600
+	// +optional
+	CooldownSeconds *int32 `json:"cooldownSeconds,omitempty"`
+}
+
+// VolumeExpansionRecord records an expansion of a volume issued by the VolumeAutoExpansionPolicy.
+type VolumeExpansionRecord struct {
+	// The name of the volumeClaimTemplate expanded.
+	Volume string `json:"volume"`
+
+	// The size of the volume before the expansion.
+	From resource.Quantity `json:"from"`
+
+	// The size of the volume requested by the expansion.
+	To resource.Quantity `json:"to"`
+
+	// The highest space utilization as a percentage observed when the expansion was triggered.
+	//
+	// +optional
+	Utilization int32 `json:"utilization,omitempty"`
+
+	// The name of the VolumeExpansion OpsRequest issued.
+	OpsRequest string `json:"opsRequest"`
+
+	// The time when the expansion was triggered.
+	Time metav1.Time `json:"time"`
+}
+
 type ComponentNetwork struct {
 	// Host networking requested for this pod. Use the host's network namespace.
 	//
@@ -553,6 +625,11 @@ type ClusterComponentConfig struct {
 	// +optional
 	ExternalManaged *bool `json:"externalManaged,omitempty"`
 
+	// Tuning selects a tuning profile to derive parameter values of the configuration from the resources of the Component.
+	//
+	// +optional
+	Tuning *ConfigTuning `json:"tuning,omitempty"`
+
 	// Represents a checksum or hash of the configuration content.
 	//
 	// The controller uses this value to detect changes and determine if a reconfiguration or restart
@@ -589,6 +666,39 @@ type ClusterComponentConfig struct {
 	//
 	// +optional
 	ReconfigureArgs [][]string `json:"reconfigureArgs,omitempty"`
+
+	// Specifies the instances to apply the updated configuration to.
+	//
+	// The controller uses this value to roll out the updated configuration in stages.
+	// The updated configuration is applied to all the instances if it is empty.
+	//
+	// +optional
+	Instances []string `json:"instances,omitempty"`
+}
+
+// ConfigTuning specifies the tuning profile applied to a configuration.
+type ConfigTuning struct {
+	// Specifies the name of the tuning profile, e.g. "oltp", "analytics" or "low-memory".
+	//
+	// The profile is looked up among the `tuningProfiles` of the configuration files of the template,
+	// as declared in the ParamConfigRenderer of the ComponentDefinition.
+	// The formulas of the profile are re-evaluated each time the configuration is rendered, e.g. after a vertical
+	// or horizontal scaling, and the derived values go through the same validation and reload process as the
+	// parameters set by the user. Parameters set explicitly by the user take precedence over the derived values.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([a-z0-9\-]*[a-z0-9])?$`
+	Profile string `json:"profile"`
+
+	// Specifies whether to only preview the profile.
+	//
+	// When set to true, the derived values are evaluated and reported in the status of the ComponentParameter,
+	// along with the current values in the configuration, but they are not applied.
+	// The errors evaluating the profile are reported in the status as well, rather than failing the configuration.
+	//
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
 // ClusterComponentConfigSource represents the source of a configuration for a component.
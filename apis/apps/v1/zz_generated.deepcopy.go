@@ -127,6 +127,23 @@ func (in *ActionOutputMatcher) DeepCopy() *ActionOutputMatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutPeriod) DeepCopyInto(out *BlackoutPeriod) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutPeriod.
+func (in *BlackoutPeriod) DeepCopy() *BlackoutPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -210,6 +227,11 @@ func (in *ClusterComponentConfig) DeepCopyInto(out *ClusterComponentConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(ConfigTuning)
+		**out = **in
+	}
 	if in.ConfigHash != nil {
 		in, out := &in.ConfigHash, &out.ConfigHash
 		*out = new(string)
@@ -241,6 +263,11 @@ func (in *ClusterComponentConfig) DeepCopyInto(out *ClusterComponentConfig) {
 			}
 		}
 	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentConfig.
@@ -349,6 +376,11 @@ func (in *ClusterComponentSpec) DeepCopyInto(out *ClusterComponentSpec) {
 		*out = new(PersistentVolumeClaimRetentionPolicy)
 		**out = **in
 	}
+	if in.VolumeAutoExpansion != nil {
+		in, out := &in.VolumeAutoExpansion, &out.VolumeAutoExpansion
+		*out = new(VolumeAutoExpansionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]corev1.Volume, len(*in))
@@ -622,6 +654,33 @@ func (in *ClusterObjectReference) DeepCopy() *ClusterObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterReplicationStatus) DeepCopyInto(out *ClusterReplicationStatus) {
+	*out = *in
+	if in.LagSeconds != nil {
+		in, out := &in.LagSeconds, &out.LagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Standbys != nil {
+		in, out := &in.Standbys, &out.Standbys
+		*out = make([]ClusterStandbyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterReplicationStatus.
+func (in *ClusterReplicationStatus) DeepCopy() *ClusterReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterRestore) DeepCopyInto(out *ClusterRestore) {
 	*out = *in
@@ -780,6 +839,16 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(ClusterRestore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceWindowPolicy != nil {
+		in, out := &in.MaintenanceWindowPolicy, &out.MaintenanceWindowPolicy
+		*out = new(MaintenanceWindowPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Standby != nil {
+		in, out := &in.Standby, &out.Standby
+		*out = new(ClusterStandby)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -792,6 +861,41 @@ func (in *ClusterSpec) DeepCopy() *ClusterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStandby) DeepCopyInto(out *ClusterStandby) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStandby.
+func (in *ClusterStandby) DeepCopy() *ClusterStandby {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStandby)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStandbyStatus) DeepCopyInto(out *ClusterStandbyStatus) {
+	*out = *in
+	if in.LagSeconds != nil {
+		in, out := &in.LagSeconds, &out.LagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStandbyStatus.
+func (in *ClusterStandbyStatus) DeepCopy() *ClusterStandbyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStandbyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	*out = *in
@@ -816,6 +920,11 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ClusterReplicationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -1484,11 +1593,31 @@ func (in *ComponentLifecycleActions) DeepCopyInto(out *ComponentLifecycleActions
 		*out = new(Action)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ParameterQuery != nil {
+		in, out := &in.ParameterQuery, &out.ParameterQuery
+		*out = new(Action)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AccountProvision != nil {
 		in, out := &in.AccountProvision, &out.AccountProvision
 		*out = new(Action)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplicationSetup != nil {
+		in, out := &in.ReplicationSetup, &out.ReplicationSetup
+		*out = new(Action)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Promote != nil {
+		in, out := &in.Promote, &out.Promote
+		*out = new(Action)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicationLagProbe != nil {
+		in, out := &in.ReplicationLagProbe, &out.ReplicationLagProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentLifecycleActions.
@@ -1570,6 +1699,65 @@ func (in *ComponentNetwork) DeepCopy() *ComponentNetwork {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReplication) DeepCopyInto(out *ComponentReplication) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(ComponentReplicationSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReplication.
+func (in *ComponentReplication) DeepCopy() *ComponentReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReplicationSource) DeepCopyInto(out *ComponentReplicationSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReplicationSource.
+func (in *ComponentReplicationSource) DeepCopy() *ComponentReplicationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReplicationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReplicationStatus) DeepCopyInto(out *ComponentReplicationStatus) {
+	*out = *in
+	if in.LagSeconds != nil {
+		in, out := &in.LagSeconds, &out.LagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastLagProbeTime != nil {
+		in, out := &in.LastLagProbeTime, &out.LastLagProbeTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReplicationStatus.
+func (in *ComponentReplicationStatus) DeepCopy() *ComponentReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentService) DeepCopyInto(out *ComponentService) {
 	*out = *in
@@ -1640,6 +1828,16 @@ func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
 		*out = new(PersistentVolumeClaimRetentionPolicy)
 		**out = **in
 	}
+	if in.VolumeAutoExpansion != nil {
+		in, out := &in.VolumeAutoExpansion, &out.VolumeAutoExpansion
+		*out = new(VolumeAutoExpansionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ComponentReplication)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]corev1.Volume, len(*in))
@@ -1777,6 +1975,18 @@ func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.VolumeExpansions != nil {
+		in, out := &in.VolumeExpansions, &out.VolumeExpansions
+		*out = make([]VolumeExpansionRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ComponentReplicationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
@@ -2056,6 +2266,21 @@ func (in *ComponentVolume) DeepCopy() *ComponentVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigTuning) DeepCopyInto(out *ConfigTuning) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTuning.
+func (in *ConfigTuning) DeepCopy() *ConfigTuning {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigTuning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionCredentialAuth) DeepCopyInto(out *ConnectionCredentialAuth) {
 	*out = *in
@@ -2600,6 +2825,81 @@ func (in *LogConfig) DeepCopy() *LogConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceOperation) DeepCopyInto(out *MaintenanceOperation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceOperation.
+func (in *MaintenanceOperation) DeepCopy() *MaintenanceOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]MaintenanceWeekday, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowPolicy) DeepCopyInto(out *MaintenanceWindowPolicy) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.BlackoutPeriods != nil {
+		in, out := &in.BlackoutPeriods, &out.BlackoutPeriods
+		*out = make([]BlackoutPeriod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]MaintenanceOperation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowPolicy.
+func (in *MaintenanceWindowPolicy) DeepCopy() *MaintenanceWindowPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultipleClusterObjectCombinedOption) DeepCopyInto(out *MultipleClusterObjectCombinedOption) {
 	*out = *in
@@ -4092,3 +4392,52 @@ func (in *VarSource) DeepCopy() *VarSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAutoExpansionPolicy) DeepCopyInto(out *VolumeAutoExpansionPolicy) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(int32)
+		**out = **in
+	}
+	out.MaxSize = in.MaxSize.DeepCopy()
+	if in.CooldownSeconds != nil {
+		in, out := &in.CooldownSeconds, &out.CooldownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAutoExpansionPolicy.
+func (in *VolumeAutoExpansionPolicy) DeepCopy() *VolumeAutoExpansionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAutoExpansionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeExpansionRecord) DeepCopyInto(out *VolumeExpansionRecord) {
+	*out = *in
+	out.From = in.From.DeepCopy()
+	out.To = in.To.DeepCopy()
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeExpansionRecord.
+func (in *VolumeExpansionRecord) DeepCopy() *VolumeExpansionRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeExpansionRecord)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -85,6 +87,20 @@ type BackupSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.parameters"
 	// +optional
 	Parameters []ParameterPair `json:"parameters,omitempty"`
+
+	// Places the backup under legal hold.
+	// A backup under legal hold can not be deleted, neither by users nor by the
+	// retention or garbage collection of the controller, until the hold is released.
+	//
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
+
+	// Specifies the time until which the backup is locked against deletion.
+	// While the lock is in effect, the backup can not be deleted and the time
+	// can only be extended.
+	//
+	// +optional
+	LockUntil *metav1.Time `json:"lockUntil,omitempty"`
 }
 
 // BackupStatus defines the observed state of Backup.
@@ -136,6 +152,11 @@ type BackupStatus struct {
 	// +optional
 	FailureReason string `json:"failureReason,omitempty"`
 
+	// Records the reason why the backup is waiting in the `Pending` phase.
+	//
+	// +optional
+	PendingReason string `json:"pendingReason,omitempty"`
+
 	// Any error or blocker encountered while deleting the Backup and its data.
 	// This field does not replace FailureReason, which records a failure of the
 	// backup operation itself.
@@ -191,6 +212,12 @@ type BackupStatus struct {
 	// +optional
 	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty"`
 
+	// Records the data key used to encrypt the backup data when the envelope
+	// encryption is enabled by `encryptionConfig.keyManagement`.
+	//
+	// +optional
+	EncryptionKey *BackupEncryptionKey `json:"encryptionKey,omitempty"`
+
 	// Records the actions status for this backup.
 	//
 	// +optional
@@ -213,6 +240,25 @@ type BackupStatus struct {
 	// +optional
 	BaseBackupName string `json:"baseBackupName,omitempty"`
 
+	// Records the result of the last verification of the backup, which restores the backup into
+	// a throw-away namespace and verifies the restored data.
+	//
+	// +optional
+	Verification *BackupVerificationResult `json:"verification,omitempty"`
+
+	// Records the copies of the backup replicated to the secondary backup repositories.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=backupRepoName
+	Copies []BackupCopyStatus `json:"copies,omitempty"`
+
+	// Records the result of the tiered retention policy of the backup schedule, which explains
+	// why the backup is kept or expired.
+	//
+	// +optional
+	Retention *BackupRetentionStatus `json:"retention,omitempty"`
+
 	// Records any additional information for the backup.
 	//
 	// +optional
@@ -239,6 +285,145 @@ type BackupTimeRange struct {
 	End *metav1.Time `json:"end,omitempty"`
 }
 
+// BackupVerificationResult records the result of a backup verification.
+type BackupVerificationResult struct {
+	// The result of the verification, either `Verified` or `Failed`.
+	//
+	// +kubebuilder:validation:Required
+	Phase BackupVerificationRunPhase `json:"phase"`
+
+	// The name of the BackupVerification that verified the backup.
+	//
+	// +optional
+	VerificationName string `json:"verificationName,omitempty"`
+
+	// Records the time the verification was completed.
+	//
+	// +optional
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+
+	// Records the reason why the verification failed.
+	//
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// BackupCopyPhase is the phase of a backup copy.
+// +enum
+// +kubebuilder:validation:Enum={Running,Completed,Failed,Deleting,Expired}
+type BackupCopyPhase string
+
+const (
+	// BackupCopyPhaseRunning means the backup data is being copied to the secondary repository.
+	BackupCopyPhaseRunning BackupCopyPhase = "Running"
+
+	// BackupCopyPhaseCompleted means the copy is completed and can be used for restores.
+	BackupCopyPhaseCompleted BackupCopyPhase = "Completed"
+
+	// BackupCopyPhaseFailed means the copy failed, it will be retried later.
+	BackupCopyPhaseFailed BackupCopyPhase = "Failed"
+
+	// BackupCopyPhaseDeleting means the copy is expired and its data is being deleted.
+	BackupCopyPhaseDeleting BackupCopyPhase = "Deleting"
+
+	// BackupCopyPhaseExpired means the copy is expired and its data has been deleted.
+	BackupCopyPhaseExpired BackupCopyPhase = "Expired"
+)
+
+// BackupCopyStatus records the status of a backup copy stored in a secondary backup repository.
+type BackupCopyStatus struct {
+	// The name of the BackupRepo where the copy is stored.
+	//
+	// +kubebuilder:validation:Required
+	BackupRepoName string `json:"backupRepoName"`
+
+	// The current phase of the copy.
+	//
+	// +optional
+	Phase BackupCopyPhase `json:"phase,omitempty"`
+
+	// The path of the copy in the backup repository, it is the same as `status.path`.
+	//
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Records the time the last copy was started.
+	//
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// Records the time the last copy was completed.
+	//
+	// +optional
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// The date and time when the copy will be deleted from the backup repository.
+	//
+	// +optional
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+
+	// Records the time range of the backed up data contained in the copy.
+	// For continuous backups, the copy is synchronized periodically, and the end time
+	// advances with each synchronization.
+	//
+	// +optional
+	TimeRange *BackupTimeRange `json:"timeRange,omitempty"`
+
+	// Records the reason why the copy failed.
+	//
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// BackupRetentionDecision is the decision of the tiered retention policy for a backup.
+// +enum
+// +kubebuilder:validation:Enum={Keep,Expire}
+type BackupRetentionDecision string
+
+const (
+	BackupRetentionDecisionKeep   BackupRetentionDecision = "Keep"
+	BackupRetentionDecisionExpire BackupRetentionDecision = "Expire"
+)
+
+// BackupRetentionReason is the reason why a backup is kept by the tiered retention policy.
+// +enum
+// +kubebuilder:validation:Enum={Hourly,Daily,Weekly,Monthly,Yearly,Dependency,PITRBase}
+type BackupRetentionReason string
+
+const (
+	BackupRetentionReasonHourly  BackupRetentionReason = "Hourly"
+	BackupRetentionReasonDaily   BackupRetentionReason = "Daily"
+	BackupRetentionReasonWeekly  BackupRetentionReason = "Weekly"
+	BackupRetentionReasonMonthly BackupRetentionReason = "Monthly"
+	BackupRetentionReasonYearly  BackupRetentionReason = "Yearly"
+
+	// BackupRetentionReasonDependency means the backup is the parent or base backup of a kept backup.
+	BackupRetentionReasonDependency BackupRetentionReason = "Dependency"
+
+	// BackupRetentionReasonPITRBase means the backup is the earliest base backup within the time range
+	// of a continuous backup, which is required to restore to the start of the PITR window.
+	BackupRetentionReasonPITRBase BackupRetentionReason = "PITRBase"
+)
+
+// BackupRetentionStatus records the result of the tiered retention policy for a backup.
+type BackupRetentionStatus struct {
+	// Whether the backup is kept or expired by the tiered retention policy.
+	// Expired backups are deleted by the garbage collector.
+	//
+	// +kubebuilder:validation:Required
+	Decision BackupRetentionDecision `json:"decision"`
+
+	// The reasons why the backup is kept.
+	//
+	// +optional
+	Reasons []BackupRetentionReason `json:"reasons,omitempty"`
+
+	// A human-readable message explaining the decision.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // BackupDeletionPolicy describes the policy for end-of-life maintenance of backup content.
 // +enum
 // +kubebuilder:validation:Enum={Delete,Retain}
@@ -251,7 +436,7 @@ const (
 
 // BackupPhase describes the lifecycle phase of a Backup.
 // +enum
-// +kubebuilder:validation:Enum={New,InProgress,Running,Completed,Failed,Deleting}
+// +kubebuilder:validation:Enum={New,Pending,InProgress,Running,Completed,Failed,Deleting}
 type BackupPhase string
 
 const (
@@ -259,6 +444,10 @@ const (
 	// the BackupController.
 	BackupPhaseNew BackupPhase = "New"
 
+	// BackupPhasePending means the backup is waiting for the throttle limits of
+	// the backup repository and backup policy to be satisfied.
+	BackupPhasePending BackupPhase = "Pending"
+
 	// BackupPhaseRunning means the backup is currently executing.
 	BackupPhaseRunning BackupPhase = "Running"
 
@@ -458,3 +647,12 @@ func (r *Backup) GetTimeZone() string {
 	}
 	return ""
 }
+
+// IsLocked checks if the backup is protected from deletion, either by a legal hold
+// or by a lock-until time that has not been reached yet.
+func (r *Backup) IsLocked(now time.Time) bool {
+	if r.Spec.LegalHold {
+		return true
+	}
+	return r.Spec.LockUntil != nil && r.Spec.LockUntil.After(now)
+}
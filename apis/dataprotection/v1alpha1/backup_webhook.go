@@ -0,0 +1,90 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager registers the webhook for Backup in the manager.
+func (r *Backup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&backupValidator{now: time.Now}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-dataprotection-kubeblocks-io-v1alpha1-backup,mutating=false,failurePolicy=fail,sideEffects=None,groups=dataprotection.kubeblocks.io,resources=backups,verbs=update;delete,versions=v1alpha1,name=vbackup.kb.io,admissionReviewVersions=v1
+
+// backupValidator rejects the deletion of the locked backups, and the updates
+// that shorten the lock of them.
+// +kubebuilder:object:generate=false
+type backupValidator struct {
+	now func() time.Time
+}
+
+var _ webhook.CustomValidator = &backupValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *backupValidator) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *backupValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldBackup, ok := oldObj.(*Backup)
+	if !ok {
+		return nil, fmt.Errorf("expected a Backup but got a %T", oldObj)
+	}
+	newBackup, ok := newObj.(*Backup)
+	if !ok {
+		return nil, fmt.Errorf("expected a Backup but got a %T", newObj)
+	}
+	oldLockUntil := oldBackup.Spec.LockUntil
+	if oldLockUntil == nil || !oldLockUntil.After(v.now()) {
+		return nil, nil
+	}
+	newLockUntil := newBackup.Spec.LockUntil
+	if newLockUntil == nil || newLockUntil.Before(oldLockUntil) {
+		return nil, fmt.Errorf("backup %s/%s is locked until %s, the lockUntil can only be extended",
+			newBackup.Namespace, newBackup.Name, oldLockUntil.UTC().Format(time.RFC3339))
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *backupValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	backup, ok := obj.(*Backup)
+	if !ok {
+		return nil, fmt.Errorf("expected a Backup but got a %T", obj)
+	}
+	if !backup.IsLocked(v.now()) {
+		return nil, nil
+	}
+	if backup.Spec.LegalHold {
+		return nil, fmt.Errorf("backup %s/%s is under legal hold and can not be deleted", backup.Namespace, backup.Name)
+	}
+	return nil, fmt.Errorf("backup %s/%s is locked until %s and can not be deleted",
+		backup.Namespace, backup.Name, backup.Spec.LockUntil.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,111 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBackupValidatorValidateDelete(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	v := &backupValidator{now: func() time.Time { return now }}
+	tests := []struct {
+		name    string
+		spec    BackupSpec
+		wantErr bool
+	}{
+		{
+			name: "not locked",
+		},
+		{
+			name:    "under legal hold",
+			spec:    BackupSpec{LegalHold: true},
+			wantErr: true,
+		},
+		{
+			name:    "locked until a future time",
+			spec:    BackupSpec{LockUntil: &metav1.Time{Time: now.Add(time.Hour)}},
+			wantErr: true,
+		},
+		{
+			name: "lock expired",
+			spec: BackupSpec{LockUntil: &metav1.Time{Time: now.Add(-time.Hour)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backup := &Backup{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"}, Spec: tt.spec}
+			_, err := v.ValidateDelete(context.Background(), backup)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDelete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBackupValidatorValidateUpdate(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	v := &backupValidator{now: func() time.Time { return now }}
+	lockAt := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(d)}
+	}
+	tests := []struct {
+		name    string
+		oldLock *metav1.Time
+		newLock *metav1.Time
+		wantErr bool
+	}{
+		{
+			name:    "set a lock",
+			newLock: lockAt(time.Hour),
+		},
+		{
+			name:    "extend the lock",
+			oldLock: lockAt(time.Hour),
+			newLock: lockAt(2 * time.Hour),
+		},
+		{
+			name:    "shorten the lock",
+			oldLock: lockAt(2 * time.Hour),
+			newLock: lockAt(time.Hour),
+			wantErr: true,
+		},
+		{
+			name:    "remove the lock",
+			oldLock: lockAt(time.Hour),
+			wantErr: true,
+		},
+		{
+			name:    "remove an expired lock",
+			oldLock: lockAt(-time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldBackup := &Backup{Spec: BackupSpec{LockUntil: tt.oldLock}}
+			newBackup := &Backup{Spec: BackupSpec{LockUntil: tt.newLock}}
+			_, err := v.ValidateUpdate(context.Background(), oldBackup, newBackup)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupCatalogSpec defines the content of a backup.
+type BackupCatalogSpec struct {
+	// Specifies the name of the backup that the catalog describes.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.backupName"
+	BackupName string `json:"backupName"`
+
+	// Lists the content of the backup, such as databases, tables and files.
+	//
+	// +optional
+	Entries []BackupCatalogEntry `json:"entries,omitempty"`
+
+	// Lists the kinds of entries that can be restored selectively from the backup.
+	// For example, `Database` means that the databases of the backup can be restored
+	// individually by specifying `spec.scope.databases` of the `Restore`.
+	//
+	// +listType=set
+	// +optional
+	RestorableKinds []BackupCatalogEntryKind `json:"restorableKinds,omitempty"`
+}
+
+// BackupCatalogEntryKind defines the kind of the entry in the backup catalog.
+// +enum
+// +kubebuilder:validation:Enum={Database,Table,File}
+type BackupCatalogEntryKind string
+
+const (
+	BackupCatalogEntryKindDatabase BackupCatalogEntryKind = "Database"
+	BackupCatalogEntryKindTable    BackupCatalogEntryKind = "Table"
+	BackupCatalogEntryKindFile     BackupCatalogEntryKind = "File"
+)
+
+// BackupCatalogEntry describes an item backed up in the backup.
+type BackupCatalogEntry struct {
+	// Specifies the kind of the entry.
+	//
+	// +kubebuilder:validation:Required
+	Kind BackupCatalogEntryKind `json:"kind"`
+
+	// Specifies the name of the entry. For a file, it's the path relative to the backup path.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Specifies the database that the entry belongs to, only used by the tables.
+	//
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// Specifies the size of the entry, such as "1Gi".
+	//
+	// +optional
+	Size string `json:"size,omitempty"`
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={kubeblocks},scope=Namespaced,shortName=bcat
+// +kubebuilder:printcolumn:name="BACKUP",type=string,JSONPath=`.spec.backupName`
+// +kubebuilder:printcolumn:name="RESTORABLE-KINDS",type=string,JSONPath=`.spec.restorableKinds`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BackupCatalog is the Schema for the backupcatalogs API.
+// It describes what is inside a backup and which selective restores are possible from it.
+// It is created by the controller from the manifest emitted by the backup action, and is
+// owned by the backup.
+type BackupCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BackupCatalogSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupCatalogList contains a list of BackupCatalog.
+type BackupCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupCatalog `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BackupCatalog{}, &BackupCatalogList{})
+}
+
+// IsRestorable checks if the entries of the kind can be restored selectively.
+func (r *BackupCatalog) IsRestorable(kind BackupCatalogEntryKind) bool {
+	for _, k := range r.Spec.RestorableKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEntry checks if the catalog contains the entry.
+func (r *BackupCatalog) HasEntry(kind BackupCatalogEntryKind, database, name string) bool {
+	for _, e := range r.Spec.Entries {
+		if e.Kind == kind && e.Database == database && e.Name == name {
+			return true
+		}
+	}
+	return false
+}
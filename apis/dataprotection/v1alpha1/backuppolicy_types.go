@@ -84,6 +84,51 @@ type BackupPolicySpec struct {
 	//
 	// +optional
 	RetentionPolicy BackupPolicyRetentionPolicy `json:"retentionPolicy,omitempty"`
+
+	// Specifies the policy for replicating the backups to a secondary backup repository.
+	// Once a backup is completed, its data is copied asynchronously to the secondary
+	// repository, and the copy is recorded in `backup.status.copies`.
+	// Restores can use a healthy copy when the primary backup repository is unavailable.
+	// The volume snapshot backups and the backups stored in a kopia repository are not replicated.
+	//
+	// +optional
+	Replication *BackupReplicationPolicy `json:"replication,omitempty"`
+
+	// Specifies the throttle policy for the backups of this policy. The concurrency limits
+	// count the backups of this policy only, and the backups are also limited by the
+	// throttle policy of the backup repository. For the bandwidth, the smaller limit wins.
+	//
+	// +optional
+	Throttle *ThrottlePolicy `json:"throttle,omitempty"`
+
+	// Specifies the priority of the backups of this policy when they are throttled.
+	// The pending backups with higher priority are started first, and the backups
+	// with the same priority are started in the order of creation.
+	//
+	// +kubebuilder:default=0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// BackupReplicationPolicy describes how the backups are replicated to a secondary backup repository.
+type BackupReplicationPolicy struct {
+	// Specifies the name of the BackupRepo where the copies of the backups are stored.
+	// It should be different from the backup repository of the backups, and it can use
+	// another storage provider or be located in another region.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([a-z0-9\.\-]*[a-z0-9])?$`
+	BackupRepoName string `json:"backupRepoName"`
+
+	// Determines how long the copies should be kept in the secondary backup repository,
+	// counted from the time the copy is completed. Expired copies are deleted from the
+	// secondary repository, the original backups are not affected.
+	// If not set, the copy is kept until the backup is deleted.
+	//
+	// The format is the same as `backup.spec.retentionPeriod`, e.g. `7d`, `4w`, `3mo`.
+	//
+	// +optional
+	RetentionPeriod RetentionPeriod `json:"retentionPeriod,omitempty"`
 }
 
 type BackupTarget struct {
@@ -318,6 +363,52 @@ type BackupPolicyStatus struct {
 	//
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Records the contiguous time windows within which the data of the cluster can be
+	// restored to any point in time, merged from the full, incremental and continuous
+	// backups of this policy. The windows are sorted by the start time.
+	//
+	// +optional
+	RecoverableWindows []RecoverableWindow `json:"recoverableWindows,omitempty"`
+
+	// Records the gaps between the recoverable windows, the data can not be restored
+	// to the point in time within the gaps.
+	//
+	// +optional
+	RecoverableGaps []RecoverableGap `json:"recoverableGaps,omitempty"`
+}
+
+// RecoverableWindow defines a contiguous time window within which the data can be restored
+// to any point in time.
+type RecoverableWindow struct {
+	// The start time of the window, which is the earliest recoverable point in time.
+	//
+	// +kubebuilder:validation:Required
+	Start metav1.Time `json:"start"`
+
+	// The end time of the window, which is the latest recoverable point in time.
+	//
+	// +kubebuilder:validation:Required
+	End metav1.Time `json:"end"`
+
+	// The names of the continuous backups which can be used to restore to the point in time
+	// within the window.
+	//
+	// +optional
+	ContinuousBackupNames []string `json:"continuousBackupNames,omitempty"`
+}
+
+// RecoverableGap defines a time range between two recoverable windows.
+type RecoverableGap struct {
+	// The start time of the gap.
+	//
+	// +kubebuilder:validation:Required
+	Start metav1.Time `json:"start"`
+
+	// The end time of the gap.
+	//
+	// +kubebuilder:validation:Required
+	End metav1.Time `json:"end"`
 }
 
 // BackupPolicyPhase defines phases for BackupPolicy.
@@ -36,6 +36,7 @@ const (
 )
 
 // BackupRepoSpec defines the desired state of `BackupRepo`.
+// +kubebuilder:validation:XValidation:rule="!has(self.objectLock) || (has(self.accessMethod) && self.accessMethod == 'Tool')",message="objectLock requires the accessMethod to be Tool"
 type BackupRepoSpec struct {
 	// Specifies the name of the `StorageProvider` used by this backup repository.
 	//
@@ -76,6 +77,74 @@ type BackupRepoSpec struct {
 	// +kubebuilder:validation:Pattern=`^([a-zA-Z0-9-_]+/?)*$`
 	// +optional
 	PathPrefix string `json:"pathPrefix,omitempty"`
+
+	// Specifies the S3 Object Lock retention set on the objects uploaded to
+	// the backup repository, which makes the backup data immutable (WORM) until
+	// the retention period is elapsed.
+	// It requires the `StorageProvider` to support object lock, and the backup
+	// repository to be accessed by the `datasafed` tool.
+	//
+	// +optional
+	ObjectLock *BackupRepoObjectLock `json:"objectLock,omitempty"`
+
+	// Specifies the throttle policy for the backup and restore jobs which access
+	// the backup repository. The concurrency limits count all the backups stored in
+	// the backup repository, and the restore jobs are only limited by the bandwidth.
+	//
+	// +optional
+	Throttle *ThrottlePolicy `json:"throttle,omitempty"`
+
+	// Specifies the periodic health check of the backup repository.
+	// If it is not set, the backup repository is only checked when its
+	// configuration changes.
+	//
+	// +optional
+	HealthCheck *BackupRepoHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// BackupRepoHealthCheck defines the periodic health check of the backup repository.
+type BackupRepoHealthCheck struct {
+	// Specifies the interval between two health probes. Each probe writes,
+	// reads and removes a small object in the backup repository.
+	//
+	// +kubebuilder:default="10m"
+	// +optional
+	ProbeInterval metav1.Duration `json:"probeInterval,omitempty"`
+
+	// Specifies how long a health probe can run before it is considered failed.
+	//
+	// +kubebuilder:default="5m"
+	// +optional
+	ProbeTimeout metav1.Duration `json:"probeTimeout,omitempty"`
+}
+
+// ObjectLockMode defines the retention mode of the S3 Object Lock.
+// +enum
+// +kubebuilder:validation:Enum={Governance,Compliance}
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance means that the locked objects can not be deleted
+	// or overwritten unless the user has special permissions.
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+	// ObjectLockModeCompliance means that the locked objects can not be deleted
+	// or overwritten by any user, including the root user.
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
+// BackupRepoObjectLock defines the object lock settings of the backup repository.
+type BackupRepoObjectLock struct {
+	// Specifies the retention mode of the object lock.
+	//
+	// +kubebuilder:default=Governance
+	// +optional
+	Mode ObjectLockMode `json:"mode,omitempty"`
+
+	// Specifies how long the uploaded objects are locked, counted from the time
+	// they are uploaded. For example, `30d` locks the objects for 30 days.
+	//
+	// +kubebuilder:validation:Required
+	RetentionPeriod RetentionPeriod `json:"retentionPeriod"`
 }
 
 // BackupRepoStatus defines the observed state of `BackupRepo`.
@@ -121,6 +190,48 @@ type BackupRepoStatus struct {
 	//
 	// +optional
 	IsDefault bool `json:"isDefault,omitempty"`
+
+	// Records the result of the latest health probe and the usage of the
+	// backup repository.
+	//
+	// +optional
+	Health *BackupRepoHealthStatus `json:"health,omitempty"`
+}
+
+// BackupRepoHealthStatus describes the health and the usage of the backup repository.
+//
+// The usage is estimated from the Backup objects stored in the backup repository, the storage itself
+// is not measured. Monitoring the actual capacity of the storage, such as the space occupied by the
+// objects not tracked by KubeBlocks or the quota left, is out of scope, and is left to the storage provider.
+type BackupRepoHealthStatus struct {
+	// Represents the time when the latest health probe was finished.
+	//
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// Represents how long the latest health probe took.
+	//
+	// +optional
+	LastProbeDuration *metav1.Duration `json:"lastProbeDuration,omitempty"`
+
+	// Represents the estimated used capacity of the backup repository, which is the sum of
+	// the `status.totalSize` of the Backup objects stored in it. It is not the actual usage of
+	// the storage, the objects not tracked by the Backup objects, such as the leftovers of deleted
+	// backups, are not counted.
+	//
+	// +optional
+	EstimatedUsedCapacity *resource.Quantity `json:"estimatedUsedCapacity,omitempty"`
+
+	// Represents the number of the Backup objects stored in the backup repository.
+	//
+	// +optional
+	BackupCount int32 `json:"backupCount,omitempty"`
+
+	// Represents the estimated daily growth of the used capacity, which is the total size
+	// of the Backup objects completed in the last 7 days divided by 7.
+	//
+	// +optional
+	EstimatedGrowthPerDay *resource.Quantity `json:"estimatedGrowthPerDay,omitempty"`
 }
 
 // +genclient
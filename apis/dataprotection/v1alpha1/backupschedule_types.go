@@ -41,6 +41,55 @@ type BackupScheduleSpec struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
 	Schedules []SchedulePolicy `json:"schedules"`
+
+	// Specifies the tiered (grandfather-father-son) retention policy for the backups created by the schedules.
+	//
+	// When set, the completed backups created by the schedules are retained by this policy beyond
+	// the `retentionPeriod` of the schedules: the latest backup of each of the most recent N hours, days,
+	// weeks, months and years is kept, together with the backups it depends on, and the others are deleted
+	// once they are expired by the `retentionPeriod`, if any, and are not locked.
+	// The reason why each backup is kept or expired is recorded in `backup.status.retention`.
+	//
+	// Continuous backups are not affected by this policy.
+	//
+	// +optional
+	TieredRetention *TieredRetentionPolicy `json:"tieredRetention,omitempty"`
+}
+
+// TieredRetentionPolicy describes how many backups of each period are kept.
+// The periods are aligned to UTC, and the weeks start on Monday.
+//
+// +kubebuilder:validation:MinProperties=1
+type TieredRetentionPolicy struct {
+	// Specifies the number of most recent hours for which the latest backup of each hour is kept.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Hourly *int32 `json:"hourly,omitempty"`
+
+	// Specifies the number of most recent days for which the latest backup of each day is kept.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Daily *int32 `json:"daily,omitempty"`
+
+	// Specifies the number of most recent weeks for which the latest backup of each week is kept.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Weekly *int32 `json:"weekly,omitempty"`
+
+	// Specifies the number of most recent months for which the latest backup of each month is kept.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Monthly *int32 `json:"monthly,omitempty"`
+
+	// Specifies the number of most recent years for which the latest backup of each year is kept.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Yearly *int32 `json:"yearly,omitempty"`
 }
 
 type SchedulePolicy struct {
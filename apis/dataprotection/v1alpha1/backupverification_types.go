@@ -0,0 +1,270 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupVerificationSpec defines the desired state of BackupVerification.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.backupPolicyName) != has(self.backupScheduleName)",message="exactly one of backupPolicyName and backupScheduleName must be specified"
+type BackupVerificationSpec struct {
+	// Specifies the BackupPolicy whose backups are verified.
+	//
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([a-z0-9\.\-]*[a-z0-9])?$`
+	// +optional
+	BackupPolicyName string `json:"backupPolicyName,omitempty"`
+
+	// Specifies the BackupSchedule whose backups are verified.
+	//
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([a-z0-9\.\-]*[a-z0-9])?$`
+	// +optional
+	BackupScheduleName string `json:"backupScheduleName,omitempty"`
+
+	// Specifies the backup method of the backups to be verified.
+	// If not specified, backups of all the backup methods are taken into account.
+	//
+	// +optional
+	BackupMethod string `json:"backupMethod,omitempty"`
+
+	// Specifies the cron expression for the verification runs. The timezone is in UTC.
+	// see https://en.wikipedia.org/wiki/Cron.
+	//
+	// +kubebuilder:validation:Required
+	Schedule string `json:"schedule"`
+
+	// Specifies which completed backup is verified in a run:
+	//
+	// - `Latest`: verifies the latest completed backup.
+	// - `Random`: verifies a completed backup sampled at random.
+	//
+	// +kubebuilder:default=Latest
+	// +optional
+	BackupSelection BackupVerificationSelection `json:"backupSelection,omitempty"`
+
+	// Specifies the action to verify the restored data.
+	// The backup is restored into a scratch Cluster, built from the Cluster snapshot of the backup,
+	// in a throw-away namespace. The action is run after the Cluster is up and running.
+	//
+	// +kubebuilder:validation:Required
+	Action BackupVerificationAction `json:"action"`
+
+	// Specifies the maximum duration in seconds of a run, including the restoration and the verification.
+	// The run is considered failed if it is not completed in time.
+	//
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=60
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// Specifies whether the last verified backup is retained when it expires,
+	// so that there is always a backup that is known to be restorable.
+	//
+	// +kubebuilder:default=true
+	// +optional
+	RetainLastVerifiedBackup *bool `json:"retainLastVerifiedBackup,omitempty"`
+
+	// Specifies whether the verification runs are suspended.
+	//
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// BackupVerificationSelection defines which backup is verified in a run.
+// +enum
+// +kubebuilder:validation:Enum={Latest,Random}
+type BackupVerificationSelection string
+
+const (
+	BackupVerificationSelectionLatest BackupVerificationSelection = "Latest"
+	BackupVerificationSelectionRandom BackupVerificationSelection = "Random"
+)
+
+// BackupVerificationAction defines the container that verifies the restored data,
+// for example, by connecting to the restored database and checking the row counts or checksums.
+// The verification succeeds if the container exits with code 0.
+type BackupVerificationAction struct {
+	// Specifies the image of the verification container.
+	//
+	// +kubebuilder:validation:Required
+	Image string `json:"image"`
+
+	// Specifies the commands to be executed in the verification container.
+	// The name and namespace of the backup are available in the env `DP_BACKUP_NAME` and `DP_BACKUP_NAMESPACE`,
+	// and the restored database of the backup target is available in the env `DP_DB_HOST`, `DP_DB_PORT`,
+	// `DP_DB_USER` and `DP_DB_PASSWORD`.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+
+	// Specifies the environment variables of the verification container.
+	//
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Specifies the required resources of the verification container.
+	//
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// BackupVerificationStatus defines the observed state of BackupVerification.
+type BackupVerificationStatus struct {
+	// Describes the phase of the BackupVerification.
+	//
+	// +optional
+	Phase BackupVerificationPhase `json:"phase,omitempty"`
+
+	// Represents an error that makes the BackupVerification unavailable.
+	//
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// Represents the most recent generation observed for this BackupVerification.
+	//
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Records the last time a run was scheduled.
+	//
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// Records the run in progress.
+	//
+	// +optional
+	CurrentRun *BackupVerificationRun `json:"currentRun,omitempty"`
+
+	// Records the last completed run.
+	//
+	// +optional
+	LastRun *BackupVerificationRun `json:"lastRun,omitempty"`
+
+	// Records the name of the latest backup which has been verified successfully.
+	//
+	// +optional
+	LastVerifiedBackup string `json:"lastVerifiedBackup,omitempty"`
+}
+
+// BackupVerificationPhase defines the phase of BackupVerification.
+// +enum
+// +kubebuilder:validation:Enum={Available,Failed}
+type BackupVerificationPhase string
+
+const (
+	// BackupVerificationPhaseAvailable indicates the backup verification is available.
+	BackupVerificationPhaseAvailable BackupVerificationPhase = "Available"
+
+	// BackupVerificationPhaseFailed indicates the backup verification is invalid.
+	BackupVerificationPhaseFailed BackupVerificationPhase = "Failed"
+)
+
+// BackupVerificationRun records a run of the backup verification.
+type BackupVerificationRun struct {
+	// The name of the verified backup.
+	//
+	// +kubebuilder:validation:Required
+	BackupName string `json:"backupName"`
+
+	// The throw-away namespace where the backup is restored into a scratch Cluster.
+	//
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+
+	// The current phase of the run.
+	//
+	// +optional
+	Phase BackupVerificationRunPhase `json:"phase,omitempty"`
+
+	// Records the time the run was started.
+	//
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// Records the time the run was completed.
+	//
+	// +optional
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Records the reason why the run failed.
+	//
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// BackupVerificationRunPhase defines the phase of a backup verification run.
+// +enum
+// +kubebuilder:validation:Enum={Restoring,Verifying,Verified,Failed}
+type BackupVerificationRunPhase string
+
+const (
+	// BackupVerificationRunPhaseRestoring means the backup is being restored.
+	BackupVerificationRunPhaseRestoring BackupVerificationRunPhase = "Restoring"
+
+	// BackupVerificationRunPhaseVerifying means the verification action is running against the restored data.
+	BackupVerificationRunPhaseVerifying BackupVerificationRunPhase = "Verifying"
+
+	// BackupVerificationRunPhaseVerified means the backup has been restored and verified successfully.
+	BackupVerificationRunPhaseVerified BackupVerificationRunPhase = "Verified"
+
+	// BackupVerificationRunPhaseFailed means the backup failed to be restored or verified.
+	BackupVerificationRunPhaseFailed BackupVerificationRunPhase = "Failed"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kubeblocks},scope=Namespaced,shortName=bv
+// +kubebuilder:printcolumn:name="POLICY",type=string,JSONPath=`.spec.backupPolicyName`
+// +kubebuilder:printcolumn:name="SCHEDULE",type=string,JSONPath=`.spec.backupScheduleName`
+// +kubebuilder:printcolumn:name="STATUS",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="LAST-VERIFIED-BACKUP",type=string,JSONPath=`.status.lastVerifiedBackup`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BackupVerification is the Schema for the backupverifications API.
+// It periodically restores a completed backup into a scratch Cluster in a throw-away namespace,
+// and verifies the restored data through the running database.
+type BackupVerification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupVerificationSpec   `json:"spec,omitempty"`
+	Status BackupVerificationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupVerificationList contains a list of BackupVerification.
+type BackupVerificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupVerification `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BackupVerification{}, &BackupVerificationList{})
+}
+
+// ShouldRetainLastVerifiedBackup returns whether the last verified backup is retained when it expires.
+func (r *BackupVerification) ShouldRetainLastVerifiedBackup() bool {
+	return r.Spec.RetainLastVerifiedBackup == nil || *r.Spec.RetainLastVerifiedBackup
+}
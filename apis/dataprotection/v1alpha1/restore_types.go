@@ -95,6 +95,33 @@ type RestoreSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.parameters"
 	// +optional
 	Parameters []ParameterPair `json:"parameters,omitempty"`
+
+	// Specifies the scope of a selective restore, such as specific databases or tables.
+	// The scope is validated against the `BackupCatalog` of the backup, and passed to
+	// the restore actions by the `DP_RESTORE_DATABASES` and `DP_RESTORE_TABLES` envs.
+	// If not set, the whole backup is restored.
+	//
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.scope"
+	// +optional
+	Scope *RestoreScope `json:"scope,omitempty"`
+}
+
+// RestoreScope defines the content to be restored selectively.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.databases) || has(self.tables)",message="at least one of databases and tables must be specified"
+type RestoreScope struct {
+	// Specifies the databases to be restored.
+	//
+	// +listType=set
+	// +optional
+	Databases []string `json:"databases,omitempty"`
+
+	// Specifies the tables to be restored, in the format of `<database>.<table>`.
+	//
+	// +listType=set
+	// +kubebuilder:validation:items:Pattern=`^[^.]+\..+$`
+	// +optional
+	Tables []string `json:"tables,omitempty"`
 }
 
 // BackupRef describes the backup info.
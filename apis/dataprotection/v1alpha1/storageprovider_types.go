@@ -82,6 +82,13 @@ type StorageProviderSpec struct {
 	// +optional
 	DatasafedConfigTemplate string `json:"datasafedConfigTemplate,omitempty"`
 
+	// Indicates whether the storage supports S3 Object Lock.
+	// Only when it's true, the `BackupRepo` using this provider can enable object lock,
+	// and the lock settings can be referenced as `.ObjectLock` in `datasafedConfigTemplate`.
+	//
+	// +optional
+	SupportsObjectLock bool `json:"supportsObjectLock,omitempty"`
+
 	// Describes the parameters required for storage.
 	// The parameters defined here can be referenced in the above templates,
 	// and `kbcli` uses this definition for dynamic command-line parameter parsing.
@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
 )
@@ -115,6 +117,7 @@ const (
 )
 
 // EncryptionConfig defines the parameters for encrypting backup data.
+// +kubebuilder:validation:XValidation:rule="has(self.passPhraseSecretKeyRef) != has(self.keyManagement)",message="exactly one of passPhraseSecretKeyRef and keyManagement must be specified"
 type EncryptionConfig struct {
 	// Specifies the encryption algorithm. Currently supported algorithms are:
 	//
@@ -130,8 +133,188 @@ type EncryptionConfig struct {
 	// Selects the key of a secret in the current namespace, the value of the secret
 	// is used as the encryption key.
 	//
+	// +optional
+	PassPhraseSecretKeyRef *corev1.SecretKeySelector `json:"passPhraseSecretKeyRef,omitempty"`
+
+	// Specifies the key management service for the envelope encryption.
+	// If it is set, a random data key is generated for each backup to encrypt
+	// the backup data, and the data key is wrapped by the key encryption key (KEK)
+	// managed by the key management service.
+	//
+	// +optional
+	KeyManagement *KeyManagementConfig `json:"keyManagement,omitempty"`
+}
+
+// KMSProvider defines the provider of the key management service.
+// +enum
+// +kubebuilder:validation:Enum={Secret,Vault,AWSKMS}
+type KMSProvider string
+
+const (
+	// KMSProviderSecret uses the keys stored in a Kubernetes secret as the KEKs.
+	KMSProviderSecret KMSProvider = "Secret"
+	// KMSProviderVault uses the transit secrets engine of HashiCorp Vault.
+	KMSProviderVault KMSProvider = "Vault"
+	// KMSProviderAWSKMS uses the AWS Key Management Service.
+	KMSProviderAWSKMS KMSProvider = "AWSKMS"
+)
+
+// KeyManagementConfig defines the key management service used to wrap the data keys.
+// +kubebuilder:validation:XValidation:rule="self.provider != 'Secret' || has(self.secret)",message="secret is required for the Secret provider"
+// +kubebuilder:validation:XValidation:rule="self.provider != 'Vault' || has(self.vault)",message="vault is required for the Vault provider"
+// +kubebuilder:validation:XValidation:rule="self.provider != 'AWSKMS' || has(self.awsKMS)",message="awsKMS is required for the AWSKMS provider"
+type KeyManagementConfig struct {
+	// Specifies the provider of the key management service.
+	//
+	// +kubebuilder:validation:Required
+	Provider KMSProvider `json:"provider"`
+
+	// Specifies the identifier of the KEK used to wrap new data keys:
+	//
+	// - For the `Secret` provider, it is the key of the secret data.
+	// - For the `Vault` provider, it is the name of the transit key.
+	// - For the `AWSKMS` provider, it is the key ID, key ARN or alias of the KMS key.
+	//
+	// To rotate the KEK, change it to a new key. The backups wrapped by the old KEK
+	// can still be restored as long as the old KEK is available.
+	//
 	// +kubebuilder:validation:Required
-	PassPhraseSecretKeyRef *corev1.SecretKeySelector `json:"passPhraseSecretKeyRef"`
+	KeyID string `json:"keyID"`
+
+	// Specifies the settings of the `Secret` provider.
+	//
+	// +optional
+	Secret *SecretKMSConfig `json:"secret,omitempty"`
+
+	// Specifies the settings of the `Vault` provider.
+	//
+	// +optional
+	Vault *VaultKMSConfig `json:"vault,omitempty"`
+
+	// Specifies the settings of the `AWSKMS` provider.
+	//
+	// +optional
+	AWSKMS *AWSKMSConfig `json:"awsKMS,omitempty"`
+}
+
+// SecretKMSConfig defines the secret which stores the KEKs.
+type SecretKMSConfig struct {
+	// Specifies the name of the secret in the current namespace. Each key of the
+	// secret data is a KEK.
+	//
+	// +kubebuilder:validation:Required
+	SecretName string `json:"secretName"`
+}
+
+// VaultKMSConfig defines how to access the transit secrets engine of HashiCorp Vault.
+type VaultKMSConfig struct {
+	// Specifies the address of the Vault server, e.g. `https://vault.vault:8200`.
+	//
+	// +kubebuilder:validation:Required
+	Address string `json:"address"`
+
+	// Specifies the mount path of the transit secrets engine.
+	//
+	// +kubebuilder:default=transit
+	// +optional
+	TransitMountPath string `json:"transitMountPath,omitempty"`
+
+	// Specifies the Vault namespace, only used by Vault Enterprise.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Selects the key of a secret in the current namespace, the value of the secret
+	// is used as the Vault token.
+	//
+	// +kubebuilder:validation:Required
+	TokenSecretKeyRef *corev1.SecretKeySelector `json:"tokenSecretKeyRef"`
+}
+
+// AWSKMSConfig defines how to access the AWS Key Management Service.
+type AWSKMSConfig struct {
+	// Specifies the region of the KMS key.
+	//
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Specifies the endpoint of the KMS service. It defaults to the public endpoint
+	// of the region, and can be set to use a VPC endpoint or a compatible service.
+	//
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Specifies the name of the secret in the current namespace which contains the
+	// credential. The secret contains `accessKeyId`, `secretAccessKey` and an optional
+	// `sessionToken`.
+	//
+	// +kubebuilder:validation:Required
+	CredentialSecretName string `json:"credentialSecretName"`
+}
+
+// BackupEncryptionKey records the wrapped data key of a backup.
+type BackupEncryptionKey struct {
+	// Specifies the provider of the key management service which wraps the data key.
+	//
+	// +kubebuilder:validation:Required
+	Provider KMSProvider `json:"provider"`
+
+	// Specifies the identifier of the KEK which wraps the data key, including the
+	// key version if the provider supports it.
+	//
+	// +kubebuilder:validation:Required
+	KeyID string `json:"keyID"`
+
+	// Specifies the wrapped data key, encoded in base64.
+	//
+	// +kubebuilder:validation:Required
+	WrappedDataKey string `json:"wrappedDataKey"`
+
+	// Records the time when the data key was wrapped.
+	//
+	// +optional
+	WrappedAt *metav1.Time `json:"wrappedAt,omitempty"`
+}
+
+// ThrottlePolicy defines the bandwidth and concurrency limits of the backup and restore jobs.
+// The concurrency limits only apply to the backups, the backups over the limits will wait in
+// the `Pending` phase until the running backups are finished.
+type ThrottlePolicy struct {
+	// Specifies the maximum egress bandwidth in bytes per second of the backup and restore job pods,
+	// e.g. `50Mi`. No limit is applied if the field is not set.
+	//
+	// The limit is set by the `kubernetes.io/egress-bandwidth` annotation of the pods, which requires
+	// the CNI bandwidth plugin, and it applies to all the traffic of the pods.
+	//
+	// +optional
+	UploadBandwidth *resource.Quantity `json:"uploadBandwidth,omitempty"`
+
+	// Specifies the maximum ingress bandwidth in bytes per second of the backup and restore job pods,
+	// e.g. `100Mi`. No limit is applied if the field is not set.
+	//
+	// The limit is set by the `kubernetes.io/ingress-bandwidth` annotation of the pods, which requires
+	// the CNI bandwidth plugin, and it applies to all the traffic of the pods.
+	//
+	// +optional
+	DownloadBandwidth *resource.Quantity `json:"downloadBandwidth,omitempty"`
+
+	// Specifies the maximum number of the concurrent backups.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentBackups *int32 `json:"maxConcurrentBackups,omitempty"`
+
+	// Specifies the maximum number of the concurrent backups whose target pods are on the same node.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentBackupsPerNode *int32 `json:"maxConcurrentBackupsPerNode,omitempty"`
+
+	// Specifies the maximum number of the concurrent backups in the same namespace.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentBackupsPerNamespace *int32 `json:"maxConcurrentBackupsPerNamespace,omitempty"`
 }
 
 type ActionSetParametersSchema struct {
@@ -26,9 +26,24 @@ package v1alpha1
 import (
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKMSConfig) DeepCopyInto(out *AWSKMSConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKMSConfig.
+func (in *AWSKMSConfig) DeepCopy() *AWSKMSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKMSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActionSet) DeepCopyInto(out *ActionSet) {
 	*out = *in
@@ -305,6 +320,136 @@ func (in *BackupActionSpec) DeepCopy() *BackupActionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupCatalog) DeepCopyInto(out *BackupCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupCatalog.
+func (in *BackupCatalog) DeepCopy() *BackupCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupCatalogEntry) DeepCopyInto(out *BackupCatalogEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupCatalogEntry.
+func (in *BackupCatalogEntry) DeepCopy() *BackupCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupCatalogList) DeepCopyInto(out *BackupCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupCatalogList.
+func (in *BackupCatalogList) DeepCopy() *BackupCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupCatalogSpec) DeepCopyInto(out *BackupCatalogSpec) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]BackupCatalogEntry, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestorableKinds != nil {
+		in, out := &in.RestorableKinds, &out.RestorableKinds
+		*out = make([]BackupCatalogEntryKind, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupCatalogSpec.
+func (in *BackupCatalogSpec) DeepCopy() *BackupCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupCopyStatus) DeepCopyInto(out *BackupCopyStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = (*in).DeepCopy()
+	}
+	if in.TimeRange != nil {
+		in, out := &in.TimeRange, &out.TimeRange
+		*out = new(BackupTimeRange)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupCopyStatus.
+func (in *BackupCopyStatus) DeepCopy() *BackupCopyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupCopyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupDataActionSpec) DeepCopyInto(out *BackupDataActionSpec) {
 	*out = *in
@@ -326,6 +471,25 @@ func (in *BackupDataActionSpec) DeepCopy() *BackupDataActionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupEncryptionKey) DeepCopyInto(out *BackupEncryptionKey) {
+	*out = *in
+	if in.WrappedAt != nil {
+		in, out := &in.WrappedAt, &out.WrappedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupEncryptionKey.
+func (in *BackupEncryptionKey) DeepCopy() *BackupEncryptionKey {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupEncryptionKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupList) DeepCopyInto(out *BackupList) {
 	*out = *in
@@ -455,7 +619,7 @@ func (in *BackupPolicy) DeepCopyInto(out *BackupPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPolicy.
@@ -545,6 +709,16 @@ func (in *BackupPolicySpec) DeepCopyInto(out *BackupPolicySpec) {
 		*out = new(EncryptionConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(BackupReplicationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Throttle != nil {
+		in, out := &in.Throttle, &out.Throttle
+		*out = new(ThrottlePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPolicySpec.
@@ -560,6 +734,20 @@ func (in *BackupPolicySpec) DeepCopy() *BackupPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupPolicyStatus) DeepCopyInto(out *BackupPolicyStatus) {
 	*out = *in
+	if in.RecoverableWindows != nil {
+		in, out := &in.RecoverableWindows, &out.RecoverableWindows
+		*out = make([]RecoverableWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RecoverableGaps != nil {
+		in, out := &in.RecoverableGaps, &out.RecoverableGaps
+		*out = make([]RecoverableGap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPolicyStatus.
@@ -706,6 +894,21 @@ func (in *BackupRef) DeepCopy() *BackupRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupReplicationPolicy) DeepCopyInto(out *BackupReplicationPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupReplicationPolicy.
+func (in *BackupReplicationPolicy) DeepCopy() *BackupReplicationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupReplicationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupRepo) DeepCopyInto(out *BackupRepo) {
 	*out = *in
@@ -733,6 +936,57 @@ func (in *BackupRepo) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepoHealthCheck) DeepCopyInto(out *BackupRepoHealthCheck) {
+	*out = *in
+	out.ProbeInterval = in.ProbeInterval
+	out.ProbeTimeout = in.ProbeTimeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepoHealthCheck.
+func (in *BackupRepoHealthCheck) DeepCopy() *BackupRepoHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepoHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepoHealthStatus) DeepCopyInto(out *BackupRepoHealthStatus) {
+	*out = *in
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastProbeDuration != nil {
+		in, out := &in.LastProbeDuration, &out.LastProbeDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.EstimatedUsedCapacity != nil {
+		in, out := &in.EstimatedUsedCapacity, &out.EstimatedUsedCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EstimatedGrowthPerDay != nil {
+		in, out := &in.EstimatedGrowthPerDay, &out.EstimatedGrowthPerDay
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepoHealthStatus.
+func (in *BackupRepoHealthStatus) DeepCopy() *BackupRepoHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepoHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupRepoList) DeepCopyInto(out *BackupRepoList) {
 	*out = *in
@@ -765,6 +1019,21 @@ func (in *BackupRepoList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepoObjectLock) DeepCopyInto(out *BackupRepoObjectLock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepoObjectLock.
+func (in *BackupRepoObjectLock) DeepCopy() *BackupRepoObjectLock {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepoObjectLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupRepoSpec) DeepCopyInto(out *BackupRepoSpec) {
 	*out = *in
@@ -781,6 +1050,21 @@ func (in *BackupRepoSpec) DeepCopyInto(out *BackupRepoSpec) {
 		*out = new(v1.SecretReference)
 		**out = **in
 	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(BackupRepoObjectLock)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Throttle != nil {
+		in, out := &in.Throttle, &out.Throttle
+		*out = new(ThrottlePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(BackupRepoHealthCheck)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepoSpec.
@@ -808,6 +1092,11 @@ func (in *BackupRepoStatus) DeepCopyInto(out *BackupRepoStatus) {
 		*out = new(v1.SecretReference)
 		**out = **in
 	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(BackupRepoHealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepoStatus.
@@ -820,6 +1109,26 @@ func (in *BackupRepoStatus) DeepCopy() *BackupRepoStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetentionStatus) DeepCopyInto(out *BackupRetentionStatus) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]BackupRetentionReason, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetentionStatus.
+func (in *BackupRetentionStatus) DeepCopy() *BackupRetentionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetentionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupSchedule) DeepCopyInto(out *BackupSchedule) {
 	*out = *in
@@ -894,6 +1203,11 @@ func (in *BackupScheduleSpec) DeepCopyInto(out *BackupScheduleSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TieredRetention != nil {
+		in, out := &in.TieredRetention, &out.TieredRetention
+		*out = new(TieredRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleSpec.
@@ -936,6 +1250,10 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = make([]ParameterPair, len(*in))
 		copy(*out, *in)
 	}
+	if in.LockUntil != nil {
+		in, out := &in.LockUntil, &out.LockUntil
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
@@ -995,6 +1313,11 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		*out = new(EncryptionConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EncryptionKey != nil {
+		in, out := &in.EncryptionKey, &out.EncryptionKey
+		*out = new(BackupEncryptionKey)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Actions != nil {
 		in, out := &in.Actions, &out.Actions
 		*out = make([]ActionStatus, len(*in))
@@ -1007,6 +1330,23 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		*out = make([]VolumeSnapshotStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(BackupVerificationResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Copies != nil {
+		in, out := &in.Copies, &out.Copies
+		*out = make([]BackupCopyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupRetentionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Extras != nil {
 		in, out := &in.Extras, &out.Extras
 		*out = make([]map[string]string, len(*in))
@@ -1111,6 +1451,185 @@ func (in *BackupTimeRange) DeepCopy() *BackupTimeRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerification) DeepCopyInto(out *BackupVerification) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerification.
+func (in *BackupVerification) DeepCopy() *BackupVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupVerification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationAction) DeepCopyInto(out *BackupVerificationAction) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationAction.
+func (in *BackupVerificationAction) DeepCopy() *BackupVerificationAction {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationList) DeepCopyInto(out *BackupVerificationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupVerification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationList.
+func (in *BackupVerificationList) DeepCopy() *BackupVerificationList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupVerificationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationResult) DeepCopyInto(out *BackupVerificationResult) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationResult.
+func (in *BackupVerificationResult) DeepCopy() *BackupVerificationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationRun) DeepCopyInto(out *BackupVerificationRun) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationRun.
+func (in *BackupVerificationRun) DeepCopy() *BackupVerificationRun {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationSpec) DeepCopyInto(out *BackupVerificationSpec) {
+	*out = *in
+	in.Action.DeepCopyInto(&out.Action)
+	if in.RetainLastVerifiedBackup != nil {
+		in, out := &in.RetainLastVerifiedBackup, &out.RetainLastVerifiedBackup
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationSpec.
+func (in *BackupVerificationSpec) DeepCopy() *BackupVerificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationStatus) DeepCopyInto(out *BackupVerificationStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentRun != nil {
+		in, out := &in.CurrentRun, &out.CurrentRun
+		*out = new(BackupVerificationRun)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastRun != nil {
+		in, out := &in.LastRun, &out.LastRun
+		*out = new(BackupVerificationRun)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationStatus.
+func (in *BackupVerificationStatus) DeepCopy() *BackupVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BaseJobActionSpec) DeepCopyInto(out *BaseJobActionSpec) {
 	*out = *in
@@ -1169,6 +1688,11 @@ func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KeyManagement != nil {
+		in, out := &in.KeyManagement, &out.KeyManagement
+		*out = new(KeyManagementConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfig.
@@ -1340,6 +1864,36 @@ func (in *JobActionTarget) DeepCopy() *JobActionTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyManagementConfig) DeepCopyInto(out *KeyManagementConfig) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretKMSConfig)
+		**out = **in
+	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultKMSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSKMS != nil {
+		in, out := &in.AWSKMS, &out.AWSKMS
+		*out = new(AWSKMSConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyManagementConfig.
+func (in *KeyManagementConfig) DeepCopy() *KeyManagementConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyManagementConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeResources) DeepCopyInto(out *KubeResources) {
 	*out = *in
@@ -1543,6 +2097,45 @@ func (in *ReadyConfig) DeepCopy() *ReadyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecoverableGap) DeepCopyInto(out *RecoverableGap) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecoverableGap.
+func (in *RecoverableGap) DeepCopy() *RecoverableGap {
+	if in == nil {
+		return nil
+	}
+	out := new(RecoverableGap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecoverableWindow) DeepCopyInto(out *RecoverableWindow) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	if in.ContinuousBackupNames != nil {
+		in, out := &in.ContinuousBackupNames, &out.ContinuousBackupNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecoverableWindow.
+func (in *RecoverableWindow) DeepCopy() *RecoverableWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(RecoverableWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequiredPolicyForAllPodSelection) DeepCopyInto(out *RequiredPolicyForAllPodSelection) {
 	*out = *in
@@ -1681,6 +2274,31 @@ func (in *RestoreList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreScope) DeepCopyInto(out *RestoreScope) {
+	*out = *in
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreScope.
+func (in *RestoreScope) DeepCopy() *RestoreScope {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
@@ -1718,6 +2336,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = make([]ParameterPair, len(*in))
 		copy(*out, *in)
 	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(RestoreScope)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
@@ -1957,6 +2580,21 @@ func (in *SchedulingSpec) DeepCopy() *SchedulingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKMSConfig) DeepCopyInto(out *SecretKMSConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKMSConfig.
+func (in *SecretKMSConfig) DeepCopy() *SecretKMSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKMSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceOfOneToMany) DeepCopyInto(out *SourceOfOneToMany) {
 	*out = *in
@@ -2145,6 +2783,86 @@ func (in *TargetVolumeInfo) DeepCopy() *TargetVolumeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThrottlePolicy) DeepCopyInto(out *ThrottlePolicy) {
+	*out = *in
+	if in.UploadBandwidth != nil {
+		in, out := &in.UploadBandwidth, &out.UploadBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DownloadBandwidth != nil {
+		in, out := &in.DownloadBandwidth, &out.DownloadBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxConcurrentBackups != nil {
+		in, out := &in.MaxConcurrentBackups, &out.MaxConcurrentBackups
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentBackupsPerNode != nil {
+		in, out := &in.MaxConcurrentBackupsPerNode, &out.MaxConcurrentBackupsPerNode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentBackupsPerNamespace != nil {
+		in, out := &in.MaxConcurrentBackupsPerNamespace, &out.MaxConcurrentBackupsPerNamespace
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlePolicy.
+func (in *ThrottlePolicy) DeepCopy() *ThrottlePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ThrottlePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TieredRetentionPolicy) DeepCopyInto(out *TieredRetentionPolicy) {
+	*out = *in
+	if in.Hourly != nil {
+		in, out := &in.Hourly, &out.Hourly
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Daily != nil {
+		in, out := &in.Daily, &out.Daily
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Weekly != nil {
+		in, out := &in.Weekly, &out.Weekly
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Monthly != nil {
+		in, out := &in.Monthly, &out.Monthly
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Yearly != nil {
+		in, out := &in.Yearly, &out.Yearly
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TieredRetentionPolicy.
+func (in *TieredRetentionPolicy) DeepCopy() *TieredRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TieredRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
 	*out = *in
@@ -2167,6 +2885,26 @@ func (in *ValueFrom) DeepCopy() *ValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultKMSConfig) DeepCopyInto(out *VaultKMSConfig) {
+	*out = *in
+	if in.TokenSecretKeyRef != nil {
+		in, out := &in.TokenSecretKeyRef, &out.TokenSecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultKMSConfig.
+func (in *VaultKMSConfig) DeepCopy() *VaultKMSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultKMSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VersionMapping) DeepCopyInto(out *VersionMapping) {
 	*out = *in
@@ -0,0 +1,361 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComponentAutoscalerSpec defines the desired state of ComponentAutoscaler
+type ComponentAutoscalerSpec struct {
+	// Specifies the target Cluster name this autoscaler applies to.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.targetClusterName"
+	TargetClusterName string `json:"targetClusterName"`
+
+	// Specifies the target Component name this autoscaler applies to.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.targetComponentName"
+	TargetComponentName string `json:"targetComponentName"`
+
+	// Specifies the metrics used to calculate the desired replicas and resources.
+	// The largest recommendation among all metrics is used.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Metrics []AutoscalerMetric `json:"metrics"`
+
+	// Specifies the policy to scale the replicas of the Component.
+	//
+	// +optional
+	Horizontal *HorizontalAutoscalingPolicy `json:"horizontal,omitempty"`
+
+	// Specifies the policy to scale the CPU and memory of the Component.
+	// Only the metrics with the `Resource` source are used for vertical scaling.
+	//
+	// If both horizontal and vertical scaling are enabled, the replicas are scaled first,
+	// and the resources are only scaled when the replicas reach their bounds.
+	//
+	// +optional
+	Vertical *VerticalAutoscalingPolicy `json:"vertical,omitempty"`
+
+	// Specifies the interval in seconds to collect the metrics and evaluate the scaling.
+	//
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=10
+	// +optional
+	SyncPeriodSeconds *int32 `json:"syncPeriodSeconds,omitempty"`
+
+	// Specifies the tolerance of the ratio between the current and the target metric values,
+	// within which no scaling happens, in percentage.
+	//
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TolerancePercent *int32 `json:"tolerancePercent,omitempty"`
+
+	// Suspends the autoscaling, the OpsRequests already created are not affected.
+	//
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+}
+
+// MetricSourceType defines the source of an autoscaler metric.
+//
+// +enum
+// +kubebuilder:validation:Enum={Resource,Probe}
+type MetricSourceType string
+
+const (
+	// ResourceMetricSource reads the CPU and memory usage of the pods from metrics.k8s.io.
+	ResourceMetricSource MetricSourceType = "Resource"
+
+	// ProbeMetricSource reads the output of a kbagent probe reported by the pods.
+	ProbeMetricSource MetricSourceType = "Probe"
+)
+
+// AutoscalerMetric defines a metric the autoscaler scales on.
+//
+// +kubebuilder:validation:XValidation:rule="self.source != 'Resource' || has(self.resource)",message="resource is required for the Resource source"
+// +kubebuilder:validation:XValidation:rule="self.source != 'Probe' || has(self.probe)",message="probe is required for the Probe source"
+type AutoscalerMetric struct {
+	// Specifies the source of the metric.
+	//
+	// +kubebuilder:validation:Required
+	Source MetricSourceType `json:"source"`
+
+	// Specifies the resource metric, used with the `Resource` source.
+	//
+	// +optional
+	Resource *ResourceMetric `json:"resource,omitempty"`
+
+	// Specifies the probe metric, used with the `Probe` source.
+	//
+	// +optional
+	Probe *ProbeMetric `json:"probe,omitempty"`
+}
+
+// ResourceMetric defines a metric of the CPU or memory usage.
+type ResourceMetric struct {
+	// Specifies the name of the resource.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum={cpu,memory}
+	Name corev1.ResourceName `json:"name"`
+
+	// Specifies the target average utilization of the resource, in percentage of the requests.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	TargetAverageUtilization int32 `json:"targetAverageUtilization"`
+}
+
+// ProbeMetric defines a metric reported by a kbagent probe.
+//
+// The probe is expected to output a single number, which is the value of the metric for the replica.
+type ProbeMetric struct {
+	// Specifies the name of the probe.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Specifies the target average value of the metric across the replicas.
+	//
+	// +kubebuilder:validation:Required
+	TargetAverageValue resource.Quantity `json:"targetAverageValue"`
+}
+
+// HorizontalAutoscalingPolicy defines how to scale the replicas of a Component.
+type HorizontalAutoscalingPolicy struct {
+	// Specifies the lower limit of the replicas.
+	// The replicas are also limited by the `replicasLimit` of the ComponentDefinition.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// Specifies the upper limit of the replicas.
+	// The replicas are also limited by the `replicasLimit` of the ComponentDefinition.
+	//
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Specifies the max number of replicas to add in one scaling.
+	//
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxScaleOutStep *int32 `json:"maxScaleOutStep,omitempty"`
+
+	// Specifies the max number of replicas to remove in one scaling.
+	//
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxScaleInStep *int32 `json:"maxScaleInStep,omitempty"`
+
+	// Specifies the cool-down window in seconds after a scaling, before scaling out again.
+	//
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ScaleOutCooldownSeconds *int32 `json:"scaleOutCooldownSeconds,omitempty"`
+
+	// Specifies the cool-down window in seconds after a scaling, before scaling in again.
+	//
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ScaleInCooldownSeconds *int32 `json:"scaleInCooldownSeconds,omitempty"`
+}
+
+// VerticalAutoscalingPolicy defines how to scale the CPU and memory of a Component.
+type VerticalAutoscalingPolicy struct {
+	// Specifies the lower limit of the requests.
+	//
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+
+	// Specifies the upper limit of the requests.
+	//
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+
+	// Specifies the max change of the requests in one scaling, in percentage of the current requests.
+	// The limits are changed proportionally.
+	//
+	// +kubebuilder:default=50
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxStepPercent *int32 `json:"maxStepPercent,omitempty"`
+
+	// Specifies the cool-down window in seconds after a scaling, before scaling up again.
+	//
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ScaleUpCooldownSeconds *int32 `json:"scaleUpCooldownSeconds,omitempty"`
+
+	// Specifies the cool-down window in seconds after a scaling, before scaling down again.
+	//
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ScaleDownCooldownSeconds *int32 `json:"scaleDownCooldownSeconds,omitempty"`
+}
+
+// ComponentAutoscalerStatus defines the observed state of ComponentAutoscaler
+type ComponentAutoscalerStatus struct {
+	// The most recent generation number of the ComponentAutoscaler object that has been observed by the controller.
+	//
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// The current number of replicas of the Component.
+	//
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+
+	// The desired number of replicas of the Component, calculated from the metrics.
+	//
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+
+	// The current resource requests of the Component.
+	//
+	// +optional
+	CurrentRequests corev1.ResourceList `json:"currentRequests,omitempty"`
+
+	// The desired resource requests of the Component, calculated from the metrics.
+	//
+	// +optional
+	DesiredRequests corev1.ResourceList `json:"desiredRequests,omitempty"`
+
+	// Records the latest values of the metrics.
+	//
+	// +optional
+	CurrentMetrics []MetricStatus `json:"currentMetrics,omitempty"`
+
+	// The name of the OpsRequest created by the autoscaler that is still in progress.
+	//
+	// +optional
+	ActiveOpsRequest string `json:"activeOpsRequest,omitempty"`
+
+	// The last time the autoscaler scaled the Component.
+	//
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// The direction of the last scaling, e.g. `ScaleOut`, `ScaleIn`, `ScaleUp` or `ScaleDown`.
+	//
+	// +optional
+	LastScaleDirection ScaleDirection `json:"lastScaleDirection,omitempty"`
+
+	// Represents the latest available observations of the autoscaler's current state.
+	// Known .status.conditions.type are: "ScalingActive".
+	//
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// MetricStatus records the latest value of a metric.
+type MetricStatus struct {
+	// The source of the metric.
+	Source MetricSourceType `json:"source"`
+
+	// The name of the metric, the resource name or the probe name.
+	Name string `json:"name"`
+
+	// The current average value of the metric. For resource metrics, it is the utilization in percentage.
+	CurrentAverageValue resource.Quantity `json:"currentAverageValue"`
+
+	// The number of replicas reporting the metric.
+	Replicas int32 `json:"replicas"`
+}
+
+// ScaleDirection defines the direction of a scaling.
+type ScaleDirection string
+
+const (
+	ScaleOut  ScaleDirection = "ScaleOut"
+	ScaleIn   ScaleDirection = "ScaleIn"
+	ScaleUp   ScaleDirection = "ScaleUp"
+	ScaleDown ScaleDirection = "ScaleDown"
+)
+
+const (
+	// ScalingActive is added to a componentautoscaler when the metrics are available and the autoscaler is working.
+	ScalingActive ConditionType = "ScalingActive"
+)
+
+const (
+	// ReasonSuspended is a reason for condition ScalingActive.
+	ReasonSuspended = "Suspended"
+
+	// ReasonFailedGetMetrics is a reason for condition ScalingActive.
+	ReasonFailedGetMetrics = "FailedGetMetrics"
+
+	// ReasonInvalidTarget is a reason for condition ScalingActive.
+	ReasonInvalidTarget = "InvalidTarget"
+
+	// ReasonValidMetrics is a reason for condition ScalingActive.
+	ReasonValidMetrics = "ValidMetrics"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kubeblocks},shortName=cas
+// +kubebuilder:printcolumn:name="TARGET-CLUSTER-NAME",type="string",JSONPath=".spec.targetClusterName",description="target cluster name."
+// +kubebuilder:printcolumn:name="TARGET-COMPONENT-NAME",type="string",JSONPath=".spec.targetComponentName",description="target component name."
+// +kubebuilder:printcolumn:name="REPLICAS",type="integer",JSONPath=".status.currentReplicas",description="current replicas."
+// +kubebuilder:printcolumn:name="DESIRED",type="integer",JSONPath=".status.desiredReplicas",description="desired replicas."
+// +kubebuilder:printcolumn:name="ACTIVE",type="string",JSONPath=".status.conditions[?(@.type==\"ScalingActive\")].status",description="scaling active."
+// +kubebuilder:printcolumn:name="LAST-SCALE-TIME",type="date",JSONPath=".status.lastScaleTime"
+
+// ComponentAutoscaler is the Schema for the componentautoscalers API
+type ComponentAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComponentAutoscalerSpec   `json:"spec,omitempty"`
+	Status ComponentAutoscalerStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ComponentAutoscalerList contains a list of ComponentAutoscaler
+type ComponentAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComponentAutoscaler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ComponentAutoscaler{}, &ComponentAutoscalerList{})
+}
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,6 +46,10 @@ const (
 	ConditionTypeBackup             = "Backup"
 	ConditionTypeInstanceRebuilding = "InstancesRebuilding"
 	ConditionTypeCustomOperation    = "CustomOperation"
+	ConditionTypeMaintenanceWindow  = "WaitForMaintenanceWindow"
+	ConditionTypePromoting          = "Promoting"
+	ConditionTypeDemoting           = "Demoting"
+	ConditionTypeLogicalImporting   = "LogicalImporting"
 
 	// condition and event reasons
 	ReasonClusterPhaseMismatch  = "ClusterPhaseMismatch"
@@ -79,6 +84,11 @@ const (
 	ReasonReconfigureRunning              = "ReconfigureRunning"
 	ReasonBackupStarted                   = "BackupStarted"
 	ReasonRestoreStarted                  = "RestoreStarted"
+	ReasonOutsideMaintenanceWindow        = "OutsideMaintenanceWindow"
+	ReasonMaintenanceWindowOpen           = "MaintenanceWindowOpen"
+	ReasonPromoteStarted                  = "PromoteStarted"
+	ReasonDemoteStarted                   = "DemoteStarted"
+	ReasonLogicalImportStarted            = "LogicalImportStarted"
 )
 
 func (r *OpsRequest) SetStatusCondition(condition metav1.Condition) {
@@ -97,6 +107,29 @@ func NewWaitForProcessingCondition(ops *OpsRequest) *metav1.Condition {
 	}
 }
 
+// NewWaitForMaintenanceWindowCondition creates a condition that the OpsRequest is waiting for the maintenance window of the cluster.
+func NewWaitForMaintenanceWindowCondition(ops *OpsRequest, next time.Time) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               ConditionTypeMaintenanceWindow,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonOutsideMaintenanceWindow,
+		LastTransitionTime: metav1.Now(),
+		Message: fmt.Sprintf("the %s operation is disruptive, wait for the maintenance window of Cluster: %s which opens at %s",
+			ops.Spec.Type, ops.Spec.GetClusterName(), next.Format(time.RFC3339)),
+	}
+}
+
+// NewMaintenanceWindowOpenCondition creates a condition that the maintenance window of the cluster is open.
+func NewMaintenanceWindowOpenCondition(ops *OpsRequest) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               ConditionTypeMaintenanceWindow,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonMaintenanceWindowOpen,
+		LastTransitionTime: metav1.Now(),
+		Message:            fmt.Sprintf("the maintenance window of Cluster: %s is open", ops.Spec.GetClusterName()),
+	}
+}
+
 // NewCancelingCondition the controller is canceling the OpsRequest
 func NewCancelingCondition(ops *OpsRequest) *metav1.Condition {
 	return &metav1.Condition{
@@ -376,6 +409,45 @@ func NewBackupCondition(ops *OpsRequest) *metav1.Condition {
 	}
 }
 
+// NewPromoteCondition creates a condition that the OpsRequest starts to promote the standby cluster.
+func NewPromoteCondition(ops *OpsRequest) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               ConditionTypePromoting,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonPromoteStarted,
+		LastTransitionTime: metav1.Now(),
+		Message:            fmt.Sprintf("Start to promote the standby Cluster: %s", ops.Spec.GetClusterName()),
+	}
+}
+
+// NewDemoteCondition creates a condition that the OpsRequest starts to demote the cluster to a standby.
+func NewDemoteCondition(ops *OpsRequest) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               ConditionTypeDemoting,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonDemoteStarted,
+		LastTransitionTime: metav1.Now(),
+		Message:            fmt.Sprintf("Start to demote the Cluster %s to a standby", ops.Spec.GetClusterName()),
+	}
+}
+
+// NewLogicalImportCondition creates a condition that the OpsRequest starts to import the data logically.
+func NewLogicalImportCondition(ops *OpsRequest) *metav1.Condition {
+	message := fmt.Sprintf("Start to import the data into the Cluster: %s", ops.Spec.GetClusterName())
+	if ops.Spec.LogicalImport != nil {
+		source := ops.Spec.LogicalImport.Source
+		message = fmt.Sprintf("Start to import the data of Component %s/%s into Component %s/%s",
+			source.ClusterName, source.ComponentName, ops.Spec.GetClusterName(), ops.Spec.LogicalImport.ComponentName)
+	}
+	return &metav1.Condition{
+		Type:               ConditionTypeLogicalImporting,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonLogicalImportStarted,
+		LastTransitionTime: metav1.Now(),
+		Message:            message,
+	}
+}
+
 // NewRestoreCondition creates a condition that the OpsRequest restore the cluster.
 func NewRestoreCondition(ops *OpsRequest) *metav1.Condition {
 	return &metav1.Condition{
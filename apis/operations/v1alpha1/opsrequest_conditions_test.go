@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sethvargo/go-password/password"
@@ -106,6 +107,8 @@ func TestReasonConstantsStringDrift(t *testing.T) {
 		{"ReasonReconfigureFailed", ReasonReconfigureFailed, "ReconfigureFailed"},
 		{"ReasonBackupStarted", ReasonBackupStarted, "BackupStarted"},
 		{"ReasonRestoreStarted", ReasonRestoreStarted, "RestoreStarted"},
+		{"ReasonOutsideMaintenanceWindow", ReasonOutsideMaintenanceWindow, "OutsideMaintenanceWindow"},
+		{"ReasonMaintenanceWindowOpen", ReasonMaintenanceWindowOpen, "MaintenanceWindowOpen"},
 	}
 	for _, tc := range cases {
 		if tc.got != tc.want {
@@ -144,6 +147,8 @@ func TestNewConditionReasonsMatchConstants(t *testing.T) {
 		{"NewReconfigureFailedCondition", NewReconfigureFailedCondition(opsRequest, nil).Reason, ReasonReconfigureFailed},
 		{"NewBackupCondition", NewBackupCondition(opsRequest).Reason, ReasonBackupStarted},
 		{"NewRestoreCondition", NewRestoreCondition(opsRequest).Reason, ReasonRestoreStarted},
+		{"NewWaitForMaintenanceWindowCondition", NewWaitForMaintenanceWindowCondition(opsRequest, time.Now()).Reason, ReasonOutsideMaintenanceWindow},
+		{"NewMaintenanceWindowOpenCondition", NewMaintenanceWindowOpenCondition(opsRequest).Reason, ReasonMaintenanceWindowOpen},
 	}
 	for _, tc := range cases {
 		if tc.got != tc.want {
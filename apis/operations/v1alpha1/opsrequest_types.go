@@ -43,9 +43,9 @@ type OpsRequestSpec struct {
 	// +optional
 	Cancel bool `json:"cancel,omitempty"`
 
-	// Instructs the system to bypass pre-checks (including cluster state checks and customized pre-conditions hooks)
-	// and immediately execute the opsRequest, except for the opsRequest of 'Start' type, which will still undergo
-	// pre-checks even if `force` is true.
+	// Instructs the system to bypass pre-checks (including cluster state checks, maintenance windows and customized
+	// pre-conditions hooks) and immediately execute the opsRequest, except for the opsRequest of 'Start' type,
+	// which will still undergo pre-checks even if `force` is true.
 	//
 	// This is useful for concurrent execution of 'VerticalScaling' and 'HorizontalScaling' opsRequests.
 	// By setting `force` to true, you can bypass the default checks and demand these opsRequests to run
@@ -64,7 +64,7 @@ type OpsRequestSpec struct {
 
 	// Specifies the type of this operation. Supported types include "Start", "Stop", "Restart", "Switchover",
 	// "VerticalScaling", "HorizontalScaling", "VolumeExpansion", "Reconfiguring", "Upgrade", "Backup", "Restore",
-	// "Expose", "RebuildInstance", "Custom".
+	// "Expose", "RebuildInstance", "Custom", "Promote", "Demote", "LogicalImport".
 	//
 	// Note: This field is immutable once set.
 	//
@@ -228,6 +228,24 @@ type SpecificOpsRequest struct {
 	//
 	// +optional
 	CustomOps *CustomOps `json:"custom,omitempty"`
+
+	// Specifies the parameters to promote a standby Cluster to a primary.
+	//
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.promote"
+	// +optional
+	Promote *Promote `json:"promote,omitempty"`
+
+	// Specifies the parameters to turn a primary Cluster into a standby of another Cluster.
+	//
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.demote"
+	// +optional
+	Demote *Demote `json:"demote,omitempty"`
+
+	// Specifies the parameters to export the data of a Component logically, and import it into a Component of the Cluster.
+	//
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.logicalImport"
+	// +optional
+	LogicalImport *LogicalImport `json:"logicalImport,omitempty"`
 }
 
 // ComponentOps specifies the Component to be operated on.
@@ -556,6 +574,7 @@ type InstanceReplicasTemplate struct {
 }
 
 // Reconfigure defines the parameters for updating a Component's configuration.
+// +kubebuilder:validation:XValidation:rule="!(has(self.parameters) && has(self.rollbackToRevision))",message="parameters and rollbackToRevision are mutually exclusive"
 type Reconfigure struct {
 	// Specifies the name of the Component.
 	ComponentOps `json:",inline"`
@@ -566,6 +585,21 @@ type Reconfigure struct {
 	//
 	// +optional
 	Parameters []ParameterPair `json:"parameters,omitempty"`
+
+	// Specifies the configuration revision to roll back to.
+	//
+	// A revision is recorded for each configuration change that has been applied to the Component,
+	// and can be found in the `status.configurationStatus[*].lastDoneRevision` of the ComponentParameter.
+	// All configuration templates of the Component are restored to the parameter values of this revision,
+	// and the changes are applied with the same reload or restart policy as a forward change.
+	//
+	// Only the most recent revisions are retained, rolling back to a garbage-collected revision will fail.
+	// The rendered configuration of a revision is kept only if it fits in the size limit, otherwise only
+	// the parameters assigned explicitly in the revision are restored.
+	//
+	// +kubebuilder:validation:Pattern:=`^[0-9]+$`
+	// +optional
+	RollbackToRevision string `json:"rollbackToRevision,omitempty"`
 }
 
 type CustomOps struct {
@@ -602,6 +636,118 @@ type CustomOps struct {
 	// +listType=map
 	// +listMapKey=componentName
 	CustomOpsComponents []CustomOpsComponent `json:"components"  patchStrategy:"merge,retainKeys" patchMergeKey:"componentName"`
+
+	// Specifies the steps of a workflow that runs the OpsActions on the components in the order of their dependencies.
+	//
+	// When specified, only the OpsActions referenced by the steps are executed, instead of executing all the
+	// OpsActions sequentially on each component. Steps without dependencies between them run concurrently,
+	// and the progress of each step is reported in the `progressDetails` of the component the step runs on.
+	//
+	// +kubebuilder:validation:MaxItems=128
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Steps []CustomOpsStep `json:"steps,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+}
+
+// CustomOpsStep defines a step of the custom ops workflow, which runs an OpsAction on a component.
+type CustomOpsStep struct {
+	// Specifies the name of the step, which is unique within the workflow.
+	//
+	// +kubebuilder:validation:MaxLength=20
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([a-z0-9\-]*[a-z0-9])?$`
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Specifies the name of the Component the step runs on, which must be one of the `components`.
+	//
+	// +kubebuilder:validation:Required
+	ComponentName string `json:"componentName"`
+
+	// Specifies the name of the OpsAction defined in `opsDefinition.spec.actions` to run.
+	//
+	// +kubebuilder:validation:Required
+	ActionName string `json:"actionName"`
+
+	// Specifies the names of the steps that must be completed before the step starts.
+	// A dependency is completed if it is succeeded, skipped, or failed with the `Ignore` failure policy.
+	//
+	// +listType=set
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// Specifies a CEL expression that determines whether the step runs, the step is skipped if it is evaluated to false.
+	//
+	// The expression can refer to the completed steps through the variable `steps`, which is keyed by the step name,
+	// and each entry has the `status` and `outputs` of the step. For example:
+	//
+	// ```
+	// steps.check.status == "Succeed" && steps.check.outputs.role == "primary"
+	// ```
+	//
+	// +optional
+	When string `json:"when,omitempty"`
+
+	// Specifies the parameters passed to the OpsAction whose values come from the outputs of the dependencies.
+	// They take precedence over the parameters of the component with the same name.
+	//
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Parameters []CustomOpsStepParameter `json:"parameters,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+
+	// Specifies how the step is retried when the OpsAction fails.
+	//
+	// +optional
+	RetryPolicy *CustomOpsStepRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Specifies the names of the OpsActions to run sequentially on the component after the step fails,
+	// to roll back the changes made by the step.
+	//
+	// +optional
+	RollbackActions []string `json:"rollbackActions,omitempty"`
+}
+
+// CustomOpsStepParameter passes an output of a step to another step as a parameter.
+type CustomOpsStepParameter struct {
+	// Specifies the name of the parameter.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Specifies the name of the step that produces the output, which must be one of the `dependsOn` of the step.
+	//
+	// +kubebuilder:validation:Required
+	StepName string `json:"stepName"`
+
+	// Specifies the key of the output.
+	//
+	// The outputs of a step are read from the termination messages of the containers of its workload action,
+	// which are expected to be JSON objects with string values.
+	//
+	// +kubebuilder:validation:Required
+	OutputKey string `json:"outputKey"`
+}
+
+// CustomOpsStepRetryPolicy defines how a step is retried.
+type CustomOpsStepRetryPolicy struct {
+	// Specifies the maximum number of times the step is retried after it fails.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=0
+	// +optional
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+
+	// Specifies the number of seconds to wait before the first retry, the wait is doubled for each following retry.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=10
+	// +optional
+	BackoffSeconds int32 `json:"backoffSeconds,omitempty"`
 }
 
 type CustomOpsComponent struct {
@@ -953,6 +1099,107 @@ type Restore struct {
 	Parameters []dpv1alpha1.ParameterPair `json:"parameters,omitempty"`
 }
 
+// Promote specifies how to promote a standby Cluster to a primary.
+//
+// Only a standby of a primary Cluster in the same Kubernetes cluster can be promoted,
+// a primary Cluster in another Kubernetes cluster is not supported.
+type Promote struct {
+	// Specifies whether to skip fencing the old primary Cluster.
+	//
+	// By default, the old primary Cluster is fenced, i.e. switched into the read-only state, before the standby
+	// is promoted. Skip it if the old primary is lost or unreachable.
+	//
+	// +optional
+	SkipFencing bool `json:"skipFencing,omitempty"`
+
+	// Specifies the maximum duration in seconds to wait for the old primary Cluster to be fenced.
+	// The OpsRequest fails if the fencing does not take effect in time, e.g. the Pods of the old primary are down,
+	// it can then be retried with `skipFencing` set.
+	//
+	// Defaults to 300 seconds.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FencingTimeoutSeconds *int32 `json:"fencingTimeoutSeconds,omitempty"`
+}
+
+// Demote specifies the new primary Cluster that an old primary Cluster replicates from as a standby.
+type Demote struct {
+	// Specifies the name of the new primary Cluster.
+	//
+	// +kubebuilder:validation:Required
+	PrimaryCluster string `json:"primaryCluster"`
+
+	// Specifies the namespace of the new primary Cluster. If not specified, the namespace of the OpsRequest will be used.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// LogicalImport specifies how to export the data of a source Component logically through the `dataDump` action,
+// and import it into a Component of the Cluster through the `dataLoad` action.
+// The data is streamed from the source to the target directly, without being staged in a backup repository.
+//
+// The source and the target may run different service versions, or even different ComponentDefinitions,
+// as long as the data dumped by the source is accepted by the `dataLoad` action of the target.
+type LogicalImport struct {
+	// Specifies the name of the Component to import the data into.
+	//
+	// +kubebuilder:validation:Required
+	ComponentName string `json:"componentName"`
+
+	// Specifies the source to export the data from.
+	//
+	// +kubebuilder:validation:Required
+	Source LogicalImportSource `json:"source"`
+
+	// Specifies the databases to export, all the databases are exported if not specified.
+	//
+	// The databases are passed to the `dataDump` and `dataLoad` actions in the variable `KB_DATA_DATABASES`,
+	// separated by commas.
+	//
+	// +optional
+	Databases []string `json:"databases,omitempty"`
+
+	// Specifies the tables to export, in the format of "database.table", all the tables of the databases
+	// are exported if not specified.
+	//
+	// The tables are passed to the `dataDump` and `dataLoad` actions in the variable `KB_DATA_TABLES`,
+	// separated by commas.
+	//
+	// +optional
+	Tables []string `json:"tables,omitempty"`
+
+	// Specifies the additional parameters passed to the `dataDump` and `dataLoad` actions as variables.
+	//
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// LogicalImportSource specifies the Component to export the data from.
+type LogicalImportSource struct {
+	// Specifies the name of the source Cluster.
+	//
+	// +kubebuilder:validation:Required
+	ClusterName string `json:"clusterName"`
+
+	// Specifies the namespace of the source Cluster. If not specified, the namespace of the OpsRequest will be used.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Specifies the name of the source Component.
+	//
+	// +kubebuilder:validation:Required
+	ComponentName string `json:"componentName"`
+
+	// Specifies the instance to export the data from.
+	// If not specified, the instance is selected by the `dataDump` action of the source Component.
+	//
+	// +optional
+	InstanceName string `json:"instanceName,omitempty"`
+}
+
 // OpsRequestStatus represents the observed state of an OpsRequest.
 type OpsRequestStatus struct {
 	// Records the cluster generation after the OpsRequest action has been handled.
@@ -1024,7 +1271,7 @@ type ProgressStatusDetail struct {
 	// +optional
 	ActionTasks []ActionTask `json:"actionTasks,omitempty"`
 
-	// Represents the current processing state of the object, including "Processing", "Pending", "Failed", "Succeed"
+	// Represents the current processing state of the object, including "Processing", "Pending", "Failed", "Succeed", "Skipped"
 	// +kubebuilder:validation:Required
 	Status ProgressStatus `json:"status"`
 
@@ -1039,6 +1286,14 @@ type ProgressStatusDetail struct {
 	// Records the completion time of object processing.
 	// +optional
 	EndTime metav1.Time `json:"endTime,omitempty"`
+
+	// Records the number of times the object has been retried, used by the steps of the custom ops workflow.
+	// +optional
+	Retries int32 `json:"retries,omitempty"`
+
+	// Records the outputs produced by processing the object, used by the steps of the custom ops workflow.
+	// +optional
+	Outputs map[string]string `json:"outputs,omitempty"`
 }
 
 type ActionTask struct {
@@ -146,6 +146,56 @@ func (r *OpsRequest) ValidateOps(ctx context.Context,
 		return r.validateExpose(ctx, cluster)
 	case RebuildInstanceType:
 		return r.validateRebuildInstance(cluster)
+	case PromoteType:
+		return r.validatePromote(cluster)
+	case DemoteType:
+		return r.validateDemote(cluster)
+	case LogicalImportType:
+		return r.validateLogicalImport(cluster)
+	}
+	return nil
+}
+
+// validatePromote validates the cluster to promote is a standby.
+func (r *OpsRequest) validatePromote(cluster *appsv1.Cluster) error {
+	if cluster.Spec.Standby == nil {
+		return fmt.Errorf("cluster %s is not a standby", cluster.Name)
+	}
+	return nil
+}
+
+// validateDemote validates spec.demote
+func (r *OpsRequest) validateDemote(cluster *appsv1.Cluster) error {
+	demote := r.Spec.Demote
+	if demote == nil {
+		return notEmptyError("spec.demote")
+	}
+	namespace := demote.Namespace
+	if len(namespace) == 0 {
+		namespace = r.Namespace
+	}
+	if namespace == cluster.Namespace && demote.PrimaryCluster == cluster.Name {
+		return fmt.Errorf("cluster %s can not be a standby of itself", cluster.Name)
+	}
+	return nil
+}
+
+// validateLogicalImport validates spec.logicalImport
+func (r *OpsRequest) validateLogicalImport(cluster *appsv1.Cluster) error {
+	logicalImport := r.Spec.LogicalImport
+	if logicalImport == nil {
+		return notEmptyError("spec.logicalImport")
+	}
+	if cluster.Spec.GetComponentByName(logicalImport.ComponentName) == nil {
+		return fmt.Errorf(`component "%s" not found`, logicalImport.ComponentName)
+	}
+	source := logicalImport.Source
+	namespace := source.Namespace
+	if len(namespace) == 0 {
+		namespace = r.Namespace
+	}
+	if namespace == cluster.Namespace && source.ClusterName == cluster.Name && source.ComponentName == logicalImport.ComponentName {
+		return fmt.Errorf("component %s can not import the data from itself", logicalImport.ComponentName)
 	}
 	return nil
 }
@@ -0,0 +1,192 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpsRequestScheduleSpec defines the desired state of OpsRequestSchedule.
+type OpsRequestScheduleSpec struct {
+	// Specifies the schedule in the standard cron format, e.g. "0 3 * * 0" for every Sunday at 03:00.
+	// Predefined schedules such as "@daily" and "@weekly" are also supported.
+	//
+	// +kubebuilder:validation:Required
+	Schedule string `json:"schedule"`
+
+	// Specifies the time zone name of the schedule, e.g. "Asia/Shanghai".
+	// If not specified, the time zone of the KubeBlocks controller is used, which is UTC by default.
+	//
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Specifies how to treat the concurrent executions of the OpsRequests created by this schedule.
+	// Valid values are:
+	//
+	// - "Allow": allows the OpsRequests to run concurrently.
+	// - "Forbid": skips the new run if the previous OpsRequest has not completed yet, the skipped run is not retried.
+	// - "Replace": cancels the running OpsRequests and creates a new one.
+	//   It is only supported by the OpsRequest types which support cancellation, e.g. VerticalScaling and HorizontalScaling,
+	//   the schedule is marked as Unavailable otherwise.
+	//
+	// +kubebuilder:default=Forbid
+	// +optional
+	ConcurrencyPolicy ScheduleConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Specifies the deadline in seconds for starting the OpsRequest if it misses the scheduled time for any reason.
+	// Missed runs are counted as failed ones.
+	// If not specified, only the latest missed run will be started.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// Suspends the subsequent runs if set to true, it does not apply to the already created OpsRequests.
+	//
+	// +kubebuilder:default=false
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// Specifies the number of the succeeded OpsRequests to retain.
+	//
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuccessfulHistoryLimit *int32 `json:"successfulHistoryLimit,omitempty"`
+
+	// Specifies the number of the failed, cancelled or aborted OpsRequests to retain.
+	//
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedHistoryLimit *int32 `json:"failedHistoryLimit,omitempty"`
+
+	// Specifies the template of the OpsRequests to be created.
+	//
+	// +kubebuilder:validation:Required
+	OpsRequestTemplate OpsRequestTemplate `json:"opsRequestTemplate"`
+}
+
+// OpsRequestTemplate describes the OpsRequest that will be created when executing a schedule.
+type OpsRequestTemplate struct {
+	// Specifies the labels and annotations of the created OpsRequest.
+	// The name of the OpsRequest is generated from the name of the schedule and the scheduled time.
+	//
+	// +optional
+	Metadata OpsRequestTemplateMeta `json:"metadata,omitempty"`
+
+	// Specifies the spec of the created OpsRequest.
+	// It is validated when the OpsRequest is created, as the immutability rules of OpsRequest do not apply to the template.
+	//
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec OpsRequestSpec `json:"spec"`
+}
+
+// OpsRequestTemplateMeta is the metadata of the OpsRequest template.
+type OpsRequestTemplateMeta struct {
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// OpsRequestScheduleStatus defines the observed state of OpsRequestSchedule.
+type OpsRequestScheduleStatus struct {
+	// Represents the most recent generation observed of this OpsRequestSchedule.
+	//
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Represents the current state of the OpsRequestSchedule.
+	// Valid values are "", "Available", "Unavailable".
+	// It is "Unavailable" if the schedule or the time zone is invalid.
+	//
+	// +optional
+	Phase Phase `json:"phase,omitempty"`
+
+	// Provides additional information about the current phase.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Records the OpsRequests created by this schedule which have not completed yet.
+	//
+	// +optional
+	Active []corev1.ObjectReference `json:"active,omitempty"`
+
+	// Records the last time the OpsRequest was successfully scheduled.
+	//
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// Records the last time the OpsRequest created by this schedule succeeded.
+	//
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// Records the next time the OpsRequest will be scheduled.
+	//
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kubeblocks},shortName=opss
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".spec.schedule",description="The cron schedule."
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.opsRequestTemplate.spec.type",description="Operation request type."
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.opsRequestTemplate.spec.clusterName",description="Operand cluster."
+// +kubebuilder:printcolumn:name="SUSPEND",type="boolean",JSONPath=".spec.suspend"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.phase",description="Schedule status phase."
+// +kubebuilder:printcolumn:name="LAST-SCHEDULE-TIME",type="date",JSONPath=".status.lastScheduleTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// OpsRequestSchedule is the Schema for the opsrequestschedules API.
+// It creates OpsRequests from a template periodically according to a cron schedule.
+type OpsRequestSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpsRequestScheduleSpec   `json:"spec,omitempty"`
+	Status OpsRequestScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpsRequestScheduleList contains a list of OpsRequestSchedule.
+type OpsRequestScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpsRequestSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpsRequestSchedule{}, &OpsRequestScheduleList{})
+}
+
+// IsSuspended checks if the schedule is suspended.
+func (r *OpsRequestSchedule) IsSuspended() bool {
+	return r.Spec.Suspend != nil && *r.Spec.Suspend
+}
@@ -20,6 +20,11 @@ import (
 	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
 )
 
+const (
+	OpsRequestKind         = "OpsRequest"
+	OpsRequestScheduleKind = "OpsRequestSchedule"
+)
+
 // PodSelectionPolicy pod selection strategy.
 // +enum
 // +kubebuilder:validation:Enum={All,Any}
@@ -72,7 +77,7 @@ const (
 
 // OpsType defines operation types.
 // +enum
-// +kubebuilder:validation:Enum={Upgrade,VerticalScaling,VolumeExpansion,HorizontalScaling,Restart,Reconfiguring,Start,Stop,Expose,Switchover,Backup,Restore,RebuildInstance,Custom}
+// +kubebuilder:validation:Enum={Upgrade,VerticalScaling,VolumeExpansion,HorizontalScaling,Restart,Reconfiguring,Start,Stop,Expose,Switchover,Backup,Restore,RebuildInstance,Custom,Promote,Demote,LogicalImport}
 type OpsType string
 
 const (
@@ -90,11 +95,14 @@ const (
 	RestoreType           OpsType = "Restore"
 	RebuildInstanceType   OpsType = "RebuildInstance" // RebuildInstance rebuilding an instance is very useful when a node is offline or an instance is unrecoverable.
 	CustomType            OpsType = "Custom"          // use opsDefinition
+	PromoteType           OpsType = "Promote"         // PromoteType promotes a standby cluster to a primary.
+	DemoteType            OpsType = "Demote"          // DemoteType turns a primary cluster into a standby of another cluster.
+	LogicalImportType     OpsType = "LogicalImport"   // LogicalImportType imports the data exported from another component logically.
 )
 
 // ProgressStatus defines the status of the opsRequest progress.
 // +enum
-// +kubebuilder:validation:Enum={Processing,Pending,Failed,Succeed}
+// +kubebuilder:validation:Enum={Processing,Pending,Failed,Succeed,Skipped}
 type ProgressStatus string
 
 const (
@@ -102,6 +110,7 @@ const (
 	ProcessingProgressStatus ProgressStatus = "Processing"
 	FailedProgressStatus     ProgressStatus = "Failed"
 	SucceedProgressStatus    ProgressStatus = "Succeed"
+	SkippedProgressStatus    ProgressStatus = "Skipped"
 )
 
 // ActionTaskStatus defines the status of the task.
@@ -130,3 +139,14 @@ type OpsRecorder struct {
 	// indicates that the operation is queued for execution within its own-type scope.
 	QueueBySelf bool `json:"queueBySelf,omitempty"`
 }
+
+// ScheduleConcurrencyPolicy describes how the OpsRequests created by a schedule will be handled concurrently.
+// +enum
+// +kubebuilder:validation:Enum={Allow,Forbid,Replace}
+type ScheduleConcurrencyPolicy string
+
+const (
+	AllowConcurrent   ScheduleConcurrencyPolicy = "Allow"
+	ForbidConcurrent  ScheduleConcurrencyPolicy = "Forbid"
+	ReplaceConcurrent ScheduleConcurrencyPolicy = "Replace"
+)
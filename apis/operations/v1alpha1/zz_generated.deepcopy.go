@@ -135,6 +135,13 @@ func (in *CustomOps) DeepCopyInto(out *CustomOps) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]CustomOpsStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOps.
@@ -170,6 +177,86 @@ func (in *CustomOpsComponent) DeepCopy() *CustomOpsComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomOpsStep) DeepCopyInto(out *CustomOpsStep) {
+	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]CustomOpsStepParameter, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(CustomOpsStepRetryPolicy)
+		**out = **in
+	}
+	if in.RollbackActions != nil {
+		in, out := &in.RollbackActions, &out.RollbackActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOpsStep.
+func (in *CustomOpsStep) DeepCopy() *CustomOpsStep {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomOpsStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomOpsStepParameter) DeepCopyInto(out *CustomOpsStepParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOpsStepParameter.
+func (in *CustomOpsStepParameter) DeepCopy() *CustomOpsStepParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomOpsStepParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomOpsStepRetryPolicy) DeepCopyInto(out *CustomOpsStepRetryPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOpsStepRetryPolicy.
+func (in *CustomOpsStepRetryPolicy) DeepCopy() *CustomOpsStepRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomOpsStepRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Demote) DeepCopyInto(out *Demote) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Demote.
+func (in *Demote) DeepCopy() *Demote {
+	if in == nil {
+		return nil
+	}
+	out := new(Demote)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvVarRef) DeepCopyInto(out *EnvVarRef) {
 	*out = *in
@@ -444,6 +531,54 @@ func (in *LastConfiguration) DeepCopy() *LastConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalImport) DeepCopyInto(out *LogicalImport) {
+	*out = *in
+	out.Source = in.Source
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalImport.
+func (in *LogicalImport) DeepCopy() *LogicalImport {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalImportSource) DeepCopyInto(out *LogicalImportSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalImportSource.
+func (in *LogicalImportSource) DeepCopy() *LogicalImportSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalImportSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MatchExpressions) DeepCopyInto(out *MatchExpressions) {
 	*out = *in
@@ -783,6 +918,138 @@ func (in *OpsRequestList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsRequestSchedule) DeepCopyInto(out *OpsRequestSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsRequestSchedule.
+func (in *OpsRequestSchedule) DeepCopy() *OpsRequestSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsRequestSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsRequestSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsRequestScheduleList) DeepCopyInto(out *OpsRequestScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpsRequestSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsRequestScheduleList.
+func (in *OpsRequestScheduleList) DeepCopy() *OpsRequestScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsRequestScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsRequestScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsRequestScheduleSpec) DeepCopyInto(out *OpsRequestScheduleSpec) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SuccessfulHistoryLimit != nil {
+		in, out := &in.SuccessfulHistoryLimit, &out.SuccessfulHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedHistoryLimit != nil {
+		in, out := &in.FailedHistoryLimit, &out.FailedHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	in.OpsRequestTemplate.DeepCopyInto(&out.OpsRequestTemplate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsRequestScheduleSpec.
+func (in *OpsRequestScheduleSpec) DeepCopy() *OpsRequestScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsRequestScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsRequestScheduleStatus) DeepCopyInto(out *OpsRequestScheduleStatus) {
+	*out = *in
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = make([]v1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsRequestScheduleStatus.
+func (in *OpsRequestScheduleStatus) DeepCopy() *OpsRequestScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsRequestScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpsRequestSpec) DeepCopyInto(out *OpsRequestSpec) {
 	*out = *in
@@ -855,6 +1122,52 @@ func (in *OpsRequestStatus) DeepCopy() *OpsRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsRequestTemplate) DeepCopyInto(out *OpsRequestTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsRequestTemplate.
+func (in *OpsRequestTemplate) DeepCopy() *OpsRequestTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsRequestTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsRequestTemplateMeta) DeepCopyInto(out *OpsRequestTemplateMeta) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsRequestTemplateMeta.
+func (in *OpsRequestTemplateMeta) DeepCopy() *OpsRequestTemplateMeta {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsRequestTemplateMeta)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpsRequestVolumeClaimTemplate) DeepCopyInto(out *OpsRequestVolumeClaimTemplate) {
 	*out = *in
@@ -1159,6 +1472,13 @@ func (in *ProgressStatusDetail) DeepCopyInto(out *ProgressStatusDetail) {
 	}
 	in.StartTime.DeepCopyInto(&out.StartTime)
 	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProgressStatusDetail.
@@ -1171,6 +1491,26 @@ func (in *ProgressStatusDetail) DeepCopy() *ProgressStatusDetail {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Promote) DeepCopyInto(out *Promote) {
+	*out = *in
+	if in.FencingTimeoutSeconds != nil {
+		in, out := &in.FencingTimeoutSeconds, &out.FencingTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Promote.
+func (in *Promote) DeepCopy() *Promote {
+	if in == nil {
+		return nil
+	}
+	out := new(Promote)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RebuildInstance) DeepCopyInto(out *RebuildInstance) {
 	*out = *in
@@ -1428,6 +1768,21 @@ func (in *SpecificOpsRequest) DeepCopyInto(out *SpecificOpsRequest) {
 		*out = new(CustomOps)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Promote != nil {
+		in, out := &in.Promote, &out.Promote
+		*out = new(Promote)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Demote != nil {
+		in, out := &in.Demote, &out.Demote
+		*out = new(Demote)
+		**out = **in
+	}
+	if in.LogicalImport != nil {
+		in, out := &in.LogicalImport, &out.LogicalImport
+		*out = new(LogicalImport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpecificOpsRequest.
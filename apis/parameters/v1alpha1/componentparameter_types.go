@@ -94,6 +94,92 @@ type ComponentParameterSpec struct {
 	//
 	// +optional
 	Desired *ParameterInputs `json:"desired,omitempty"`
+
+	// RolloutStrategy specifies how the parameter changes that need a reload or restart are rolled out to the replicas.
+	//
+	// If not set, the changes are applied to all the replicas at once.
+	//
+	// +optional
+	RolloutStrategy *ParameterRolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// DriftDetection specifies how to detect the drift between the rendered configuration and the live values
+	// of the parameters in the database engine.
+	//
+	// It requires the `parameterQuery` lifecycle action of the ComponentDefinition.
+	// If not set, the drift detection is disabled.
+	//
+	// +optional
+	DriftDetection *ParameterDriftDetection `json:"driftDetection,omitempty"`
+}
+
+// ParameterRolloutStrategy defines the staged rollout of parameter changes.
+//
+// The change is applied to a canary replica first, and then to the remaining replicas in batches.
+// After each batch, the updated replicas are watched for a bake time, through the results of
+// the `availableProbe` and `roleProbe` of the component.
+// If any updated replica degrades, the rollout stops and the change is reverted to the previous revision.
+type ParameterRolloutStrategy struct {
+	// Specifies the role of the canary replica which the change is applied to first, e.g. "secondary".
+	//
+	// If not set, or no replica has the role, the replicas are updated in the order of their role update priority,
+	// from low to high.
+	//
+	// +optional
+	CanaryRole string `json:"canaryRole,omitempty"`
+
+	// Specifies the number of replicas to update in each batch after the canary.
+	//
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BatchSize int32 `json:"batchSize,omitempty"`
+
+	// Specifies the duration in seconds to watch the updated replicas before continuing with the next batch.
+	//
+	// +kubebuilder:default=60
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BakeSeconds int32 `json:"bakeSeconds,omitempty"`
+
+	// Specifies the maximum duration in seconds for the replicas of a batch to apply the change and become available.
+	// The rollout is considered as failed if it is exceeded.
+	//
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ProgressDeadlineSeconds int32 `json:"progressDeadlineSeconds,omitempty"`
+
+	// Specifies whether to revert the change to the previous revision automatically if the rollout fails.
+	// The previous values are written back to `spec.desired.assignments` as explicit assignments,
+	// which are recorded in `status.configurationStatus[*].reconcileDetail.rollout.revertedParameters`.
+	// If disabled, the rollout is paused when it fails.
+	//
+	// +kubebuilder:default=true
+	// +optional
+	AutoRevert *bool `json:"autoRevert,omitempty"`
+}
+
+// ParameterDriftDetection defines the periodic check of the live values of the parameters.
+//
+// The live values are queried from each replica through the `parameterQuery` lifecycle action, and compared with
+// the values in the rendered configuration. The check is skipped while a configuration change is in progress.
+type ParameterDriftDetection struct {
+	// Specifies the interval in seconds between two checks.
+	//
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+
+	// Specifies whether to correct the manually changed parameters automatically.
+	//
+	// When enabled, the rendered values of the manually changed parameters are applied to the replica again through
+	// the reconfigure action of their config template, and are reported as corrected only if the live values queried
+	// again are consistent. The parameters pending a restart are only reported, since a restart is disruptive.
+	// The parameters whose template has no reconfigure action, or uses the one specified by the Cluster, are not corrected.
+	//
+	// +optional
+	AutoCorrect bool `json:"autoCorrect,omitempty"`
 }
 
 // Deprecated: It is retained for API compatibility with existing ComponentParameter objects.
@@ -271,6 +357,72 @@ type ComponentParameterStatus struct {
 	// +listType=map
 	// +listMapKey=name
 	ConfigurationItemStatus []ConfigTemplateItemDetailStatus `json:"configurationStatus"`
+
+	// Drift represents the result of the most recent drift check, if the drift detection is enabled.
+	//
+	// +optional
+	Drift *ParameterDriftStatus `json:"drift,omitempty"`
+}
+
+// ParameterDriftReason describes why the live value of a parameter differs from the rendered configuration.
+//
+// +enum
+// +kubebuilder:validation:Enum={PendingRestart,ManuallyChanged}
+type ParameterDriftReason string
+
+const (
+	// ParameterPendingRestart indicates that the parameter is static and takes effect after the replica restarts.
+	ParameterPendingRestart ParameterDriftReason = "PendingRestart"
+
+	// ParameterManuallyChanged indicates that the parameter is dynamic, but the live value is not the rendered one,
+	// e.g. it is changed manually or the reload fails.
+	ParameterManuallyChanged ParameterDriftReason = "ManuallyChanged"
+)
+
+// ParameterDriftStatus represents the result of a drift check.
+type ParameterDriftStatus struct {
+	// LastCheckTime is the time when the most recent check is performed.
+	//
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// Parameters are the drifted parameters of the replicas.
+	//
+	// +optional
+	Parameters []DriftedParameter `json:"parameters,omitempty"`
+}
+
+// DriftedParameter represents a parameter whose live value differs from the rendered configuration.
+type DriftedParameter struct {
+	// FileName is the name of the configuration file that the parameter belongs to.
+	//
+	// +kubebuilder:validation:Required
+	FileName string `json:"fileName"`
+
+	// Name is the name of the parameter.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Instance is the name of the replica that the live value is queried from.
+	//
+	// +kubebuilder:validation:Required
+	Instance string `json:"instance"`
+
+	// ExpectedValue is the value in the rendered configuration.
+	//
+	// +optional
+	ExpectedValue string `json:"expectedValue,omitempty"`
+
+	// LiveValue is the value that the database engine is running with.
+	//
+	// +optional
+	LiveValue string `json:"liveValue,omitempty"`
+
+	// Reason describes why the parameter is drifted.
+	//
+	// +kubebuilder:validation:Required
+	Reason ParameterDriftReason `json:"reason"`
 }
 
 type ConfigTemplateItemDetailStatus struct {
@@ -314,6 +466,60 @@ type ConfigTemplateItemDetailStatus struct {
 	//
 	// +optional
 	ReconcileDetail *ReconcileDetail `json:"reconcileDetail,omitempty"`
+
+	// Reports the parameter values derived by the tuning profile selected for the configuration.
+	//
+	// +optional
+	Tuning *ParameterTuningStatus `json:"tuning,omitempty"`
+}
+
+// ParameterTuningStatus represents the result of the most recent evaluation of a tuning profile.
+type ParameterTuningStatus struct {
+	// Profile is the name of the evaluated tuning profile.
+	//
+	// +kubebuilder:validation:Required
+	Profile string `json:"profile"`
+
+	// DryRun indicates that the derived values are only previewed and have not been applied.
+	//
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Parameters are the values derived by the profile.
+	// Parameters set explicitly by the user are not included.
+	//
+	// +optional
+	Parameters []TunedParameter `json:"parameters,omitempty"`
+
+	// Message describes why the profile can not be evaluated in the dry run mode, e.g. a formula fails.
+	// The error does not block the configuration from being updated, since the profile is not applied anyway.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// TunedParameter represents a parameter value derived by a tuning profile.
+type TunedParameter struct {
+	// FileName is the name of the configuration file that the parameter belongs to.
+	//
+	// +kubebuilder:validation:Required
+	FileName string `json:"fileName"`
+
+	// Name is the name of the parameter.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Value is the value derived by the profile.
+	//
+	// +kubebuilder:validation:Required
+	Value string `json:"value"`
+
+	// CurrentValue is the value in the configuration when the profile is evaluated, or nil if the parameter is not set.
+	// The parameter changes if it differs from the derived value.
+	//
+	// +optional
+	CurrentValue *string `json:"currentValue,omitempty"`
 }
 
 type ReconcileDetail struct {
@@ -348,4 +554,50 @@ type ReconcileDetail struct {
 	//
 	// +optional
 	ErrMessage string `json:"errMessage,omitempty"`
+
+	// Represents the progress of the staged rollout, if the rollout strategy is specified.
+	//
+	// +optional
+	Rollout *ParameterRolloutStatus `json:"rollout,omitempty"`
+}
+
+// ParameterRolloutStatus represents the progress of a staged rollout of the configuration changes.
+type ParameterRolloutStatus struct {
+	// Instances are the replicas which the changes have been rolled out to so far.
+	//
+	// +optional
+	Instances []string `json:"instances,omitempty"`
+
+	// UpdatedInstances are the replicas which have applied the changes.
+	//
+	// +optional
+	UpdatedInstances []string `json:"updatedInstances,omitempty"`
+
+	// BatchStartTime is the time when the changes are rolled out to the current batch.
+	//
+	// +optional
+	BatchStartTime *metav1.Time `json:"batchStartTime,omitempty"`
+
+	// BakeStartTime is the time when all the replicas of the current batch have applied the changes and become available.
+	//
+	// +optional
+	BakeStartTime *metav1.Time `json:"bakeStartTime,omitempty"`
+
+	// BakedInstances are the replicas of the previous batches which have passed the bake time.
+	// They are still checked on every pass, and the rollout fails if any of them degrades.
+	//
+	// +optional
+	BakedInstances []string `json:"bakedInstances,omitempty"`
+
+	// Reverted indicates that the changes have been reverted to the previous revision since the rollout failed.
+	//
+	// +optional
+	Reverted bool `json:"reverted,omitempty"`
+
+	// RevertedParameters are the parameters which have been reverted to the previous values.
+	// The previous values are written to `spec.desired.assignments` as explicit assignments,
+	// and they are kept until they are changed again.
+	//
+	// +optional
+	RevertedParameters []string `json:"revertedParameters,omitempty"`
 }
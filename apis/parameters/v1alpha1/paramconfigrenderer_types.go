@@ -133,6 +133,64 @@ type ComponentConfigDescription struct {
 	// +listType=set
 	// +optional
 	ReRenderResourceTypes []RerenderResourceType `json:"reRenderResourceTypes,omitempty"`
+
+	// Specifies the tuning profiles that derive parameter values of the file from the resources of the Component.
+	//
+	// A profile is selected by its name in the `tuning` of the ClusterComponentConfig, and is applied to all the files
+	// of the config template that declare a profile with the same name.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	TuningProfiles []TuningProfile `json:"tuningProfiles,omitempty"`
+}
+
+// TuningProfile is a named set of formulas that derive parameter values from the resources of the Component.
+type TuningProfile struct {
+	// Specifies the name of the profile, e.g. "oltp", "analytics" or "low-memory".
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([a-z0-9\-]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Provides a brief description of the profile.
+	//
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Specifies the formulas of the parameters.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Parameters []TuningParameter `json:"parameters"`
+}
+
+// TuningParameter defines the formula that computes the value of a parameter.
+type TuningParameter struct {
+	// Specifies the name of the parameter.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Specifies the formula that computes the parameter value.
+	//
+	// The formula is a Go template rendered with the same built-in objects and functions as the config template,
+	// such as `$.component.replicas`, `$.component.resources`, `getContainerCPU`, `getContainerMemory`
+	// and `getComponentPVCSizeByName`.
+	// The rendered output, with the surrounding whitespaces trimmed, is used as the parameter value,
+	// and an empty output leaves the parameter unchanged.
+	//
+	// Example:
+	// ```
+	// {{- $mem := getContainerMemory (index $.podSpec.containers 0) }}
+	// {{- div (mul $mem 3) 4 }}
+	// ```
+	//
+	// +kubebuilder:validation:Required
+	Formula string `json:"formula"`
 }
 
 // ParamConfigRendererStatus defines the observed state of ParamConfigRenderer
@@ -165,6 +165,54 @@ type ParametersDefinitionSpec struct {
 	// +listType=set
 	// +optional
 	ImmutableParameters []string `json:"immutableParameters,omitempty"`
+
+	// Specifies the constraints between the parameters of the config file, which can not be expressed by the schema.
+	// For example, `innodb_buffer_pool_size` must be below 80% of the memory limit of the container.
+	//
+	// The constraints are validated when the parameters are updated, all the violations are reported.
+	// They are validated against the parameters assigned explicitly when the component is scaled as well.
+	// The violations caused by the changes of the component rather than the parameters do not block the
+	// configuration from being synced, but are reported in the status of the ComponentParameter.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Constraints []ParameterConstraint `json:"constraints,omitempty"`
+}
+
+// ParameterConstraint defines a constraint between the parameters in a CEL expression.
+type ParameterConstraint struct {
+	// The name of the constraint.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Specifies the CEL expression that must evaluate to true when the parameters satisfy the constraint.
+	//
+	// The following variables are available in the expression:
+	//
+	// - `parameters`: the parameters of the config file, keyed by the parameter name.
+	//   The values are typed according to the parameters schema, or strings if no schema is defined.
+	// - `component`: the component, with the fields `replicas`, `serviceVersion`, and `resources`.
+	//   The `resources` is the resource requirements of the component, e.g. `component.resources.limits.memory`,
+	//   the quantities are converted to integers, in millicores for `cpu` and in bytes for the others.
+	//
+	// The function `quantity(string)` is provided to convert a quantity string like "128Mi" to an integer.
+	//
+	// For example:
+	//
+	// - `quantity(parameters.innodb_buffer_pool_size) * 5 < component.resources.limits.memory * 4`
+	// - `!has(parameters.slave_parallel_workers) || parameters.slave_parallel_type == 'LOGICAL_CLOCK'`
+	//
+	// The cost of evaluating the expression is limited, the constraint is considered as violated if it is exceeded.
+	//
+	// +kubebuilder:validation:Required
+	Rule string `json:"rule"`
+
+	// Specifies the message to report when the constraint is violated.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // Deprecated: It is retained for API compatibility with existing ParametersDefinition objects.
@@ -426,7 +474,7 @@ type FileFormatConfig struct {
 	FormatterAction `json:",inline"`
 
 	// The config file format. Valid values are `ini`, `xml`, `yaml`, `json`,
-	// `hcl`, `dotenv`, `properties` and `toml`. Each format has its own characteristics and use cases.
+	// `hcl`, `dotenv`, `properties`, `toml`, `nginx` and `hocon`. Each format has its own characteristics and use cases.
 	//
 	// - ini: is a text-based content with a structure and syntax comprising key–value pairs for properties, reference wiki: https://en.wikipedia.org/wiki/INI_file
 	// - xml: refers to wiki: https://en.wikipedia.org/wiki/XML
@@ -437,6 +485,11 @@ type FileFormatConfig struct {
 	// - properties: a file extension mainly used in Java, reference wiki: https://en.wikipedia.org/wiki/.properties
 	// - toml: refers to wiki: https://en.wikipedia.org/wiki/TOML
 	// - props-plus: a file extension mainly used in Java, supports CamelCase(e.g: brokerMaxConnectionsPerIp)
+	// - nginx: nested brace blocks of `key value;` directives, e.g. nginx.conf. Parameters are addressed by the
+	//   dotted path of the enclosing blocks, e.g. `http.server.listen`, a block with arguments is addressed
+	//   by its name and arguments, e.g. `http.upstream backend.keepalive`.
+	// - hocon: Human-Optimized Config Object Notation used by Typesafe Config, reference url: https://github.com/lightbend/config/blob/main/HOCON.md
+	//   Parameters are addressed by the dotted path, e.g. `akka.actor.provider`.
 	//
 	// +kubebuilder:validation:Required
 	Format CfgFileFormat `json:"format"`
@@ -18,7 +18,7 @@ package v1alpha1
 
 // CfgFileFormat defines formatter of configuration files.
 // +enum
-// +kubebuilder:validation:Enum={xml,ini,yaml,json,hcl,dotenv,toml,properties,redis,props-plus,props-ultra}
+// +kubebuilder:validation:Enum={xml,ini,yaml,json,hcl,dotenv,toml,properties,redis,props-plus,props-ultra,nginx,hocon}
 type CfgFileFormat string
 
 const (
@@ -33,6 +33,8 @@ const (
 	RedisCfg        CfgFileFormat = "redis"
 	PropertiesPlus  CfgFileFormat = "props-plus"
 	PropertiesUltra CfgFileFormat = "props-ultra"
+	Nginx           CfgFileFormat = "nginx"
+	HOCON           CfgFileFormat = "hocon"
 )
 
 // ParametersDescPhase defines the ParametersDescription CR .status.phase
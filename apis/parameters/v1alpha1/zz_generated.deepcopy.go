@@ -58,6 +58,13 @@ func (in *ComponentConfigDescription) DeepCopyInto(out *ComponentConfigDescripti
 		*out = make([]RerenderResourceType, len(*in))
 		copy(*out, *in)
 	}
+	if in.TuningProfiles != nil {
+		in, out := &in.TuningProfiles, &out.TuningProfiles
+		*out = make([]TuningProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentConfigDescription.
@@ -149,6 +156,16 @@ func (in *ComponentParameterSpec) DeepCopyInto(out *ComponentParameterSpec) {
 		*out = new(ParameterInputs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(ParameterRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(ParameterDriftDetection)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentParameterSpec.
@@ -178,6 +195,11 @@ func (in *ComponentParameterStatus) DeepCopyInto(out *ComponentParameterStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(ParameterDriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentParameterStatus.
@@ -354,7 +376,12 @@ func (in *ConfigTemplateItemDetailStatus) DeepCopyInto(out *ConfigTemplateItemDe
 	if in.ReconcileDetail != nil {
 		in, out := &in.ReconcileDetail, &out.ReconcileDetail
 		*out = new(ReconcileDetail)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(ParameterTuningStatus)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -368,6 +395,21 @@ func (in *ConfigTemplateItemDetailStatus) DeepCopy() *ConfigTemplateItemDetailSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedParameter) DeepCopyInto(out *DriftedParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedParameter.
+func (in *DriftedParameter) DeepCopy() *DriftedParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileFormatConfig) DeepCopyInto(out *FileFormatConfig) {
 	*out = *in
@@ -588,6 +630,21 @@ func (in *Parameter) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterConstraint) DeepCopyInto(out *ParameterConstraint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterConstraint.
+func (in *ParameterConstraint) DeepCopy() *ParameterConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParameterDeletedPolicy) DeepCopyInto(out *ParameterDeletedPolicy) {
 	*out = *in
@@ -608,6 +665,45 @@ func (in *ParameterDeletedPolicy) DeepCopy() *ParameterDeletedPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterDriftDetection) DeepCopyInto(out *ParameterDriftDetection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterDriftDetection.
+func (in *ParameterDriftDetection) DeepCopy() *ParameterDriftDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterDriftDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterDriftStatus) DeepCopyInto(out *ParameterDriftStatus) {
+	*out = *in
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]DriftedParameter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterDriftStatus.
+func (in *ParameterDriftStatus) DeepCopy() *ParameterDriftStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterDriftStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParameterInputs) DeepCopyInto(out *ParameterInputs) {
 	*out = *in
@@ -692,6 +788,69 @@ func (in *ParameterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterRolloutStatus) DeepCopyInto(out *ParameterRolloutStatus) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdatedInstances != nil {
+		in, out := &in.UpdatedInstances, &out.UpdatedInstances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BatchStartTime != nil {
+		in, out := &in.BatchStartTime, &out.BatchStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BakeStartTime != nil {
+		in, out := &in.BakeStartTime, &out.BakeStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BakedInstances != nil {
+		in, out := &in.BakedInstances, &out.BakedInstances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RevertedParameters != nil {
+		in, out := &in.RevertedParameters, &out.RevertedParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterRolloutStatus.
+func (in *ParameterRolloutStatus) DeepCopy() *ParameterRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterRolloutStrategy) DeepCopyInto(out *ParameterRolloutStrategy) {
+	*out = *in
+	if in.AutoRevert != nil {
+		in, out := &in.AutoRevert, &out.AutoRevert
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterRolloutStrategy.
+func (in *ParameterRolloutStrategy) DeepCopy() *ParameterRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParameterSpec) DeepCopyInto(out *ParameterSpec) {
 	*out = *in
@@ -736,6 +895,28 @@ func (in *ParameterStatus) DeepCopy() *ParameterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterTuningStatus) DeepCopyInto(out *ParameterTuningStatus) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TunedParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterTuningStatus.
+func (in *ParameterTuningStatus) DeepCopy() *ParameterTuningStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterTuningStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParameterUpdate) DeepCopyInto(out *ParameterUpdate) {
 	*out = *in
@@ -1056,6 +1237,11 @@ func (in *ParametersDefinitionSpec) DeepCopyInto(out *ParametersDefinitionSpec)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]ParameterConstraint, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersDefinitionSpec.
@@ -1185,6 +1371,11 @@ func (in Payload) DeepCopy() Payload {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReconcileDetail) DeepCopyInto(out *ReconcileDetail) {
 	*out = *in
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ParameterRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileDetail.
@@ -1359,6 +1550,61 @@ func (in *ToolsSetup) DeepCopy() *ToolsSetup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TunedParameter) DeepCopyInto(out *TunedParameter) {
+	*out = *in
+	if in.CurrentValue != nil {
+		in, out := &in.CurrentValue, &out.CurrentValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunedParameter.
+func (in *TunedParameter) DeepCopy() *TunedParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(TunedParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningParameter) DeepCopyInto(out *TuningParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningParameter.
+func (in *TuningParameter) DeepCopy() *TuningParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningProfile) DeepCopyInto(out *TuningProfile) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TuningParameter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningProfile.
+func (in *TuningProfile) DeepCopy() *TuningProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnmanagedParameterSectionUpdate) DeepCopyInto(out *UnmanagedParameterSectionUpdate) {
 	*out = *in
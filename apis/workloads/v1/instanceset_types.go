@@ -565,6 +565,11 @@ type ConfigTemplate struct {
 	//
 	// +optional
 	ReconfigureArgs [][]string `json:"reconfigureArgs,omitempty"`
+
+	// The instances which the config changes are applied to, all the instances if it is empty.
+	//
+	// +optional
+	Instances []string `json:"instances,omitempty"`
 }
 
 // InstanceStatus describes the desired allocation and observed runtime state of an instance identity.
@@ -68,6 +68,11 @@ func (in *ConfigTemplate) DeepCopyInto(out *ConfigTemplate) {
 			}
 		}
 	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTemplate.
@@ -37,6 +37,7 @@ import (
 	kzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/apecloud/kubeblocks/pkg/kbagent"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
 	"github.com/apecloud/kubeblocks/pkg/kbagent/server"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
 )
@@ -75,9 +76,16 @@ func init() {
 	pflag.StringVar(&serverConfig.UnixDomainSocket, "unix-socket", "", "The path of the Unix Domain Socket for kb-agent service.")
 	pflag.IntVar(&serverConfig.Port, "port", kbagent.DefaultHTTPPort, "The HTTP Server listen port for kb-agent service.")
 	pflag.IntVar(&serverConfig.StreamingPort, "streaming-port", kbagent.DefaultStreamingPort, "The listen port used by kb-agent to stream data.")
+	pflag.IntVar(&serverConfig.GRPCPort, "grpc-port", kbagent.DefaultGRPCPort, "The gRPC Server listen port for kb-agent service.")
+	pflag.StringSliceVar(&serverConfig.Transports, "transports", []string{server.TransportHTTP},
+		fmt.Sprintf("The transports enabled to serve the kb-agent service, valid values are %s and %s.", server.TransportHTTP, server.TransportGRPC))
 	pflag.IntVar(&serverConfig.Concurrency, "max-concurrency", defaultMaxConcurrency,
 		fmt.Sprintf("The maximum number of concurrent connections the Server may serve, use the default value %d if <=0.", defaultMaxConcurrency))
 	pflag.BoolVar(&serverConfig.Logging, "api-logging", true, "Enable api logging for kb-agent request.")
+	pflag.StringVar(&serverConfig.AuthMode, "auth-mode", string(proto.AuthModeNone),
+		fmt.Sprintf("The mode to authenticate the clients of kb-agent service, valid values are %s, %s and %s.",
+			proto.AuthModeNone, proto.AuthModeToken, proto.AuthModeMTLS))
+	pflag.StringVar(&serverConfig.AuthDir, "auth-dir", kbagent.AuthMountPath, "The directory of the credentials used to authenticate the clients.")
 }
 
 func main() {
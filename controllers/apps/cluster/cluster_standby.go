@@ -0,0 +1,115 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cluster
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+// checkStandbyBootstrapped checks whether a standby cluster knows where to restore the data from.
+// The restore source is set to the latest backup of the primary cluster by the data protection controller
+// if it is not specified, and the cluster is not marked as bootstrapped.
+func checkStandbyBootstrapped(cluster *appsv1.Cluster) error {
+	if component.StandbyBootstrapPending(cluster) {
+		return intctrlutil.NewRequeueError(time.Second*10, "wait for the standby cluster to be bootstrapped from a backup of the primary")
+	}
+	return nil
+}
+
+// buildClusterReplicationStatus aggregates the replication status of the components, and the status of the
+// standby clusters replicating from the cluster.
+func buildClusterReplicationStatus(cluster *appsv1.Cluster, comps []*appsv1.Component, standbys []appsv1.Cluster) *appsv1.ClusterReplicationStatus {
+	status := &appsv1.ClusterReplicationStatus{
+		Role:   appsv1.PrimaryReplicationRole,
+		Fenced: len(comps) > 0,
+	}
+	observed := false
+	for _, comp := range comps {
+		replication := comp.Status.Replication
+		if replication == nil {
+			status.Fenced = false
+			continue
+		}
+		observed = true
+		if !replication.Fenced {
+			status.Fenced = false
+		}
+		if replication.Role != appsv1.StandbyReplicationRole {
+			continue
+		}
+		status.Role = appsv1.StandbyReplicationRole
+		if segments := strings.Split(replication.Source, "/"); len(segments) == 3 {
+			status.PrimaryCluster = strings.Join(segments[:2], "/")
+		}
+		if replication.LagSeconds != nil && (status.LagSeconds == nil || *replication.LagSeconds > *status.LagSeconds) {
+			status.LagSeconds = replication.LagSeconds
+		}
+	}
+	for _, standby := range standbys {
+		standbyStatus := appsv1.ClusterStandbyStatus{
+			Cluster: client.ObjectKeyFromObject(&standby).String(),
+		}
+		if standby.Status.Replication != nil {
+			standbyStatus.LagSeconds = standby.Status.Replication.LagSeconds
+		}
+		status.Standbys = append(status.Standbys, standbyStatus)
+	}
+	slices.SortFunc(status.Standbys, func(a, b appsv1.ClusterStandbyStatus) int {
+		return strings.Compare(a.Cluster, b.Cluster)
+	})
+	if !observed && len(status.Standbys) == 0 && cluster.Spec.Standby == nil && !cluster.Spec.Fenced {
+		return nil
+	}
+	return status
+}
+
+// listStandbyClusters lists the standby clusters replicating from the cluster.
+func listStandbyClusters(ctx context.Context, cli client.Reader, cluster *appsv1.Cluster) ([]appsv1.Cluster, error) {
+	clusterList := &appsv1.ClusterList{}
+	if err := cli.List(ctx, clusterList); err != nil {
+		return nil, err
+	}
+	var standbys []appsv1.Cluster
+	for _, c := range clusterList.Items {
+		if c.Spec.Standby != nil && component.StandbyPrimaryKey(&c) == client.ObjectKeyFromObject(cluster) {
+			standbys = append(standbys, c)
+		}
+	}
+	return standbys, nil
+}
+
+// standbyToPrimary enqueues the primary of a standby cluster, to refresh the status of the standby on the primary.
+func standbyToPrimary(_ context.Context, obj client.Object) []reconcile.Request {
+	cluster, ok := obj.(*appsv1.Cluster)
+	if !ok || cluster.Spec.Standby == nil {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: component.StandbyPrimaryKey(cluster)}}
+}
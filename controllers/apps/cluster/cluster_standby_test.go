@@ -0,0 +1,132 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+var _ = Describe("standby cluster", func() {
+	var cluster *appsv1.Cluster
+
+	newComp := func(replication *appsv1.ComponentReplicationStatus) *appsv1.Component {
+		return &appsv1.Component{
+			Status: appsv1.ComponentStatus{Replication: replication},
+		}
+	}
+
+	BeforeEach(func() {
+		cluster = &appsv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test",
+			},
+		}
+	})
+
+	It("wait for the standby to be bootstrapped", func() {
+		Expect(checkStandbyBootstrapped(cluster)).Should(Succeed())
+
+		cluster.Spec.Standby = &appsv1.ClusterStandby{PrimaryCluster: "primary"}
+		Expect(intctrlutil.IsRequeueError(checkStandbyBootstrapped(cluster))).Should(BeTrue())
+
+		cluster.Spec.Restore = &appsv1.ClusterRestore{}
+		Expect(checkStandbyBootstrapped(cluster)).Should(Succeed())
+
+		// the observed generation does not tell whether the data is in place
+		cluster.Spec.Restore = nil
+		cluster.Status.ObservedGeneration = 2
+		Expect(intctrlutil.IsRequeueError(checkStandbyBootstrapped(cluster))).Should(BeTrue())
+
+		// demoted from a primary
+		cluster.Annotations = map[string]string{constant.StandbyBootstrappedAnnotationKey: "true"}
+		Expect(checkStandbyBootstrapped(cluster)).Should(Succeed())
+	})
+
+	It("no replication", func() {
+		Expect(buildClusterReplicationStatus(cluster, []*appsv1.Component{newComp(nil)}, nil)).Should(BeNil())
+	})
+
+	It("standby", func() {
+		cluster.Spec.Standby = &appsv1.ClusterStandby{PrimaryCluster: "primary"}
+		comps := []*appsv1.Component{
+			newComp(&appsv1.ComponentReplicationStatus{
+				Role:       appsv1.StandbyReplicationRole,
+				Source:     "default/primary/mysql",
+				LagSeconds: ptr.To(int64(3)),
+			}),
+			newComp(&appsv1.ComponentReplicationStatus{
+				Role:       appsv1.StandbyReplicationRole,
+				Source:     "default/primary/proxy",
+				LagSeconds: ptr.To(int64(5)),
+			}),
+		}
+		status := buildClusterReplicationStatus(cluster, comps, nil)
+		Expect(status).ShouldNot(BeNil())
+		Expect(status.Role).Should(Equal(appsv1.StandbyReplicationRole))
+		Expect(status.PrimaryCluster).Should(Equal("default/primary"))
+		Expect(*status.LagSeconds).Should(Equal(int64(5)))
+		Expect(status.Fenced).Should(BeFalse())
+	})
+
+	It("fenced primary with standbys", func() {
+		comps := []*appsv1.Component{
+			newComp(&appsv1.ComponentReplicationStatus{Role: appsv1.PrimaryReplicationRole, Fenced: true}),
+		}
+		standbys := []appsv1.Cluster{
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "dr", Name: "b"},
+				Status: appsv1.ClusterStatus{
+					Replication: &appsv1.ClusterReplicationStatus{LagSeconds: ptr.To(int64(1))},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "dr", Name: "a"},
+			},
+		}
+		status := buildClusterReplicationStatus(cluster, comps, standbys)
+		Expect(status).ShouldNot(BeNil())
+		Expect(status.Role).Should(Equal(appsv1.PrimaryReplicationRole))
+		Expect(status.Fenced).Should(BeTrue())
+		Expect(status.Standbys).Should(HaveLen(2))
+		Expect(status.Standbys[0]).Should(Equal(appsv1.ClusterStandbyStatus{Cluster: "dr/a"}))
+		Expect(status.Standbys[1].Cluster).Should(Equal("dr/b"))
+		Expect(*status.Standbys[1].LagSeconds).Should(Equal(int64(1)))
+	})
+
+	It("not all components fenced", func() {
+		cluster.Spec.Fenced = true
+		comps := []*appsv1.Component{
+			newComp(&appsv1.ComponentReplicationStatus{Role: appsv1.PrimaryReplicationRole, Fenced: true}),
+			newComp(nil),
+		}
+		status := buildClusterReplicationStatus(cluster, comps, nil)
+		Expect(status).ShouldNot(BeNil())
+		Expect(status.Fenced).Should(BeFalse())
+	})
+})
@@ -268,6 +268,8 @@ func copyAndMergeComponent(oldCompObj, newCompObj *appsv1.Component) *appsv1.Com
 	compObjCopy.Spec.Env = compProto.Spec.Env
 	compObjCopy.Spec.VolumeClaimTemplates = compProto.Spec.VolumeClaimTemplates
 	compObjCopy.Spec.PersistentVolumeClaimRetentionPolicy = compProto.Spec.PersistentVolumeClaimRetentionPolicy
+	compObjCopy.Spec.VolumeAutoExpansion = compProto.Spec.VolumeAutoExpansion
+	compObjCopy.Spec.Replication = compProto.Spec.Replication
 	compObjCopy.Spec.Volumes = compProto.Spec.Volumes
 	compObjCopy.Spec.Network = compProto.Spec.Network
 	compObjCopy.Spec.Services = compProto.Spec.Services
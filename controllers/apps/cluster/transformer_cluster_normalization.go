@@ -73,6 +73,10 @@ func (t *clusterNormalizationTransformer) Transform(ctx graph.TransformContext,
 		return err
 	}
 
+	if err = checkStandbyBootstrapped(cluster); err != nil {
+		return err
+	}
+
 	if err = applyClusterRestoreIntent(cluster, transCtx.components, transCtx.shardings); err != nil {
 		return err
 	}
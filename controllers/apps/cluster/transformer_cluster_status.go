@@ -63,7 +63,27 @@ func (t *clusterStatusTransformer) reconcileClusterStatus(ctx context.Context, c
 		return nil
 	}
 	t.reconcileClusterPhase(cluster)
-	return t.syncClusterConditions(ctx, cli, cluster)
+	if err := t.syncClusterConditions(ctx, cli, cluster); err != nil {
+		return err
+	}
+	return t.reconcileReplicationStatus(ctx, cli, cluster)
+}
+
+func (t *clusterStatusTransformer) reconcileReplicationStatus(ctx context.Context, cli client.Reader, cluster *appsv1.Cluster) error {
+	comps, shardingComps, err := listClusterComponents(ctx, cli, cluster)
+	if err != nil {
+		return err
+	}
+	standbys, err := listStandbyClusters(ctx, cli, cluster)
+	if err != nil {
+		return err
+	}
+	allComps := maps.Values(comps)
+	for _, shardComps := range shardingComps {
+		allComps = append(allComps, shardComps...)
+	}
+	cluster.Status.Replication = buildClusterReplicationStatus(cluster, allComps, standbys)
+	return nil
 }
 
 func (t *clusterStatusTransformer) reconcileClusterPhase(cluster *appsv1.Cluster) appsv1.ClusterPhase {
@@ -24,13 +24,17 @@ import (
 	"slices"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
 
 	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	appsutil "github.com/apecloud/kubeblocks/controllers/apps/util"
 	"github.com/apecloud/kubeblocks/pkg/controller/component"
 	"github.com/apecloud/kubeblocks/pkg/controller/graph"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dprestore "github.com/apecloud/kubeblocks/pkg/dataprotection/restore"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 	"github.com/apecloud/kubeblocks/pkg/generics"
 )
 
@@ -62,6 +66,10 @@ func (t *clusterValidationTransformer) Transform(ctx graph.TransformContext, dag
 		return intctrlutil.NewRequeueError(appsutil.RequeueDuration, err.Error())
 	}
 
+	if err = t.checkRestorePointInTime(transCtx, cluster); err != nil {
+		return intctrlutil.NewRequeueError(appsutil.RequeueDuration, err.Error())
+	}
+
 	if withClusterTopology(cluster) {
 		// check again with cluster definition loaded,
 		// and update topology to cluster spec in case the default topology changed.
@@ -105,6 +113,27 @@ func (t *clusterValidationTransformer) checkDefinitionNamePattern(cluster *appsv
 	return nil
 }
 
+// checkRestorePointInTime rejects the restore time which is not recoverable with the continuous backup
+// before the cluster is provisioned, it is not checked again once the provisioning has started.
+func (t *clusterValidationTransformer) checkRestorePointInTime(transCtx *clusterTransformContext, cluster *appsv1.Cluster) error {
+	restore := cluster.Spec.Restore
+	if restore == nil || restore.PITR == "" ||
+		restore.Source.APIGroup != dptypes.DataprotectionAPIGroup || restore.Source.Kind != dptypes.BackupKind ||
+		meta.IsStatusConditionTrue(cluster.Status.Conditions, appsv1.ConditionTypeProvisioningStarted) {
+		return nil
+	}
+	namespace := restore.Source.Namespace
+	if namespace == "" {
+		namespace = cluster.Namespace
+	}
+	backup := &dpv1alpha1.Backup{}
+	if err := transCtx.Client.Get(transCtx.Context, types.NamespacedName{Namespace: namespace, Name: restore.Source.Name}, backup); err != nil {
+		return err
+	}
+	_, err := dprestore.ValidateRestorePointInTime(transCtx.Context, transCtx.Client, restore.PITR, backup)
+	return err
+}
+
 func (t *clusterValidationTransformer) checkNUpdateClusterTopology(transCtx *clusterTransformContext, cluster *appsv1.Cluster) error {
 	clusterTopology := referredClusterTopology(transCtx.clusterDef, cluster.Spec.Topology)
 	if clusterTopology == nil {
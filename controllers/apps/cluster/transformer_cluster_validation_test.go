@@ -20,10 +20,20 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package cluster
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
 	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 )
 
 var _ = Describe("cluster validation transformer test", func() {
@@ -77,4 +87,56 @@ var _ = Describe("cluster validation transformer test", func() {
 			Expect(withClusterUserDefined(cluster)).Should(BeFalse())
 		})
 	})
+
+	Context("restore point in time validation", func() {
+		It("rejects the unrecoverable restore time before provisioning", func() {
+			scheme := runtime.NewScheme()
+			Expect(dpv1alpha1.AddToScheme(scheme)).Should(Succeed())
+			start := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+			end := metav1.NewTime(start.Add(2 * time.Hour))
+			backup := &dpv1alpha1.Backup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "continuous", Labels: map[string]string{
+					dptypes.BackupTypeLabelKey: string(dpv1alpha1.BackupTypeContinuous),
+				}},
+				Status: dpv1alpha1.BackupStatus{TimeRange: &dpv1alpha1.BackupTimeRange{Start: &start, End: &end}},
+			}
+			actionSet := &dpv1alpha1.ActionSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "pitr"},
+				Spec: dpv1alpha1.ActionSetSpec{
+					Restore: &dpv1alpha1.RestoreActionSpec{BaseBackupRequired: ptr.To(false)},
+				},
+			}
+			backup.Status.BackupMethod = &dpv1alpha1.BackupMethod{ActionSetName: actionSet.Name}
+			transCtx := &clusterTransformContext{
+				Context: context.Background(),
+				Client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(backup, actionSet).Build(),
+			}
+			cluster := &appsv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cluster"},
+				Spec: appsv1.ClusterSpec{
+					Restore: &appsv1.ClusterRestore{
+						Source: appsv1.ClusterRestoreSource{
+							APIGroup: dptypes.DataprotectionAPIGroup,
+							Kind:     dptypes.BackupKind,
+							Name:     backup.Name,
+						},
+						PITR: "2026-01-01T01:00:00Z",
+					},
+				},
+			}
+			transformer := &clusterValidationTransformer{}
+			Expect(transformer.checkRestorePointInTime(transCtx, cluster)).Should(Succeed())
+
+			By("the restore time is out of the recoverable window")
+			cluster.Spec.Restore.PITR = "2026-01-01T03:00:00Z"
+			Expect(transformer.checkRestorePointInTime(transCtx, cluster)).Should(MatchError(ContainSubstring("2026-01-01T02:00:00Z")))
+
+			By("not checked again once the provisioning has started")
+			cluster.Status.Conditions = []metav1.Condition{{
+				Type:   appsv1.ConditionTypeProvisioningStarted,
+				Status: metav1.ConditionTrue,
+			}}
+			Expect(transformer.checkRestorePointInTime(transCtx, cluster)).Should(Succeed())
+		})
+	})
 })
@@ -155,6 +155,8 @@ func (r *ComponentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			&componentAccountTransformer{},
 			// handle the TLS
 			&componentTLSTransformer{},
+			// handle the credentials of the kb-agent
+			&componentKBAgentAuthTransformer{},
 			// resolve and build vars for template and Env
 			&componentVarsTransformer{},
 			// provision component system accounts, depend on vars
@@ -168,6 +170,8 @@ func (r *ComponentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			&componentWorkloadTransformer{Client: r.Client},
 			// handle component postProvision lifecycle action
 			&componentPostProvisionTransformer{},
+			// handle the disaster-recovery replication of the component
+			&componentReplicationTransformer{},
 			// update component status
 			&componentStatusTransformer{Client: r.Client},
 			// notify dependent components the possible spec changes
@@ -36,9 +36,11 @@ const (
 	preTerminateFailedEventReason  = "PreTerminateFailed"
 	memberJoinFailedEventReason    = "MemberJoinFailed"
 	memberLeaveFailedEventReason   = "MemberLeaveFailed"
+	replicationFailedEventReason   = "ReplicationFailed"
 
 	postProvisionFailureFingerprintAnnotationKey = "apps.kubeblocks.io/post-provision-failure-fingerprint"
 	preTerminateFailureFingerprintAnnotationKey  = "apps.kubeblocks.io/pre-terminate-failure-fingerprint"
+	replicationFailureFingerprintAnnotationKey   = "apps.kubeblocks.io/replication-failure-fingerprint"
 )
 
 func reportLifecycleActionFailureEvent(transCtx *componentTransformContext, dag *graph.DAG,
@@ -0,0 +1,137 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package component
+
+import (
+	"crypto/rand"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	"github.com/apecloud/kubeblocks/pkg/controller/plan"
+	"github.com/apecloud/kubeblocks/pkg/kbagent"
+	kbaproto "github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+// componentKBAgentAuthTransformer handles the credentials used by the kb-agent to authenticate its callers.
+type componentKBAgentAuthTransformer struct{}
+
+var _ graph.Transformer = &componentKBAgentAuthTransformer{}
+
+func (t *componentKBAgentAuthTransformer) Transform(ctx graph.TransformContext, dag *graph.DAG) error {
+	var (
+		transCtx        = ctx.(*componentTransformContext)
+		synthesizedComp = transCtx.SynthesizeComponent
+		graphCli, _     = transCtx.Client.(model.GraphClient)
+	)
+
+	secretObj, err := t.secretObject(transCtx, synthesizedComp)
+	if err != nil {
+		return err
+	}
+
+	mode := kbagent.AuthModeOf(synthesizedComp.PodSpec)
+	if mode == "" || mode == kbaproto.AuthModeNone {
+		if secretObj != nil {
+			graphCli.Delete(dag, secretObj)
+		}
+		return nil
+	}
+
+	secret, err := newKBAgentAuthSecret(transCtx.Component, synthesizedComp)
+	if err != nil {
+		return err
+	}
+	if secretObj == nil {
+		if secret, err = t.composeCredentials(synthesizedComp, secret); err != nil {
+			return err
+		}
+		graphCli.Create(dag, secret)
+	} else {
+		// the credentials are generated once, we only support updating labels and annotations.
+		secretCopy := secretObj.DeepCopy()
+		secretCopy.Labels = secret.Labels
+		secretCopy.Annotations = secret.Annotations
+		if !reflect.DeepEqual(secretObj, secretCopy) {
+			graphCli.Update(dag, secretObj, secretCopy)
+		}
+	}
+	component.AddInstanceAssistantObject(synthesizedComp, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: synthesizedComp.Namespace,
+			Name:      kbagent.AuthSecretName(synthesizedComp.ClusterName, synthesizedComp.Name),
+		},
+	})
+	return nil
+}
+
+func (t *componentKBAgentAuthTransformer) secretObject(transCtx *componentTransformContext,
+	synthesizedComp *component.SynthesizedComponent) (*corev1.Secret, error) {
+	secretKey := types.NamespacedName{
+		Namespace: synthesizedComp.Namespace,
+		Name:      kbagent.AuthSecretName(synthesizedComp.ClusterName, synthesizedComp.Name),
+	}
+	secret := &corev1.Secret{}
+	err := transCtx.Client.Get(transCtx.Context, secretKey, secret)
+	if err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return secret, nil
+}
+
+// composeCredentials generates both the token and the certificates, so that the auth mode can be switched
+// without re-generating the credentials.
+func (t *componentKBAgentAuthTransformer) composeCredentials(synthesizedComp *component.SynthesizedComponent,
+	secret *corev1.Secret) (*corev1.Secret, error) {
+	secret.Data[kbaproto.AuthTokenKey] = []byte(rand.Text())
+	keys := plan.TLSSecretKeys{
+		CA:   ptr.To(kbaproto.AuthCAKey),
+		Cert: ptr.To(kbaproto.AuthCertKey),
+		Key:  ptr.To(kbaproto.AuthKeyKey),
+	}
+	return plan.ComposeTLSCertsWithSecret(*synthesizedComp, keys, secret)
+}
+
+func newKBAgentAuthSecret(comp *appsv1.Component, synthesizedComp *component.SynthesizedComponent) (*corev1.Secret, error) {
+	secretName := kbagent.AuthSecretName(synthesizedComp.ClusterName, synthesizedComp.Name)
+	secret := builder.NewSecretBuilder(synthesizedComp.Namespace, secretName).
+		// priority: static < dynamic < built-in
+		AddLabelsInMap(synthesizedComp.StaticLabels).
+		AddLabelsInMap(synthesizedComp.DynamicLabels).
+		AddLabelsInMap(constant.GetCompLabels(synthesizedComp.ClusterName, synthesizedComp.Name)).
+		AddAnnotationsInMap(synthesizedComp.StaticAnnotations).
+		AddAnnotationsInMap(synthesizedComp.DynamicAnnotations).
+		SetData(map[string][]byte{}).
+		GetObject()
+	if err := setCompOwnershipNFinalizer(comp, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package component
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	appsutil "github.com/apecloud/kubeblocks/controllers/apps/util"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	"github.com/apecloud/kubeblocks/pkg/kbagent"
+	kbaproto "github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+var _ = Describe("kb-agent auth transformer test", func() {
+	const (
+		clusterName = "test-cluster"
+		compName    = "comp"
+	)
+
+	var (
+		reader   *appsutil.MockReader
+		dag      *graph.DAG
+		transCtx *componentTransformContext
+	)
+
+	BeforeEach(func() {
+		reader = &appsutil.MockReader{}
+
+		comp := &appsv1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testCtx.DefaultNamespace,
+				Name:      constant.GenerateClusterComponentName(clusterName, compName),
+				Labels: map[string]string{
+					constant.AppManagedByLabelKey:   constant.AppName,
+					constant.AppInstanceLabelKey:    clusterName,
+					constant.KBAppComponentLabelKey: compName,
+				},
+			},
+			Spec: appsv1.ComponentSpec{},
+		}
+
+		graphCli := model.NewGraphClient(reader)
+		dag = graph.NewDAG()
+		graphCli.Root(dag, comp, comp, model.ActionStatusPtr())
+
+		transCtx = &componentTransformContext{
+			Context:       ctx,
+			Client:        graphCli,
+			EventRecorder: nil,
+			Logger:        logger,
+			Component:     comp,
+			ComponentOrig: comp.DeepCopy(),
+			SynthesizeComponent: &component.SynthesizedComponent{
+				Namespace:   testCtx.DefaultNamespace,
+				ClusterName: clusterName,
+				Name:        compName,
+				PodSpec: &corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: kbagent.ContainerName,
+						},
+					},
+				},
+			},
+		}
+	})
+
+	authSecrets := func() []client.Object {
+		graphCli := transCtx.Client.(model.GraphClient)
+		return graphCli.FindAll(dag, &corev1.Secret{})
+	}
+
+	It("disabled", func() {
+		transformer := &componentKBAgentAuthTransformer{}
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+		Expect(authSecrets()).Should(BeEmpty())
+	})
+
+	It("enabled", func() {
+		transCtx.SynthesizeComponent.PodSpec.Containers[0].Args = kbagent.AuthArgs(kbaproto.AuthModeToken)
+
+		transformer := &componentKBAgentAuthTransformer{}
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+
+		objs := authSecrets()
+		Expect(objs).Should(HaveLen(1))
+		secret := objs[0].(*corev1.Secret)
+		Expect(secret.GetName()).Should(Equal(kbagent.AuthSecretName(clusterName, compName)))
+		for _, key := range []string{kbaproto.AuthTokenKey, kbaproto.AuthCAKey, kbaproto.AuthCertKey, kbaproto.AuthKeyKey} {
+			Expect(secret.Data).Should(HaveKey(key))
+		}
+		_, err := kbaproto.NewCredentials(kbaproto.AuthModeMTLS, secret.Data)
+		Expect(err).Should(BeNil())
+	})
+
+	It("disable after provision", func() {
+		reader.Objects = append(reader.Objects, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testCtx.DefaultNamespace,
+				Name:      kbagent.AuthSecretName(clusterName, compName),
+			},
+		})
+
+		transformer := &componentKBAgentAuthTransformer{}
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+
+		objs := authSecrets()
+		Expect(objs).Should(HaveLen(1))
+		graphCli := transCtx.Client.(model.GraphClient)
+		Expect(graphCli.IsAction(dag, objs[0], model.ActionDeletePtr())).Should(BeTrue())
+	})
+})
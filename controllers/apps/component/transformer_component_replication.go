@@ -0,0 +1,167 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package component
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/lifecycle"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+const (
+	replicationSetupStep = "replicationSetup"
+	promoteStep          = "promote"
+	readonlyStep         = "readonly"
+	readwriteStep        = "readwrite"
+)
+
+// componentReplicationTransformer drives the disaster-recovery replication of the component, it sets up
+// the replication from the source, promotes the component when the source is removed, and fences it
+// through the readonly and readwrite lifecycle actions.
+type componentReplicationTransformer struct{}
+
+var _ graph.Transformer = &componentReplicationTransformer{}
+
+func (t *componentReplicationTransformer) Transform(ctx graph.TransformContext, dag *graph.DAG) error {
+	transCtx, _ := ctx.(*componentTransformContext)
+	if isCompDeleting(transCtx.ComponentOrig) {
+		return nil
+	}
+
+	comp := transCtx.Component
+	synthesizedComp := transCtx.SynthesizeComponent
+	if synthesizedComp == nil || (comp.Spec.Replication == nil && comp.Status.Replication == nil) {
+		return nil
+	}
+	step, pending := nextReplicationStep(comp.Spec.Replication, comp.Status.Replication)
+	if !pending {
+		return nil
+	}
+	if !checkPostProvisionDone(transCtx) || comp.Status.Phase != appsv1.RunningComponentPhase {
+		return intctrlutil.NewDelayedRequeueError(time.Second*10, "wait for the component to be running to replicate")
+	}
+
+	if err := t.call(transCtx, comp.Spec.Replication, step); err != nil {
+		err = lifecycle.IgnoreNotDefined(err)
+		if err != nil {
+			reportLifecycleActionFailureEvent(transCtx, dag,
+				replicationFailureFingerprintAnnotationKey, replicationFailedEventReason, step, err)
+			if errors.Is(err, lifecycle.ErrPreconditionFailed) {
+				return fmt.Errorf("%w: %w", intctrlutil.NewDelayedRequeueError(time.Second*10, "wait for lifecycle action precondition"), err)
+			}
+			return fmt.Errorf("%w: %w", intctrlutil.NewDelayedRequeueError(time.Second*5, fmt.Sprintf("%s action failed", step)), err)
+		}
+	}
+	comp.Status.Replication = applyReplicationStep(comp.Spec.Replication, comp.Status.Replication, step)
+
+	// there may be more steps to take, e.g. fence the component after promoting it
+	if _, pending = nextReplicationStep(comp.Spec.Replication, comp.Status.Replication); pending {
+		return intctrlutil.NewDelayedRequeueError(time.Second, "continue to replicate")
+	}
+	return nil
+}
+
+func (t *componentReplicationTransformer) call(transCtx *componentTransformContext, replication *appsv1.ComponentReplication, step string) error {
+	synthesizedComp := transCtx.SynthesizeComponent
+	if synthesizedComp.LifecycleActions.ComponentLifecycleActions == nil {
+		return lifecycle.ErrActionNotDefined
+	}
+	pods, err := component.ListOwnedInstances(transCtx.Context, transCtx.Client, transCtx.Component, transCtx.RunningWorkload)
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return fmt.Errorf("has no pods to running the %s action", step)
+	}
+	lfa, err := lifecycle.New(synthesizedComp.Namespace, synthesizedComp.ClusterName, synthesizedComp.Name,
+		synthesizedComp.LifecycleActions.ComponentLifecycleActions, synthesizedComp.TemplateVars, nil, pods)
+	if err != nil {
+		return err
+	}
+	switch step {
+	case replicationSetupStep:
+		return lfa.ReplicationSetup(transCtx.Context, transCtx.Client, nil, *replication.Source)
+	case promoteStep:
+		return lfa.Promote(transCtx.Context, transCtx.Client, nil)
+	case readonlyStep:
+		return lfa.Readonly(transCtx.Context, transCtx.Client, nil)
+	default:
+		return lfa.Readwrite(transCtx.Context, transCtx.Client, nil)
+	}
+}
+
+// nextReplicationStep returns the next lifecycle action to take to make the observed replication status
+// meet the spec. A standby is set up first, it is up to the engine to keep a standby read-only.
+func nextReplicationStep(spec *appsv1.ComponentReplication, status *appsv1.ComponentReplicationStatus) (string, bool) {
+	if spec == nil {
+		spec = &appsv1.ComponentReplication{}
+	}
+	if status == nil {
+		status = &appsv1.ComponentReplicationStatus{}
+	}
+	if spec.Source != nil {
+		if status.Role != appsv1.StandbyReplicationRole || status.Source != component.ReplicationSourceKey(spec.Source) {
+			return replicationSetupStep, true
+		}
+		return "", false
+	}
+	if status.Role == appsv1.StandbyReplicationRole {
+		return promoteStep, true
+	}
+	if spec.Fenced && !status.Fenced {
+		return readonlyStep, true
+	}
+	if !spec.Fenced && status.Fenced {
+		return readwriteStep, true
+	}
+	return "", false
+}
+
+func applyReplicationStep(spec *appsv1.ComponentReplication, status *appsv1.ComponentReplicationStatus, step string) *appsv1.ComponentReplicationStatus {
+	if status == nil {
+		status = &appsv1.ComponentReplicationStatus{
+			Role: appsv1.PrimaryReplicationRole,
+		}
+	}
+	switch step {
+	case replicationSetupStep:
+		status.Role = appsv1.StandbyReplicationRole
+		status.Source = component.ReplicationSourceKey(spec.Source)
+		status.Fenced = false
+		status.LagSeconds = nil
+		status.LastLagProbeTime = nil
+	case promoteStep:
+		status.Role = appsv1.PrimaryReplicationRole
+		status.Source = ""
+		status.LagSeconds = nil
+		status.LastLagProbeTime = nil
+	case readonlyStep:
+		status.Fenced = true
+	case readwriteStep:
+		status.Fenced = false
+	}
+	return status
+}
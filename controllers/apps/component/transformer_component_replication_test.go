@@ -0,0 +1,104 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package component
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+)
+
+var _ = Describe("replication transformer", func() {
+	var source = &appsv1.ComponentReplicationSource{
+		Namespace:     "default",
+		ClusterName:   "primary",
+		ComponentName: "mysql",
+	}
+
+	// reconcile takes the replication steps till the status meets the spec
+	reconcile := func(spec *appsv1.ComponentReplication, status *appsv1.ComponentReplicationStatus) ([]string, *appsv1.ComponentReplicationStatus) {
+		var steps []string
+		for {
+			step, pending := nextReplicationStep(spec, status)
+			if !pending {
+				return steps, status
+			}
+			steps = append(steps, step)
+			status = applyReplicationStep(spec, status, step)
+			Expect(len(steps)).Should(BeNumerically("<=", 2))
+		}
+	}
+
+	It("no replication", func() {
+		steps, status := reconcile(nil, nil)
+		Expect(steps).Should(BeEmpty())
+		Expect(status).Should(BeNil())
+	})
+
+	It("set up a standby", func() {
+		steps, status := reconcile(&appsv1.ComponentReplication{Source: source}, nil)
+		Expect(steps).Should(Equal([]string{replicationSetupStep}))
+		Expect(status.Role).Should(Equal(appsv1.StandbyReplicationRole))
+		Expect(status.Source).Should(Equal("default/primary/mysql"))
+	})
+
+	It("demote a fenced primary", func() {
+		steps, status := reconcile(&appsv1.ComponentReplication{Source: source},
+			&appsv1.ComponentReplicationStatus{Role: appsv1.PrimaryReplicationRole, Fenced: true})
+		Expect(steps).Should(Equal([]string{replicationSetupStep}))
+		Expect(status.Role).Should(Equal(appsv1.StandbyReplicationRole))
+		Expect(status.Fenced).Should(BeFalse())
+	})
+
+	It("replicate from another source", func() {
+		status := &appsv1.ComponentReplicationStatus{Role: appsv1.StandbyReplicationRole, Source: "default/old/mysql"}
+		steps, status := reconcile(&appsv1.ComponentReplication{Source: source}, status)
+		Expect(steps).Should(Equal([]string{replicationSetupStep}))
+		Expect(status.Source).Should(Equal("default/primary/mysql"))
+	})
+
+	It("promote a standby", func() {
+		lag := int64(3)
+		status := &appsv1.ComponentReplicationStatus{Role: appsv1.StandbyReplicationRole, Source: "default/primary/mysql", LagSeconds: &lag}
+		steps, status := reconcile(nil, status)
+		Expect(steps).Should(Equal([]string{promoteStep}))
+		Expect(status.Role).Should(Equal(appsv1.PrimaryReplicationRole))
+		Expect(status.Source).Should(BeEmpty())
+		Expect(status.LagSeconds).Should(BeNil())
+	})
+
+	It("promote and fence", func() {
+		status := &appsv1.ComponentReplicationStatus{Role: appsv1.StandbyReplicationRole, Source: "default/primary/mysql"}
+		steps, status := reconcile(&appsv1.ComponentReplication{Fenced: true}, status)
+		Expect(steps).Should(Equal([]string{promoteStep, readonlyStep}))
+		Expect(status.Fenced).Should(BeTrue())
+	})
+
+	It("fence and unfence a primary", func() {
+		steps, status := reconcile(&appsv1.ComponentReplication{Fenced: true}, nil)
+		Expect(steps).Should(Equal([]string{readonlyStep}))
+		Expect(status).Should(Equal(&appsv1.ComponentReplicationStatus{Role: appsv1.PrimaryReplicationRole, Fenced: true}))
+
+		steps, status = reconcile(nil, status)
+		Expect(steps).Should(Equal([]string{readwriteStep}))
+		Expect(status.Fenced).Should(BeFalse())
+	})
+})
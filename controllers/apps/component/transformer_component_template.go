@@ -411,6 +411,7 @@ func (t *componentFileTemplateTransformer) buildConfigTemplates(transCtx *compon
 				ReconfigureActionName: actionName(tpl),
 				Parameters:            parameters(tpl),
 				ReconfigureArgs:       tpl.ReconfigureArgs,
+				Instances:             tpl.Instances,
 			}
 			synthesizedComp.Configs = append(synthesizedComp.Configs, config)
 		}
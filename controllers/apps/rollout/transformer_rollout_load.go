@@ -36,6 +36,7 @@ import (
 const (
 	componentNotReadyRequeueDuration = 10 * time.Second
 	infiniteDelayRequeueDuration     = 3600 * time.Second
+	maintenanceWindowRequeueDuration = 300 * time.Second
 )
 
 type rolloutLoadTransformer struct{}
@@ -93,6 +93,10 @@ func (t *rolloutReplaceTransformer) component(transCtx *rolloutTransformContext,
 		return controllerutil.NewDelayedRequeueError(componentNotReadyRequeueDuration, fmt.Sprintf("the component %s is not ready", comp.Name))
 	}
 
+	if err := checkMaintenanceWindow(transCtx); err != nil {
+		return err
+	}
+
 	// update cluster spec after the cluster and component are ready
 	if !exist {
 		for _, tpl := range tpls {
@@ -275,6 +279,10 @@ func (t *rolloutReplaceTransformer) sharding(transCtx *rolloutTransformContext,
 		return controllerutil.NewDelayedRequeueError(componentNotReadyRequeueDuration, fmt.Sprintf("the sharding %s is not ready", sharding.Name))
 	}
 
+	if err := checkMaintenanceWindow(transCtx); err != nil {
+		return err
+	}
+
 	// update cluster spec after the cluster and sharding are ready
 	if !exist {
 		for _, tpl := range tpls {
@@ -430,6 +438,19 @@ func (t *rolloutReplaceTransformer) pickShardingInstancesToRollout(transCtx *rol
 	return "", nil, fmt.Errorf("the instance template %s has not been found", tplName)
 }
 
+// checkMaintenanceWindow holds the instance replacement until the maintenance window of the cluster opens.
+func checkMaintenanceWindow(transCtx *rolloutTransformContext) error {
+	allowed, next, err := transCtx.Cluster.Spec.MaintenanceWindowPolicy.Allows(appsv1.RolloutReplaceOperation, time.Now())
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return controllerutil.NewDelayedRequeueError(min(time.Until(next), maintenanceWindowRequeueDuration),
+			fmt.Sprintf("wait for the maintenance window which opens at %s", next.Format(time.RFC3339)))
+	}
+	return nil
+}
+
 func replaceCompReplicas(rollout *appsv1alpha1.Rollout,
 	comp appsv1alpha1.RolloutComponent, spec *appsv1.ClusterComponentSpec) (int32, int32, error) {
 	// the original replicas
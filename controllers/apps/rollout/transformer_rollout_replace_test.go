@@ -21,11 +21,14 @@ package rollout
 
 import (
 	"testing"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/controllerutil"
 )
 
 func TestReplaceCompInstanceTemplatesFromSpecSupportsLegacyPrefix(t *testing.T) {
@@ -181,3 +184,36 @@ func TestReplaceShardingInstanceTemplatesAlwaysProvidesDefaultForFreshRollout(t
 		t.Fatalf("expected a default template entry for the current rollout, got nil — this is the regression that panics teardown")
 	}
 }
+
+func TestCheckMaintenanceWindowHoldsReplacement(t *testing.T) {
+	now := time.Now()
+	transCtx := &rolloutTransformContext{
+		Cluster: &appsv1.Cluster{
+			Spec: appsv1.ClusterSpec{
+				MaintenanceWindowPolicy: &appsv1.MaintenanceWindowPolicy{
+					BlackoutPeriods: []appsv1.BlackoutPeriod{
+						{
+							Start: metav1.NewTime(now.Add(-time.Hour)),
+							End:   metav1.NewTime(now.Add(time.Hour)),
+						},
+					},
+				},
+			},
+		},
+	}
+	if err := checkMaintenanceWindow(transCtx); !controllerutil.IsDelayedRequeueError(err) {
+		t.Fatalf("expected a delayed requeue error within the blackout period, got %v", err)
+	}
+
+	transCtx.Cluster.Spec.MaintenanceWindowPolicy.Operations = []appsv1.MaintenanceOperation{
+		{Name: appsv1.RolloutReplaceOperation, Disruptive: false},
+	}
+	if err := checkMaintenanceWindow(transCtx); err != nil {
+		t.Fatalf("expected no error for a non-disruptive replacement, got %v", err)
+	}
+
+	transCtx.Cluster.Spec.MaintenanceWindowPolicy = nil
+	if err := checkMaintenanceWindow(transCtx); err != nil {
+		t.Fatalf("expected no error without maintenance window policy, got %v", err)
+	}
+}
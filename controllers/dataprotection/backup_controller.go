@@ -24,6 +24,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -57,6 +59,7 @@ import (
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/action"
 	dpbackup "github.com/apecloud/kubeblocks/pkg/dataprotection/backup"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/kms"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
@@ -83,6 +86,7 @@ func (e *backupNamespaceTerminatingError) Error() string {
 // +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups/finalizers,verbs=update
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backupcatalogs,verbs=get;list;watch;create;update;patch;delete
 
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create;update;patch;delete
@@ -90,6 +94,7 @@ func (e *backupNamespaceTerminatingError) Error() string {
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotclasses,verbs=get;list;watch
 
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 
@@ -122,6 +127,14 @@ func (r *BackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 	reqCtx.Log.V(1).Info("reconcile", "backup", req.NamespacedName, "phase", backup.Status.Phase)
 
+	// the locked backup can not be deleted until the lock is released, and it keeps
+	// its phase so that it is still available for restore.
+	if !backup.GetDeletionTimestamp().IsZero() {
+		if blocked, res, err := r.blockLockedBackupDeletion(reqCtx, backup); blocked {
+			return res, err
+		}
+	}
+
 	// if backup is being deleted, set backup phase to Deleting. The backup
 	// reference workloads, data and volume snapshots will be deleted by controller
 	// later when the backup status.phase is deleting.
@@ -134,7 +147,7 @@ func (r *BackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	switch backup.Status.Phase {
-	case "", dpv1alpha1.BackupPhaseNew:
+	case "", dpv1alpha1.BackupPhaseNew, dpv1alpha1.BackupPhasePending:
 		return r.handleNewPhase(reqCtx, backup)
 	case dpv1alpha1.BackupPhaseRunning:
 		return r.handleRunningPhase(reqCtx, backup)
@@ -241,7 +254,12 @@ func (r *BackupReconciler) deleteBackupFiles(reqCtx intctrlutil.RequestCtx, back
 		},
 	}
 
-	status, err := deleter.DeleteBackupFiles(backup)
+	// delete the copies stored in the secondary backup repositories first,
+	// and then the backup files stored in the primary backup repository.
+	status, err := deleteBackupCopies(deleter, backup)
+	if status == dpbackup.DeletionStatusSucceeded {
+		status, err = deleter.DeleteBackupFiles(backup)
+	}
 	switch status {
 	case dpbackup.DeletionStatusSucceeded:
 		return deleteBackup()
@@ -262,6 +280,19 @@ func (r *BackupReconciler) deleteBackupFiles(reqCtx intctrlutil.RequestCtx, back
 	return err
 }
 
+// deleteBackupCopies deletes the files of the backup copies which have not been expired.
+func deleteBackupCopies(deleter *dpbackup.Deleter, backup *dpv1alpha1.Backup) (dpbackup.DeletionStatus, error) {
+	for _, backupCopy := range backup.Status.Copies {
+		if backupCopy.Phase == dpv1alpha1.BackupCopyPhaseExpired {
+			continue
+		}
+		if status, err := deleter.DeleteBackupCopyFiles(backup, backupCopy.BackupRepoName); status != dpbackup.DeletionStatusSucceeded {
+			return status, err
+		}
+	}
+	return dpbackup.DeletionStatusSucceeded, nil
+}
+
 func (r *BackupReconciler) recordBackupDeletionBlocker(
 	reqCtx intctrlutil.RequestCtx,
 	backup *dpv1alpha1.Backup,
@@ -296,6 +327,54 @@ func (r *BackupReconciler) ensureWorkerServiceAccountForBackupDeletion(reqCtx in
 	return EnsureWorkerServiceAccount(reqCtx, r.Client, namespace, nil)
 }
 
+// blockLockedBackupDeletion blocks the deletion of the backup if the backup itself,
+// or any backup depending on it, is under legal hold or locked until a future time.
+func (r *BackupReconciler) blockLockedBackupDeletion(reqCtx intctrlutil.RequestCtx,
+	backup *dpv1alpha1.Backup) (bool, ctrl.Result, error) {
+	now := time.Now()
+	lockedBackup := backup
+	if !backup.IsLocked(now) {
+		dependents, err := listDependentBackups(reqCtx.Ctx, r.Client, backup)
+		if err != nil {
+			res, err := intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+			return true, res, err
+		}
+		lockedBackup = nil
+		for _, dependent := range dependents {
+			if dependent.IsLocked(now) {
+				lockedBackup = dependent
+				break
+			}
+		}
+		if lockedBackup == nil {
+			return false, ctrl.Result{}, nil
+		}
+	}
+
+	deletionFailureReason := fmt.Sprintf("deletion is blocked, %s", backupLockMessage(lockedBackup))
+	if backup.Status.DeletionFailureReason != deletionFailureReason {
+		patch := client.MergeFrom(backup.DeepCopy())
+		backup.Status.DeletionFailureReason = deletionFailureReason
+		if err := r.Status().Patch(reqCtx.Ctx, backup, patch); err != nil {
+			res, err := intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+			return true, res, err
+		}
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "BackupLocked", deletionFailureReason)
+	}
+
+	switch {
+	case lockedBackup.Spec.LegalHold && lockedBackup == backup:
+		// releasing the legal hold will trigger the reconciliation.
+		return true, ctrl.Result{}, nil
+	case lockedBackup.Spec.LegalHold:
+		res, err := intctrlutil.RequeueAfter(time.Minute, reqCtx.Log, "wait for the dependent backup to be released")
+		return true, res, err
+	default:
+		res, err := intctrlutil.RequeueAfter(lockedBackup.Spec.LockUntil.Sub(now), reqCtx.Log, "wait for the backup lock to expire")
+		return true, res, err
+	}
+}
+
 // handleDeletingPhase handles the deletion of backup. It will delete the backup CR
 // and the backup workload(job).
 func (r *BackupReconciler) handleDeletingPhase(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup) (ctrl.Result, error) {
@@ -311,6 +390,13 @@ func (r *BackupReconciler) handleDeletingPhase(reqCtx intctrlutil.RequestCtx, ba
 		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
 	}
 
+	// delete the catalog manifests which have not been handed over, e.g. of a failed backup.
+	if manifestList, err := r.listBackupCatalogManifests(reqCtx, backup); err != nil {
+		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+	} else if err = r.deleteBackupCatalogManifests(reqCtx, manifestList); err != nil {
+		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+	}
+
 	if backup.Spec.DeletionPolicy == dpv1alpha1.BackupDeletionPolicyRetain {
 		r.Recorder.Event(backup, corev1.EventTypeWarning, "Retain", "can not delete the backup if deletionPolicy is Retain")
 		return intctrlutil.Reconciled()
@@ -346,6 +432,7 @@ func (r *BackupReconciler) handleNewPhase(
 		return r.updateStatusIfFailed(reqCtx, backup, request.Backup, err)
 	}
 	backupStatusCopy := request.Backup.Status.DeepCopy()
+	throttled := setBackupThrottleAnnotations(request)
 	// set and patch backup object meta, including labels, annotations and finalizers
 	// if the backup object meta is changed, the backup object will be patched.
 	if wait, err := PatchBackupObjectMeta(backup, request); err != nil {
@@ -354,6 +441,12 @@ func (r *BackupReconciler) handleNewPhase(
 		return intctrlutil.Reconciled()
 	}
 	request.Backup.Status = *backupStatusCopy
+	// wait in the pending phase if the throttle limits are reached.
+	if reason, err := r.admitBackup(reqCtx, request, throttled); err != nil {
+		return r.updateStatusIfFailed(reqCtx, backup, request.Backup, err)
+	} else if reason != "" {
+		return r.patchBackupPending(reqCtx, backup, reason)
+	}
 	// set and patch backup status
 	if err = r.patchBackupStatus(backup, request); err != nil {
 		return r.updateStatusIfFailed(reqCtx, backup, request.Backup, err)
@@ -361,6 +454,131 @@ func (r *BackupReconciler) handleNewPhase(
 	return intctrlutil.Reconciled()
 }
 
+// setBackupThrottleAnnotations records the target nodes and the priority of the backup for
+// evaluating the throttle policies, and returns true if the backup is limited by the concurrency
+// limits of the throttle policies.
+func setBackupThrottleAnnotations(request *dpbackup.Request) bool {
+	if request.BackupRepo == nil ||
+		!dpbackup.HasConcurrencyLimits(request.BackupRepo.Spec.Throttle, request.BackupPolicy.Spec.Throttle) {
+		return false
+	}
+	nodes := sets.New[string]()
+	for _, target := range request.PreparedTargets {
+		for _, pod := range target.TargetPods {
+			if pod.Spec.NodeName != "" {
+				nodes.Insert(pod.Spec.NodeName)
+			}
+		}
+	}
+	if nodes.Len() > 0 {
+		request.Annotations[dptypes.BackupTargetNodesAnnotationKey] = strings.Join(sets.List(nodes), ",")
+	}
+	request.Annotations[dptypes.BackupPriorityAnnotationKey] = strconv.Itoa(int(request.BackupPolicy.Spec.Priority))
+	return true
+}
+
+// admitBackup evaluates the throttle policies against the backups stored in the same backup
+// repository and the backups of the same backup policy, and returns the reason if the backup
+// should wait. Otherwise, the backup is admitted by recording it in the annotation of the backup
+// repository, which is patched with optimistic lock, so the concurrency slot is reserved even if
+// the backups in the cache are stale or several backups are admitted at the same time.
+func (r *BackupReconciler) admitBackup(reqCtx intctrlutil.RequestCtx,
+	request *dpbackup.Request, throttled bool) (string, error) {
+	if !throttled {
+		return "", nil
+	}
+	repo := request.BackupRepo
+	backups, err := r.listThrottledBackups(reqCtx, request)
+	if err != nil {
+		return "", err
+	}
+	policyThrottles, err := r.getPolicyThrottles(reqCtx, request, backups)
+	if err != nil {
+		return "", err
+	}
+	admitted := dpbackup.GetAdmittedBackups(repo)
+	reason := dpbackup.EvaluateBackupThrottle(repo.Spec.Throttle, policyThrottles, request.Backup, backups, admitted)
+	if reason != "" || admitted.Has(dpbackup.BackupKey(request.Backup)) {
+		return reason, nil
+	}
+	patch := client.MergeFromWithOptions(repo.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	dpbackup.SetAdmittedBackups(repo, admitted.Insert(dpbackup.BackupKey(request.Backup)), backups)
+	if err = r.Client.Patch(reqCtx.Ctx, repo, patch); err != nil {
+		if apierrors.IsConflict(err) {
+			return "", intctrlutil.NewErrorf(intctrlutil.ErrorTypeRequeue,
+				"backup repo %s is changed when admitting the backup: %s", repo.Name, err.Error())
+		}
+		return "", err
+	}
+	return "", nil
+}
+
+// listThrottledBackups lists the backups stored in the backup repository and the backups of the
+// backup policy, which are evaluated by the throttle policies.
+func (r *BackupReconciler) listThrottledBackups(reqCtx intctrlutil.RequestCtx,
+	request *dpbackup.Request) ([]*dpv1alpha1.Backup, error) {
+	repoBackups := &dpv1alpha1.BackupList{}
+	if err := r.Client.List(reqCtx.Ctx, repoBackups,
+		client.MatchingLabels{dataProtectionBackupRepoKey: request.BackupRepo.Name}); err != nil {
+		return nil, err
+	}
+	policyBackups := &dpv1alpha1.BackupList{}
+	if err := r.Client.List(reqCtx.Ctx, policyBackups, client.InNamespace(request.Backup.Namespace),
+		client.MatchingLabels{dptypes.BackupPolicyLabelKey: request.Backup.Spec.BackupPolicyName}); err != nil {
+		return nil, err
+	}
+	var backups []*dpv1alpha1.Backup
+	keys := sets.New[string]()
+	for _, items := range [][]dpv1alpha1.Backup{repoBackups.Items, policyBackups.Items} {
+		for i := range items {
+			if key := dpbackup.BackupKey(&items[i]); !keys.Has(key) {
+				keys.Insert(key)
+				backups = append(backups, &items[i])
+			}
+		}
+	}
+	return backups, nil
+}
+
+// getPolicyThrottles returns the throttle policies of the backup policies of the backup and the pending
+// backups, which are used to check whether the pending backups are held by their own backup policies.
+func (r *BackupReconciler) getPolicyThrottles(reqCtx intctrlutil.RequestCtx,
+	request *dpbackup.Request, backups []*dpv1alpha1.Backup) (map[string]*dpv1alpha1.ThrottlePolicy, error) {
+	policyThrottles := map[string]*dpv1alpha1.ThrottlePolicy{
+		dpbackup.BackupPolicyKey(request.Backup): request.BackupPolicy.Spec.Throttle,
+	}
+	for _, b := range backups {
+		key := dpbackup.BackupPolicyKey(b)
+		if _, ok := policyThrottles[key]; ok || b.Status.Phase != dpv1alpha1.BackupPhasePending {
+			continue
+		}
+		policy := &dpv1alpha1.BackupPolicy{}
+		if err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: b.Namespace, Name: b.Spec.BackupPolicyName}, policy); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		policyThrottles[key] = policy.Spec.Throttle
+	}
+	return policyThrottles, nil
+}
+
+// patchBackupPending updates the backup to the pending phase with the reason, and requeues it
+// to check the throttle limits again.
+func (r *BackupReconciler) patchBackupPending(reqCtx intctrlutil.RequestCtx,
+	backup *dpv1alpha1.Backup, reason string) (ctrl.Result, error) {
+	if backup.Status.Phase != dpv1alpha1.BackupPhasePending || backup.Status.PendingReason != reason {
+		patch := client.MergeFrom(backup.DeepCopy())
+		backup.Status.Phase = dpv1alpha1.BackupPhasePending
+		backup.Status.PendingReason = reason
+		if err := r.Client.Status().Patch(reqCtx.Ctx, backup, patch); err != nil {
+			return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+		}
+		r.Recorder.Event(backup, corev1.EventTypeNormal, "BackupThrottled", reason)
+	}
+	return intctrlutil.RequeueAfter(backupThrottleRequeueInterval, reqCtx.Log, "wait for the throttle limits")
+}
+
 // recordBackupStatusTargets records the backup status target or targets for next reconcile.
 func (r *BackupReconciler) recordBackupStatusTargets(
 	reqCtx intctrlutil.RequestCtx,
@@ -485,6 +703,10 @@ func (r *BackupReconciler) prepareBackupRequest(
 		if err := checkEncryptionConfig(reqCtx.Ctx, backupPolicy.Spec.EncryptionConfig, r.Client, backupPolicy.Namespace); err != nil {
 			return nil, fmt.Errorf("failed to validate backupPolicy's encryption config: %w", err)
 		}
+		// the kopia repository is shared by the backups, it can not be encrypted by the per-backup data keys
+		if backupPolicy.Spec.UseKopia && kms.UseKeyManagement(backupPolicy.Spec.EncryptionConfig) {
+			return nil, intctrlutil.NewFatalError("encryptionConfig.keyManagement is not supported when useKopia is enabled")
+		}
 	}
 
 	request.BackupPolicy = backupPolicy
@@ -585,6 +807,15 @@ func (r *BackupReconciler) patchBackupStatus(
 	} else if request.BackupPolicy.Spec.EncryptionConfig != nil {
 		request.Status.EncryptionConfig = request.BackupPolicy.Spec.EncryptionConfig
 	}
+	if request.Status.EncryptionKey == nil {
+		// generate the data key for the envelope encryption
+		encryptionKey, err := kms.NewBackupEncryptionKey(request.Ctx, r.Client, request.Namespace,
+			request.Status.EncryptionConfig, request.ParentBackup)
+		if err != nil {
+			return err
+		}
+		request.Status.EncryptionKey = encryptionKey
+	}
 	var actionStatuses []dpv1alpha1.ActionStatus
 	for i := range request.PreparedTargets {
 		preparedTarget := &request.PreparedTargets[i]
@@ -611,6 +842,7 @@ func (r *BackupReconciler) patchBackupStatus(
 
 	// update phase to running
 	request.Status.Phase = dpv1alpha1.BackupPhaseRunning
+	request.Status.PendingReason = ""
 	request.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now().UTC()}
 
 	// set status parent backup and base backup name
@@ -656,6 +888,10 @@ func (r *BackupReconciler) handleRunningPhase(
 	if err = r.syncContinuousBackupEncryptionConfig(reqCtx, backup, request.BackupPolicy); err != nil {
 		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "sync continuous backup encryption config failed")
 	}
+	// the data key is provided to the backup jobs by a secret
+	if err = kms.EnsureDataKeySecret(reqCtx.Ctx, r.Client, backup, backup.Namespace, backup); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "failed to prepare the data key secret")
+	}
 	targets := dputils.GetBackupTargets(request.BackupPolicy, request.BackupMethod)
 	var (
 		existFailedAction bool
@@ -849,12 +1085,34 @@ func (r *BackupReconciler) syncContinuousBackupEncryptionConfig(reqCtx intctrlut
 		return nil
 	}
 	if !reflect.DeepEqual(backup.Status.EncryptionConfig, backupPolicy.Spec.EncryptionConfig) {
+		encryptionKey, err := r.syncContinuousBackupEncryptionKey(reqCtx, backup, backupPolicy.Spec.EncryptionConfig)
+		if err != nil {
+			return err
+		}
 		backup.Status.EncryptionConfig = backupPolicy.Spec.EncryptionConfig
+		backup.Status.EncryptionKey = encryptionKey
 		return r.Client.Status().Update(reqCtx.Ctx, backup)
 	}
 	return nil
 }
 
+// syncContinuousBackupEncryptionKey returns the data key of the continuous backup for the
+// new encryption config. The data key is kept and rewrapped by the new KEK if the key
+// management config is changed, so the data which has been uploaded can still be decrypted.
+func (r *BackupReconciler) syncContinuousBackupEncryptionKey(reqCtx intctrlutil.RequestCtx,
+	backup *dpv1alpha1.Backup, config *dpv1alpha1.EncryptionConfig) (*dpv1alpha1.BackupEncryptionKey, error) {
+	if !kms.UseKeyManagement(config) {
+		return nil, nil
+	}
+	if !kms.UseKeyManagement(backup.Status.EncryptionConfig) || backup.Status.EncryptionKey == nil {
+		return kms.NewBackupEncryptionKey(reqCtx.Ctx, r.Client, backup.Namespace, config, nil)
+	}
+	if reflect.DeepEqual(backup.Status.EncryptionConfig.KeyManagement, config.KeyManagement) {
+		return backup.Status.EncryptionKey, nil
+	}
+	return kms.RewrapBackupEncryptionKey(reqCtx.Ctx, r.Client, backup, config.KeyManagement)
+}
+
 func (r *BackupReconciler) checkRestoreInProgress(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup) (restoreInProgress bool, err error) {
 	if backup.Annotations[dptypes.SkipRestorationCheckAnnotationKey] == trueVal {
 		return false, nil
@@ -940,6 +1198,14 @@ func (r *BackupReconciler) checkIsCompletedDuringRunning(reqCtx intctrlutil.Requ
 func (r *BackupReconciler) handleCompletedPhase(
 	reqCtx intctrlutil.RequestCtx,
 	backup *dpv1alpha1.Backup) (ctrl.Result, error) {
+	if err := r.syncBackupCatalog(reqCtx, backup); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+
+	if err := r.rewrapDataKey(reqCtx, backup); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+
 	if err := r.deleteExternalResources(reqCtx, backup); err != nil {
 		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
 	}
@@ -947,6 +1213,114 @@ func (r *BackupReconciler) handleCompletedPhase(
 	return intctrlutil.Reconciled()
 }
 
+// rewrapDataKey wraps the data key of the backup with the current KEK of the key management
+// config if it is requested by the annotation. The key management config of the backup policy
+// is used if it is available, so the backups can be rewrapped after the KEK is rotated.
+func (r *BackupReconciler) rewrapDataKey(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup) error {
+	if _, ok := backup.Annotations[dptypes.RewrapDataKeyAnnotationKey]; !ok {
+		return nil
+	}
+	removeAnnotation := func() error {
+		patch := client.MergeFrom(backup.DeepCopy())
+		delete(backup.Annotations, dptypes.RewrapDataKeyAnnotationKey)
+		return r.Client.Patch(reqCtx.Ctx, backup, patch)
+	}
+	if !kms.UseKeyManagement(backup.Status.EncryptionConfig) {
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "RewrapDataKeyFailed",
+			"the backup is not encrypted by the key management service")
+		return removeAnnotation()
+	}
+
+	target := backup.Status.EncryptionConfig.KeyManagement
+	backupPolicy := &dpv1alpha1.BackupPolicy{}
+	err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.BackupPolicyName}, backupPolicy)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && kms.UseKeyManagement(backupPolicy.Spec.EncryptionConfig) {
+		target = backupPolicy.Spec.EncryptionConfig.KeyManagement
+	}
+	encryptionKey, err := kms.RewrapBackupEncryptionKey(reqCtx.Ctx, r.Client, backup, target)
+	if err != nil {
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "RewrapDataKeyFailed", err.Error())
+		return err
+	}
+
+	patch := client.MergeFrom(backup.DeepCopy())
+	backup.Status.EncryptionConfig.KeyManagement = target.DeepCopy()
+	backup.Status.EncryptionKey = encryptionKey
+	if err = r.Client.Status().Patch(reqCtx.Ctx, backup, patch); err != nil {
+		return err
+	}
+	r.Recorder.Eventf(backup, corev1.EventTypeNormal, "DataKeyRewrapped",
+		"the data key is rewrapped by KEK %s", encryptionKey.KeyID)
+	return removeAnnotation()
+}
+
+// syncBackupCatalog creates the BackupCatalog of the backup from the catalog manifests
+// handed over by the backup manager containers, and then deletes the manifests.
+func (r *BackupReconciler) syncBackupCatalog(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup) error {
+	manifestList, err := r.listBackupCatalogManifests(reqCtx, backup)
+	if err != nil || len(manifestList.Items) == 0 {
+		return err
+	}
+	// sort the manifests to keep the order of the catalog entries stable
+	sort.Slice(manifestList.Items, func(i, j int) bool {
+		return manifestList.Items[i].Name < manifestList.Items[j].Name
+	})
+	var manifests []string
+	for _, cm := range manifestList.Items {
+		manifests = append(manifests, cm.Data[dpbackup.BackupCatalogManifestKey])
+	}
+	spec, err := dpbackup.BuildBackupCatalogSpec(backup.Name, manifests)
+	if err != nil {
+		// the manifests are emitted by the backup action and will not be changed,
+		// so just record the error and drop them.
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "InvalidBackupCatalog", err.Error())
+	} else {
+		catalog := &dpv1alpha1.BackupCatalog{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      backup.Name,
+				Namespace: backup.Namespace,
+			},
+		}
+		if _, err = controllerutil.CreateOrUpdate(reqCtx.Ctx, r.Client, catalog, func() error {
+			if catalog.Labels == nil {
+				catalog.Labels = map[string]string{}
+			}
+			catalog.Labels[dptypes.BackupNameLabelKey] = backup.Name
+			catalog.Spec = *spec
+			return controllerutil.SetControllerReference(backup, catalog, r.Scheme)
+		}); err != nil {
+			return err
+		}
+	}
+	return r.deleteBackupCatalogManifests(reqCtx, manifestList)
+}
+
+func (r *BackupReconciler) listBackupCatalogManifests(reqCtx intctrlutil.RequestCtx,
+	backup *dpv1alpha1.Backup) (*corev1.ConfigMapList, error) {
+	manifestList := &corev1.ConfigMapList{}
+	if err := r.Client.List(reqCtx.Ctx, manifestList, client.InNamespace(backup.Namespace),
+		client.MatchingLabels{
+			dptypes.BackupNameLabelKey:    backup.Name,
+			dptypes.BackupCatalogLabelKey: trueVal,
+		}); err != nil {
+		return nil, err
+	}
+	return manifestList, nil
+}
+
+func (r *BackupReconciler) deleteBackupCatalogManifests(reqCtx intctrlutil.RequestCtx,
+	manifestList *corev1.ConfigMapList) error {
+	for i := range manifestList.Items {
+		if err := r.Client.Delete(reqCtx.Ctx, &manifestList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *BackupReconciler) updateStatusIfFailed(
 	reqCtx intctrlutil.RequestCtx,
 	original *dpv1alpha1.Backup,
@@ -1016,6 +1390,11 @@ func (r *BackupReconciler) deleteExternalResources(
 		return err
 	}
 
+	// delete the data key secret which is only used by the backup jobs.
+	if err := kms.ReleaseDataKeySecrets(reqCtx.Ctx, r.Client, backup.Namespace, backup); err != nil {
+		return err
+	}
+
 	// delete the external statefulSets.
 	return deleteRelatedObjectList(reqCtx, r.Client, &appsv1.StatefulSetList{}, namespaces, labels)
 }
@@ -1024,21 +1403,13 @@ func (r *BackupReconciler) deleteExternalResources(
 func (r *BackupReconciler) deleteRelatedBackups(
 	reqCtx intctrlutil.RequestCtx,
 	backup *dpv1alpha1.Backup) error {
-	backupList := &dpv1alpha1.BackupList{}
-	labels := map[string]string{
-		dptypes.BackupPolicyLabelKey: backup.Spec.BackupPolicyName,
-	}
-	if err := r.Client.List(reqCtx.Ctx, backupList,
-		client.InNamespace(backup.Namespace), client.MatchingLabels(labels)); client.IgnoreNotFound(err) != nil {
+	dependents, err := listDependentBackups(reqCtx.Ctx, r.Client, backup)
+	if err != nil {
 		return err
 	}
-	for i := range backupList.Items {
-		bp := &backupList.Items[i]
+	for _, bp := range dependents {
 		// delete backups related to the current backup
 		// files in the related backup's status.path will be deleted by its own associated deleter
-		if bp.Status.ParentBackupName != backup.Name && bp.Status.BaseBackupName != backup.Name {
-			continue
-		}
 		if err := intctrlutil.BackgroundDeleteObject(r.Client, reqCtx.Ctx, bp); err != nil {
 			return err
 		}
@@ -0,0 +1,390 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dpbackup "github.com/apecloud/kubeblocks/pkg/dataprotection/backup"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/kms"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+func TestBlockLockedBackupDeletion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+
+	now := metav1.Now()
+	newBackup := func(name string, deleting bool) *dpv1alpha1.Backup {
+		backup := &dpv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "default",
+				Name:       name,
+				Labels:     map[string]string{dptypes.BackupPolicyLabelKey: "policy"},
+				Finalizers: []string{dptypes.DataProtectionFinalizerName},
+			},
+			Spec:   dpv1alpha1.BackupSpec{BackupPolicyName: "policy", BackupMethod: "xtrabackup"},
+			Status: dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseCompleted},
+		}
+		if deleting {
+			backup.DeletionTimestamp = &now
+		}
+		return backup
+	}
+
+	held := newBackup("held", true)
+	held.Spec.LegalHold = true
+	parent := newBackup("parent", true)
+	child := newBackup("child", false)
+	child.Spec.LockUntil = &metav1.Time{Time: now.Add(time.Hour)}
+	child.Status.ParentBackupName = parent.Name
+
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.Backup{}).
+		WithObjects(held, parent, child).
+		Build()
+	r := &BackupReconciler{Client: cli, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+
+	ctx := context.Background()
+	tests := []struct {
+		backup      *dpv1alpha1.Backup
+		wantRequeue bool
+		wantReason  string
+	}{
+		{backup: held, wantReason: "backup held is under legal hold"},
+		{backup: parent, wantRequeue: true, wantReason: "backup child is locked until"},
+	}
+	for _, tt := range tests {
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tt.backup)})
+		if err != nil {
+			t.Fatalf("reconcile %s: %v", tt.backup.Name, err)
+		}
+		if (res.RequeueAfter > 0) != tt.wantRequeue {
+			t.Errorf("backup %s: unexpected result %+v", tt.backup.Name, res)
+		}
+		obj := &dpv1alpha1.Backup{}
+		if err = cli.Get(ctx, client.ObjectKeyFromObject(tt.backup), obj); err != nil {
+			t.Fatalf("backup %s should be kept: %v", tt.backup.Name, err)
+		}
+		if obj.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+			t.Errorf("backup %s: expected phase Completed, got %s", tt.backup.Name, obj.Status.Phase)
+		}
+		if !strings.Contains(obj.Status.DeletionFailureReason, tt.wantReason) {
+			t.Errorf("backup %s: unexpected deletion failure reason %q", tt.backup.Name, obj.Status.DeletionFailureReason)
+		}
+	}
+}
+
+func TestSyncBackupCatalog(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+
+	backup := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "backup", UID: "backup-uid"},
+		Status:     dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseCompleted},
+	}
+	newManifest := func(name, data string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				Labels: map[string]string{
+					dptypes.BackupNameLabelKey:    backup.Name,
+					dptypes.BackupCatalogLabelKey: trueVal,
+				},
+			},
+			Data: map[string]string{dpbackup.BackupCatalogManifestKey: data},
+		}
+	}
+	ctx := context.Background()
+	reqCtx := intctrlutil.RequestCtx{Ctx: ctx}
+
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(backup,
+			newManifest("backup-catalog-pod-0", `{"entries":[{"kind":"Database","name":"db1"}],"restorableKinds":["Database","Table"]}`),
+			newManifest("backup-catalog-pod-1", `{"entries":[{"kind":"Table","database":"db1","name":"t1"}],"restorableKinds":["Database"]}`)).
+		Build()
+	r := &BackupReconciler{Client: cli, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+	if err := r.syncBackupCatalog(reqCtx, backup); err != nil {
+		t.Fatalf("sync backup catalog: %v", err)
+	}
+	catalog := &dpv1alpha1.BackupCatalog{}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(backup), catalog); err != nil {
+		t.Fatalf("get backup catalog: %v", err)
+	}
+	if len(catalog.Spec.Entries) != 2 || !catalog.IsRestorable(dpv1alpha1.BackupCatalogEntryKindDatabase) ||
+		catalog.IsRestorable(dpv1alpha1.BackupCatalogEntryKindTable) {
+		t.Fatalf("unexpected catalog spec: %+v", catalog.Spec)
+	}
+	if owner := metav1.GetControllerOf(catalog); owner == nil || owner.UID != backup.UID {
+		t.Fatalf("expected the catalog to be owned by the backup, got %v", owner)
+	}
+	manifests := &corev1.ConfigMapList{}
+	if err := cli.List(ctx, manifests); err != nil || len(manifests.Items) != 0 {
+		t.Fatalf("expected the manifests to be deleted, got %d, err: %v", len(manifests.Items), err)
+	}
+
+	// the invalid manifest is dropped without creating the catalog
+	invalidBackup := backup.DeepCopy()
+	invalidBackup.Name = "invalid"
+	invalidBackup.ResourceVersion = ""
+	invalidManifest := newManifest("invalid-catalog-pod-0", `{"entries":[{"kind":"Table","name":"t1"}]}`)
+	invalidManifest.Labels[dptypes.BackupNameLabelKey] = invalidBackup.Name
+	cli = fake.NewClientBuilder().WithScheme(scheme).WithObjects(invalidBackup, invalidManifest).Build()
+	recorder := record.NewFakeRecorder(100)
+	r = &BackupReconciler{Client: cli, Scheme: scheme, Recorder: recorder}
+	if err := r.syncBackupCatalog(reqCtx, invalidBackup); err != nil {
+		t.Fatalf("sync backup catalog: %v", err)
+	}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(invalidBackup), &dpv1alpha1.BackupCatalog{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected no catalog for the invalid manifest, got %v", err)
+	}
+	if event := <-recorder.Events; !strings.Contains(event, "InvalidBackupCatalog") {
+		t.Fatalf("unexpected event: %s", event)
+	}
+}
+
+func TestBackupThrottlePending(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+
+	maxConcurrentBackups := int32(1)
+	repo := &dpv1alpha1.BackupRepo{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo"},
+		Spec: dpv1alpha1.BackupRepoSpec{
+			Throttle: &dpv1alpha1.ThrottlePolicy{MaxConcurrentBackups: &maxConcurrentBackups},
+		},
+	}
+	running := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "running",
+			Labels:    map[string]string{dataProtectionBackupRepoKey: repo.Name},
+		},
+		Status: dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseRunning},
+	}
+	backup := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "backup",
+			Labels:    map[string]string{dataProtectionBackupRepoKey: repo.Name},
+		},
+		Status: dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseNew},
+	}
+	admitted := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "admitted",
+			Labels:    map[string]string{dataProtectionBackupRepoKey: repo.Name},
+		},
+		Status: dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseNew},
+	}
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.Backup{}).
+		WithObjects(repo, running, backup, admitted).
+		Build()
+	r := &BackupReconciler{Client: cli, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+	ctx := context.Background()
+	reqCtx := intctrlutil.RequestCtx{Ctx: ctx, Log: ctrl.Log}
+
+	request := &dpbackup.Request{
+		Backup:       backup.DeepCopy(),
+		BackupRepo:   repo,
+		BackupPolicy: &dpv1alpha1.BackupPolicy{Spec: dpv1alpha1.BackupPolicySpec{Priority: 5}},
+		PreparedTargets: []dpbackup.PreparedTarget{{
+			TargetPods: []*corev1.Pod{
+				{Spec: corev1.PodSpec{NodeName: "node2"}},
+				{Spec: corev1.PodSpec{NodeName: "node1"}},
+			},
+		}},
+	}
+	request.Annotations = map[string]string{}
+	if !setBackupThrottleAnnotations(request) {
+		t.Fatalf("expected the backup to be throttled")
+	}
+	if request.Annotations[dptypes.BackupTargetNodesAnnotationKey] != "node1,node2" ||
+		request.Annotations[dptypes.BackupPriorityAnnotationKey] != "5" {
+		t.Fatalf("unexpected throttle annotations: %v", request.Annotations)
+	}
+
+	reason, err := r.admitBackup(reqCtx, request, true)
+	if err != nil || reason == "" {
+		t.Fatalf("expected the backup to be throttled, reason: %q, err: %v", reason, err)
+	}
+	res, err := r.patchBackupPending(reqCtx, backup, reason)
+	if err != nil || res.RequeueAfter != backupThrottleRequeueInterval {
+		t.Fatalf("unexpected result: %v, err: %v", res, err)
+	}
+	got := &dpv1alpha1.Backup{}
+	if err = cli.Get(ctx, client.ObjectKeyFromObject(backup), got); err != nil {
+		t.Fatalf("get backup: %v", err)
+	}
+	if got.Status.Phase != dpv1alpha1.BackupPhasePending || got.Status.PendingReason != reason {
+		t.Fatalf("unexpected backup status: %+v", got.Status)
+	}
+
+	// the admitted backup reserves the slot after the running backup is completed, even if it is not running yet
+	running.Status.Phase = dpv1alpha1.BackupPhaseCompleted
+	if err = cli.Status().Update(ctx, running); err != nil {
+		t.Fatalf("update backup: %v", err)
+	}
+	staleRepo := &dpv1alpha1.BackupRepo{}
+	if err = cli.Get(ctx, client.ObjectKeyFromObject(repo), staleRepo); err != nil {
+		t.Fatalf("get repo: %v", err)
+	}
+	request.BackupRepo = staleRepo.DeepCopy()
+	request.Backup = admitted.DeepCopy()
+	if reason, err = r.admitBackup(reqCtx, request, true); err != nil || reason != "" {
+		t.Fatalf("expected the backup to be admitted, reason: %q, err: %v", reason, err)
+	}
+	if request.BackupRepo.Annotations[dptypes.BackupRepoAdmittedBackupsAnnotationKey] != "default/admitted" {
+		t.Fatalf("unexpected repo annotations: %v", request.BackupRepo.Annotations)
+	}
+	request.BackupRepo = staleRepo.DeepCopy()
+	request.Backup = got.DeepCopy()
+	if _, err = r.admitBackup(reqCtx, request, true); !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeRequeue) {
+		t.Fatalf("expected a requeue error for the stale repo, got %v", err)
+	}
+	if err = cli.Get(ctx, client.ObjectKeyFromObject(repo), request.BackupRepo); err != nil {
+		t.Fatalf("get repo: %v", err)
+	}
+	if reason, err = r.admitBackup(reqCtx, request, true); err != nil || reason == "" {
+		t.Fatalf("expected the backup to be throttled by the admitted backup, reason: %q, err: %v", reason, err)
+	}
+
+	// the concurrency limits of the backup policy only count the backups of the policy
+	repo.Spec.Throttle = nil
+	request.BackupRepo.Spec.Throttle = nil
+	request.BackupPolicy.Spec.Throttle = &dpv1alpha1.ThrottlePolicy{MaxConcurrentBackups: &maxConcurrentBackups}
+	request.Backup.Spec.BackupPolicyName = "another"
+	if reason, err = r.admitBackup(reqCtx, request, true); err != nil || reason != "" {
+		t.Fatalf("expected the backup to be admitted, reason: %q, err: %v", reason, err)
+	}
+}
+
+func TestRewrapDataKey(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+
+	ctx := context.Background()
+	kekSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "kek"},
+		Data:       map[string][]byte{"v1": []byte("old"), "v2": []byte("new")},
+	}
+	newConfig := func(keyID string) *dpv1alpha1.EncryptionConfig {
+		return &dpv1alpha1.EncryptionConfig{
+			Algorithm: dpv1alpha1.DefaultEncryptionAlgorithm,
+			KeyManagement: &dpv1alpha1.KeyManagementConfig{
+				Provider: dpv1alpha1.KMSProviderSecret,
+				KeyID:    keyID,
+				Secret:   &dpv1alpha1.SecretKMSConfig{SecretName: kekSecret.Name},
+			},
+		}
+	}
+	policy := &dpv1alpha1.BackupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "policy"},
+		Spec:       dpv1alpha1.BackupPolicySpec{EncryptionConfig: newConfig("v2")},
+	}
+	backup := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "backup",
+			Annotations: map[string]string{dptypes.RewrapDataKeyAnnotationKey: "true"},
+		},
+		Spec:   dpv1alpha1.BackupSpec{BackupPolicyName: policy.Name},
+		Status: dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseCompleted, EncryptionConfig: newConfig("v1")},
+	}
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.Backup{}).
+		WithObjects(kekSecret, policy).
+		Build()
+	encryptionKey, err := kms.NewBackupEncryptionKey(ctx, cli, "default", backup.Status.EncryptionConfig, nil)
+	if err != nil {
+		t.Fatalf("new encryption key: %v", err)
+	}
+	backup.Status.EncryptionKey = encryptionKey
+	if err = cli.Create(ctx, backup); err != nil {
+		t.Fatalf("create backup: %v", err)
+	}
+	provider, err := kms.NewProvider(ctx, cli, "default", policy.Spec.EncryptionConfig.KeyManagement)
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	dataKey, err := kms.UnwrapDataKey(ctx, provider, encryptionKey)
+	if err != nil {
+		t.Fatalf("unwrap data key: %v", err)
+	}
+
+	r := &BackupReconciler{Client: cli, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+	reqCtx := intctrlutil.RequestCtx{Ctx: ctx, Log: ctrl.Log}
+	if err = r.rewrapDataKey(reqCtx, backup); err != nil {
+		t.Fatalf("rewrap data key: %v", err)
+	}
+	got := &dpv1alpha1.Backup{}
+	if err = cli.Get(ctx, client.ObjectKeyFromObject(backup), got); err != nil {
+		t.Fatalf("get backup: %v", err)
+	}
+	if _, ok := got.Annotations[dptypes.RewrapDataKeyAnnotationKey]; ok {
+		t.Errorf("expected the rewrap annotation to be removed")
+	}
+	if got.Status.EncryptionKey.KeyID != "v2" || got.Status.EncryptionConfig.KeyManagement.KeyID != "v2" {
+		t.Fatalf("unexpected encryption key: %+v", got.Status.EncryptionKey)
+	}
+	rewrapped, err := kms.UnwrapDataKey(ctx, provider, got.Status.EncryptionKey)
+	if err != nil {
+		t.Fatalf("unwrap rewrapped data key: %v", err)
+	}
+	if string(rewrapped) != string(dataKey) {
+		t.Errorf("the data key is changed after rewrapping")
+	}
+}
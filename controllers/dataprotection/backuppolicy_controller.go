@@ -22,17 +22,21 @@ package dataprotection
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dprestore "github.com/apecloud/kubeblocks/pkg/dataprotection/restore"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
 )
 
 // BackupPolicyReconciler reconciles a BackupPolicy object
@@ -45,6 +49,8 @@ type BackupPolicyReconciler struct {
 // +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backuppolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backuppolicies/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backuppolicies/finalizers,verbs=update
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups,verbs=get;list;watch
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=actionsets,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the backuppolicy closer to the desired state.
@@ -72,7 +78,7 @@ func (r *BackupPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	if backupPolicy.Status.ObservedGeneration == backupPolicy.Generation &&
 		backupPolicy.Status.Phase.IsAvailable() {
-		return ctrl.Result{}, nil
+		return r.syncRecoverableWindows(reqCtx, backupPolicy)
 	}
 
 	patchStatus := func(phase dpv1alpha1.Phase, message string) error {
@@ -94,7 +100,64 @@ func (r *BackupPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
 	}
 	intctrlutil.RecordCreatedEvent(r.Recorder, backupPolicy)
-	return ctrl.Result{}, nil
+	return r.syncRecoverableWindows(reqCtx, backupPolicy)
+}
+
+// syncRecoverableWindows computes the point-in-time recoverable windows from the continuous
+// backups of the backup policy and their base backups, and records them in the status.
+func (r *BackupPolicyReconciler) syncRecoverableWindows(reqCtx intctrlutil.RequestCtx,
+	backupPolicy *dpv1alpha1.BackupPolicy) (ctrl.Result, error) {
+	backupList := &dpv1alpha1.BackupList{}
+	if err := r.Client.List(reqCtx.Ctx, backupList, client.InNamespace(backupPolicy.Namespace),
+		client.MatchingLabels{
+			dptypes.BackupPolicyLabelKey: backupPolicy.Name,
+			dptypes.BackupTypeLabelKey:   string(dpv1alpha1.BackupTypeContinuous),
+		}); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	var windows []dpv1alpha1.RecoverableWindow
+	for i := range backupList.Items {
+		backup := &backupList.Items[i]
+		if !backup.DeletionTimestamp.IsZero() || backup.Status.Phase == dpv1alpha1.BackupPhaseDeleting {
+			continue
+		}
+		var actionSetName string
+		if backup.Status.BackupMethod != nil {
+			actionSetName = backup.Status.BackupMethod.ActionSetName
+		}
+		actionSet, err := dputils.GetActionSetByName(reqCtx, r.Client, actionSetName)
+		if client.IgnoreNotFound(err) != nil {
+			return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+		}
+		window, err := dprestore.GetRecoverableWindow(reqCtx.Ctx, r.Client, backup, actionSet)
+		if err != nil {
+			return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+		}
+		if window != nil {
+			windows = append(windows, *window)
+		}
+	}
+	mergedWindows, gaps := dprestore.MergeRecoverableWindows(windows)
+	if reflect.DeepEqual(mergedWindows, backupPolicy.Status.RecoverableWindows) &&
+		reflect.DeepEqual(gaps, backupPolicy.Status.RecoverableGaps) {
+		return intctrlutil.Reconciled()
+	}
+	patch := client.MergeFrom(backupPolicy.DeepCopy())
+	backupPolicy.Status.RecoverableWindows = mergedWindows
+	backupPolicy.Status.RecoverableGaps = gaps
+	if err := r.Client.Status().Patch(reqCtx.Ctx, backupPolicy, patch); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	return intctrlutil.Reconciled()
+}
+
+// mapBackupToPolicy enqueues the backup policy of the backup to refresh the recoverable windows.
+func (r *BackupPolicyReconciler) mapBackupToPolicy(_ context.Context, obj client.Object) []ctrl.Request {
+	policyName := obj.GetLabels()[dptypes.BackupPolicyLabelKey]
+	if policyName == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: client.ObjectKey{Namespace: obj.GetNamespace(), Name: policyName}}}
 }
 
 func (r *BackupPolicyReconciler) validateBackupPolicy(backupPolicy *dpv1alpha1.BackupPolicy) error {
@@ -126,6 +189,7 @@ func (r *BackupPolicyReconciler) validateBackupPolicy(backupPolicy *dpv1alpha1.B
 func (r *BackupPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return intctrlutil.NewControllerManagedBy(mgr).
 		For(&dpv1alpha1.BackupPolicy{}).
+		Watches(&dpv1alpha1.Backup{}, handler.EnqueueRequestsFromMapFunc(r.mapBackupToPolicy)).
 		Complete(r)
 }
 
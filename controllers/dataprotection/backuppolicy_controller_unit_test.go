@@ -0,0 +1,104 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+func TestSyncRecoverableWindows(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+
+	base := time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC)
+	policy := &dpv1alpha1.BackupPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "policy"}}
+	newBackup := func(name string, backupType dpv1alpha1.BackupType, start, end time.Duration) *dpv1alpha1.Backup {
+		backup := &dpv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				Labels: map[string]string{
+					dptypes.BackupPolicyLabelKey: policy.Name,
+					dptypes.BackupTypeLabelKey:   string(backupType),
+				},
+			},
+			Spec:   dpv1alpha1.BackupSpec{BackupPolicyName: policy.Name},
+			Status: dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseCompleted},
+		}
+		if backupType == dpv1alpha1.BackupTypeContinuous {
+			backup.Status.TimeRange = &dpv1alpha1.BackupTimeRange{
+				Start: &metav1.Time{Time: base.Add(start)},
+				End:   &metav1.Time{Time: base.Add(end)},
+			}
+		} else {
+			backup.Status.CompletionTimestamp = &metav1.Time{Time: base.Add(end)}
+		}
+		return backup
+	}
+
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.BackupPolicy{}).
+		WithObjects(policy,
+			newBackup("full-1", dpv1alpha1.BackupTypeFull, 0, 2*time.Hour),
+			newBackup("full-2", dpv1alpha1.BackupTypeFull, 0, 7*time.Hour),
+			newBackup("continuous-1", dpv1alpha1.BackupTypeContinuous, time.Hour, 4*time.Hour),
+			newBackup("continuous-2", dpv1alpha1.BackupTypeContinuous, 6*time.Hour, 9*time.Hour)).
+		Build()
+	r := &BackupPolicyReconciler{Client: cli, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+	ctx := context.Background()
+	reqCtx := intctrlutil.RequestCtx{Ctx: ctx, Log: ctrl.Log}
+
+	if _, err := r.syncRecoverableWindows(reqCtx, policy); err != nil {
+		t.Fatalf("sync recoverable windows: %v", err)
+	}
+	got := &dpv1alpha1.BackupPolicy{}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(policy), got); err != nil {
+		t.Fatalf("get backup policy: %v", err)
+	}
+	windows, gaps := got.Status.RecoverableWindows, got.Status.RecoverableGaps
+	if len(windows) != 2 || len(gaps) != 1 {
+		t.Fatalf("unexpected windows: %v, gaps: %v", windows, gaps)
+	}
+	if !windows[0].Start.Time.Equal(base.Add(2*time.Hour)) || !windows[0].End.Time.Equal(base.Add(4*time.Hour)) {
+		t.Fatalf("unexpected first window: %v", windows[0])
+	}
+	if !windows[1].Start.Time.Equal(base.Add(7*time.Hour)) || !windows[1].End.Time.Equal(base.Add(9*time.Hour)) {
+		t.Fatalf("unexpected second window: %v", windows[1])
+	}
+	if !gaps[0].Start.Time.Equal(base.Add(4*time.Hour)) || !gaps[0].End.Time.Equal(base.Add(7*time.Hour)) {
+		t.Fatalf("unexpected gap: %v", gaps[0])
+	}
+}
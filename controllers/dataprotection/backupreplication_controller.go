@@ -0,0 +1,628 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dpbackup "github.com/apecloud/kubeblocks/pkg/dataprotection/backup"
+	dperrors "github.com/apecloud/kubeblocks/pkg/dataprotection/errors"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/kms"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+const (
+	replicationContainerName = "replicate"
+
+	// replicaRepoVolumeMountPath is the path where the secondary backup repository is mounted in the copy job.
+	replicaRepoVolumeMountPath = "/replica-backupdata"
+
+	// backupCopyEndTimeAnnotationKey records the end time of the backup when the copy job is created,
+	// all data before this time is contained in the copy once the job is completed.
+	backupCopyEndTimeAnnotationKey = "dataprotection.kubeblocks.io/backup-end-time"
+
+	// backupCopyRetryInterval is the interval to retry a failed copy.
+	backupCopyRetryInterval = 10 * time.Minute
+
+	// backupCopySyncInterval is the interval to synchronize the new data of a continuous backup to its copy.
+	backupCopySyncInterval = 5 * time.Minute
+
+	// backupCopyWaitInterval is the interval to check again when the copy can not be started for now.
+	backupCopyWaitInterval = time.Minute
+)
+
+// BackupReplicationReconciler replicates the backups to the secondary backup repository specified by
+// the replication policy of the backup policy, and deletes the copies when they expire.
+type BackupReplicationReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backuppolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backuprepos,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile copies the data of a backup to the secondary backup repository, keeps the copy of a
+// continuous backup in sync, and deletes the expired copies.
+func (r *BackupReplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqCtx := intctrlutil.RequestCtx{
+		Ctx:      ctx,
+		Req:      req,
+		Log:      log.FromContext(ctx).WithValues("backup", req.NamespacedName),
+		Recorder: r.Recorder,
+	}
+
+	backup := &dpv1alpha1.Backup{}
+	if err := r.Client.Get(reqCtx.Ctx, reqCtx.Req.NamespacedName, backup); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	// the copies are deleted together with the backup by the backup controller.
+	if !backup.DeletionTimestamp.IsZero() {
+		return intctrlutil.Reconciled()
+	}
+
+	original := backup.DeepCopy()
+	requeueAfter, err := r.reconcileCopies(reqCtx, backup)
+	if err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	if !reflect.DeepEqual(original.Labels, backup.Labels) {
+		labeled := original.DeepCopy()
+		labeled.Labels = backup.Labels
+		if err = r.Client.Patch(reqCtx.Ctx, labeled, client.MergeFrom(original)); err != nil {
+			return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+		}
+	}
+	if !reflect.DeepEqual(original.Status, backup.Status) {
+		if err = r.Client.Status().Patch(reqCtx.Ctx, backup, client.MergeFrom(original)); err != nil {
+			return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+		}
+	}
+	if requeueAfter > 0 {
+		return intctrlutil.RequeueAfter(requeueAfter, reqCtx.Log, "")
+	}
+	return intctrlutil.Reconciled()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackupReplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return intctrlutil.NewControllerManagedBy(mgr).
+		Named("backup-replication").
+		For(&dpv1alpha1.Backup{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
+
+// reconcileCopies adds the copy for the secondary backup repository of the replication policy,
+// reconciles all copies of the backup, and returns the duration to reconcile again.
+func (r *BackupReplicationReconciler) reconcileCopies(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup) (time.Duration, error) {
+	policy, err := r.getReplicationPolicy(reqCtx.Ctx, backup)
+	if err != nil {
+		return 0, err
+	}
+	if policy != nil && isBackupReplicable(backup) && findBackupCopy(backup, policy.BackupRepoName) == nil {
+		backup.Status.Copies = append(backup.Status.Copies, dpv1alpha1.BackupCopyStatus{
+			BackupRepoName: policy.BackupRepoName,
+			Phase:          dpv1alpha1.BackupCopyPhaseRunning,
+			Path:           backup.Status.Path,
+		})
+	}
+
+	var requeueAfter time.Duration
+	for i := range backup.Status.Copies {
+		backupCopy := &backup.Status.Copies[i]
+		after, err := r.reconcileCopy(reqCtx, backup, backupCopy, policy)
+		if err != nil {
+			return 0, err
+		}
+		if after > 0 && (requeueAfter == 0 || after < requeueAfter) {
+			requeueAfter = after
+		}
+	}
+	setReplicaBackupRepoLabel(backup)
+	return requeueAfter, nil
+}
+
+// getReplicationPolicy returns the replication policy of the backup policy, nil is returned
+// if the backup policy is not found or the backups need not to be replicated.
+func (r *BackupReplicationReconciler) getReplicationPolicy(ctx context.Context,
+	backup *dpv1alpha1.Backup) (*dpv1alpha1.BackupReplicationPolicy, error) {
+	backupPolicy := &dpv1alpha1.BackupPolicy{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.BackupPolicyName}, backupPolicy); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	policy := backupPolicy.Spec.Replication
+	if policy == nil || policy.BackupRepoName == "" || policy.BackupRepoName == backup.Status.BackupRepoName {
+		return nil, nil
+	}
+	return policy, nil
+}
+
+// reconcileCopy reconciles a copy of the backup according to its phase and the copy job.
+func (r *BackupReplicationReconciler) reconcileCopy(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup,
+	backupCopy *dpv1alpha1.BackupCopyStatus, policy *dpv1alpha1.BackupReplicationPolicy) (time.Duration, error) {
+	switch backupCopy.Phase {
+	case dpv1alpha1.BackupCopyPhaseExpired:
+		return 0, nil
+	case dpv1alpha1.BackupCopyPhaseDeleting:
+		return 0, r.deleteCopy(reqCtx, backup, backupCopy)
+	}
+
+	job := &batchv1.Job{}
+	exists, err := intctrlutil.CheckResourceExists(reqCtx.Ctx, r.Client, buildBackupCopyJobKey(backup, backupCopy.BackupRepoName), job)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		return 0, r.checkCopyJob(reqCtx, backup, backupCopy, policy, job)
+	}
+
+	now := time.Now()
+	if backupCopy.Expiration != nil && !now.Before(backupCopy.Expiration.Time) {
+		backupCopy.Phase = dpv1alpha1.BackupCopyPhaseDeleting
+		r.Recorder.Eventf(backup, corev1.EventTypeNormal, "BackupCopyExpired",
+			"the copy in backup repo %s is expired, deleting it", backupCopy.BackupRepoName)
+		return 0, r.deleteCopy(reqCtx, backup, backupCopy)
+	}
+
+	var startAfter time.Duration
+	switch backupCopy.Phase {
+	case dpv1alpha1.BackupCopyPhaseFailed:
+		startAfter = backupCopyRetryInterval
+	case dpv1alpha1.BackupCopyPhaseCompleted:
+		if !needSyncBackupCopy(backup, backupCopy) {
+			return durationUntil(backupCopy.Expiration, now), nil
+		}
+		startAfter = backupCopySyncInterval
+	}
+	if !isBackupReplicable(backup) {
+		return durationUntil(backupCopy.Expiration, now), nil
+	}
+	if backupCopy.StartTimestamp != nil {
+		if wait := backupCopy.StartTimestamp.Add(startAfter).Sub(now); wait > 0 {
+			return wait, nil
+		}
+	}
+	return r.startCopy(reqCtx, backup, backupCopy)
+}
+
+// startCopy creates the job to copy the backup data to the secondary backup repository.
+func (r *BackupReplicationReconciler) startCopy(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup,
+	backupCopy *dpv1alpha1.BackupCopyStatus) (time.Duration, error) {
+	failCopy := func(reason string) (time.Duration, error) {
+		backupCopy.Phase = dpv1alpha1.BackupCopyPhaseFailed
+		backupCopy.StartTimestamp = &metav1.Time{Time: time.Now()}
+		backupCopy.FailureReason = reason
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "BackupCopyFailed", reason)
+		return backupCopyRetryInterval, nil
+	}
+
+	// the parent backup of an incremental backup must be copied first, so the
+	// backup chain in the secondary repository is always complete.
+	if parentName := backup.Status.ParentBackupName; parentName != "" {
+		parent := &dpv1alpha1.Backup{}
+		if err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: backup.Namespace, Name: parentName}, parent); err != nil {
+			if apierrors.IsNotFound(err) {
+				return failCopy(fmt.Sprintf("parent backup %s is not found", parentName))
+			}
+			return 0, err
+		}
+		if parentCopy := findBackupCopy(parent, backupCopy.BackupRepoName); parentCopy == nil ||
+			parentCopy.Phase != dpv1alpha1.BackupCopyPhaseCompleted {
+			backupCopy.FailureReason = fmt.Sprintf("waiting for the parent backup %s to be copied", parentName)
+			return backupCopyWaitInterval, nil
+		}
+	}
+
+	primaryRepo, err := r.getReadyBackupRepo(reqCtx, backup, backup.Status.BackupRepoName, dataProtectionWaitRepoPreparationKey)
+	if primaryRepo == nil {
+		return r.handleBackupRepoError(backup, backupCopy, err, failCopy)
+	}
+	replicaRepo, err := r.getReadyBackupRepo(reqCtx, backup, backupCopy.BackupRepoName, dataProtectionWaitReplicaRepoPreparationKey)
+	if replicaRepo == nil {
+		return r.handleBackupRepoError(backup, backupCopy, err, failCopy)
+	}
+
+	if err = r.createCopyJob(reqCtx, backup, backupCopy, primaryRepo, replicaRepo); err != nil {
+		return 0, err
+	}
+	if backupCopy.Phase != dpv1alpha1.BackupCopyPhaseCompleted {
+		backupCopy.Phase = dpv1alpha1.BackupCopyPhaseRunning
+	}
+	backupCopy.Path = backup.Status.Path
+	backupCopy.StartTimestamp = &metav1.Time{Time: time.Now()}
+	backupCopy.FailureReason = ""
+	return 0, nil
+}
+
+// getReadyBackupRepo gets the backup repo and checks if it is ready in the namespace of the backup.
+// If the repo has not been prepared in the namespace, the backup will be labeled to ask the backup
+// repo controller to prepare it.
+func (r *BackupReplicationReconciler) getReadyBackupRepo(reqCtx intctrlutil.RequestCtx,
+	backup *dpv1alpha1.Backup, repoName, waitLabelKey string) (*dpv1alpha1.BackupRepo, error) {
+	repo := &dpv1alpha1.BackupRepo{}
+	if err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Name: repoName}, repo); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, intctrlutil.NewFatalError(fmt.Sprintf("backup repo %s is not found", repoName))
+		}
+		return nil, err
+	}
+	err := checkBackupRepoInNamespace(reqCtx.Ctx, r.Client, repo, backup.Namespace)
+	if intctrlutil.IsTargetError(err, dperrors.ErrorTypeWaitForBackupRepoPreparation) {
+		if backup.Labels == nil {
+			backup.Labels = map[string]string{}
+		}
+		backup.Labels[waitLabelKey] = trueVal
+	}
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// handleBackupRepoError handles the error when a backup repo for the copy is unavailable.
+func (r *BackupReplicationReconciler) handleBackupRepoError(backup *dpv1alpha1.Backup, backupCopy *dpv1alpha1.BackupCopyStatus,
+	err error, failCopy func(string) (time.Duration, error)) (time.Duration, error) {
+	switch {
+	case intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal):
+		return failCopy(err.Error())
+	case intctrlutil.IsTargetError(err, dperrors.ErrorTypeWaitForBackupRepoPreparation):
+		// the backup repo controller will remove the label after the repo is prepared,
+		// which triggers the reconciliation again.
+		backupCopy.FailureReason = err.Error()
+		return 0, nil
+	case intctrlutil.IsTargetError(err, dperrors.ErrorTypeBackupRepoIsNotReady):
+		backupCopy.FailureReason = err.Error()
+		return backupCopyWaitInterval, nil
+	}
+	return 0, err
+}
+
+// checkCopyJob updates the copy status according to the copy job, and deletes the job once it is finished.
+func (r *BackupReplicationReconciler) checkCopyJob(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup,
+	backupCopy *dpv1alpha1.BackupCopyStatus, policy *dpv1alpha1.BackupReplicationPolicy, job *batchv1.Job) error {
+	_, finishedType, msg := utils.IsJobFinished(job)
+	now := metav1.Now()
+	switch finishedType {
+	case batchv1.JobComplete:
+		backupCopy.Phase = dpv1alpha1.BackupCopyPhaseCompleted
+		backupCopy.CompletionTimestamp = &now
+		backupCopy.FailureReason = ""
+		backupCopy.TimeRange = copiedTimeRange(backup, job)
+		backupCopy.Expiration = nil
+		if policy != nil && policy.BackupRepoName == backupCopy.BackupRepoName && policy.RetentionPeriod != "" {
+			duration, err := policy.RetentionPeriod.ToDuration()
+			if err != nil {
+				return err
+			}
+			backupCopy.Expiration = &metav1.Time{Time: now.Add(duration)}
+		}
+		r.Recorder.Eventf(backup, corev1.EventTypeNormal, "BackupCopyCompleted",
+			"the backup has been copied to backup repo %s", backupCopy.BackupRepoName)
+	case batchv1.JobFailed:
+		// the copy of a continuous backup is still usable if it failed to synchronize the new data.
+		if backupCopy.Phase != dpv1alpha1.BackupCopyPhaseCompleted {
+			backupCopy.Phase = dpv1alpha1.BackupCopyPhaseFailed
+		}
+		backupCopy.FailureReason = fmt.Sprintf("copy job %s failed: %s", job.Name, msg)
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "BackupCopyFailed", backupCopy.FailureReason)
+	default:
+		return nil
+	}
+	return intctrlutil.BackgroundDeleteObject(r.Client, reqCtx.Ctx, job)
+}
+
+// deleteCopy deletes the files of the copy from the secondary backup repository.
+func (r *BackupReplicationReconciler) deleteCopy(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup,
+	backupCopy *dpv1alpha1.BackupCopyStatus) error {
+	deleter := &dpbackup.Deleter{
+		RequestCtx: reqCtx,
+		Client:     r.Client,
+		Scheme:     r.Scheme,
+		EnsureWorkerServiceAccount: func() (string, error) {
+			return EnsureWorkerServiceAccount(reqCtx, r.Client, backup.Namespace, nil)
+		},
+	}
+	status, err := deleter.DeleteBackupCopyFiles(backup, backupCopy.BackupRepoName)
+	switch status {
+	case dpbackup.DeletionStatusSucceeded:
+		backupCopy.Phase = dpv1alpha1.BackupCopyPhaseExpired
+		backupCopy.FailureReason = ""
+		job := &batchv1.Job{}
+		jobKey := dpbackup.BuildDeleteBackupCopyFilesJobKey(backup, backupCopy.BackupRepoName)
+		if err = r.Client.Get(reqCtx.Ctx, jobKey, job); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		return intctrlutil.BackgroundDeleteObject(r.Client, reqCtx.Ctx, job)
+	case dpbackup.DeletionStatusFailed:
+		if backupCopy.FailureReason != err.Error() {
+			backupCopy.FailureReason = err.Error()
+			r.Recorder.Event(backup, corev1.EventTypeWarning, "DeleteBackupCopyFailed", err.Error())
+		}
+		return nil
+	}
+	return err
+}
+
+func (r *BackupReplicationReconciler) createCopyJob(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup,
+	backupCopy *dpv1alpha1.BackupCopyStatus, primaryRepo, replicaRepo *dpv1alpha1.BackupRepo) error {
+	saName, err := EnsureWorkerServiceAccount(reqCtx, r.Client, backup.Namespace, nil)
+	if err != nil {
+		return err
+	}
+	runAsUser := int64(0)
+	container := corev1.Container{
+		Name:            replicationContainerName,
+		Command:         []string{"sh", "-c"},
+		Image:           viper.GetString(constant.KBToolsImage),
+		ImagePullPolicy: corev1.PullPolicy(viper.GetString(constant.KBImagePullPolicy)),
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: boolptr.False(),
+			RunAsUser:                &runAsUser,
+		},
+	}
+	intctrlutil.InjectZeroResourcesLimitsForDataProtection(&container)
+	podSpec := corev1.PodSpec{
+		Containers:         []corev1.Container{container},
+		RestartPolicy:      corev1.RestartPolicyNever,
+		ServiceAccountName: saName,
+	}
+	if err = utils.AddTolerations(&podSpec); err != nil {
+		return err
+	}
+	utils.InjectDatasafed(&podSpec, primaryRepo, dpbackup.RepoVolumeMountPath,
+		kms.BuildJobEncryptionConfig(backup), backup.Status.KopiaRepoPath)
+	replicaDatasafed := utils.InjectReplicaDatasafed(&podSpec, replicaRepo, replicaRepoVolumeMountPath)
+	if replicaDatasafed == "" {
+		return intctrlutil.NewFatalError(fmt.Sprintf("backup repo %s can not be accessed", replicaRepo.Name))
+	}
+	podSpec.Containers[0].Args = []string{buildBackupCopyScript(backup.Status.Path, replicaDatasafed)}
+
+	jobKey := buildBackupCopyJobKey(backup, backupCopy.BackupRepoName)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: jobKey.Namespace,
+			Name:      jobKey.Name,
+			Labels: map[string]string{
+				constant.AppManagedByLabelKey: dptypes.AppName,
+				dptypes.BackupNameLabelKey:    backup.Name,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: jobKey.Namespace,
+					Name:      jobKey.Name,
+				},
+				Spec: podSpec,
+			},
+			BackoffLimit: &dptypes.DefaultBackOffLimit,
+		},
+	}
+	if clusterUID, ok := backup.Labels[dptypes.ClusterUIDLabelKey]; ok {
+		job.Labels[dptypes.ClusterUIDLabelKey] = clusterUID
+	}
+	if backup.Status.TimeRange != nil && backup.Status.TimeRange.End != nil {
+		job.Annotations = map[string]string{
+			backupCopyEndTimeAnnotationKey: backup.Status.TimeRange.End.UTC().Format(time.RFC3339),
+		}
+	}
+	if err = utils.SetControllerReference(backup, job, r.Scheme); err != nil {
+		return err
+	}
+	reqCtx.Log.V(1).Info("create a job to copy the backup", "job", job.Name, "backupRepo", backupCopy.BackupRepoName)
+	err = r.Client.Create(reqCtx.Ctx, job)
+	if apierrors.IsAlreadyExists(err) {
+		err = r.Client.Get(reqCtx.Ctx, jobKey, job)
+	}
+	if err != nil {
+		return err
+	}
+	// the data key secret is owned by the job, so it is deleted together with the job
+	return kms.EnsureDataKeySecret(reqCtx.Ctx, r.Client, backup, job.Namespace, job)
+}
+
+// buildBackupCopyScript builds the script to copy the backup files which do not exist in
+// the secondary backup repository yet. The files are copied in order, so only the last file
+// that has been copied may be partial, due to an interrupted copy or a continuous backup
+// still writing to it, it is compared by checksum and copied again if it differs.
+func buildBackupCopyScript(backupPath, replicaDatasafed string) string {
+	if !strings.HasPrefix(backupPath, "/") {
+		backupPath = "/" + backupPath
+	}
+	return fmt.Sprintf(`
+set -e
+set -o pipefail
+export PATH="$PATH:$%s"
+backupPath="%s"
+
+function replica_datasafed() {
+	%s "$@"
+}
+
+# list the files of the backup with the absolute paths
+function list_files() {
+	"$@" list -f --recursive "${backupPath}" | while read -r file; do
+		if [ -z "${file}" ]; then
+			continue
+		fi
+		case "${file}" in
+			/*) ;;
+			"${backupPath#/}"/*) file="/${file}" ;;
+			*) file="${backupPath%%/}/${file}" ;;
+		esac
+		echo "${file}"
+	done
+}
+
+function checksum() {
+	"$@" - | sha256sum | cut -d' ' -f1
+}
+
+list_files datasafed | sort > /tmp/source-files
+if ! list_files replica_datasafed > /tmp/replica-files 2>/tmp/replica-error; then
+	# the backup path does not exist in the secondary backup repository before the first copy
+	if ! grep -qi "not found\|no such file" /tmp/replica-error; then
+		cat /tmp/replica-error >&2
+		exit 1
+	fi
+	: > /tmp/replica-files
+fi
+
+# the last file in the source order which has been copied
+lastCopied=$(awk 'NR==FNR {copied[$0]=1; next} ($0 in copied) {last=$0} END {print last}' /tmp/replica-files /tmp/source-files)
+
+count=0
+while read -r file; do
+	if grep -Fxq "${file}" /tmp/replica-files; then
+		if [ "${file}" != "${lastCopied}" ]; then
+			continue
+		fi
+		# the file may be copied partially, or be appended in the primary backup repository
+		if [ "$(checksum datasafed pull "${file}")" = "$(checksum replica_datasafed pull "${file}")" ]; then
+			continue
+		fi
+	fi
+	echo "copying ${file}"
+	datasafed pull "${file}" - | replica_datasafed push - "${file}"
+	count=$((count+1))
+done < /tmp/source-files
+echo "${count} files are copied"
+`, dptypes.DPDatasafedBinPath, backupPath, replicaDatasafed)
+}
+
+// isBackupReplicable checks if the backup data can be copied to another backup repository.
+func isBackupReplicable(backup *dpv1alpha1.Backup) bool {
+	if backup.Status.BackupRepoName == "" || backup.Status.Path == "" {
+		return false
+	}
+	if backupMethod := backup.Status.BackupMethod; backupMethod != nil && boolptr.IsSetToTrue(backupMethod.SnapshotVolumes) {
+		return false
+	}
+	// the data of a kopia backup is stored in the shared kopia repository, rather than the backup path.
+	if backup.Status.KopiaRepoPath != "" {
+		return false
+	}
+	switch backup.Status.Phase {
+	case dpv1alpha1.BackupPhaseCompleted:
+		return true
+	case dpv1alpha1.BackupPhaseRunning:
+		// the continuous backup is running all the time, the log segments are copied periodically.
+		return backup.Labels[dptypes.BackupTypeLabelKey] == string(dpv1alpha1.BackupTypeContinuous)
+	}
+	return false
+}
+
+// needSyncBackupCopy checks if the backup has new data that has not been copied.
+func needSyncBackupCopy(backup *dpv1alpha1.Backup, backupCopy *dpv1alpha1.BackupCopyStatus) bool {
+	if backup.Labels[dptypes.BackupTypeLabelKey] != string(dpv1alpha1.BackupTypeContinuous) {
+		return false
+	}
+	if backup.Status.TimeRange == nil || backup.Status.TimeRange.End == nil {
+		return false
+	}
+	if backupCopy.TimeRange == nil || backupCopy.TimeRange.End == nil {
+		return true
+	}
+	return backup.Status.TimeRange.End.After(backupCopy.TimeRange.End.Time)
+}
+
+// copiedTimeRange returns the time range of the data contained in the copy.
+func copiedTimeRange(backup *dpv1alpha1.Backup, job *batchv1.Job) *dpv1alpha1.BackupTimeRange {
+	if backup.Status.TimeRange == nil {
+		return nil
+	}
+	timeRange := backup.Status.TimeRange.DeepCopy()
+	if endTime, ok := job.Annotations[backupCopyEndTimeAnnotationKey]; ok {
+		end, err := time.Parse(time.RFC3339, endTime)
+		if err == nil {
+			timeRange.End = &metav1.Time{Time: end}
+		}
+	}
+	return timeRange
+}
+
+// setReplicaBackupRepoLabel labels the backup with the backup repo of the latest copy which has not
+// been expired, so the backup repo will not be deleted while the copy exists.
+func setReplicaBackupRepoLabel(backup *dpv1alpha1.Backup) {
+	repoName := ""
+	for _, backupCopy := range backup.Status.Copies {
+		if backupCopy.Phase != dpv1alpha1.BackupCopyPhaseExpired {
+			repoName = backupCopy.BackupRepoName
+		}
+	}
+	if repoName == "" {
+		delete(backup.Labels, dataProtectionReplicaBackupRepoKey)
+		delete(backup.Labels, dataProtectionWaitReplicaRepoPreparationKey)
+		return
+	}
+	if backup.Labels == nil {
+		backup.Labels = map[string]string{}
+	}
+	backup.Labels[dataProtectionReplicaBackupRepoKey] = repoName
+}
+
+func findBackupCopy(backup *dpv1alpha1.Backup, repoName string) *dpv1alpha1.BackupCopyStatus {
+	for i := range backup.Status.Copies {
+		if backup.Status.Copies[i].BackupRepoName == repoName {
+			return &backup.Status.Copies[i]
+		}
+	}
+	return nil
+}
+
+func durationUntil(t *metav1.Time, now time.Time) time.Duration {
+	if t == nil {
+		return 0
+	}
+	// add a little delay to make sure the time has passed when requeue.
+	return t.Sub(now) + 100*time.Millisecond
+}
+
+func buildBackupCopyJobKey(backup *dpv1alpha1.Backup, repoName string) client.ObjectKey {
+	jobName := fmt.Sprintf("%s-copy-%s-%s", backup.UID[:8], repoName, backup.Name)
+	if len(jobName) > 63 {
+		jobName = strings.TrimSuffix(jobName[:63], "-")
+	}
+	return client.ObjectKey{Namespace: backup.Namespace, Name: jobName}
+}
@@ -0,0 +1,353 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	dpbackup "github.com/apecloud/kubeblocks/pkg/dataprotection/backup"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+const replicationTestNamespace = "default"
+
+func newReplicationTestRepo(name string) (*dpv1alpha1.BackupRepo, *corev1.Secret) {
+	repo := &dpv1alpha1.BackupRepo{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       dpv1alpha1.BackupRepoSpec{AccessMethod: dpv1alpha1.AccessMethodTool},
+		Status: dpv1alpha1.BackupRepoStatus{
+			Phase:                dpv1alpha1.BackupRepoReady,
+			ToolConfigSecretName: name + "-config",
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: replicationTestNamespace, Name: name + "-config"},
+	}
+	return repo, secret
+}
+
+func newReplicationTestClient(t *testing.T, objs ...client.Object) (client.Client, *BackupReplicationReconciler) {
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{corev1.AddToScheme, batchv1.AddToScheme, dpv1alpha1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("add scheme: %v", err)
+		}
+	}
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.SetDefault(dptypes.CfgKeyWorkerServiceAccountName, "worker-sa")
+	viper.SetDefault(dptypes.CfgKeyWorkerClusterRoleName, "worker-role")
+
+	objs = append(objs,
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: replicationTestNamespace, Name: "worker-sa"}},
+		&dpv1alpha1.BackupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: replicationTestNamespace, Name: "policy"},
+			Spec: dpv1alpha1.BackupPolicySpec{
+				Replication: &dpv1alpha1.BackupReplicationPolicy{
+					BackupRepoName:  "replica",
+					RetentionPeriod: "7d",
+				},
+			},
+		})
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.Backup{}, &batchv1.Job{}).
+		WithObjects(objs...).
+		Build()
+	return cli, &BackupReplicationReconciler{Client: cli, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+}
+
+func newReplicationTestBackup() *dpv1alpha1.Backup {
+	return &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: replicationTestNamespace,
+			Name:      "backup",
+			UID:       "12345678-abcd",
+		},
+		Spec: dpv1alpha1.BackupSpec{
+			BackupPolicyName: "policy",
+			BackupMethod:     "xtrabackup",
+		},
+		Status: dpv1alpha1.BackupStatus{
+			Phase:          dpv1alpha1.BackupPhaseCompleted,
+			BackupRepoName: "primary",
+			Path:           "/default/mysql/backup",
+			BackupMethod:   &dpv1alpha1.BackupMethod{Name: "xtrabackup"},
+		},
+	}
+}
+
+func completeReplicationTestJob(t *testing.T, cli client.Client, key client.ObjectKey) {
+	job := &batchv1.Job{}
+	if err := cli.Get(context.Background(), key, job); err != nil {
+		t.Fatalf("get job %s: %v", key.Name, err)
+	}
+	job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+		Type:   batchv1.JobComplete,
+		Status: corev1.ConditionTrue,
+	})
+	if err := cli.Status().Update(context.Background(), job); err != nil {
+		t.Fatalf("update job status: %v", err)
+	}
+}
+
+func TestBackupReplication(t *testing.T) {
+	primary, primarySecret := newReplicationTestRepo("primary")
+	replica, replicaSecret := newReplicationTestRepo("replica")
+	backup := newReplicationTestBackup()
+	cli, r := newReplicationTestClient(t, primary, primarySecret, replica, replicaSecret, backup)
+
+	ctx := context.Background()
+	key := client.ObjectKeyFromObject(backup)
+	reconcileAndGet := func() *dpv1alpha1.Backup {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("reconcile: %v", err)
+		}
+		obj := &dpv1alpha1.Backup{}
+		if err := cli.Get(ctx, key, obj); err != nil {
+			t.Fatalf("get backup: %v", err)
+		}
+		return obj
+	}
+
+	// start to copy the backup
+	obj := reconcileAndGet()
+	if len(obj.Status.Copies) != 1 {
+		t.Fatalf("unexpected copies: %+v", obj.Status.Copies)
+	}
+	backupCopy := obj.Status.Copies[0]
+	if backupCopy.BackupRepoName != "replica" || backupCopy.Phase != dpv1alpha1.BackupCopyPhaseRunning ||
+		backupCopy.Path != backup.Status.Path || backupCopy.StartTimestamp == nil {
+		t.Fatalf("unexpected copy: %+v", backupCopy)
+	}
+	if obj.Labels[dataProtectionReplicaBackupRepoKey] != "replica" {
+		t.Fatalf("unexpected labels: %v", obj.Labels)
+	}
+	jobKey := buildBackupCopyJobKey(obj, "replica")
+	job := &batchv1.Job{}
+	if err := cli.Get(ctx, jobKey, job); err != nil {
+		t.Fatalf("get copy job: %v", err)
+	}
+	script := job.Spec.Template.Spec.Containers[0].Args[0]
+	if !strings.Contains(script, `backupPath="/default/mysql/backup"`) ||
+		!strings.Contains(script, "--conf /etc/datasafed-replica/datasafed.conf") {
+		t.Fatalf("unexpected copy script: %s", script)
+	}
+	var secrets []string
+	for _, v := range job.Spec.Template.Spec.Volumes {
+		if v.Secret != nil {
+			secrets = append(secrets, v.Secret.SecretName)
+		}
+	}
+	if strings.Join(secrets, ",") != "primary-config,replica-config" {
+		t.Fatalf("unexpected secret volumes: %v", secrets)
+	}
+
+	// complete the copy
+	completeReplicationTestJob(t, cli, jobKey)
+	obj = reconcileAndGet()
+	backupCopy = obj.Status.Copies[0]
+	if backupCopy.Phase != dpv1alpha1.BackupCopyPhaseCompleted || backupCopy.Expiration == nil ||
+		backupCopy.Expiration.Sub(backupCopy.CompletionTimestamp.Time) != 7*24*time.Hour {
+		t.Fatalf("unexpected copy: %+v", backupCopy)
+	}
+	if err := cli.Get(ctx, jobKey, &batchv1.Job{}); err == nil {
+		t.Fatalf("copy job should be deleted")
+	}
+
+	// the copy expires
+	obj.Status.Copies[0].Expiration = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	if err := cli.Status().Update(ctx, obj); err != nil {
+		t.Fatalf("update backup status: %v", err)
+	}
+	obj = reconcileAndGet()
+	if obj.Status.Copies[0].Phase != dpv1alpha1.BackupCopyPhaseDeleting {
+		t.Fatalf("unexpected copy: %+v", obj.Status.Copies[0])
+	}
+	deleteJobKey := dpbackup.BuildDeleteBackupCopyFilesJobKey(obj, "replica")
+	completeReplicationTestJob(t, cli, deleteJobKey)
+	obj = reconcileAndGet()
+	if obj.Status.Copies[0].Phase != dpv1alpha1.BackupCopyPhaseExpired {
+		t.Fatalf("unexpected copy: %+v", obj.Status.Copies[0])
+	}
+	if _, ok := obj.Labels[dataProtectionReplicaBackupRepoKey]; ok {
+		t.Fatalf("unexpected labels: %v", obj.Labels)
+	}
+}
+
+func TestBackupReplicationWaitForRepoAndParent(t *testing.T) {
+	primary, primarySecret := newReplicationTestRepo("primary")
+	// the replica repo has not been prepared in the namespace
+	replica, _ := newReplicationTestRepo("replica")
+	parent := newReplicationTestBackup()
+	parent.Name = "parent"
+	backup := newReplicationTestBackup()
+	backup.Status.ParentBackupName = parent.Name
+	cli, r := newReplicationTestClient(t, primary, primarySecret, replica, parent, backup)
+
+	ctx := context.Background()
+	reconcileAndGet := func(key client.ObjectKey) *dpv1alpha1.Backup {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("reconcile: %v", err)
+		}
+		obj := &dpv1alpha1.Backup{}
+		if err := cli.Get(ctx, key, obj); err != nil {
+			t.Fatalf("get backup: %v", err)
+		}
+		return obj
+	}
+
+	// the incremental backup waits for the copy of its parent
+	obj := reconcileAndGet(client.ObjectKeyFromObject(backup))
+	if len(obj.Status.Copies) != 1 || !strings.Contains(obj.Status.Copies[0].FailureReason, "parent backup") {
+		t.Fatalf("unexpected copies: %+v", obj.Status.Copies)
+	}
+
+	// the parent waits for the replica repo to be prepared
+	obj = reconcileAndGet(client.ObjectKeyFromObject(parent))
+	if obj.Labels[dataProtectionWaitReplicaRepoPreparationKey] != trueVal ||
+		obj.Labels[dataProtectionReplicaBackupRepoKey] != "replica" {
+		t.Fatalf("unexpected labels: %v", obj.Labels)
+	}
+	if err := cli.Get(ctx, buildBackupCopyJobKey(obj, "replica"), &batchv1.Job{}); err == nil {
+		t.Fatalf("copy job should not be created")
+	}
+	reqs := (&BackupRepoReconciler{}).mapBackupToRepo(ctx, obj)
+	if len(reqs) != 1 || reqs[0].Name != "replica" {
+		t.Fatalf("unexpected requests: %v", reqs)
+	}
+}
+
+func TestBuildBackupCopyJobKey(t *testing.T) {
+	backup := newReplicationTestBackup()
+	backup.Name = strings.Repeat("b", 60)
+	key := buildBackupCopyJobKey(backup, "replica")
+	if len(key.Name) > 63 || !strings.HasPrefix(key.Name, "12345678-copy-replica-") {
+		t.Fatalf("unexpected job key: %v", key)
+	}
+}
+
+func TestIsBackupReplicable(t *testing.T) {
+	backup := newReplicationTestBackup()
+	if !isBackupReplicable(backup) {
+		t.Fatal("expect the completed backup to be replicable")
+	}
+	backup.Status.KopiaRepoPath = "/default/mysql/kopia"
+	if isBackupReplicable(backup) {
+		t.Fatal("expect the kopia backup not to be replicable")
+	}
+}
+
+func TestBuildBackupCopyScript(t *testing.T) {
+	script := buildBackupCopyScript("default/mysql/backup", "replica-datasafed")
+	if strings.Contains(script, "|| true") {
+		t.Fatal("the errors of listing the replica files should not be ignored")
+	}
+	if !strings.Contains(script, `backupPath="/default/mysql/backup"`) ||
+		!strings.Contains(script, `"$(checksum datasafed pull "${file}")" = "$(checksum replica_datasafed pull "${file}")"`) {
+		t.Fatalf("unexpected script: %s", script)
+	}
+}
+
+const fakeDatasafed = `#!/bin/bash
+root="${FAKE_ROOT}"
+case "$1" in
+list)
+	if [ ! -d "${root}$4" ]; then
+		echo "not found" >&2
+		exit 1
+	fi
+	find "${root}$4" -type f | sed "s#^${root}##"
+	;;
+pull)
+	echo "$2" >> "${root}.pulls"
+	cat "${root}$2"
+	;;
+push)
+	mkdir -p "$(dirname "${root}$3")"
+	cat > "${root}$3"
+	;;
+esac
+`
+
+func TestBackupCopyScriptCopiesIncrementally(t *testing.T) {
+	for _, bin := range []string{"bash", "sha256sum", "awk"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Skipf("%s is not available", bin)
+		}
+	}
+	dir := t.TempDir()
+	writeFile := func(path, content string) {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	binDir := filepath.Join(dir, "bin")
+	source, replica := filepath.Join(dir, "source"), filepath.Join(dir, "replica")
+	writeFile(filepath.Join(binDir, "datasafed"), fakeDatasafed)
+	writeFile(filepath.Join(source, "backup/a"), "a")
+	writeFile(filepath.Join(source, "backup/b"), "b-full")
+	writeFile(filepath.Join(source, "backup/c"), "c")
+	// a has been copied completely before, b has been copied partially
+	writeFile(filepath.Join(replica, "backup/a"), "a")
+	writeFile(filepath.Join(replica, "backup/b"), "b")
+
+	script := buildBackupCopyScript("backup", "FAKE_ROOT="+replica+" "+filepath.Join(binDir, "datasafed"))
+	cmd := exec.Command("bash", "-c", script)
+	cmd.Env = append(os.Environ(), "PATH="+binDir+":"+os.Getenv("PATH"), "FAKE_ROOT="+source)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("run the copy script: %v, output: %s", err, out)
+	}
+	if !strings.Contains(string(out), "2 files are copied") {
+		t.Fatalf("unexpected output: %s", out)
+	}
+	for file, expected := range map[string]string{"a": "a", "b": "b-full", "c": "c"} {
+		content, err := os.ReadFile(filepath.Join(replica, "backup", file))
+		if err != nil || string(content) != expected {
+			t.Fatalf("unexpected copy of %s: %q, err: %v", file, content, err)
+		}
+	}
+	// only the last copied file is compared by checksum
+	pulls, err := os.ReadFile(replica + ".pulls")
+	if err != nil || string(pulls) != "/backup/b\n" {
+		t.Fatalf("unexpected pulls from the replica: %q, err: %v", pulls, err)
+	}
+}
@@ -103,6 +103,9 @@ func (r *reconcileContext) getDigest() string {
 	content += r.provider.Spec.PersistentVolumeClaimTemplate
 	content += r.provider.Spec.CSIDriverSecretTemplate
 	content += r.provider.Spec.DatasafedConfigTemplate
+	if objectLock := r.renderCtx.ObjectLock; objectLock != nil {
+		content += objectLock.Mode + objectLock.RetainDuration
+	}
 	r.digest = md5Digest(content)
 	return r.digest
 }
@@ -124,6 +127,10 @@ func (r *reconcileContext) preCheckResourceName() string {
 	return cutName(fmt.Sprintf("pre-check-%s-%s", r.repo.UID[:8], r.repo.Name))
 }
 
+func (r *reconcileContext) healthCheckResourceName() string {
+	return cutName(fmt.Sprintf("health-check-%s-%s", r.repo.UID[:8], r.repo.Name))
+}
+
 // BackupRepoReconciler reconciles a BackupRepo object
 type BackupRepoReconciler struct {
 	client.Client
@@ -162,6 +169,10 @@ type BackupRepoReconciler struct {
 // create or delete Jobs
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 
+// update the backup repo health condition of Clusters
+// +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=clusters/status,verbs=get;update;patch
+
 // manage service accounts for worker
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
@@ -213,7 +224,7 @@ func (r *BackupRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	// check parameters for rendering templates
-	parameters, err := r.checkParameters(reqCtx, repo, provider)
+	parameters, objectLock, err := r.checkParameters(reqCtx, repo, provider)
 	if err != nil {
 		_ = r.updateStatus(reqCtx, repo)
 		return checkedRequeueWithError(err, reqCtx.Log, "check parameters failed")
@@ -226,6 +237,7 @@ func (r *BackupRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		Parameters: parameters,
 		renderCtx: renderContext{
 			Parameters: parameters,
+			ObjectLock: objectLock,
 		},
 	}
 
@@ -287,6 +299,14 @@ func (r *BackupRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return checkedRequeueWithError(err, reqCtx.Log,
 				"check associated restores failed")
 		}
+
+		// probe the repo periodically if the health check is enabled
+		requeueAfter, err := r.checkRepoHealth(reconCtx)
+		if err != nil {
+			return checkedRequeueWithError(err, reqCtx.Log,
+				"failed to check the health of the repo")
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 
 	return ctrl.Result{}, nil
@@ -400,6 +420,10 @@ func (r *BackupRepoReconciler) checkStorageProvider(
 			return provider, newDependencyError("DatasafedConfigTemplate is empty")
 		}
 	}
+	if repo.Spec.ObjectLock != nil && !provider.Spec.SupportsObjectLock {
+		reason = ReasonInvalidStorageProvider
+		return provider, newDependencyError("object lock is not supported by the storage provider")
+	}
 
 	// check its status
 	reason = ReasonStorageProviderReady
@@ -407,7 +431,8 @@ func (r *BackupRepoReconciler) checkStorageProvider(
 }
 
 func (r *BackupRepoReconciler) checkParameters(reqCtx intctrlutil.RequestCtx,
-	repo *dpv1alpha1.BackupRepo, provider *dpv1alpha1.StorageProvider) (parameters map[string]string, err error) {
+	repo *dpv1alpha1.BackupRepo, provider *dpv1alpha1.StorageProvider) (
+	parameters map[string]string, objectLock *objectLockRenderContext, err error) {
 	reason := ReasonUnknownError
 	defer func() {
 		r.updateConditionInDefer(reqCtx.Ctx, repo, ConditionTypeParametersChecked, reason, nil, nil, &err)
@@ -419,11 +444,39 @@ func (r *BackupRepoReconciler) checkParameters(reqCtx intctrlutil.RequestCtx,
 		if apierrors.IsNotFound(err) {
 			reason = ReasonCredentialSecretNotFound
 		}
-		return nil, err
+		return nil, nil, err
 	}
 	// TODO: verify parameters
+
+	// the object lock settings are rendered into the datasafed config
+	objectLock, err = buildObjectLockRenderContext(repo)
+	if err != nil {
+		reason = ReasonInvalidObjectLock
+		return nil, nil, newDependencyError(err.Error())
+	}
 	reason = ReasonParametersChecked
-	return parameters, nil
+	return parameters, objectLock, nil
+}
+
+func buildObjectLockRenderContext(repo *dpv1alpha1.BackupRepo) (*objectLockRenderContext, error) {
+	if repo.Spec.ObjectLock == nil {
+		return nil, nil
+	}
+	retention, err := repo.Spec.ObjectLock.RetentionPeriod.ToDuration()
+	if err != nil {
+		return nil, fmt.Errorf("invalid object lock retention period: %w", err)
+	}
+	if retention <= 0 {
+		return nil, fmt.Errorf("object lock retention period must be positive")
+	}
+	mode := repo.Spec.ObjectLock.Mode
+	if mode == "" {
+		mode = dpv1alpha1.ObjectLockModeGovernance
+	}
+	return &objectLockRenderContext{
+		Mode:           strings.ToUpper(string(mode)),
+		RetainDuration: retention.String(),
+	}, nil
 }
 
 func (r *BackupRepoReconciler) createStorageClassAndSecret(reconCtx *reconcileContext) (err error) {
@@ -724,12 +777,13 @@ func (r *BackupRepoReconciler) preCheckRepo(reconCtx *reconcileContext) (err err
 }
 
 func (r *BackupRepoReconciler) removePreCheckResources(reconCtx *reconcileContext) error {
-	objects := []client.Object{
-		&batchv1.Job{},
-		&corev1.PersistentVolumeClaim{},
-		&corev1.Secret{},
-	}
-	name := reconCtx.preCheckResourceName()
+	return r.removeDerivedResources(reconCtx, reconCtx.preCheckResourceName(),
+		&batchv1.Job{}, &corev1.PersistentVolumeClaim{}, &corev1.Secret{})
+}
+
+// removeDerivedResources removes the objects with the specified name in the
+// namespace of the controller manager.
+func (r *BackupRepoReconciler) removeDerivedResources(reconCtx *reconcileContext, name string, objects ...client.Object) error {
 	namespace := viper.GetString(constant.CfgKeyCtrlrMgrNS)
 	objKey := client.ObjectKey{Name: name, Namespace: namespace}
 	for _, obj := range objects {
@@ -1017,11 +1071,11 @@ func (r *BackupRepoReconciler) constructPVCByTemplate(
 }
 
 func (r *BackupRepoReconciler) listAssociatedBackups(
-	ctx context.Context, repo *dpv1alpha1.BackupRepo, extraSelector map[string]string) ([]*dpv1alpha1.Backup, error) {
+	ctx context.Context, repo *dpv1alpha1.BackupRepo, repoLabelKey string, extraSelector map[string]string) ([]*dpv1alpha1.Backup, error) {
 	// list backups associated with the repo
 	backupList := &dpv1alpha1.BackupList{}
 	selectors := client.MatchingLabels{
-		dataProtectionBackupRepoKey: repo.Name,
+		repoLabelKey: repo.Name,
 	}
 	for k, v := range extraSelector {
 		selectors[k] = v
@@ -1040,8 +1094,18 @@ func (r *BackupRepoReconciler) listAssociatedBackups(
 }
 
 func (r *BackupRepoReconciler) prepareForAssociatedBackups(reconCtx *reconcileContext) error {
-	backups, err := r.listAssociatedBackups(reconCtx.Ctx, reconCtx.repo, map[string]string{
-		dataProtectionWaitRepoPreparationKey: trueVal,
+	// prepare for the backups storing data in the repo, and the backups replicating data to the repo.
+	err := r.prepareForBackupsWaitingRepo(reconCtx, dataProtectionBackupRepoKey, dataProtectionWaitRepoPreparationKey)
+	if replicaErr := r.prepareForBackupsWaitingRepo(reconCtx,
+		dataProtectionReplicaBackupRepoKey, dataProtectionWaitReplicaRepoPreparationKey); err == nil {
+		err = replicaErr
+	}
+	return err
+}
+
+func (r *BackupRepoReconciler) prepareForBackupsWaitingRepo(reconCtx *reconcileContext, repoLabelKey, waitLabelKey string) error {
+	backups, err := r.listAssociatedBackups(reconCtx.Ctx, reconCtx.repo, repoLabelKey, map[string]string{
+		waitLabelKey: trueVal,
 	})
 	if err != nil {
 		return err
@@ -1053,9 +1117,9 @@ func (r *BackupRepoReconciler) prepareForAssociatedBackups(reconCtx *reconcileCo
 		if retErr == nil {
 			retErr = err
 		}
-		if err == nil && backup.Labels[dataProtectionWaitRepoPreparationKey] != "" {
+		if err == nil && backup.Labels[waitLabelKey] != "" {
 			patch := client.MergeFrom(backup.DeepCopy())
-			delete(backup.Labels, dataProtectionWaitRepoPreparationKey)
+			delete(backup.Labels, waitLabelKey)
 			if err = r.Client.Patch(reconCtx.Ctx, backup, patch, multicluster.InControlContext()); err != nil {
 				reconCtx.Log.Error(err, "failed to patch backup",
 					"backup", client.ObjectKeyFromObject(backup))
@@ -1310,10 +1374,16 @@ func (r *BackupRepoReconciler) deleteExternalResources(
 
 	// TODO: block deletion if any BackupPolicy is referencing to this repo
 
-	// check if the repo is still being used by any backup
-	if backups, err := r.listAssociatedBackups(reqCtx.Ctx, repo, nil); err != nil {
+	// check if the repo is still being used by any backup or backup copy
+	backups, err := r.listAssociatedBackups(reqCtx.Ctx, repo, dataProtectionBackupRepoKey, nil)
+	if err != nil {
 		return err
-	} else if len(backups) > 0 {
+	}
+	replicatedBackups, err := r.listAssociatedBackups(reqCtx.Ctx, repo, dataProtectionReplicaBackupRepoKey, nil)
+	if err != nil {
+		return err
+	}
+	if len(backups) > 0 || len(replicatedBackups) > 0 {
 		_ = updateCondition(reqCtx.Ctx, r.Client, repo, ConditionTypeDerivedObjectsDeleted,
 			metav1.ConditionFalse, ReasonHaveAssociatedBackups,
 			"some backups still refer to this repo")
@@ -1346,7 +1416,7 @@ func (r *BackupRepoReconciler) deleteExternalResources(
 	}
 
 	// update condition status
-	err := updateCondition(reqCtx.Ctx, r.Client, repo, ConditionTypeDerivedObjectsDeleted,
+	err = updateCondition(reqCtx.Ctx, r.Client, repo, ConditionTypeDerivedObjectsDeleted,
 		metav1.ConditionTrue, ReasonDerivedObjectsDeleted, "")
 	if err != nil {
 		return fmt.Errorf("failed to update condition: %w", err)
@@ -1355,6 +1425,7 @@ func (r *BackupRepoReconciler) deleteExternalResources(
 	// maintain mappers
 	r.secretRefMapper.removeRef(repo)
 	r.providerRefMapper.removeRef(repo)
+	deleteBackupRepoMetrics(repo.Name)
 
 	return nil
 }
@@ -1465,10 +1536,6 @@ func (r *BackupRepoReconciler) deleteSecrets(reqCtx intctrlutil.RequestCtx, repo
 
 func (r *BackupRepoReconciler) mapBackupToRepo(ctx context.Context, obj client.Object) []ctrl.Request {
 	backup := obj.(*dpv1alpha1.Backup)
-	repoName, ok := backup.Labels[dataProtectionBackupRepoKey]
-	if !ok {
-		return nil
-	}
 	// ignore failed backups
 	if backup.Status.Phase == dpv1alpha1.BackupPhaseFailed &&
 		backup.Labels[dptypes.BackupTypeLabelKey] != string(dpv1alpha1.BackupTypeContinuous) {
@@ -1477,14 +1544,22 @@ func (r *BackupRepoReconciler) mapBackupToRepo(ctx context.Context, obj client.O
 	// we should reconcile the BackupRepo when:
 	//   1. the Backup needs to use the BackupRepo, but it's not ready for the namespace.
 	//   2. the Backup is being deleted, because it may block the deletion of the BackupRepo.
-	shouldReconcileRepo := backup.Labels[dataProtectionWaitRepoPreparationKey] == trueVal ||
-		!backup.DeletionTimestamp.IsZero()
-	if shouldReconcileRepo {
-		return []ctrl.Request{{
-			NamespacedName: client.ObjectKey{Name: repoName},
-		}}
+	var requests []ctrl.Request
+	for repoLabelKey, waitLabelKey := range map[string]string{
+		dataProtectionBackupRepoKey:        dataProtectionWaitRepoPreparationKey,
+		dataProtectionReplicaBackupRepoKey: dataProtectionWaitReplicaRepoPreparationKey,
+	} {
+		repoName, ok := backup.Labels[repoLabelKey]
+		if !ok {
+			continue
+		}
+		if backup.Labels[waitLabelKey] == trueVal || !backup.DeletionTimestamp.IsZero() {
+			requests = append(requests, ctrl.Request{
+				NamespacedName: client.ObjectKey{Name: repoName},
+			})
+		}
 	}
-	return nil
+	return requests
 }
 
 func (r *BackupRepoReconciler) mapRestoreToRepo(ctx context.Context, obj client.Object) []ctrl.Request {
@@ -1597,6 +1672,16 @@ type renderContext struct {
 	Parameters                map[string]string
 	CSIDriverSecretRef        corev1.SecretReference
 	GeneratedStorageClassName string
+	ObjectLock                *objectLockRenderContext
+}
+
+// objectLockRenderContext is the object lock settings that can be referenced
+// in the datasafed config template.
+type objectLockRenderContext struct {
+	// Mode is the S3 Object Lock mode, GOVERNANCE or COMPLIANCE.
+	Mode string
+	// RetainDuration is the duration to lock the uploaded objects, e.g. "720h0m0s".
+	RetainDuration string
 }
 
 func renderTemplate(name, tpl string, rCtx renderContext) (string, error) {
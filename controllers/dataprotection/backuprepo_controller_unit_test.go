@@ -0,0 +1,242 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+func TestRenderObjectLockInToolConfig(t *testing.T) {
+	const tpl = `[storage]
+type = s3
+{{- with .ObjectLock }}
+object_lock_mode = {{ .Mode }}
+object_lock_retain_until_date = {{ .RetainDuration }}
+{{- end }}`
+
+	repo := &dpv1alpha1.BackupRepo{}
+	objectLock, err := buildObjectLockRenderContext(repo)
+	if err != nil || objectLock != nil {
+		t.Fatalf("expected no object lock, got %+v, err: %v", objectLock, err)
+	}
+	content, err := renderTemplate("tool-config", tpl, renderContext{ObjectLock: objectLock})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if content != "[storage]\ntype = s3" {
+		t.Errorf("unexpected content without object lock: %q", content)
+	}
+
+	repo.Spec.ObjectLock = &dpv1alpha1.BackupRepoObjectLock{
+		Mode:            dpv1alpha1.ObjectLockModeCompliance,
+		RetentionPeriod: "30d",
+	}
+	objectLock, err = buildObjectLockRenderContext(repo)
+	if err != nil {
+		t.Fatalf("build object lock: %v", err)
+	}
+	content, err = renderTemplate("tool-config", tpl, renderContext{ObjectLock: objectLock})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	expected := "[storage]\ntype = s3\nobject_lock_mode = COMPLIANCE\nobject_lock_retain_until_date = 720h0m0s"
+	if content != expected {
+		t.Errorf("unexpected content with object lock: %q", content)
+	}
+
+	repo.Spec.ObjectLock.RetentionPeriod = "abc"
+	if _, err = buildObjectLockRenderContext(repo); err == nil {
+		t.Errorf("expected an error for the invalid retention period")
+	}
+}
+
+func TestComputeBackupRepoUsage(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	newBackup := func(size string, completedBefore time.Duration) *dpv1alpha1.Backup {
+		return &dpv1alpha1.Backup{Status: dpv1alpha1.BackupStatus{
+			TotalSize:           size,
+			CompletionTimestamp: &metav1.Time{Time: now.Add(-completedBefore)},
+		}}
+	}
+	health := computeBackupRepoUsage([]*dpv1alpha1.Backup{
+		newBackup("10Gi", 30*24*time.Hour),
+		newBackup("7Gi", 24*time.Hour),
+		newBackup("7Gi", 6*24*time.Hour),
+		newBackup("", time.Hour),
+		newBackup("invalid", time.Hour),
+	}, now)
+	if health.BackupCount != 5 {
+		t.Errorf("unexpected backup count: %d", health.BackupCount)
+	}
+	if health.EstimatedUsedCapacity.Cmp(resource.MustParse("24Gi")) != 0 {
+		t.Errorf("unexpected used capacity: %s", health.EstimatedUsedCapacity.String())
+	}
+	if health.EstimatedGrowthPerDay.Cmp(resource.MustParse("2Gi")) != 0 {
+		t.Errorf("unexpected growth per day: %s", health.EstimatedGrowthPerDay.String())
+	}
+}
+
+func TestCheckRepoHealth(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme, dpv1alpha1.AddToScheme, appsv1.AddToScheme,
+	} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("add scheme: %v", err)
+		}
+	}
+	const namespace = "kb-system"
+	originalNamespace := viper.GetString(constant.CfgKeyCtrlrMgrNS)
+	viper.Set(constant.CfgKeyCtrlrMgrNS, namespace)
+	defer viper.Set(constant.CfgKeyCtrlrMgrNS, originalNamespace)
+
+	repo := &dpv1alpha1.BackupRepo{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo", UID: "0123456789abcdef"},
+		Spec: dpv1alpha1.BackupRepoSpec{
+			AccessMethod: dpv1alpha1.AccessMethodTool,
+			HealthCheck: &dpv1alpha1.BackupRepoHealthCheck{
+				ProbeInterval: metav1.Duration{Duration: 10 * time.Minute},
+				ProbeTimeout:  metav1.Duration{Duration: 5 * time.Minute},
+			},
+		},
+		Status: dpv1alpha1.BackupRepoStatus{Phase: dpv1alpha1.BackupRepoReady, IsDefault: true},
+	}
+	provider := &dpv1alpha1.StorageProvider{ObjectMeta: metav1.ObjectMeta{Name: "provider"}}
+	backup := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "backup",
+			Labels:    map[string]string{dataProtectionBackupRepoKey: repo.Name},
+		},
+		Status: dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseCompleted, TotalSize: "1Gi"},
+	}
+	cluster := &appsv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cluster"},
+		Spec: appsv1.ClusterSpec{
+			Backup: &appsv1.ClusterBackup{Enabled: boolptr.True()},
+		},
+	}
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.BackupRepo{}, &appsv1.Cluster{}).
+		WithIndex(&corev1.Event{}, "involvedObject.uid", func(obj client.Object) []string {
+			return []string{string(obj.(*corev1.Event).InvolvedObject.UID)}
+		}).
+		WithObjects(repo, provider, backup, cluster).
+		Build()
+	r := &BackupRepoReconciler{
+		Client:     cli,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(100),
+		RestConfig: &rest.Config{},
+	}
+	ctx := context.Background()
+	reconCtx := &reconcileContext{
+		RequestCtx: intctrlutil.RequestCtx{Ctx: ctx, Log: ctrl.Log},
+		repo:       repo,
+		provider:   provider,
+	}
+
+	createProbeJob := func(conditionType batchv1.JobConditionType) {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              reconCtx.healthCheckResourceName(),
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Minute)),
+				Annotations: map[string]string{
+					dataProtectionBackupRepoDigestAnnotationKey: reconCtx.getDigest(),
+				},
+			},
+			Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+				Type:   conditionType,
+				Status: corev1.ConditionTrue,
+			}}},
+		}
+		if err := cli.Create(ctx, job); err != nil {
+			t.Fatalf("create job: %v", err)
+		}
+	}
+	checkProbeResult := func(expected metav1.ConditionStatus) {
+		requeueAfter, err := r.checkRepoHealth(reconCtx)
+		if err != nil {
+			t.Fatalf("check repo health: %v", err)
+		}
+		if requeueAfter != 10*time.Minute {
+			t.Errorf("unexpected requeue interval: %v", requeueAfter)
+		}
+		if !meta.IsStatusConditionPresentAndEqual(repo.Status.Conditions, ConditionTypeHealthy, expected) {
+			t.Errorf("unexpected repo conditions: %v", repo.Status.Conditions)
+		}
+		health := repo.Status.Health
+		if health == nil || health.LastProbeTime == nil || health.BackupCount != 1 ||
+			health.EstimatedUsedCapacity.Cmp(resource.MustParse("1Gi")) != 0 {
+			t.Errorf("unexpected repo health: %+v", health)
+		}
+		got := &appsv1.Cluster{}
+		if err = cli.Get(ctx, client.ObjectKeyFromObject(cluster), got); err != nil {
+			t.Fatalf("get cluster: %v", err)
+		}
+		if !meta.IsStatusConditionPresentAndEqual(got.Status.Conditions, appsv1.ConditionTypeBackupRepoHealthy, expected) {
+			t.Errorf("unexpected cluster conditions: %v", got.Status.Conditions)
+		}
+		err = cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: reconCtx.healthCheckResourceName()}, &batchv1.Job{})
+		if !apierrors.IsNotFound(err) {
+			t.Errorf("expected the probe job to be removed, err: %v", err)
+		}
+	}
+
+	createProbeJob(batchv1.JobFailed)
+	checkProbeResult(metav1.ConditionFalse)
+
+	// the next probe is not due yet
+	requeueAfter, err := r.checkRepoHealth(reconCtx)
+	if err != nil {
+		t.Fatalf("check repo health: %v", err)
+	}
+	if requeueAfter <= 0 || requeueAfter > 10*time.Minute {
+		t.Errorf("unexpected requeue interval: %v", requeueAfter)
+	}
+
+	createProbeJob(batchv1.JobComplete)
+	checkProbeResult(metav1.ConditionTrue)
+}
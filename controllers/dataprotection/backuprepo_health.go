@@ -0,0 +1,427 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/multicluster"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+const (
+	defaultHealthProbeInterval = 10 * time.Minute
+	defaultHealthProbeTimeout  = 5 * time.Minute
+
+	// the window used to estimate the growth rate of the used capacity
+	repoGrowthEstimationWindow = 7 * 24 * time.Hour
+
+	healthCheckContainerName = "health-check"
+
+	reasonBackupRepoHealthy   = "BackupRepoHealthy"
+	reasonBackupRepoUnhealthy = "BackupRepoUnhealthy"
+)
+
+var (
+	backupRepoHealthyGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubeblocks_backuprepo_healthy",
+		Help: "Whether the latest health probe of the backup repository succeeded (1) or not (0).",
+	}, []string{"backuprepo"})
+	backupRepoProbeDurationGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubeblocks_backuprepo_probe_duration_seconds",
+		Help: "Duration of the latest health probe of the backup repository.",
+	}, []string{"backuprepo"})
+	backupRepoUsedBytesGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubeblocks_backuprepo_estimated_used_bytes",
+		Help: "Total size of the Backup objects stored in the backup repository.",
+	}, []string{"backuprepo"})
+	backupRepoBackupsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubeblocks_backuprepo_backups",
+		Help: "Number of the Backup objects stored in the backup repository.",
+	}, []string{"backuprepo"})
+	backupRepoGrowthGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubeblocks_backuprepo_estimated_growth_bytes_per_day",
+		Help: "Estimated daily growth of the used capacity of the backup repository, from the Backup objects completed in the last 7 days.",
+	}, []string{"backuprepo"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		backupRepoHealthyGauge,
+		backupRepoProbeDurationGauge,
+		backupRepoUsedBytesGauge,
+		backupRepoBackupsGauge,
+		backupRepoGrowthGauge,
+	)
+}
+
+func setBackupRepoMetrics(repo *dpv1alpha1.BackupRepo) {
+	health := repo.Status.Health
+	if health == nil {
+		return
+	}
+	if cond := meta.FindStatusCondition(repo.Status.Conditions, ConditionTypeHealthy); cond != nil {
+		healthy := 0.0
+		if cond.Status == metav1.ConditionTrue {
+			healthy = 1
+		}
+		backupRepoHealthyGauge.WithLabelValues(repo.Name).Set(healthy)
+	}
+	if health.LastProbeDuration != nil {
+		backupRepoProbeDurationGauge.WithLabelValues(repo.Name).Set(health.LastProbeDuration.Seconds())
+	}
+	if health.EstimatedUsedCapacity != nil {
+		backupRepoUsedBytesGauge.WithLabelValues(repo.Name).Set(float64(health.EstimatedUsedCapacity.Value()))
+	}
+	backupRepoBackupsGauge.WithLabelValues(repo.Name).Set(float64(health.BackupCount))
+	if health.EstimatedGrowthPerDay != nil {
+		backupRepoGrowthGauge.WithLabelValues(repo.Name).Set(float64(health.EstimatedGrowthPerDay.Value()))
+	}
+}
+
+func deleteBackupRepoMetrics(repoName string) {
+	for _, gauge := range []*prometheus.GaugeVec{
+		backupRepoHealthyGauge,
+		backupRepoProbeDurationGauge,
+		backupRepoUsedBytesGauge,
+		backupRepoBackupsGauge,
+		backupRepoGrowthGauge,
+	} {
+		gauge.DeleteLabelValues(repoName)
+	}
+}
+
+// checkRepoHealth runs a lightweight probe job periodically to check whether
+// the repo is still readable and writable, and refreshes the estimated usage of the repo.
+// It returns the duration after which the repo should be checked again.
+func (r *BackupRepoReconciler) checkRepoHealth(reconCtx *reconcileContext) (time.Duration, error) {
+	repo := reconCtx.repo
+	if repo.Spec.HealthCheck == nil {
+		return 0, nil
+	}
+	interval := repo.Spec.HealthCheck.ProbeInterval.Duration
+	if interval <= 0 {
+		interval = defaultHealthProbeInterval
+	}
+	timeout := repo.Spec.HealthCheck.ProbeTimeout.Duration
+	if timeout <= 0 {
+		timeout = defaultHealthProbeTimeout
+	}
+
+	namespace := viper.GetString(constant.CfgKeyCtrlrMgrNS)
+	job := &batchv1.Job{}
+	err := r.Client.Get(reconCtx.Ctx, client.ObjectKey{Name: reconCtx.healthCheckResourceName(), Namespace: namespace},
+		job, multicluster.InControlContext())
+	if err != nil && !apierrors.IsNotFound(err) {
+		return 0, err
+	}
+	if apierrors.IsNotFound(err) {
+		if health := repo.Status.Health; health != nil && health.LastProbeTime != nil {
+			if elapsed := wallClock.Since(health.LastProbeTime.Time); elapsed < interval {
+				return interval - elapsed, nil
+			}
+		}
+		saName, err := EnsureWorkerServiceAccount(reconCtx.RequestCtx, r.Client, namespace, r.MultiClusterMgr)
+		if err != nil {
+			return 0, err
+		}
+		if _, err = r.runHealthCheckJob(reconCtx, namespace, saName); err != nil {
+			return 0, err
+		}
+		return defaultCheckInterval, nil
+	}
+
+	// the job was created for the old configuration of the repo, run it again
+	if !reconCtx.hasSameDigest(job) {
+		return defaultCheckInterval, r.removeHealthCheckResources(reconCtx)
+	}
+
+	finished, jobStatus, failureReason := utils.IsJobFinished(job)
+	duration := wallClock.Since(job.CreationTimestamp.Time)
+	if !finished {
+		if duration <= timeout {
+			return defaultCheckInterval, nil
+		}
+		jobStatus = batchv1.JobFailed
+		failureReason = "timeout"
+	}
+
+	status := metav1.ConditionTrue
+	reason := ReasonProbeSucceeded
+	message := ""
+	if jobStatus == batchv1.JobFailed {
+		status = metav1.ConditionFalse
+		reason = ReasonProbeFailed
+		info, err := r.collectPreCheckFailureMessage(reconCtx, job, nil)
+		if err != nil {
+			return 0, fmt.Errorf("failed to collect health probe failure message, err: %w", err)
+		}
+		message = "Health probe job failed, information collected for diagnosis.\n\n"
+		message += fmt.Sprintf("Job failure message: %s\n\n", failureReason)
+		message += info
+		// max length of metav1.Condition.Message is 32K
+		const messageLimit = 32 * 1024
+		if len(message) > messageLimit {
+			message = message[:messageLimit]
+		}
+	}
+
+	backups, err := r.listAssociatedBackups(reconCtx.Ctx, repo, dataProtectionBackupRepoKey, nil)
+	if err != nil {
+		return 0, err
+	}
+	wasHealthy := !meta.IsStatusConditionFalse(repo.Status.Conditions, ConditionTypeHealthy)
+	patch := client.MergeFrom(repo.DeepCopy())
+	now := metav1.NewTime(wallClock.Now())
+	health := computeBackupRepoUsage(backups, now.Time)
+	health.LastProbeTime = &now
+	health.LastProbeDuration = &metav1.Duration{Duration: duration}
+	repo.Status.Health = health
+	setCondition(repo, ConditionTypeHealthy, status, reason, message)
+	if err = r.Client.Status().Patch(reconCtx.Ctx, repo, patch, multicluster.InControlContext()); err != nil {
+		return 0, err
+	}
+	setBackupRepoMetrics(repo)
+	if wasHealthy && status == metav1.ConditionFalse {
+		r.Recorder.Eventf(repo, corev1.EventTypeWarning, ReasonProbeFailed,
+			"health probe of the backup repo failed: %s", failureReason)
+	}
+
+	if err = r.updateClusterBackupRepoCondition(reconCtx, status == metav1.ConditionTrue); err != nil {
+		return 0, err
+	}
+	if err = r.removeHealthCheckResources(reconCtx); err != nil {
+		return 0, err
+	}
+	return interval, nil
+}
+
+func (r *BackupRepoReconciler) removeHealthCheckResources(reconCtx *reconcileContext) error {
+	return r.removeDerivedResources(reconCtx, reconCtx.healthCheckResourceName(), &batchv1.Job{})
+}
+
+func (r *BackupRepoReconciler) runHealthCheckJob(reconCtx *reconcileContext, namespace string, saName string) (*batchv1.Job, error) {
+	name := reconCtx.healthCheckResourceName()
+	digestAnnotations := map[string]string{
+		dataProtectionBackupRepoDigestAnnotationKey: reconCtx.getDigest(),
+	}
+	// the PVC and the tool config secret are kept between probes, and they
+	// are recreated if the configuration of the repo is changed.
+	var derived client.Object
+	var err error
+	switch {
+	case reconCtx.repo.AccessByMount():
+		derived, err = r.createRepoPVC(reconCtx, name, namespace, digestAnnotations, multicluster.InControlContext())
+	case reconCtx.repo.AccessByTool():
+		derived, err = r.createToolConfigSecret(reconCtx, name, namespace, digestAnnotations, multicluster.InControlContext())
+	default:
+		err = fmt.Errorf("unknown access method: %s", reconCtx.repo.Spec.AccessMethod)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !reconCtx.hasSameDigest(derived) {
+		if err = r.removeDerivedResources(reconCtx, name, derived); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("health check PVC or tool config secret digest not match, try again")
+	}
+
+	job := &batchv1.Job{}
+	job.Name = name
+	job.Namespace = namespace
+	_, err = createObjectIfNotExist(reconCtx.Ctx, r.Client, job, func() error {
+		container := corev1.Container{
+			Name:            healthCheckContainerName,
+			Image:           viper.GetString(constant.KBToolsImage),
+			ImagePullPolicy: corev1.PullPolicy(viper.GetString(constant.KBImagePullPolicy)),
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: boolptr.False(),
+			},
+		}
+		podSpec := corev1.PodSpec{
+			RestartPolicy:      corev1.RestartPolicyNever,
+			ServiceAccountName: saName,
+			SecurityContext: &corev1.PodSecurityContext{
+				// Set FSGroup to 65532 to ensure the mounted volumes have correct group ownership
+				// for the container user (65532:65532 defined in Dockerfile) to access files
+				FSGroup: pointer.Int64(65532),
+			},
+		}
+		if reconCtx.repo.AccessByMount() {
+			container.Command = []string{
+				"sh", "-c", `set -ex; date > /backup/health-check.txt; sync; cat /backup/health-check.txt; rm /backup/health-check.txt; sync`,
+			}
+			container.VolumeMounts = []corev1.VolumeMount{{
+				Name:      "backup-pvc",
+				MountPath: "/backup",
+			}}
+			podSpec.Volumes = []corev1.Volume{{
+				Name: "backup-pvc",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: name,
+					},
+				},
+			}}
+		} else {
+			filePath := filepath.Join("/", reconCtx.repo.Spec.PathPrefix, "health-check.txt")
+			container.Command = []string{
+				"sh", "-c",
+				fmt.Sprintf(`
+set -ex
+export PATH="$PATH:$DP_DATASAFED_BIN_PATH"
+date | datasafed push - %s
+datasafed pull %s -
+datasafed rm %s`, filePath, filePath, filePath),
+			}
+		}
+		podSpec.Containers = []corev1.Container{container}
+		job.Spec = batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: podSpec,
+			},
+			BackoffLimit: pointer.Int32(0),
+		}
+		job.Labels = map[string]string{
+			dataProtectionBackupRepoKey: reconCtx.repo.Name,
+		}
+		job.Annotations = digestAnnotations
+		if err := utils.AddTolerations(&job.Spec.Template.Spec); err != nil {
+			return err
+		}
+		for i := range job.Spec.Template.Spec.Containers {
+			intctrlutil.InjectZeroResourcesLimitsForDataProtection(&job.Spec.Template.Spec.Containers[i])
+		}
+		if reconCtx.repo.AccessByTool() {
+			utils.InjectDatasafedWithConfig(&job.Spec.Template.Spec, name, "")
+		}
+		return controllerutil.SetControllerReference(reconCtx.repo, job, r.Scheme)
+	}, multicluster.InControlContext())
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// computeBackupRepoUsage estimates the used capacity and the daily growth of the repo
+// from the sizes of the Backup objects stored in it, the objects in the storage are
+// not listed.
+func computeBackupRepoUsage(backups []*dpv1alpha1.Backup, now time.Time) *dpv1alpha1.BackupRepoHealthStatus {
+	used := resource.NewQuantity(0, resource.BinarySI)
+	growth := resource.NewQuantity(0, resource.BinarySI)
+	var count int32
+	for _, backup := range backups {
+		count++
+		if backup.Status.TotalSize == "" {
+			continue
+		}
+		size, err := resource.ParseQuantity(backup.Status.TotalSize)
+		if err != nil {
+			continue
+		}
+		used.Add(size)
+		completion := backup.Status.CompletionTimestamp
+		if completion != nil && now.Sub(completion.Time) <= repoGrowthEstimationWindow {
+			growth.Add(size)
+		}
+	}
+	days := int64(repoGrowthEstimationWindow / (24 * time.Hour))
+	return &dpv1alpha1.BackupRepoHealthStatus{
+		EstimatedUsedCapacity: used,
+		BackupCount:           count,
+		EstimatedGrowthPerDay: resource.NewQuantity(growth.Value()/days, resource.BinarySI),
+	}
+}
+
+// updateClusterBackupRepoCondition sets the BackupRepoHealthy condition of the
+// clusters which back up to the repo. The condition is only added when the repo
+// turns unhealthy, and it is set back to true once the repo recovers.
+func (r *BackupRepoReconciler) updateClusterBackupRepoCondition(reconCtx *reconcileContext, healthy bool) error {
+	clusterList := &appsv1.ClusterList{}
+	if err := r.Client.List(reconCtx.Ctx, clusterList, multicluster.InControlContext()); err != nil {
+		return err
+	}
+	repo := reconCtx.repo
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		if !clusterUsesBackupRepo(cluster, repo) {
+			continue
+		}
+		cond := metav1.Condition{
+			Type:               appsv1.ConditionTypeBackupRepoHealthy,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: cluster.Generation,
+			Reason:             reasonBackupRepoHealthy,
+			Message:            fmt.Sprintf("backup repo %s is healthy", repo.Name),
+		}
+		if !healthy {
+			cond.Status = metav1.ConditionFalse
+			cond.Reason = reasonBackupRepoUnhealthy
+			cond.Message = fmt.Sprintf("backup repo %s is unhealthy, check the status of the BackupRepo for details", repo.Name)
+		}
+		existing := meta.FindStatusCondition(cluster.Status.Conditions, cond.Type)
+		if existing == nil && healthy {
+			continue
+		}
+		if existing != nil && existing.Status == cond.Status && existing.Message == cond.Message {
+			continue
+		}
+		patch := client.MergeFrom(cluster.DeepCopy())
+		meta.SetStatusCondition(&cluster.Status.Conditions, cond)
+		if err := r.Client.Status().Patch(reconCtx.Ctx, cluster, patch, multicluster.InControlContext()); err != nil {
+			return err
+		}
+		if !healthy {
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, reasonBackupRepoUnhealthy, cond.Message)
+		}
+	}
+	return nil
+}
+
+func clusterUsesBackupRepo(cluster *appsv1.Cluster, repo *dpv1alpha1.BackupRepo) bool {
+	if cluster.Spec.Backup == nil || !boolptr.IsSetToTrue(cluster.Spec.Backup.Enabled) {
+		return false
+	}
+	if cluster.Spec.Backup.RepoName == "" {
+		return repo.Status.IsDefault
+	}
+	return cluster.Spec.Backup.RepoName == repo.Name
+}
@@ -0,0 +1,544 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
+)
+
+const (
+	verificationContainerName = "verify"
+)
+
+// BackupVerificationReconciler reconciles a BackupVerification object
+type BackupVerificationReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backupverifications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backupverifications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backupverifications/finalizers,verbs=update
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=clusters,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;delete
+
+// Reconcile starts a verification run when the scheduled time comes, which restores a completed backup
+// into a scratch cluster in a throw-away namespace, runs the verification action against the running
+// database and tears down the namespace.
+func (r *BackupVerificationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqCtx := intctrlutil.RequestCtx{
+		Ctx:      ctx,
+		Req:      req,
+		Log:      log.FromContext(ctx).WithValues("backupVerification", req.NamespacedName),
+		Recorder: r.Recorder,
+	}
+
+	verification := &dpv1alpha1.BackupVerification{}
+	if err := r.Client.Get(reqCtx.Ctx, reqCtx.Req.NamespacedName, verification); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+
+	res, err := intctrlutil.HandleCRDeletion(reqCtx, r, verification, dptypes.DataProtectionFinalizerName, func() (*ctrl.Result, error) {
+		if verification.Status.CurrentRun == nil {
+			return nil, nil
+		}
+		return nil, r.deleteVerificationNamespace(reqCtx, verification.Status.CurrentRun.Namespace)
+	})
+	if res != nil {
+		return *res, err
+	}
+
+	original := verification.DeepCopy()
+	var requeueAfter time.Duration
+	if verification.Status.CurrentRun != nil {
+		requeueAfter, err = r.reconcileRun(reqCtx, verification)
+	} else {
+		requeueAfter, err = r.reconcileSchedule(reqCtx, verification)
+	}
+	if err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	verification.Status.ObservedGeneration = verification.Generation
+	if !reflect.DeepEqual(original.Status, verification.Status) {
+		if err = r.Client.Status().Patch(reqCtx.Ctx, verification, client.MergeFrom(original)); err != nil {
+			return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+		}
+	}
+	if requeueAfter > 0 {
+		return intctrlutil.RequeueAfter(requeueAfter, reqCtx.Log, "")
+	}
+	return intctrlutil.Reconciled()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackupVerificationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return intctrlutil.NewControllerManagedBy(mgr).
+		For(&dpv1alpha1.BackupVerification{}).
+		Watches(&appsv1.Cluster{}, handler.EnqueueRequestsFromMapFunc(r.parseVerificationObject)).
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(r.parseVerificationObject)).
+		Complete(r)
+}
+
+// parseVerificationObject maps the Cluster and Job of a run to the BackupVerification.
+func (r *BackupVerificationReconciler) parseVerificationObject(_ context.Context, obj client.Object) []reconcile.Request {
+	labels := obj.GetLabels()
+	name, namespace := labels[dptypes.BackupVerificationLabelKey], labels[dptypes.BackupVerificationNamespaceLabelKey]
+	if name == "" || namespace == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: namespace, Name: name}}}
+}
+
+// reconcileSchedule starts a new run if the scheduled time has come, and returns the duration to the next scheduled time.
+func (r *BackupVerificationReconciler) reconcileSchedule(reqCtx intctrlutil.RequestCtx,
+	verification *dpv1alpha1.BackupVerification) (time.Duration, error) {
+	sched, err := dputils.ParseCronSchedule(verification.Spec.Schedule)
+	if err != nil {
+		verification.Status.Phase = dpv1alpha1.BackupVerificationPhaseFailed
+		verification.Status.FailureReason = fmt.Sprintf("invalid schedule %q: %s", verification.Spec.Schedule, err.Error())
+		r.Recorder.Event(verification, corev1.EventTypeWarning, "InvalidSchedule", verification.Status.FailureReason)
+		// wait for the spec to be updated.
+		return 0, nil
+	}
+	verification.Status.Phase = dpv1alpha1.BackupVerificationPhaseAvailable
+	verification.Status.FailureReason = ""
+	if verification.Spec.Suspend {
+		return 0, nil
+	}
+
+	now := time.Now()
+	scheduledTime, err := mostRecentVerificationTime(verification, sched, now)
+	if err != nil {
+		// skip the missed times and wait for the next scheduled time.
+		r.Recorder.Event(verification, corev1.EventTypeWarning, "TooManyMissedTimes", err.Error())
+		verification.Status.LastScheduleTime = &metav1.Time{Time: now}
+	} else if scheduledTime != nil {
+		if err = r.startRun(reqCtx, verification, *scheduledTime); err != nil {
+			return 0, err
+		}
+		if verification.Status.CurrentRun != nil {
+			return 0, nil
+		}
+	}
+	// add a little delay to make sure the next scheduled time has passed when requeue.
+	return sched.Next(now).Sub(now) + 100*time.Millisecond, nil
+}
+
+// startRun selects a backup to verify and creates the throw-away namespace to restore it.
+func (r *BackupVerificationReconciler) startRun(reqCtx intctrlutil.RequestCtx,
+	verification *dpv1alpha1.BackupVerification, scheduledTime time.Time) error {
+	verification.Status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
+	backups, err := r.listVerifiableBackups(reqCtx.Ctx, verification)
+	if err != nil {
+		return err
+	}
+	backup := selectBackupToVerify(backups, verification.Spec.BackupSelection)
+	if backup == nil {
+		r.Recorder.Event(verification, corev1.EventTypeWarning, "NoBackupToVerify", "no completed backup to verify is found")
+		return nil
+	}
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   verificationNamespaceName(verification, scheduledTime),
+			Labels: verificationLabels(verification),
+		},
+	}
+	namespace.Labels[constant.AppManagedByLabelKey] = dptypes.AppName
+	if err = r.Client.Create(reqCtx.Ctx, namespace); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	verification.Status.CurrentRun = &dpv1alpha1.BackupVerificationRun{
+		BackupName:     backup.Name,
+		Namespace:      namespace.Name,
+		Phase:          dpv1alpha1.BackupVerificationRunPhaseRestoring,
+		StartTimestamp: &metav1.Time{Time: time.Now()},
+	}
+	r.Recorder.Eventf(verification, corev1.EventTypeNormal, "VerificationStarted",
+		"start to verify backup %s in namespace %s", backup.Name, namespace.Name)
+	return nil
+}
+
+// reconcileRun drives the run in progress, and returns the duration to wait for the run to time out.
+func (r *BackupVerificationReconciler) reconcileRun(reqCtx intctrlutil.RequestCtx,
+	verification *dpv1alpha1.BackupVerification) (time.Duration, error) {
+	run := verification.Status.CurrentRun
+	timeout := time.Duration(verification.Spec.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = time.Hour
+	}
+	deadline := run.StartTimestamp.Add(timeout)
+	if !time.Now().Before(deadline) {
+		return 0, r.completeRun(reqCtx, verification, fmt.Errorf("the verification is not completed in %s", timeout))
+	}
+
+	backup := &dpv1alpha1.Backup{}
+	if err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: verification.Namespace, Name: run.BackupName}, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, r.completeRun(reqCtx, verification, fmt.Errorf("backup %s is deleted", run.BackupName))
+		}
+		return 0, err
+	}
+
+	switch run.Phase {
+	case dpv1alpha1.BackupVerificationRunPhaseRestoring:
+		cluster, err := buildVerificationCluster(verification, backup)
+		if err != nil {
+			return 0, r.completeRun(reqCtx, verification, err)
+		}
+		err = r.Client.Get(reqCtx.Ctx, client.ObjectKeyFromObject(cluster), cluster)
+		if apierrors.IsNotFound(err) {
+			return time.Until(deadline), r.Client.Create(reqCtx.Ctx, cluster)
+		}
+		if err != nil {
+			return 0, err
+		}
+		restoreCond := meta.FindStatusCondition(cluster.Status.Conditions, appsv1.ConditionTypeRestore)
+		switch {
+		case restoreCond != nil && restoreCond.Status == metav1.ConditionFalse:
+			return 0, r.completeRun(reqCtx, verification, fmt.Errorf("failed to restore the backup: %s", restoreCond.Message))
+		case cluster.Status.Phase == appsv1.FailedClusterPhase:
+			return 0, r.completeRun(reqCtx, verification, fmt.Errorf("the restored cluster %s is failed", cluster.Name))
+		case restoreCond != nil && restoreCond.Status == metav1.ConditionTrue && cluster.Status.Phase == appsv1.RunningClusterPhase:
+			run.Phase = dpv1alpha1.BackupVerificationRunPhaseVerifying
+			return 0, nil
+		}
+	case dpv1alpha1.BackupVerificationRunPhaseVerifying:
+		job := &batchv1.Job{}
+		err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: run.Namespace, Name: verification.Name}, job)
+		if apierrors.IsNotFound(err) {
+			job, err = r.buildVerificationJob(reqCtx.Ctx, verification, backup)
+			if err != nil || job == nil {
+				return time.Until(deadline), err
+			}
+			return time.Until(deadline), r.Client.Create(reqCtx.Ctx, job)
+		}
+		if err != nil {
+			return 0, err
+		}
+		for _, cond := range job.Status.Conditions {
+			if cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case batchv1.JobComplete:
+				return 0, r.completeRun(reqCtx, verification, nil)
+			case batchv1.JobFailed:
+				return 0, r.completeRun(reqCtx, verification, fmt.Errorf("the verification action failed: %s", cond.Message))
+			}
+		}
+	}
+	return time.Until(deadline), nil
+}
+
+// completeRun records the result of the run on the backup, and tears down the throw-away namespace.
+func (r *BackupVerificationReconciler) completeRun(reqCtx intctrlutil.RequestCtx,
+	verification *dpv1alpha1.BackupVerification, runErr error) error {
+	run := verification.Status.CurrentRun
+	if err := r.deleteVerificationNamespace(reqCtx, run.Namespace); err != nil {
+		return err
+	}
+	run.CompletionTimestamp = &metav1.Time{Time: time.Now()}
+	if runErr != nil {
+		run.Phase = dpv1alpha1.BackupVerificationRunPhaseFailed
+		run.FailureReason = runErr.Error()
+	} else {
+		run.Phase = dpv1alpha1.BackupVerificationRunPhaseVerified
+	}
+
+	backup := &dpv1alpha1.Backup{}
+	err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: verification.Namespace, Name: run.BackupName}, backup)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		patch := client.MergeFrom(backup.DeepCopy())
+		backup.Status.Verification = &dpv1alpha1.BackupVerificationResult{
+			Phase:            run.Phase,
+			VerificationName: verification.Name,
+			Timestamp:        run.CompletionTimestamp,
+			FailureReason:    run.FailureReason,
+		}
+		if err = r.Client.Status().Patch(reqCtx.Ctx, backup, patch); err != nil {
+			return err
+		}
+	}
+
+	if runErr != nil {
+		r.Recorder.Eventf(verification, corev1.EventTypeWarning, "VerificationFailed",
+			"failed to verify backup %s: %s", run.BackupName, runErr.Error())
+	} else {
+		verification.Status.LastVerifiedBackup = run.BackupName
+		r.Recorder.Eventf(verification, corev1.EventTypeNormal, "VerificationSucceeded", "backup %s is verified", run.BackupName)
+	}
+	verification.Status.LastRun = run
+	verification.Status.CurrentRun = nil
+	return nil
+}
+
+func (r *BackupVerificationReconciler) deleteVerificationNamespace(reqCtx intctrlutil.RequestCtx, name string) error {
+	namespace := &corev1.Namespace{}
+	if err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Name: name}, namespace); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	// the namespace must be the one created by the verification.
+	if namespace.Labels[dptypes.BackupVerificationLabelKey] == "" {
+		return nil
+	}
+	return intctrlutil.BackgroundDeleteObject(r.Client, reqCtx.Ctx, namespace)
+}
+
+// listVerifiableBackups lists the completed backups of the backup policy or backup schedule,
+// which can be restored to a cluster.
+func (r *BackupVerificationReconciler) listVerifiableBackups(ctx context.Context,
+	verification *dpv1alpha1.BackupVerification) ([]*dpv1alpha1.Backup, error) {
+	opts := []client.ListOption{client.InNamespace(verification.Namespace)}
+	if len(verification.Spec.BackupScheduleName) > 0 {
+		opts = append(opts, client.MatchingLabels{dptypes.BackupScheduleLabelKey: verification.Spec.BackupScheduleName})
+	}
+	backupList := &dpv1alpha1.BackupList{}
+	if err := r.Client.List(ctx, backupList, opts...); err != nil {
+		return nil, err
+	}
+	var backups []*dpv1alpha1.Backup
+	for i := range backupList.Items {
+		if isVerifiableBackup(verification, &backupList.Items[i]) {
+			backups = append(backups, &backupList.Items[i])
+		}
+	}
+	return backups, nil
+}
+
+func isVerifiableBackup(verification *dpv1alpha1.BackupVerification, backup *dpv1alpha1.Backup) bool {
+	if !backup.DeletionTimestamp.IsZero() || backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+		return false
+	}
+	if len(verification.Spec.BackupPolicyName) > 0 && backup.Spec.BackupPolicyName != verification.Spec.BackupPolicyName {
+		return false
+	}
+	if len(verification.Spec.BackupMethod) > 0 && backup.Spec.BackupMethod != verification.Spec.BackupMethod {
+		return false
+	}
+	// the continuous backups are restored to a point in time, together with a full backup.
+	if backup.Labels[dptypes.BackupTypeLabelKey] == string(dpv1alpha1.BackupTypeContinuous) {
+		return false
+	}
+	// the scratch cluster is built from the cluster snapshot, and the database is reached through the target.
+	return len(backup.Annotations[constant.ClusterSnapshotAnnotationKey]) > 0 && verificationTarget(backup) != nil
+}
+
+// verificationTarget returns the backup target whose data is verified, that is the first one for a sharding backup.
+func verificationTarget(backup *dpv1alpha1.Backup) *dpv1alpha1.BackupStatusTarget {
+	switch {
+	case backup.Status.Target != nil:
+		return backup.Status.Target
+	case len(backup.Status.Targets) > 0:
+		return &backup.Status.Targets[0]
+	default:
+		return nil
+	}
+}
+
+// selectBackupToVerify selects the backup to verify in a run.
+func selectBackupToVerify(backups []*dpv1alpha1.Backup, selection dpv1alpha1.BackupVerificationSelection) *dpv1alpha1.Backup {
+	if len(backups) == 0 {
+		return nil
+	}
+	if selection == dpv1alpha1.BackupVerificationSelectionRandom {
+		return backups[rand.Intn(len(backups))]
+	}
+	latest := backups[0]
+	for _, backup := range backups[1:] {
+		if backup.GetEndTime().After(latest.GetEndTime().Time) {
+			latest = backup
+		}
+	}
+	return latest
+}
+
+// mostRecentVerificationTime returns the latest scheduled time which has not been run yet, the earlier ones are skipped.
+// An error is returned if too many scheduled times are missed, e.g. the controller is down for long.
+func mostRecentVerificationTime(verification *dpv1alpha1.BackupVerification, sched *dputils.CronSchedule, now time.Time) (*time.Time, error) {
+	earliest := verification.CreationTimestamp.Time
+	if verification.Status.LastScheduleTime != nil {
+		earliest = verification.Status.LastScheduleTime.Time
+	}
+	return dputils.MostRecentScheduleTime(sched, earliest, now)
+}
+
+// verificationNamespaceName generates the name of the throw-away namespace of the run scheduled at the time.
+func verificationNamespaceName(verification *dpv1alpha1.BackupVerification, scheduledTime time.Time) string {
+	suffix := "-verify-" + strconv.FormatInt(scheduledTime.Unix()/60, 36)
+	prefix := strings.ReplaceAll(verification.Name, ".", "-")
+	if maxLen := 63 - len(suffix); len(prefix) > maxLen {
+		prefix = strings.TrimRight(prefix[:maxLen], "-")
+	}
+	return prefix + suffix
+}
+
+func verificationLabels(verification *dpv1alpha1.BackupVerification) map[string]string {
+	return map[string]string{
+		dptypes.BackupVerificationLabelKey:          verification.Name,
+		dptypes.BackupVerificationNamespaceLabelKey: verification.Namespace,
+	}
+}
+
+// buildVerificationCluster builds the scratch cluster in the throw-away namespace from the cluster snapshot of
+// the backup, which restores the data from the backup. The cluster keeps the name of the original cluster,
+// so that the connection credential of the backup target refers to the secrets of the scratch cluster.
+func buildVerificationCluster(verification *dpv1alpha1.BackupVerification, backup *dpv1alpha1.Backup) (*appsv1.Cluster, error) {
+	snapshot := &appsv1.Cluster{}
+	if err := json.Unmarshal([]byte(backup.Annotations[constant.ClusterSnapshotAnnotationKey]), snapshot); err != nil {
+		return nil, fmt.Errorf("invalid cluster snapshot of backup %s: %s", backup.Name, err.Error())
+	}
+	cluster := &appsv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        snapshot.Name,
+			Namespace:   verification.Status.CurrentRun.Namespace,
+			Labels:      verificationLabels(verification),
+			Annotations: snapshot.Annotations,
+		},
+		Spec: snapshot.Spec,
+	}
+	cluster.Spec.TerminationPolicy = appsv1.WipeOut
+	cluster.Spec.Backup = nil
+	cluster.Spec.Standby = nil
+	cluster.Spec.Fenced = false
+	cluster.Spec.Restore = &appsv1.ClusterRestore{
+		Source: appsv1.ClusterRestoreSource{
+			APIGroup:  dptypes.DataprotectionAPIGroup,
+			Kind:      dptypes.BackupKind,
+			Name:      backup.Name,
+			Namespace: backup.Namespace,
+		},
+	}
+	// the scratch cluster is only reached inside the cluster.
+	var services []appsv1.ClusterService
+	for _, svc := range cluster.Spec.Services {
+		if svc.Spec.Type == corev1.ServiceTypeLoadBalancer || svc.Spec.Type == corev1.ServiceTypeNodePort {
+			continue
+		}
+		services = append(services, svc)
+	}
+	cluster.Spec.Services = services
+	for i := range cluster.Spec.ComponentSpecs {
+		cluster.Spec.ComponentSpecs[i].OfflineInstances = nil
+		cluster.Spec.ComponentSpecs[i].TLS = false
+		cluster.Spec.ComponentSpecs[i].Issuer = nil
+	}
+	return cluster, nil
+}
+
+// buildVerificationJob builds the Job that runs the verification action against the database of the backup target
+// in the scratch cluster, it returns nil if no target pod is ready yet.
+func (r *BackupVerificationReconciler) buildVerificationJob(ctx context.Context,
+	verification *dpv1alpha1.BackupVerification, backup *dpv1alpha1.Backup) (*batchv1.Job, error) {
+	namespace := verification.Status.CurrentRun.Namespace
+	target := verificationTarget(backup)
+	if target == nil || target.PodSelector == nil || target.PodSelector.LabelSelector == nil {
+		return nil, intctrlutil.NewFatalError(fmt.Sprintf("the target of backup %s is not found", backup.Name))
+	}
+	selector, err := metav1.LabelSelectorAsSelector(target.PodSelector.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	podList := &corev1.PodList{}
+	if err = r.Client.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	var pod *corev1.Pod
+	for i := range podList.Items {
+		if intctrlutil.IsPodReady(&podList.Items[i]) {
+			pod = &podList.Items[i]
+			break
+		}
+	}
+	if pod == nil {
+		return nil, nil
+	}
+	targetEnv, err := dputils.BuildEnvByTarget(pod, target.ConnectionCredential, target.ContainerPort)
+	if err != nil {
+		return nil, err
+	}
+
+	action := verification.Spec.Action
+	env := append([]corev1.EnvVar{
+		{Name: dptypes.DPBackupName, Value: backup.Name},
+		{Name: dptypes.DPBackupNamespace, Value: backup.Namespace},
+	}, targetEnv...)
+	container := corev1.Container{
+		Name:            verificationContainerName,
+		Image:           action.Image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         action.Command,
+		Env:             append(env, action.Env...),
+		Resources:       *action.Resources.DeepCopy(),
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      verification.Name,
+			Namespace: namespace,
+			Labels:    verificationLabels(verification),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](0),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: verificationLabels(verification),
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers:    []corev1.Container{container},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,368 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
+)
+
+func newVerificationTestBackup(name string, phase dpv1alpha1.BackupPhase, completion time.Time) *dpv1alpha1.Backup {
+	snapshot := &appsv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "mycluster"},
+		Spec: appsv1.ClusterSpec{
+			TerminationPolicy: appsv1.Delete,
+			ComponentSpecs:    []appsv1.ClusterComponentSpec{{Name: "mysql", Replicas: 2, TLS: true}},
+			Services: []appsv1.ClusterService{
+				{Service: appsv1.Service{Name: "internet", Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}}},
+				{Service: appsv1.Service{Name: "internal", Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP}}},
+			},
+			Backup: &appsv1.ClusterBackup{Enabled: boolptr.True()},
+		},
+	}
+	data, _ := json.Marshal(snapshot)
+	return &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        name,
+			Annotations: map[string]string{constant.ClusterSnapshotAnnotationKey: string(data)},
+		},
+		Spec: dpv1alpha1.BackupSpec{
+			BackupPolicyName: "policy",
+			BackupMethod:     "mysqldump",
+		},
+		Status: dpv1alpha1.BackupStatus{
+			Phase:               phase,
+			CompletionTimestamp: &metav1.Time{Time: completion},
+			// a logical backup without target volumes
+			BackupMethod: &dpv1alpha1.BackupMethod{Name: "mysqldump"},
+			Target: &dpv1alpha1.BackupStatusTarget{
+				BackupTarget: dpv1alpha1.BackupTarget{
+					PodSelector: &dpv1alpha1.PodSelector{
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: constant.GetCompLabels("mycluster", "mysql"),
+						},
+					},
+					ConnectionCredential: &dpv1alpha1.ConnectionCredential{
+						SecretName:  "mycluster-mysql-account-root",
+						UsernameKey: "username",
+						PasswordKey: "password",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestBackupVerificationRun(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{corev1.AddToScheme, batchv1.AddToScheme, appsv1.AddToScheme, dpv1alpha1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("add scheme: %v", err)
+		}
+	}
+
+	now := time.Now()
+	verification := &dpv1alpha1.BackupVerification{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "verify",
+			CreationTimestamp: metav1.Time{Time: now.Add(-2 * time.Minute)},
+		},
+		Spec: dpv1alpha1.BackupVerificationSpec{
+			BackupPolicyName: "policy",
+			Schedule:         "* * * * *",
+			Action: dpv1alpha1.BackupVerificationAction{
+				Image:   "mysql",
+				Command: []string{"mysqlcheck"},
+			},
+			TimeoutSeconds: 3600,
+		},
+	}
+	continuous := newVerificationTestBackup("continuous", dpv1alpha1.BackupPhaseCompleted, now)
+	continuous.Labels = map[string]string{dptypes.BackupTypeLabelKey: string(dpv1alpha1.BackupTypeContinuous)}
+	noSnapshot := newVerificationTestBackup("no-snapshot", dpv1alpha1.BackupPhaseCompleted, now)
+	noSnapshot.Annotations = nil
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.BackupVerification{}, &dpv1alpha1.Backup{}, &appsv1.Cluster{}, &batchv1.Job{}).
+		WithObjects(verification,
+			newVerificationTestBackup("old", dpv1alpha1.BackupPhaseCompleted, now.Add(-time.Hour)),
+			newVerificationTestBackup("latest", dpv1alpha1.BackupPhaseCompleted, now.Add(-time.Minute)),
+			newVerificationTestBackup("failed", dpv1alpha1.BackupPhaseFailed, now),
+			continuous, noSnapshot).
+		Build()
+	r := &BackupVerificationReconciler{Client: cli, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+
+	ctx := context.Background()
+	key := client.ObjectKeyFromObject(verification)
+	reconcileAndGet := func() *dpv1alpha1.BackupVerification {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("reconcile: %v", err)
+		}
+		obj := &dpv1alpha1.BackupVerification{}
+		if err := cli.Get(ctx, key, obj); err != nil {
+			t.Fatalf("get backup verification: %v", err)
+		}
+		return obj
+	}
+
+	// start a run to verify the latest backup
+	obj := reconcileAndGet()
+	run := obj.Status.CurrentRun
+	if run == nil || run.BackupName != "latest" || run.Phase != dpv1alpha1.BackupVerificationRunPhaseRestoring {
+		t.Fatalf("unexpected run: %+v", run)
+	}
+	if err := cli.Get(ctx, client.ObjectKey{Name: run.Namespace}, &corev1.Namespace{}); err != nil {
+		t.Fatalf("get namespace: %v", err)
+	}
+
+	// restore the backup into a scratch cluster in the throw-away namespace
+	obj = reconcileAndGet()
+	cluster := &appsv1.Cluster{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: run.Namespace, Name: "mycluster"}, cluster); err != nil {
+		t.Fatalf("get cluster: %v", err)
+	}
+	if source := cluster.Spec.Restore.Source; source.Name != "latest" || source.Namespace != "default" || source.Kind != dptypes.BackupKind {
+		t.Fatalf("unexpected restore source: %+v", source)
+	}
+	if cluster.Spec.TerminationPolicy != appsv1.WipeOut || cluster.Spec.Backup != nil ||
+		len(cluster.Spec.Services) != 1 || cluster.Spec.ComponentSpecs[0].TLS {
+		t.Fatalf("unexpected scratch cluster: %+v", cluster.Spec)
+	}
+	cluster.Status.Phase = appsv1.RunningClusterPhase
+	cluster.Status.Conditions = []metav1.Condition{{
+		Type:               appsv1.ConditionTypeRestore,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Completed",
+		LastTransitionTime: metav1.Now(),
+	}}
+	if err := cli.Status().Update(ctx, cluster); err != nil {
+		t.Fatalf("update cluster: %v", err)
+	}
+	if obj = reconcileAndGet(); obj.Status.CurrentRun.Phase != dpv1alpha1.BackupVerificationRunPhaseVerifying {
+		t.Fatalf("expect verifying, got %s", obj.Status.CurrentRun.Phase)
+	}
+
+	// wait for the database to be ready
+	reconcileAndGet()
+	job := &batchv1.Job{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: run.Namespace, Name: "verify"}, job); err == nil {
+		t.Fatal("the verification job should not be created before the database is ready")
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: run.Namespace,
+			Name:      "mycluster-mysql-0",
+			Labels:    constant.GetCompLabels("mycluster", "mysql"),
+		},
+		Spec: corev1.PodSpec{
+			Subdomain:  "mycluster-mysql-headless",
+			Containers: []corev1.Container{{Name: "mysql", Ports: []corev1.ContainerPort{{ContainerPort: 3306}}}},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	if err := cli.Create(ctx, pod); err != nil {
+		t.Fatalf("create pod: %v", err)
+	}
+
+	// run the verification action against the restored database
+	reconcileAndGet()
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: run.Namespace, Name: "verify"}, job); err != nil {
+		t.Fatalf("get job: %v", err)
+	}
+	env := map[string]corev1.EnvVar{}
+	for _, e := range job.Spec.Template.Spec.Containers[0].Env {
+		env[e.Name] = e
+	}
+	if env[dptypes.DPDBPort].Value != "3306" || env[dptypes.DPDBHost].Value == "" ||
+		env[dptypes.DPDBUser].ValueFrom.SecretKeyRef.Name != "mycluster-mysql-account-root" {
+		t.Fatalf("unexpected env of the verification job: %+v", env)
+	}
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	if err := cli.Status().Update(ctx, job); err != nil {
+		t.Fatalf("update job: %v", err)
+	}
+	obj = reconcileAndGet()
+	if obj.Status.CurrentRun != nil || obj.Status.LastRun.Phase != dpv1alpha1.BackupVerificationRunPhaseVerified ||
+		obj.Status.LastVerifiedBackup != "latest" {
+		t.Fatalf("unexpected status: %+v", obj.Status)
+	}
+	if err := cli.Get(ctx, client.ObjectKey{Name: run.Namespace}, &corev1.Namespace{}); err == nil {
+		t.Fatalf("the namespace %s should be deleted", run.Namespace)
+	}
+	backup := &dpv1alpha1.Backup{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: "default", Name: "latest"}, backup); err != nil {
+		t.Fatalf("get backup: %v", err)
+	}
+	if backup.Status.Verification == nil || backup.Status.Verification.Phase != dpv1alpha1.BackupVerificationRunPhaseVerified {
+		t.Fatalf("unexpected verification result: %+v", backup.Status.Verification)
+	}
+
+	// the last verified backup is retained by GC
+	gc := &GCReconciler{Client: cli}
+	if retained, err := gc.isLastVerifiedBackup(ctx, backup); err != nil || !retained {
+		t.Fatalf("expect the last verified backup to be retained, got %v, %v", retained, err)
+	}
+	obj.Spec.RetainLastVerifiedBackup = new(bool)
+	if err := cli.Update(ctx, obj); err != nil {
+		t.Fatalf("update backup verification: %v", err)
+	}
+	if retained, err := gc.isLastVerifiedBackup(ctx, backup); err != nil || retained {
+		t.Fatalf("expect the backup not to be retained, got %v, %v", retained, err)
+	}
+}
+
+func TestBackupVerificationTimeout(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{corev1.AddToScheme, batchv1.AddToScheme, appsv1.AddToScheme, dpv1alpha1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("add scheme: %v", err)
+		}
+	}
+	verification := &dpv1alpha1.BackupVerification{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "verify",
+			Finalizers: []string{dptypes.DataProtectionFinalizerName},
+		},
+		Spec: dpv1alpha1.BackupVerificationSpec{
+			BackupPolicyName: "policy",
+			Schedule:         "0 0 * * *",
+			TimeoutSeconds:   60,
+		},
+		Status: dpv1alpha1.BackupVerificationStatus{
+			CurrentRun: &dpv1alpha1.BackupVerificationRun{
+				BackupName:     "backup",
+				Namespace:      "verify-ns",
+				Phase:          dpv1alpha1.BackupVerificationRunPhaseRestoring,
+				StartTimestamp: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			},
+			LastVerifiedBackup: "verified",
+		},
+	}
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.BackupVerification{}, &dpv1alpha1.Backup{}, &appsv1.Cluster{}, &batchv1.Job{}).
+		WithObjects(verification, newVerificationTestBackup("backup", dpv1alpha1.BackupPhaseCompleted, time.Now())).
+		Build()
+	r := &BackupVerificationReconciler{Client: cli, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+
+	ctx := context.Background()
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(verification)}); err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	obj := &dpv1alpha1.BackupVerification{}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(verification), obj); err != nil {
+		t.Fatalf("get backup verification: %v", err)
+	}
+	if obj.Status.CurrentRun != nil || obj.Status.LastRun.Phase != dpv1alpha1.BackupVerificationRunPhaseFailed ||
+		obj.Status.LastVerifiedBackup != "verified" {
+		t.Fatalf("unexpected status: %+v", obj.Status)
+	}
+	backup := &dpv1alpha1.Backup{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: "default", Name: "backup"}, backup); err != nil {
+		t.Fatalf("get backup: %v", err)
+	}
+	if backup.Status.Verification == nil || backup.Status.Verification.Phase != dpv1alpha1.BackupVerificationRunPhaseFailed {
+		t.Fatalf("unexpected verification result: %+v", backup.Status.Verification)
+	}
+}
+
+func TestVerificationNamespaceName(t *testing.T) {
+	verification := &dpv1alpha1.BackupVerification{
+		ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a.b-", 20)},
+	}
+	name := verificationNamespaceName(verification, time.Now())
+	if len(name) > 63 || strings.Contains(name, ".") || strings.Contains(name, "--") {
+		t.Fatalf("invalid namespace name %q", name)
+	}
+}
+
+func TestBackupVerificationTooManyMissedTimes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{corev1.AddToScheme, batchv1.AddToScheme, appsv1.AddToScheme, dpv1alpha1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("add scheme: %v", err)
+		}
+	}
+	now := time.Now()
+	verification := &dpv1alpha1.BackupVerification{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "verify",
+			Finalizers:        []string{dptypes.DataProtectionFinalizerName},
+			CreationTimestamp: metav1.Time{Time: now.Add(-3 * time.Hour)},
+		},
+		Spec: dpv1alpha1.BackupVerificationSpec{
+			BackupPolicyName: "policy",
+			Schedule:         "* * * * *",
+			TimeoutSeconds:   60,
+		},
+	}
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.BackupVerification{}).
+		WithObjects(verification).
+		Build()
+	recorder := record.NewFakeRecorder(100)
+	r := &BackupVerificationReconciler{Client: cli, Scheme: scheme, Recorder: recorder}
+
+	ctx := context.Background()
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(verification)}); err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	obj := &dpv1alpha1.BackupVerification{}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(verification), obj); err != nil {
+		t.Fatalf("get backup verification: %v", err)
+	}
+	// the missed times are skipped without starting a run
+	if obj.Status.CurrentRun != nil || obj.Status.LastScheduleTime == nil || obj.Status.LastScheduleTime.Time.Before(now.Add(-time.Minute)) {
+		t.Fatalf("unexpected status: %+v", obj.Status)
+	}
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, "too many missed start times") {
+			t.Fatalf("unexpected event: %s", event)
+		}
+	default:
+		t.Fatalf("expect the TooManyMissedTimes event")
+	}
+}
@@ -21,7 +21,12 @@ package dataprotection
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -67,7 +72,7 @@ func (r *ClusterBackupReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return intctrlutil.Reconciled()
 	}
 
-	backups, err := r.listCandidateBackups(reqCtx.Ctx, cluster)
+	backups, heldBackups, err := r.listCandidateBackups(reqCtx.Ctx, cluster)
 	if err != nil {
 		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "failed to list candidate backups")
 	}
@@ -80,6 +85,12 @@ func (r *ClusterBackupReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return intctrlutil.RequeueAfter(reconcileInterval, reqCtx.Log, "waiting for cluster backup cleanup")
 	}
 
+	if len(heldBackups) > 0 {
+		// the backups under legal hold or locked are kept after the cluster is deleted.
+		msg := fmt.Sprintf("skip deleting the locked backups: %s", strings.Join(heldBackups, ", "))
+		reqCtx.Log.Info(msg)
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, "SkipLockedBackups", msg)
+	}
 	return r.removeClusterFinalizer(reqCtx, cluster)
 }
 
@@ -124,17 +135,25 @@ func (r *ClusterBackupReconciler) removeClusterFinalizer(reqCtx intctrlutil.Requ
 	return intctrlutil.Reconciled()
 }
 
-func (r *ClusterBackupReconciler) listCandidateBackups(ctx context.Context, cluster *appsv1.Cluster) ([]*dpv1alpha1.Backup, error) {
-	backups, err := r.listRelatedBackups(ctx, cluster)
+// listCandidateBackups lists the backups to be deleted with the cluster, and the names
+// of the backups which are skipped because they are locked.
+func (r *ClusterBackupReconciler) listCandidateBackups(ctx context.Context, cluster *appsv1.Cluster) ([]*dpv1alpha1.Backup, []string, error) {
+	backups, err := listClusterRelatedBackups(ctx, r.Client, cluster)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	now := time.Now()
 	candidates := make([]*dpv1alpha1.Backup, 0, len(backups))
+	var heldBackups []string
 	for _, backup := range backups {
 		if backup.Spec.DeletionPolicy == dpv1alpha1.BackupDeletionPolicyRetain {
 			continue
 		}
+		if backup.IsLocked(now) {
+			heldBackups = append(heldBackups, backup.Name)
+			continue
+		}
 		if cluster.Spec.TerminationPolicy == appsv1.WipeOut {
 			candidates = append(candidates, backup)
 			continue
@@ -152,13 +171,15 @@ func (r *ClusterBackupReconciler) listCandidateBackups(ctx context.Context, clus
 		}
 		candidates = append(candidates, backup)
 	}
-	return candidates, nil
+	sort.Strings(heldBackups)
+	return candidates, heldBackups, nil
 }
 
-func (r *ClusterBackupReconciler) listRelatedBackups(ctx context.Context, cluster *appsv1.Cluster) ([]*dpv1alpha1.Backup, error) {
+// listClusterRelatedBackups lists the backups of the cluster, including the ones without the cluster UID label.
+func listClusterRelatedBackups(ctx context.Context, cli client.Client, cluster *appsv1.Cluster) ([]*dpv1alpha1.Backup, error) {
 	listByLabels := func(labels map[string]string) ([]dpv1alpha1.Backup, error) {
 		backupList := &dpv1alpha1.BackupList{}
-		if err := r.Client.List(ctx, backupList, client.InNamespace(cluster.Namespace), client.MatchingLabels(labels)); err != nil {
+		if err := cli.List(ctx, backupList, client.InNamespace(cluster.Namespace), client.MatchingLabels(labels)); err != nil {
 			return nil, err
 		}
 		return backupList.Items, nil
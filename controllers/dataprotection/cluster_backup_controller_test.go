@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -149,6 +150,26 @@ var _ = Describe("Cluster Backup Controller", func() {
 		clusterEventuallyDeleted(client.ObjectKeyFromObject(cluster))
 	})
 
+	It("skips locked backups during wipeout", func() {
+		cluster := newCluster("cluster-wipeout-locked", appsv1.WipeOut)
+		unlocked := newBackup(cluster, "backup-unlocked", dpv1alpha1.BackupPhaseCompleted, string(dpv1alpha1.BackupTypeFull), dpv1alpha1.BackupDeletionPolicyDelete, true)
+		held := newBackup(cluster, "backup-held", dpv1alpha1.BackupPhaseCompleted, string(dpv1alpha1.BackupTypeFull), dpv1alpha1.BackupDeletionPolicyDelete, true)
+		locked := newBackup(cluster, "backup-locked", dpv1alpha1.BackupPhaseCompleted, string(dpv1alpha1.BackupTypeFull), dpv1alpha1.BackupDeletionPolicyDelete, true)
+		Expect(testapps.GetAndChangeObj(&testCtx, client.ObjectKeyFromObject(held), func(backup *dpv1alpha1.Backup) {
+			backup.Spec.LegalHold = true
+		})()).Should(Succeed())
+		Expect(testapps.GetAndChangeObj(&testCtx, client.ObjectKeyFromObject(locked), func(backup *dpv1alpha1.Backup) {
+			backup.Spec.LockUntil = &metav1.Time{Time: time.Now().Add(time.Hour)}
+		})()).Should(Succeed())
+
+		Expect(k8sClient.Delete(ctx, cluster)).Should(Succeed())
+
+		backupEventuallyDeleted(client.ObjectKeyFromObject(unlocked))
+		clusterEventuallyDeleted(client.ObjectKeyFromObject(cluster))
+		backupConsistentlyExists(client.ObjectKeyFromObject(held))
+		backupConsistentlyExists(client.ObjectKeyFromObject(locked))
+	})
+
 	It("deletes only failed non-continuous backups for non-wipeout policies", func() {
 		cluster := newCluster("cluster-delete", appsv1.Delete)
 		failed := newBackup(cluster, "backup-failed-delete", dpv1alpha1.BackupPhaseFailed, string(dpv1alpha1.BackupTypeFull), dpv1alpha1.BackupDeletionPolicyDelete, true)
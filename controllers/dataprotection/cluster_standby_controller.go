@@ -0,0 +1,171 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+// standbyPrimaryField is the field index of the standby clusters by their primary clusters.
+const standbyPrimaryField = "spec.standby.primary"
+
+// ClusterStandbyReconciler bootstraps a new standby cluster from the latest completed backup of its primary,
+// by setting the restore source of the standby cluster.
+type ClusterStandbyReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=clusters,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups,verbs=get;list;watch
+
+func (r *ClusterStandbyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqCtx := intctrlutil.RequestCtx{
+		Ctx:      ctx,
+		Req:      req,
+		Log:      log.FromContext(ctx).WithValues("cluster", req.NamespacedName),
+		Recorder: r.Recorder,
+	}
+
+	cluster := &appsv1.Cluster{}
+	if err := r.Client.Get(reqCtx.Ctx, reqCtx.Req.NamespacedName, cluster); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	if !needStandbyBootstrap(cluster) {
+		return intctrlutil.Reconciled()
+	}
+
+	backup, err := r.latestBackupOfPrimary(reqCtx.Ctx, cluster)
+	if err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "failed to find the backup of the primary cluster")
+	}
+	if backup == nil {
+		r.Recorder.Eventf(cluster, corev1.EventTypeWarning, "StandbyBootstrap",
+			"no completed backup of the primary cluster %s is found", cluster.Spec.Standby.PrimaryCluster)
+		return intctrlutil.RequeueAfter(reconcileInterval*30, reqCtx.Log, "waiting for a backup of the primary cluster")
+	}
+
+	patch := client.MergeFrom(cluster.DeepCopy())
+	cluster.Spec.Restore = &appsv1.ClusterRestore{
+		Source: appsv1.ClusterRestoreSource{
+			APIGroup:  dpv1alpha1.GroupVersion.Group,
+			Kind:      dptypes.BackupKind,
+			Name:      backup.Name,
+			Namespace: backup.Namespace,
+		},
+	}
+	if err = r.Client.Patch(reqCtx.Ctx, cluster, patch); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "failed to set the restore source of the standby cluster")
+	}
+	r.Recorder.Eventf(cluster, corev1.EventTypeNormal, "StandbyBootstrap",
+		"bootstrap the standby cluster from backup %s/%s", backup.Namespace, backup.Name)
+	return intctrlutil.Reconciled()
+}
+
+func (r *ClusterStandbyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &appsv1.Cluster{}, standbyPrimaryField, indexStandbyPrimary); err != nil {
+		return err
+	}
+	return intctrlutil.NewControllerManagedBy(mgr).
+		Named("cluster-standby").
+		For(&appsv1.Cluster{}).
+		Watches(&dpv1alpha1.Backup{}, handler.EnqueueRequestsFromMapFunc(r.mapBackupToStandbys)).
+		Complete(r)
+}
+
+// mapBackupToStandbys maps a backup to the standby clusters waiting to be bootstrapped from it.
+func (r *ClusterStandbyReconciler) mapBackupToStandbys(ctx context.Context, obj client.Object) []reconcile.Request {
+	primaryName := obj.GetLabels()[constant.AppInstanceLabelKey]
+	if primaryName == "" {
+		return nil
+	}
+	clusterList := &appsv1.ClusterList{}
+	primaryKey := client.ObjectKey{Namespace: obj.GetNamespace(), Name: primaryName}
+	if err := r.Client.List(ctx, clusterList, client.MatchingFields{standbyPrimaryField: primaryKey.String()}); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range clusterList.Items {
+		if needStandbyBootstrap(&clusterList.Items[i]) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&clusterList.Items[i])})
+		}
+	}
+	return requests
+}
+
+// indexStandbyPrimary indexes the standby clusters by their primary clusters, in the format of "namespace/name".
+func indexStandbyPrimary(obj client.Object) []string {
+	cluster, ok := obj.(*appsv1.Cluster)
+	if !ok || cluster.Spec.Standby == nil {
+		return nil
+	}
+	return []string{component.StandbyPrimaryKey(cluster).String()}
+}
+
+// latestBackupOfPrimary returns the latest completed non-continuous backup of the primary cluster.
+func (r *ClusterStandbyReconciler) latestBackupOfPrimary(ctx context.Context, cluster *appsv1.Cluster) (*dpv1alpha1.Backup, error) {
+	primary := &appsv1.Cluster{}
+	primaryKey := component.StandbyPrimaryKey(cluster)
+	if err := r.Client.Get(ctx, primaryKey, primary); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		// the backups are kept after the primary cluster is deleted
+		primary.Namespace, primary.Name = primaryKey.Namespace, primaryKey.Name
+	}
+	backups, err := listClusterRelatedBackups(ctx, r.Client, primary)
+	if err != nil {
+		return nil, err
+	}
+	var latest *dpv1alpha1.Backup
+	for _, backup := range backups {
+		if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted || backup.Status.CompletionTimestamp == nil {
+			continue
+		}
+		if backup.Labels[dptypes.BackupTypeLabelKey] == string(dpv1alpha1.BackupTypeContinuous) {
+			continue
+		}
+		if latest == nil || backup.Status.CompletionTimestamp.After(latest.Status.CompletionTimestamp.Time) {
+			latest = backup
+		}
+	}
+	return latest, nil
+}
+
+// needStandbyBootstrap checks whether the cluster is a standby cluster waiting for the restore source.
+func needStandbyBootstrap(cluster *appsv1.Cluster) bool {
+	return cluster.GetDeletionTimestamp().IsZero() && component.StandbyBootstrapPending(cluster)
+}
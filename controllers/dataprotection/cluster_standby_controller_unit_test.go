@@ -0,0 +1,90 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+func TestMapBackupToStandbys(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add apps scheme: %v", err)
+	}
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add dataprotection scheme: %v", err)
+	}
+
+	newStandby := func(namespace, name, primaryNamespace string) *appsv1.Cluster {
+		return &appsv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: appsv1.ClusterSpec{
+				Standby: &appsv1.ClusterStandby{PrimaryCluster: "primary", Namespace: primaryNamespace},
+			},
+		}
+	}
+	pending := newStandby("dr", "pending", "default")
+	sameNamespace := newStandby("default", "same-namespace", "")
+	otherPrimary := newStandby("dr", "other-primary", "other")
+	bootstrapped := newStandby("dr", "bootstrapped", "default")
+	bootstrapped.Annotations = map[string]string{constant.StandbyBootstrappedAnnotationKey: "true"}
+	restored := newStandby("dr", "restored", "default")
+	restored.Spec.Restore = &appsv1.ClusterRestore{}
+	primary := &appsv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "primary"}}
+
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithIndex(&appsv1.Cluster{}, standbyPrimaryField, indexStandbyPrimary).
+		WithObjects(primary, pending, sameNamespace, otherPrimary, bootstrapped, restored).
+		Build()
+	r := &ClusterStandbyReconciler{Client: cli}
+
+	backup := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "backup",
+			Labels:    map[string]string{constant.AppInstanceLabelKey: "primary"},
+		},
+	}
+	requests := r.mapBackupToStandbys(context.Background(), backup)
+	expected := map[string]bool{"dr/pending": true, "default/same-namespace": true}
+	if len(requests) != len(expected) {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+	for _, req := range requests {
+		if !expected[req.String()] {
+			t.Fatalf("unexpected request: %s", req.String())
+		}
+	}
+
+	backup.Labels = nil
+	if requests = r.mapBackupToStandbys(context.Background(), backup); requests != nil {
+		t.Fatalf("expected no requests, got %v", requests)
+	}
+}
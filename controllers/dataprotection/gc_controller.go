@@ -22,6 +22,7 @@ package dataprotection
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sort"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dpbackup "github.com/apecloud/kubeblocks/pkg/dataprotection/backup"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
@@ -71,7 +73,9 @@ func (r *GCReconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 // +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups,verbs=get;list;watch;delete
-// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups/status,verbs=get
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backupschedules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backupverifications,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // delete expired backups.
@@ -102,6 +106,14 @@ func (r *GCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		"phase", backup.Status.Phase, "expiration", backup.Status.Expiration)
 	reqCtx.Log = reqCtx.Log.WithValues("expiration", backup.Status.Expiration)
 
+	// the completed backups created by a schedule with tiered retention policy are
+	// retained by the policy instead of the expiration.
+	if retained, err := r.reconcileTieredRetention(reqCtx, backup); err != nil {
+		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+	} else if retained {
+		return intctrlutil.Reconciled()
+	}
+
 	now := r.clock.Now()
 	if backup.Status.Expiration == nil || backup.Status.Expiration.After(now) {
 		reqCtx.Log.V(1).Info("backup is not expired yet, skipping")
@@ -134,7 +146,12 @@ func getGCFrequency() time.Duration {
 }
 
 // isBackupDeletable returns true if the backup can be deleted.
+// The locked backups are never deletable, even if the deletion webhook is not enabled.
 func (r *GCReconciler) isBackupDeletable(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup) (bool, error) {
+	if backup.IsLocked(r.clock.Now()) {
+		reqCtx.Log.V(1).Info(fmt.Sprintf("%s, skipping", backupLockMessage(backup)))
+		return false, nil
+	}
 	if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
 		return backup.Status.Phase == dpv1alpha1.BackupPhaseFailed, nil
 	}
@@ -172,6 +189,16 @@ func (r *GCReconciler) isBackupDeletable(reqCtx intctrlutil.RequestCtx, backup *
 			backup.Namespace, backup.Name))
 		return false, nil
 	}
+	isLastVerified, err := r.isLastVerifiedBackup(reqCtx.Ctx, backup)
+	if err != nil {
+		return true, err
+	}
+	if isLastVerified {
+		reqCtx.Log.V(1).Info(fmt.Sprintf(
+			"backup %s/%s is the last verified backup of backup verification %s and will be retained, skipping",
+			backup.Namespace, backup.Name, backup.Status.Verification.VerificationName))
+		return false, nil
+	}
 	if backupPolicy.Spec.RetentionPolicy == dpv1alpha1.BackupPolicyRetentionPolicyRetainLatestBackup {
 		isLatest, err := r.isLatestCompletedBackup(reqCtx.Ctx, backup)
 		if err != nil {
@@ -187,6 +214,86 @@ func (r *GCReconciler) isBackupDeletable(reqCtx intctrlutil.RequestCtx, backup *
 	return true, nil
 }
 
+// reconcileTieredRetention evaluates the tiered retention policy of the backup schedule for the backup,
+// records the result in the backup status, and deletes the backup if it is expired by the policy,
+// unless it is locked or its expiration has not passed yet.
+// It returns true if the backup is handled by the tiered retention policy.
+func (r *GCReconciler) reconcileTieredRetention(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup) (bool, error) {
+	scheduleName := backup.Labels[dptypes.BackupScheduleLabelKey]
+	if len(scheduleName) == 0 || backup.Labels[dptypes.BackupTypeLabelKey] == string(dpv1alpha1.BackupTypeContinuous) {
+		return false, nil
+	}
+	backupSchedule := &dpv1alpha1.BackupSchedule{}
+	if err := r.Get(reqCtx.Ctx, client.ObjectKey{Namespace: backup.Namespace, Name: scheduleName}, backupSchedule); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	var retention *dpv1alpha1.BackupRetentionStatus
+	if backupSchedule.Spec.TieredRetention != nil && backup.Status.Phase == dpv1alpha1.BackupPhaseCompleted {
+		backupList := &dpv1alpha1.BackupList{}
+		if err := r.List(reqCtx.Ctx, backupList, client.InNamespace(backup.Namespace),
+			client.MatchingLabels{dptypes.BackupScheduleLabelKey: scheduleName}); err != nil {
+			return false, err
+		}
+		var backups []*dpv1alpha1.Backup
+		for i := range backupList.Items {
+			if backupList.Items[i].DeletionTimestamp.IsZero() {
+				backups = append(backups, &backupList.Items[i])
+			}
+		}
+		retention = dpbackup.EvaluateTieredRetention(backupSchedule.Spec.TieredRetention, backups)[backup.Name]
+	}
+	if !reflect.DeepEqual(backup.Status.Retention, retention) {
+		patch := client.MergeFrom(backup.DeepCopy())
+		backup.Status.Retention = retention
+		if err := r.Status().Patch(reqCtx.Ctx, backup, patch); err != nil {
+			return false, err
+		}
+	}
+	if retention == nil {
+		return false, nil
+	}
+	if retention.Decision == dpv1alpha1.BackupRetentionDecisionKeep {
+		reqCtx.Log.V(1).Info(fmt.Sprintf("backup %s/%s is kept by the tiered retention policy, reasons: %v",
+			backup.Namespace, backup.Name, retention.Reasons))
+		return true, nil
+	}
+	now := r.clock.Now()
+	if backup.IsLocked(now) {
+		reqCtx.Log.V(1).Info(fmt.Sprintf("%s, skipping", backupLockMessage(backup)))
+		return true, nil
+	}
+	// the expiration of the backup is the minimum retention.
+	if backup.Status.Expiration != nil && backup.Status.Expiration.After(now) {
+		reqCtx.Log.V(1).Info("backup is not expired yet, skipping")
+		return true, nil
+	}
+	isLastVerified, err := r.isLastVerifiedBackup(reqCtx.Ctx, backup)
+	if err != nil || isLastVerified {
+		return true, err
+	}
+	reqCtx.Log.Info("backup is expired by the tiered retention policy, delete it", "backup", client.ObjectKeyFromObject(backup))
+	if err = intctrlutil.BackgroundDeleteObject(r.Client, reqCtx.Ctx, backup); err != nil {
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "RemoveExpiredBackupsFailed", err.Error())
+		return true, err
+	}
+	return true, nil
+}
+
+// isLastVerifiedBackup returns true if the backup is the last verified backup of a backup verification
+// which retains the last verified backup.
+func (r *GCReconciler) isLastVerifiedBackup(ctx context.Context, backup *dpv1alpha1.Backup) (bool, error) {
+	result := backup.Status.Verification
+	if result == nil || result.Phase != dpv1alpha1.BackupVerificationRunPhaseVerified || len(result.VerificationName) == 0 {
+		return false, nil
+	}
+	verification := &dpv1alpha1.BackupVerification{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: result.VerificationName}, verification); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return verification.ShouldRetainLastVerifiedBackup() && verification.Status.LastVerifiedBackup == backup.Name, nil
+}
+
 // isLatestCompletedBackup returns true if the backup is the latest completed backup.
 func (r *GCReconciler) isLatestCompletedBackup(ctx context.Context, backup *dpv1alpha1.Backup) (bool, error) {
 	if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
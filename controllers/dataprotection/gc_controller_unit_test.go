@@ -0,0 +1,193 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+func TestGCTieredRetention(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+
+	now := time.Now()
+	newBackup := func(name string, completion time.Time) *dpv1alpha1.Backup {
+		return &dpv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				Labels:    map[string]string{dptypes.BackupScheduleLabelKey: "schedule"},
+			},
+			Spec: dpv1alpha1.BackupSpec{BackupPolicyName: "policy", BackupMethod: "xtrabackup"},
+			Status: dpv1alpha1.BackupStatus{
+				Phase:               dpv1alpha1.BackupPhaseCompleted,
+				CompletionTimestamp: &metav1.Time{Time: completion},
+				// the backups kept by the tiered retention policy are retained beyond the expiration
+				Expiration: &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+		}
+	}
+	schedule := &dpv1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "schedule"},
+		Spec: dpv1alpha1.BackupScheduleSpec{
+			BackupPolicyName: "policy",
+			TieredRetention:  &dpv1alpha1.TieredRetentionPolicy{Daily: ptr.To[int32](1)},
+		},
+	}
+	latest := newBackup("latest", now)
+	old := newBackup("old", now.Add(-48*time.Hour))
+	notExpired := newBackup("not-expired", now.Add(-72*time.Hour))
+	notExpired.Status.Expiration = &metav1.Time{Time: now.Add(time.Hour)}
+	locked := newBackup("locked", now.Add(-96*time.Hour))
+	locked.Spec.LegalHold = true
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.Backup{}).
+		WithObjects(schedule, latest, old, notExpired, locked).
+		Build()
+	r := &GCReconciler{Client: cli, Recorder: record.NewFakeRecorder(100), clock: clock.RealClock{}}
+
+	ctx := context.Background()
+	for _, backup := range []*dpv1alpha1.Backup{latest, old, notExpired, locked} {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(backup)}); err != nil {
+			t.Fatalf("reconcile %s: %v", backup.Name, err)
+		}
+	}
+
+	obj := &dpv1alpha1.Backup{}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(latest), obj); err != nil {
+		t.Fatalf("get backup: %v", err)
+	}
+	retention := obj.Status.Retention
+	if retention == nil || retention.Decision != dpv1alpha1.BackupRetentionDecisionKeep ||
+		len(retention.Reasons) != 1 || retention.Reasons[0] != dpv1alpha1.BackupRetentionReasonDaily {
+		t.Fatalf("unexpected retention: %+v", retention)
+	}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(old), obj); err == nil {
+		t.Fatalf("backup %s should be deleted, retention: %+v", old.Name, obj.Status.Retention)
+	}
+	for _, backup := range []*dpv1alpha1.Backup{notExpired, locked} {
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(backup), obj); err != nil {
+			t.Fatalf("backup %s should be kept: %v", backup.Name, err)
+		}
+		if obj.Status.Retention == nil || obj.Status.Retention.Decision != dpv1alpha1.BackupRetentionDecisionExpire {
+			t.Fatalf("unexpected retention of backup %s: %+v", backup.Name, obj.Status.Retention)
+		}
+	}
+}
+
+func TestGCSkipLockedBackups(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+
+	now := time.Now()
+	newBackup := func(name string, mutate func(spec *dpv1alpha1.BackupSpec)) *dpv1alpha1.Backup {
+		backup := &dpv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       dpv1alpha1.BackupSpec{BackupPolicyName: "policy", BackupMethod: "xtrabackup"},
+			Status: dpv1alpha1.BackupStatus{
+				Phase:      dpv1alpha1.BackupPhaseFailed,
+				Expiration: &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+		}
+		mutate(&backup.Spec)
+		return backup
+	}
+	held := newBackup("held", func(spec *dpv1alpha1.BackupSpec) { spec.LegalHold = true })
+	locked := newBackup("locked", func(spec *dpv1alpha1.BackupSpec) {
+		spec.LockUntil = &metav1.Time{Time: now.Add(time.Hour)}
+	})
+	lockExpired := newBackup("lock-expired", func(spec *dpv1alpha1.BackupSpec) {
+		spec.LockUntil = &metav1.Time{Time: now.Add(-time.Minute)}
+	})
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&dpv1alpha1.Backup{}).
+		WithObjects(held, locked, lockExpired).
+		Build()
+	r := &GCReconciler{Client: cli, Recorder: record.NewFakeRecorder(100), clock: clock.RealClock{}}
+
+	ctx := context.Background()
+	for _, backup := range []*dpv1alpha1.Backup{held, locked, lockExpired} {
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(backup)}); err != nil {
+			t.Fatalf("reconcile %s: %v", backup.Name, err)
+		}
+	}
+
+	for _, backup := range []*dpv1alpha1.Backup{held, locked} {
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(backup), &dpv1alpha1.Backup{}); err != nil {
+			t.Fatalf("locked backup %s should be kept: %v", backup.Name, err)
+		}
+	}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(lockExpired), &dpv1alpha1.Backup{}); err == nil {
+		t.Fatalf("backup %s should be deleted after the lock expired", lockExpired.Name)
+	}
+}
+
+func TestIsBackupDeletableLocked(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+
+	policy := &dpv1alpha1.BackupPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "policy"}}
+	backup := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "backup",
+			Labels:    map[string]string{dptypes.BackupTypeLabelKey: string(dpv1alpha1.BackupTypeFull)},
+		},
+		Spec:   dpv1alpha1.BackupSpec{BackupPolicyName: policy.Name, BackupMethod: "xtrabackup", LegalHold: true},
+		Status: dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseCompleted},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy, backup).Build()
+	r := &GCReconciler{Client: cli, Recorder: record.NewFakeRecorder(100), clock: clock.RealClock{}}
+	reqCtx := intctrlutil.RequestCtx{Ctx: context.Background(), Log: ctrl.Log}
+
+	deletable, err := r.isBackupDeletable(reqCtx, backup)
+	if err != nil || deletable {
+		t.Fatalf("locked backup should not be deletable, deletable: %v, err: %v", deletable, err)
+	}
+
+	backup.Spec.LegalHold = false
+	deletable, err = r.isBackupDeletable(reqCtx, backup)
+	if err != nil || !deletable {
+		t.Fatalf("unlocked backup should be deletable, deletable: %v, err: %v", deletable, err)
+	}
+}
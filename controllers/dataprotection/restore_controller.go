@@ -45,6 +45,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	dperrors "github.com/apecloud/kubeblocks/pkg/dataprotection/errors"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/kms"
 	dprestore "github.com/apecloud/kubeblocks/pkg/dataprotection/restore"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
@@ -96,6 +97,11 @@ func (r *RestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		if err = r.deleteExternalResources(reqCtx, restore); err != nil {
 			return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
 		}
+	case dpv1alpha1.RestorePhaseFailed:
+		// keep the failed jobs for diagnosis, but do not keep the data keys
+		if err = kms.ReleaseDataKeySecrets(reqCtx.Ctx, r.Client, restore.Namespace, restore); err != nil {
+			return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+		}
 	}
 	return intctrlutil.Reconciled()
 }
@@ -155,7 +161,10 @@ func (r *RestoreReconciler) deleteExternalResources(reqCtx intctrlutil.RequestCt
 		viper.GetString(constant.CfgKeyCtrlrMgrNS): {},
 	}
 
-	return deleteRelatedObjectList(reqCtx, r.Client, &batchv1.JobList{}, namespaces, labels)
+	if err := deleteRelatedObjectList(reqCtx, r.Client, &batchv1.JobList{}, namespaces, labels); err != nil {
+		return err
+	}
+	return kms.ReleaseDataKeySecrets(reqCtx.Ctx, r.Client, restore.Namespace, restore)
 }
 
 func CheckBackupRepoForRestore(reqCtx intctrlutil.RequestCtx, cli client.Client, restore *dpv1alpha1.Restore) (string, error) {
@@ -174,7 +183,11 @@ func CheckBackupRepoForRestore(reqCtx intctrlutil.RequestCtx, cli client.Client,
 	}
 
 	restoreNamespace := restore.Namespace
-	repoName := backup.Status.BackupRepoName
+	// use a copy of the backup if the primary backup repository is unavailable.
+	repoName, err := dprestore.GetBackupRepoNameForRestore(reqCtx.Ctx, cli, backup)
+	if err != nil {
+		return "", err
+	}
 	repo := &dpv1alpha1.BackupRepo{}
 	if err := cli.Get(reqCtx.Ctx, client.ObjectKey{Name: repoName}, repo); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -182,40 +195,7 @@ func CheckBackupRepoForRestore(reqCtx intctrlutil.RequestCtx, cli client.Client,
 		}
 		return repoName, err
 	}
-	if repo.Status.Phase != dpv1alpha1.BackupRepoReady {
-		return repoName, dperrors.NewBackupRepoIsNotReady(repo.Name)
-	}
-	switch {
-	case repo.AccessByMount():
-		pvcName := repo.Status.BackupPVCName
-		if pvcName == "" {
-			return repoName, intctrlutil.NewFatalError(fmt.Sprintf("BackupPVCName is empty in BackupRepo %s", repoName))
-		}
-		pvc := &corev1.PersistentVolumeClaim{}
-		pvcKey := client.ObjectKey{Namespace: restoreNamespace, Name: pvcName}
-		if err := cli.Get(reqCtx.Ctx, pvcKey, pvc); err != nil {
-			if apierrors.IsNotFound(err) {
-				return repoName, intctrlutil.NewErrorf(dperrors.ErrorTypeWaitForBackupRepoPreparation,
-					"backup repo %s is not ready in the namespace %s", repoName, restoreNamespace)
-			}
-			return repoName, err
-		}
-	case repo.AccessByTool():
-		toolConfigSecretName := repo.Status.ToolConfigSecretName
-		if toolConfigSecretName == "" {
-			return repoName, intctrlutil.NewFatalError(fmt.Sprintf("ToolConfigSecretName is empty in BackupRepo %s", repoName))
-		}
-		secret := &corev1.Secret{}
-		secretKey := client.ObjectKey{Namespace: restoreNamespace, Name: toolConfigSecretName}
-		if err := cli.Get(reqCtx.Ctx, secretKey, secret); err != nil {
-			if apierrors.IsNotFound(err) {
-				return repoName, intctrlutil.NewErrorf(dperrors.ErrorTypeWaitForBackupRepoPreparation,
-					"backup repo %s is not ready in the namespace %s", repoName, restoreNamespace)
-			}
-			return repoName, err
-		}
-	}
-	return repoName, nil
+	return repoName, checkBackupRepoInNamespace(reqCtx.Ctx, cli, repo, restoreNamespace)
 }
 
 func (r *RestoreReconciler) newAction(reqCtx intctrlutil.RequestCtx, restore *dpv1alpha1.Restore) (ctrl.Result, error) {
@@ -507,7 +487,11 @@ func (r *RestoreReconciler) handleBackupActionSet(reqCtx intctrlutil.RequestCtx,
 	if len(jobs) == 0 {
 		return true, nil
 	}
-	// 3. create jobs
+	// 3. create jobs, the data key of the backup is provided to the jobs by a secret
+	if err = kms.EnsureDataKeySecret(reqCtx.Ctx, r.Client, backupSet.Backup,
+		restoreMgr.Restore.Namespace, restoreMgr.Restore); err != nil {
+		return false, err
+	}
 	jobs, err = restoreMgr.CreateJobsIfNotExist(reqCtx, r.Client, restoreMgr.Restore, jobs)
 	if err != nil {
 		return false, err
@@ -36,6 +36,10 @@ const (
 	dataProtectionWaitRepoPreparationKey = "dataprotection.kubeblocks.io/wait-repo-preparation"
 	dataProtectionIsToolConfigKey        = "dataprotection.kubeblocks.io/is-tool-config"
 
+	// label keys for the backups replicated to a secondary backup repo
+	dataProtectionReplicaBackupRepoKey          = "dataprotection.kubeblocks.io/replica-backup-repo-name"
+	dataProtectionWaitReplicaRepoPreparationKey = "dataprotection.kubeblocks.io/wait-replica-repo-preparation"
+
 	// annotation keys
 	dataProtectionBackupRepoDigestAnnotationKey     = "dataprotection.kubeblocks.io/backup-repo-digest"
 	dataProtectionNeedUpdateToolConfigAnnotationKey = "dataprotection.kubeblocks.io/need-update-tool-config"
@@ -50,6 +54,7 @@ const (
 	ConditionTypePVCTemplateChecked    = "PVCTemplateChecked"
 	ConditionTypeDerivedObjectsDeleted = "DerivedObjectsDeleted"
 	ConditionTypePreCheckPassed        = "PreCheckPassed"
+	ConditionTypeHealthy               = "Healthy"
 
 	// condition reasons
 	ReasonStorageProviderReady      = "StorageProviderReady"
@@ -57,6 +62,7 @@ const (
 	ReasonStorageProviderNotFound   = "StorageProviderNotFound"
 	ReasonInvalidStorageProvider    = "InvalidStorageProvider"
 	ReasonParametersChecked         = "ParametersChecked"
+	ReasonInvalidObjectLock         = "InvalidObjectLock"
 	ReasonCredentialSecretNotFound  = "CredentialSecretNotFound"
 	ReasonPrepareCSISecretFailed    = "PrepareCSISecretFailed"
 	ReasonPrepareStorageClassFailed = "PrepareStorageClassFailed"
@@ -71,6 +77,8 @@ const (
 	ReasonDigestChanged             = "DigestChanged"
 	ReasonUnknownError              = "UnknownError"
 	ReasonSkipped                   = "Skipped"
+	ReasonProbeSucceeded            = "ProbeSucceeded"
+	ReasonProbeFailed               = "ProbeFailed"
 )
 
 // constant  for volume populator
@@ -96,3 +104,6 @@ const (
 )
 
 var reconcileInterval = time.Second
+
+// backupThrottleRequeueInterval is the interval to check the throttle limits again for the pending backups.
+var backupThrottleRequeueInterval = 30 * time.Second
@@ -27,6 +27,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -48,6 +49,7 @@ import (
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	dpbackup "github.com/apecloud/kubeblocks/pkg/dataprotection/backup"
 	dperrors "github.com/apecloud/kubeblocks/pkg/dataprotection/errors"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/kms"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
@@ -783,6 +785,13 @@ func checkEncryptionConfig(ctx context.Context, config *dpv1alpha1.EncryptionCon
 	if config == nil {
 		return nil
 	}
+	if kms.UseKeyManagement(config) {
+		// building the provider checks the secrets which it refers to
+		if _, err := kms.NewProvider(ctx, cli, namespace, config.KeyManagement); err != nil {
+			return fmt.Errorf("failed to check key management config: %w", err)
+		}
+		return nil
+	}
 	secretKeyRef := config.PassPhraseSecretKeyRef
 	if secretKeyRef == nil {
 		return fmt.Errorf("encryptionConfig.passPhraseSecretKeyRef if empty")
@@ -799,3 +808,67 @@ func checkEncryptionConfig(ctx context.Context, config *dpv1alpha1.EncryptionCon
 func getPopulatePVCName(pvcUID types.UID) string {
 	return fmt.Sprintf("%s-%s", PopulatePodPrefix, pvcUID)
 }
+
+// checkBackupRepoInNamespace checks if the backup repo is ready and can be accessed in the namespace.
+func checkBackupRepoInNamespace(ctx context.Context, cli client.Client, repo *dpv1alpha1.BackupRepo, namespace string) error {
+	if repo.Status.Phase != dpv1alpha1.BackupRepoReady {
+		return dperrors.NewBackupRepoIsNotReady(repo.Name)
+	}
+	var (
+		objKey client.ObjectKey
+		obj    client.Object
+	)
+	switch {
+	case repo.AccessByMount():
+		if repo.Status.BackupPVCName == "" {
+			return intctrlutil.NewFatalError(fmt.Sprintf("BackupPVCName is empty in BackupRepo %s", repo.Name))
+		}
+		objKey = client.ObjectKey{Namespace: namespace, Name: repo.Status.BackupPVCName}
+		obj = &corev1.PersistentVolumeClaim{}
+	case repo.AccessByTool():
+		if repo.Status.ToolConfigSecretName == "" {
+			return intctrlutil.NewFatalError(fmt.Sprintf("ToolConfigSecretName is empty in BackupRepo %s", repo.Name))
+		}
+		objKey = client.ObjectKey{Namespace: namespace, Name: repo.Status.ToolConfigSecretName}
+		obj = &corev1.Secret{}
+	default:
+		return nil
+	}
+	if err := cli.Get(ctx, objKey, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return intctrlutil.NewErrorf(dperrors.ErrorTypeWaitForBackupRepoPreparation,
+				"backup repo %s is not ready in the namespace %s", repo.Name, namespace)
+		}
+		return err
+	}
+	return nil
+}
+
+// listDependentBackups lists the backups whose parent or base backup is the given backup.
+func listDependentBackups(ctx context.Context, cli client.Client, backup *dpv1alpha1.Backup) ([]*dpv1alpha1.Backup, error) {
+	backupList := &dpv1alpha1.BackupList{}
+	labels := map[string]string{
+		dptypes.BackupPolicyLabelKey: backup.Spec.BackupPolicyName,
+	}
+	if err := cli.List(ctx, backupList,
+		client.InNamespace(backup.Namespace), client.MatchingLabels(labels)); client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	var dependents []*dpv1alpha1.Backup
+	for i := range backupList.Items {
+		bp := &backupList.Items[i]
+		if bp.Status.ParentBackupName != backup.Name && bp.Status.BaseBackupName != backup.Name {
+			continue
+		}
+		dependents = append(dependents, bp)
+	}
+	return dependents, nil
+}
+
+// backupLockMessage describes why the backup is locked against deletion.
+func backupLockMessage(backup *dpv1alpha1.Backup) string {
+	if backup.Spec.LegalHold {
+		return fmt.Sprintf("backup %s is under legal hold", backup.Name)
+	}
+	return fmt.Sprintf("backup %s is locked until %s", backup.Name, backup.Spec.LockUntil.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,241 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+// metricValue is the observed value of an autoscaler metric.
+type metricValue struct {
+	metric experimental.AutoscalerMetric
+	// the average value across the replicas, in percentage of the requests for resource metrics.
+	average float64
+	// the target average value.
+	target float64
+	// the number of replicas reporting the metric.
+	replicas int32
+}
+
+func (v metricValue) name() string {
+	if v.metric.Resource != nil {
+		return string(v.metric.Resource.Name)
+	}
+	if v.metric.Probe != nil {
+		return v.metric.Probe.Name
+	}
+	return ""
+}
+
+func (v metricValue) ratio() float64 {
+	return v.average / v.target
+}
+
+// metricsSource provides the values of the autoscaler metrics with a specific source.
+type metricsSource interface {
+	fetch(ctx context.Context, reader client.Reader, scaler *experimental.ComponentAutoscaler,
+		metric experimental.AutoscalerMetric, pods []corev1.Pod) (*metricValue, error)
+}
+
+// metricsSources holds the supported metrics sources, a new source is plugged in by registering it here.
+var metricsSources = map[experimental.MetricSourceType]metricsSource{
+	experimental.ResourceMetricSource: &resourceMetricsSource{},
+	experimental.ProbeMetricSource:    &probeMetricsSource{},
+}
+
+func fetchMetric(ctx context.Context, reader client.Reader, scaler *experimental.ComponentAutoscaler,
+	metric experimental.AutoscalerMetric, pods []corev1.Pod) (*metricValue, error) {
+	source, ok := metricsSources[metric.Source]
+	if !ok {
+		return nil, fmt.Errorf("unsupported metric source: %s", metric.Source)
+	}
+	return source.fetch(ctx, reader, scaler, metric, pods)
+}
+
+// resourceMetricsSource reads the resource usage of the pods from metrics.k8s.io.
+type resourceMetricsSource struct{}
+
+func (s *resourceMetricsSource) fetch(ctx context.Context, reader client.Reader, scaler *experimental.ComponentAutoscaler,
+	metric experimental.AutoscalerMetric, pods []corev1.Pod) (*metricValue, error) {
+	if metric.Resource == nil {
+		return nil, fmt.Errorf("the resource metric is not specified")
+	}
+	podMetricsList := &metricsv1beta1.PodMetricsList{}
+	if err := reader.List(ctx, podMetricsList, client.InNamespace(scaler.Namespace),
+		client.MatchingLabels(constant.GetCompLabels(scaler.Spec.TargetClusterName, scaler.Spec.TargetComponentName))); err != nil {
+		return nil, err
+	}
+	return resourceUtilization(*metric.Resource, pods, podMetricsList.Items)
+}
+
+// resourceUtilization calculates the utilization of the resource, in percentage of the requests of the pods.
+func resourceUtilization(metric experimental.ResourceMetric, pods []corev1.Pod, podMetrics []metricsv1beta1.PodMetrics) (*metricValue, error) {
+	usages := map[string]corev1.ResourceList{}
+	for _, m := range podMetrics {
+		containers := corev1.ResourceList{}
+		for _, c := range m.Containers {
+			if q, ok := c.Usage[metric.Name]; ok {
+				containers[corev1.ResourceName(c.Name)] = q
+			}
+		}
+		usages[m.Name] = containers
+	}
+
+	var (
+		usage, request float64
+		replicas       int32
+	)
+	for _, pod := range pods {
+		containers, ok := usages[pod.Name]
+		if !ok {
+			continue
+		}
+		var podUsage, podRequest float64
+		for _, c := range pod.Spec.Containers {
+			q, ok := containers[corev1.ResourceName(c.Name)]
+			if !ok {
+				continue
+			}
+			r, ok := c.Resources.Requests[metric.Name]
+			if !ok || r.IsZero() {
+				continue
+			}
+			podUsage += q.AsApproximateFloat64()
+			podRequest += r.AsApproximateFloat64()
+		}
+		if podRequest == 0 {
+			continue
+		}
+		usage += podUsage
+		request += podRequest
+		replicas++
+	}
+	if replicas == 0 {
+		return nil, fmt.Errorf("no %s metrics or requests are available for the pods", metric.Name)
+	}
+	return &metricValue{
+		metric:   experimental.AutoscalerMetric{Source: experimental.ResourceMetricSource, Resource: &metric},
+		average:  usage / request * 100,
+		target:   float64(metric.TargetAverageUtilization),
+		replicas: replicas,
+	}, nil
+}
+
+// probeMetricsSource reads the output of the kbagent probe, which is reported as events of the pods.
+type probeMetricsSource struct{}
+
+func (s *probeMetricsSource) fetch(ctx context.Context, reader client.Reader, scaler *experimental.ComponentAutoscaler,
+	metric experimental.AutoscalerMetric, pods []corev1.Pod) (*metricValue, error) {
+	if metric.Probe == nil {
+		return nil, fmt.Errorf("the probe metric is not specified")
+	}
+	events := &corev1.EventList{}
+	if err := reader.List(ctx, events, client.InNamespace(scaler.Namespace),
+		client.MatchingFields{"reason": metric.Probe.Name}); err != nil {
+		return nil, err
+	}
+	return probeAverage(*metric.Probe, pods, events.Items)
+}
+
+// probeAverage calculates the average of the latest probe outputs of the pods.
+func probeAverage(metric experimental.ProbeMetric, pods []corev1.Pod, events []corev1.Event) (*metricValue, error) {
+	podNames := map[string]bool{}
+	for _, pod := range pods {
+		podNames[pod.Name] = true
+	}
+
+	latest := map[string]corev1.Event{}
+	for _, event := range events {
+		if event.ReportingController != proto.ProbeEventReportingController ||
+			event.InvolvedObject.FieldPath != proto.ProbeEventFieldPath ||
+			event.Reason != metric.Name || !podNames[event.InvolvedObject.Name] {
+			continue
+		}
+		if last, ok := latest[event.InvolvedObject.Name]; ok && !eventTime(event).After(eventTime(last)) {
+			continue
+		}
+		latest[event.InvolvedObject.Name] = event
+	}
+
+	var (
+		sum      float64
+		replicas int32
+	)
+	for _, event := range latest {
+		probeEvent := &proto.ProbeEvent{}
+		if err := json.Unmarshal([]byte(event.Message), probeEvent); err != nil {
+			return nil, err
+		}
+		// the output is the latest succeed output on failure.
+		if len(probeEvent.Output) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(string(probeEvent.Output)), 64)
+		if err != nil {
+			return nil, fmt.Errorf("the output of probe %s is not a number: %s", metric.Name, string(probeEvent.Output))
+		}
+		sum += value
+		replicas++
+	}
+	if replicas == 0 {
+		return nil, fmt.Errorf("no output of probe %s is available for the pods", metric.Name)
+	}
+	target := metric.TargetAverageValue.AsApproximateFloat64()
+	if target <= 0 {
+		return nil, fmt.Errorf("the target average value of probe %s must be positive", metric.Name)
+	}
+	return &metricValue{
+		metric:   experimental.AutoscalerMetric{Source: experimental.ProbeMetricSource, Probe: &metric},
+		average:  sum / float64(replicas),
+		target:   target,
+		replicas: replicas,
+	}, nil
+}
+
+// eventTime returns the time the event was last reported, the kbagent updates the EventTime of an existing event.
+func eventTime(event corev1.Event) time.Time {
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.LastTimestamp.Time
+}
+
+func metricStatus(v metricValue) experimental.MetricStatus {
+	return experimental.MetricStatus{
+		Source:              v.metric.Source,
+		Name:                v.name(),
+		CurrentAverageValue: *resource.NewMilliQuantity(int64(v.average*1000), resource.DecimalSI),
+		Replicas:            v.replicas,
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+
+	experimentalv1alpha1 "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+var _ = Describe("autoscaler metrics test", func() {
+	newPod := func(name, cpu string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "main",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+						},
+					},
+					{Name: "sidecar"},
+				},
+			},
+		}
+	}
+
+	Context("resource metrics", func() {
+		It("should calculate the utilization of the requests", func() {
+			pods := []corev1.Pod{newPod("pod-0", "1"), newPod("pod-1", "1"), newPod("pod-2", "1")}
+			podMetrics := []metricsv1beta1.PodMetrics{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "pod-0"},
+					Containers: []metricsv1beta1.ContainerMetrics{
+						{Name: "main", Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("600m")}},
+						{Name: "sidecar", Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "pod-1"},
+					Containers: []metricsv1beta1.ContainerMetrics{
+						{Name: "main", Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}},
+					},
+				},
+			}
+			metric := experimentalv1alpha1.ResourceMetric{Name: corev1.ResourceCPU, TargetAverageUtilization: 50}
+			value, err := resourceUtilization(metric, pods, podMetrics)
+			Expect(err).Should(Succeed())
+			Expect(value.replicas).Should(BeEquivalentTo(2))
+			Expect(value.average).Should(BeNumerically("~", 80, 0.01))
+			Expect(value.ratio()).Should(BeNumerically("~", 1.6, 0.01))
+		})
+
+		It("should fail without metrics", func() {
+			metric := experimentalv1alpha1.ResourceMetric{Name: corev1.ResourceCPU, TargetAverageUtilization: 50}
+			_, err := resourceUtilization(metric, []corev1.Pod{newPod("pod-0", "1")}, nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("probe metrics", func() {
+		newEvent := func(pod, probe, output string, t time.Time) corev1.Event {
+			message, _ := json.Marshal(proto.ProbeEvent{Instance: "comp", Probe: probe, Output: []byte(output)})
+			return corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pod + "-" + output},
+				InvolvedObject: corev1.ObjectReference{
+					Kind:      "Pod",
+					Name:      pod,
+					FieldPath: proto.ProbeEventFieldPath,
+				},
+				Reason:              probe,
+				Message:             string(message),
+				EventTime:           metav1.NewMicroTime(t),
+				ReportingController: proto.ProbeEventReportingController,
+			}
+		}
+
+		It("should average the latest outputs of the pods", func() {
+			now := time.Now()
+			pods := []corev1.Pod{newPod("pod-0", "1"), newPod("pod-1", "1")}
+			events := []corev1.Event{
+				newEvent("pod-0", "connections", "100", now.Add(-time.Minute)),
+				newEvent("pod-0", "connections", "300", now),
+				newEvent("pod-1", "connections", "100\n", now),
+				newEvent("pod-1", "other", "1000", now),
+				newEvent("pod-2", "connections", "1000", now),
+			}
+			metric := experimentalv1alpha1.ProbeMetric{Name: "connections", TargetAverageValue: resource.MustParse("100")}
+			value, err := probeAverage(metric, pods, events)
+			Expect(err).Should(Succeed())
+			Expect(value.replicas).Should(BeEquivalentTo(2))
+			Expect(value.average).Should(BeNumerically("~", 200, 0.01))
+			Expect(value.ratio()).Should(BeNumerically("~", 2, 0.01))
+		})
+
+		It("should fail if the output is not a number", func() {
+			pods := []corev1.Pod{newPod("pod-0", "1")}
+			events := []corev1.Event{newEvent("pod-0", "connections", "many", time.Now())}
+			metric := experimentalv1alpha1.ProbeMetric{Name: "connections", TargetAverageValue: resource.MustParse("100")}
+			_, err := probeAverage(metric, pods, events)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
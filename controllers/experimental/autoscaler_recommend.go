@@ -0,0 +1,168 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+)
+
+const (
+	defaultAutoscalerSyncPeriodSeconds = 30
+	defaultAutoscalerTolerancePercent  = 10
+	defaultMaxScaleStep                = 1
+	defaultScaleOutCooldownSeconds     = 300
+	defaultScaleInCooldownSeconds      = 600
+	defaultMaxStepPercent              = 50
+)
+
+func withinTolerance(scaler *experimental.ComponentAutoscaler, ratio float64) bool {
+	tolerance := float64(ptr.Deref(scaler.Spec.TolerancePercent, defaultAutoscalerTolerancePercent)) / 100
+	return math.Abs(ratio-1) <= tolerance
+}
+
+// desiredReplicas calculates the desired replicas from the metrics, which is limited by the step sizes,
+// the bounds of the policy and the replicas limit of the ComponentDefinition.
+func desiredReplicas(scaler *experimental.ComponentAutoscaler, current int32,
+	values []metricValue, limit *appsv1.ReplicasLimit) int32 {
+	policy := scaler.Spec.Horizontal
+	desired := int32(-1)
+	for _, v := range values {
+		proposal := current
+		if current > 0 && !withinTolerance(scaler, v.ratio()) {
+			proposal = int32(math.Ceil(float64(current) * v.ratio()))
+		}
+		desired = max(desired, proposal)
+	}
+	if desired < 0 {
+		desired = current
+	}
+
+	if desired > current {
+		desired = min(desired, current+ptr.Deref(policy.MaxScaleOutStep, defaultMaxScaleStep))
+	}
+	if desired < current {
+		desired = max(desired, current-ptr.Deref(policy.MaxScaleInStep, defaultMaxScaleStep))
+	}
+
+	lower, upper := ptr.Deref(policy.MinReplicas, 1), policy.MaxReplicas
+	if limit != nil {
+		lower = max(lower, limit.MinReplicas)
+		upper = min(upper, limit.MaxReplicas)
+	}
+	return max(min(desired, upper), lower)
+}
+
+// desiredRequests calculates the desired resource requests from the resource metrics, which is limited by the
+// step size and the bounds of the policy. Only the resources with requests set are scaled.
+func desiredRequests(scaler *experimental.ComponentAutoscaler, requests corev1.ResourceList, values []metricValue) corev1.ResourceList {
+	policy := scaler.Spec.Vertical
+	step := float64(ptr.Deref(policy.MaxStepPercent, defaultMaxStepPercent)) / 100
+	desired := requests.DeepCopy()
+	for _, v := range values {
+		if v.metric.Resource == nil {
+			continue
+		}
+		name := v.metric.Resource.Name
+		current, ok := requests[name]
+		if !ok || current.IsZero() || withinTolerance(scaler, v.ratio()) {
+			continue
+		}
+		value := current.AsApproximateFloat64()
+		proposal := value * v.ratio()
+		proposal = max(min(proposal, value*(1+step)), value*(1-step))
+		if q, ok := policy.MaxAllowed[name]; ok {
+			proposal = min(proposal, q.AsApproximateFloat64())
+		}
+		if q, ok := policy.MinAllowed[name]; ok {
+			proposal = max(proposal, q.AsApproximateFloat64())
+		}
+		desired[name] = roundResource(name, proposal)
+	}
+	return desired
+}
+
+// roundResource rounds the CPU up to millicores and the memory up to mebibytes.
+func roundResource(name corev1.ResourceName, value float64) resource.Quantity {
+	if name == corev1.ResourceCPU {
+		return *resource.NewMilliQuantity(int64(math.Ceil(value*1000)), resource.DecimalSI)
+	}
+	const mi = 1024 * 1024
+	return *resource.NewQuantity(int64(math.Ceil(value/mi))*mi, resource.BinarySI)
+}
+
+// scaleResources changes the requests to the desired ones, and changes the limits proportionally.
+func scaleResources(resources corev1.ResourceRequirements, desired corev1.ResourceList) corev1.ResourceRequirements {
+	result := *resources.DeepCopy()
+	for name, q := range desired {
+		current := resources.Requests[name]
+		if current.Cmp(q) == 0 {
+			continue
+		}
+		if limit, ok := resources.Limits[name]; ok && !current.IsZero() {
+			ratio := q.AsApproximateFloat64() / current.AsApproximateFloat64()
+			result.Limits[name] = roundResource(name, limit.AsApproximateFloat64()*ratio)
+		}
+		result.Requests[name] = q
+	}
+	return result
+}
+
+// scaleDirectionOfRequests returns the direction to change the current requests to the desired ones, or empty if
+// there is no change. Scaling up wins if some resources are scaled up and others are scaled down.
+func scaleDirectionOfRequests(current, desired corev1.ResourceList) experimental.ScaleDirection {
+	var direction experimental.ScaleDirection
+	for name, q := range desired {
+		switch q.Cmp(current[name]) {
+		case 1:
+			return experimental.ScaleUp
+		case -1:
+			direction = experimental.ScaleDown
+		}
+	}
+	return direction
+}
+
+// inCooldown checks whether a scaling in the direction has to wait for the cool-down window since the last scaling.
+func inCooldown(scaler *experimental.ComponentAutoscaler, direction experimental.ScaleDirection, now time.Time) (bool, time.Duration) {
+	if scaler.Status.LastScaleTime == nil {
+		return false, 0
+	}
+	var seconds int32
+	switch direction {
+	case experimental.ScaleOut:
+		seconds = ptr.Deref(scaler.Spec.Horizontal.ScaleOutCooldownSeconds, defaultScaleOutCooldownSeconds)
+	case experimental.ScaleIn:
+		seconds = ptr.Deref(scaler.Spec.Horizontal.ScaleInCooldownSeconds, defaultScaleInCooldownSeconds)
+	case experimental.ScaleUp:
+		seconds = ptr.Deref(scaler.Spec.Vertical.ScaleUpCooldownSeconds, defaultScaleOutCooldownSeconds)
+	case experimental.ScaleDown:
+		seconds = ptr.Deref(scaler.Spec.Vertical.ScaleDownCooldownSeconds, defaultScaleInCooldownSeconds)
+	}
+	remaining := scaler.Status.LastScaleTime.Add(time.Duration(seconds) * time.Second).Sub(now)
+	return remaining > 0, remaining
+}
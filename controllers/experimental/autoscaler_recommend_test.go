@@ -0,0 +1,142 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	experimentalv1alpha1 "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+var _ = Describe("autoscaler recommendation test", func() {
+	var scaler *experimentalv1alpha1.ComponentAutoscaler
+
+	cpuValue := func(utilization float64, target int32) metricValue {
+		return metricValue{
+			metric: experimentalv1alpha1.AutoscalerMetric{
+				Source:   experimentalv1alpha1.ResourceMetricSource,
+				Resource: &experimentalv1alpha1.ResourceMetric{Name: corev1.ResourceCPU, TargetAverageUtilization: target},
+			},
+			average: utilization,
+			target:  float64(target),
+		}
+	}
+
+	BeforeEach(func() {
+		scaler = &experimentalv1alpha1.ComponentAutoscaler{
+			Spec: experimentalv1alpha1.ComponentAutoscalerSpec{
+				Horizontal: &experimentalv1alpha1.HorizontalAutoscalingPolicy{
+					MinReplicas:     ptr.To[int32](1),
+					MaxReplicas:     10,
+					MaxScaleOutStep: ptr.To[int32](2),
+				},
+				Vertical: &experimentalv1alpha1.VerticalAutoscalingPolicy{
+					MaxAllowed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				},
+			},
+		}
+	})
+
+	Context("desired replicas", func() {
+		It("should keep the replicas within the tolerance", func() {
+			Expect(desiredReplicas(scaler, 3, []metricValue{cpuValue(75, 70)}, nil)).Should(BeEquivalentTo(3))
+		})
+
+		It("should limit the scale out step", func() {
+			Expect(desiredReplicas(scaler, 3, []metricValue{cpuValue(140, 70)}, nil)).Should(BeEquivalentTo(5))
+		})
+
+		It("should use the largest recommendation", func() {
+			Expect(desiredReplicas(scaler, 4, []metricValue{cpuValue(35, 70), cpuValue(80, 70)}, nil)).Should(BeEquivalentTo(5))
+		})
+
+		It("should limit the scale in step", func() {
+			Expect(desiredReplicas(scaler, 4, []metricValue{cpuValue(10, 70)}, nil)).Should(BeEquivalentTo(3))
+		})
+
+		It("should respect the replicas limit of the component definition", func() {
+			limit := &appsv1.ReplicasLimit{MinReplicas: 1, MaxReplicas: 4}
+			Expect(desiredReplicas(scaler, 4, []metricValue{cpuValue(140, 70)}, limit)).Should(BeEquivalentTo(4))
+			limit = &appsv1.ReplicasLimit{MinReplicas: 3, MaxReplicas: 5}
+			Expect(desiredReplicas(scaler, 3, []metricValue{cpuValue(10, 70)}, limit)).Should(BeEquivalentTo(3))
+		})
+	})
+
+	Context("desired requests", func() {
+		It("should limit the step and the max allowed", func() {
+			requests := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+			desired := desiredRequests(scaler, requests, []metricValue{cpuValue(140, 70)})
+			Expect(desired.Cpu().String()).Should(Equal("1500m"))
+
+			requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1800m")}
+			desired = desiredRequests(scaler, requests, []metricValue{cpuValue(140, 70)})
+			Expect(desired.Cpu().String()).Should(Equal("2"))
+			Expect(scaleDirectionOfRequests(requests, desired)).Should(Equal(experimentalv1alpha1.ScaleUp))
+		})
+
+		It("should scale the limits proportionally", func() {
+			resources := corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			}
+			scaled := scaleResources(resources, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")})
+			Expect(scaled.Requests.Cpu().String()).Should(Equal("500m"))
+			Expect(scaled.Limits.Cpu().String()).Should(Equal("1"))
+		})
+	})
+
+	Context("scaling ops requests", func() {
+		It("should build the horizontal scaling ops request", func() {
+			scaler.Name = "scaler"
+			scaler.Spec.TargetClusterName = "cluster"
+			scaler.Spec.TargetComponentName = "comp"
+			opsRequest := buildHorizontalScalingOpsRequest(scaler, 5, 3)
+			Expect(opsRequest.Spec.ClusterName).Should(Equal("cluster"))
+			Expect(opsRequest.Labels).Should(HaveKeyWithValue(constant.ComponentAutoscalerLabelKey, "scaler"))
+			Expect(opsRequest.Spec.HorizontalScalingList).Should(HaveLen(1))
+			hScaling := opsRequest.Spec.HorizontalScalingList[0]
+			Expect(hScaling.ComponentName).Should(Equal("comp"))
+			Expect(hScaling.ScaleOut).Should(BeNil())
+			Expect(*hScaling.ScaleIn.ReplicaChanges).Should(BeEquivalentTo(2))
+		})
+	})
+
+	Context("cool-down window", func() {
+		It("should wait for the cool-down window of the direction", func() {
+			now := time.Now()
+			scaler.Status.LastScaleTime = &metav1.Time{Time: now.Add(-time.Minute * 6)}
+			cooling, _ := inCooldown(scaler, experimentalv1alpha1.ScaleOut, now)
+			Expect(cooling).Should(BeFalse())
+			cooling, remaining := inCooldown(scaler, experimentalv1alpha1.ScaleIn, now)
+			Expect(cooling).Should(BeTrue())
+			Expect(remaining).Should(Equal(4 * time.Minute))
+		})
+	})
+})
@@ -0,0 +1,373 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+// ComponentAutoscalerReconciler reconciles a ComponentAutoscaler object
+type ComponentAutoscalerReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// MetricsReader reads the metrics and the probe events without the cache, since the metrics API does not support watch.
+	MetricsReader client.Reader
+}
+
+//+kubebuilder:rbac:groups=experimental.kubeblocks.io,resources=componentautoscalers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=experimental.kubeblocks.io,resources=componentautoscalers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=experimental.kubeblocks.io,resources=componentautoscalers/finalizers,verbs=update
+
+// +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=components,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=componentdefinitions,verbs=get;list;watch
+
+// +kubebuilder:rbac:groups=operations.kubeblocks.io,resources=opsrequests,verbs=get;list;watch;create
+
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=get;list
+//+kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list
+
+// Reconcile collects the metrics of the target Component, and creates the HorizontalScaling or VerticalScaling
+// OpsRequest when the Component needs to be scaled.
+func (r *ComponentAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqCtx := intctrlutil.RequestCtx{
+		Ctx:      ctx,
+		Req:      req,
+		Log:      log.FromContext(ctx).WithValues("ComponentAutoscaler", req.NamespacedName),
+		Recorder: r.Recorder,
+	}
+
+	scaler := &experimental.ComponentAutoscaler{}
+	if err := r.Client.Get(reqCtx.Ctx, reqCtx.Req.NamespacedName, scaler); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	if !scaler.DeletionTimestamp.IsZero() {
+		// the created OpsRequests are deleted by the garbage collector.
+		return intctrlutil.Reconciled()
+	}
+
+	original := scaler.DeepCopy()
+	requeueAfter, err := r.reconcileAutoscaler(reqCtx, scaler)
+	if err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	scaler.Status.ObservedGeneration = scaler.Generation
+	if !reflect.DeepEqual(original.Status, scaler.Status) {
+		if err = r.Client.Status().Patch(reqCtx.Ctx, scaler, client.MergeFrom(original)); err != nil {
+			return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+		}
+	}
+	if requeueAfter > 0 {
+		return intctrlutil.RequeueAfter(requeueAfter, reqCtx.Log, "")
+	}
+	return intctrlutil.Reconciled()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ComponentAutoscalerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return intctrlutil.NewControllerManagedBy(mgr).
+		For(&experimental.ComponentAutoscaler{}).
+		Owns(&opsv1alpha1.OpsRequest{}).
+		Complete(r)
+}
+
+// reconcileAutoscaler evaluates the metrics and scales the Component, it returns the duration to the next evaluation.
+func (r *ComponentAutoscalerReconciler) reconcileAutoscaler(reqCtx intctrlutil.RequestCtx,
+	scaler *experimental.ComponentAutoscaler) (time.Duration, error) {
+	syncPeriod := time.Duration(ptr.Deref(scaler.Spec.SyncPeriodSeconds, defaultAutoscalerSyncPeriodSeconds)) * time.Second
+	if ptr.Deref(scaler.Spec.Suspend, false) {
+		setScalingActiveCondition(scaler, metav1.ConditionFalse, experimental.ReasonSuspended, "the autoscaling is suspended")
+		return 0, nil
+	}
+
+	cluster := &appsv1.Cluster{}
+	if err := r.Client.Get(reqCtx.Ctx, types.NamespacedName{Namespace: scaler.Namespace, Name: scaler.Spec.TargetClusterName}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			setScalingActiveCondition(scaler, metav1.ConditionFalse, experimental.ReasonInvalidTarget,
+				fmt.Sprintf("the cluster %s is not found", scaler.Spec.TargetClusterName))
+			return syncPeriod, nil
+		}
+		return 0, err
+	}
+	compSpec := cluster.Spec.GetComponentByName(scaler.Spec.TargetComponentName)
+	if compSpec == nil {
+		setScalingActiveCondition(scaler, metav1.ConditionFalse, experimental.ReasonInvalidTarget,
+			fmt.Sprintf("the component %s is not found in the cluster %s", scaler.Spec.TargetComponentName, cluster.Name))
+		return syncPeriod, nil
+	}
+	limit, err := r.replicasLimit(reqCtx.Ctx, scaler)
+	if err != nil {
+		return 0, err
+	}
+	active, err := r.syncActiveOpsRequest(reqCtx.Ctx, scaler)
+	if err != nil {
+		return 0, err
+	}
+
+	values, err := r.fetchMetrics(reqCtx.Ctx, scaler)
+	if err != nil {
+		setScalingActiveCondition(scaler, metav1.ConditionFalse, experimental.ReasonFailedGetMetrics, err.Error())
+		return syncPeriod, nil
+	}
+	setScalingActiveCondition(scaler, metav1.ConditionTrue, experimental.ReasonValidMetrics, "the metrics are available")
+
+	scaler.Status.CurrentMetrics = nil
+	for _, v := range values {
+		scaler.Status.CurrentMetrics = append(scaler.Status.CurrentMetrics, metricStatus(v))
+	}
+	scaler.Status.CurrentReplicas = compSpec.Replicas
+	scaler.Status.DesiredReplicas = compSpec.Replicas
+	scaler.Status.CurrentRequests = compSpec.Resources.Requests.DeepCopy()
+	scaler.Status.DesiredRequests = compSpec.Resources.Requests.DeepCopy()
+	if scaler.Spec.Horizontal != nil {
+		scaler.Status.DesiredReplicas = desiredReplicas(scaler, compSpec.Replicas, values, limit)
+	}
+	if scaler.Spec.Vertical != nil {
+		scaler.Status.DesiredRequests = desiredRequests(scaler, compSpec.Resources.Requests, values)
+	}
+	if active {
+		// wait for the in-progress scaling.
+		return syncPeriod, nil
+	}
+
+	// scale the replicas first, and scale the resources only when the replicas can not be changed.
+	var (
+		direction  experimental.ScaleDirection
+		opsRequest *opsv1alpha1.OpsRequest
+	)
+	switch {
+	case scaler.Status.DesiredReplicas > compSpec.Replicas:
+		direction = experimental.ScaleOut
+	case scaler.Status.DesiredReplicas < compSpec.Replicas:
+		direction = experimental.ScaleIn
+	case scaler.Spec.Vertical != nil:
+		direction = scaleDirectionOfRequests(compSpec.Resources.Requests, scaler.Status.DesiredRequests)
+	}
+	if len(direction) == 0 {
+		return syncPeriod, nil
+	}
+	if cooling, remaining := inCooldown(scaler, direction, time.Now()); cooling {
+		return min(remaining, syncPeriod), nil
+	}
+
+	switch direction {
+	case experimental.ScaleOut, experimental.ScaleIn:
+		opsRequest = buildHorizontalScalingOpsRequest(scaler, compSpec.Replicas, scaler.Status.DesiredReplicas)
+	default:
+		opsRequest = buildVerticalScalingOpsRequest(scaler, direction, scaleResources(compSpec.Resources, scaler.Status.DesiredRequests))
+	}
+	if err = r.createOpsRequest(reqCtx.Ctx, scaler, opsRequest, direction); err != nil {
+		return 0, err
+	}
+	return syncPeriod, nil
+}
+
+// replicasLimit returns the replicas limit defined in the ComponentDefinition of the target Component.
+func (r *ComponentAutoscalerReconciler) replicasLimit(ctx context.Context, scaler *experimental.ComponentAutoscaler) (*appsv1.ReplicasLimit, error) {
+	comp := &appsv1.Component{}
+	compKey := types.NamespacedName{
+		Namespace: scaler.Namespace,
+		Name:      constant.GenerateClusterComponentName(scaler.Spec.TargetClusterName, scaler.Spec.TargetComponentName),
+	}
+	if err := r.Client.Get(ctx, compKey, comp); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	compDef := &appsv1.ComponentDefinition{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: comp.Spec.CompDef}, compDef); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return compDef.Spec.ReplicasLimit, nil
+}
+
+// syncActiveOpsRequest checks whether the OpsRequest created by the autoscaler is still in progress.
+// The cool-down window starts when the OpsRequest completes.
+func (r *ComponentAutoscalerReconciler) syncActiveOpsRequest(ctx context.Context, scaler *experimental.ComponentAutoscaler) (bool, error) {
+	if len(scaler.Status.ActiveOpsRequest) == 0 {
+		// the status may fail to be updated after the OpsRequest is created, adopt the in-progress one
+		// to avoid creating a duplicate.
+		opsName, err := r.inProgressOpsRequest(ctx, scaler)
+		if err != nil || len(opsName) == 0 {
+			return false, err
+		}
+		scaler.Status.ActiveOpsRequest = opsName
+	}
+	opsRequest := &opsv1alpha1.OpsRequest{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: scaler.Namespace, Name: scaler.Status.ActiveOpsRequest}, opsRequest); err != nil {
+		if apierrors.IsNotFound(err) {
+			scaler.Status.ActiveOpsRequest = ""
+			return false, nil
+		}
+		return false, err
+	}
+	if !opsRequest.IsComplete() {
+		return true, nil
+	}
+	scaler.Status.ActiveOpsRequest = ""
+	if !opsRequest.Status.CompletionTimestamp.IsZero() {
+		scaler.Status.LastScaleTime = opsRequest.Status.CompletionTimestamp.DeepCopy()
+	}
+	if opsRequest.Status.Phase != opsv1alpha1.OpsSucceedPhase {
+		r.Recorder.Eventf(scaler, corev1.EventTypeWarning, "ScalingFailed", "the OpsRequest %s is %s", opsRequest.Name, opsRequest.Status.Phase)
+	}
+	return false, nil
+}
+
+// inProgressOpsRequest returns the name of the OpsRequest created by the autoscaler which is still in progress.
+func (r *ComponentAutoscalerReconciler) inProgressOpsRequest(ctx context.Context, scaler *experimental.ComponentAutoscaler) (string, error) {
+	opsList := &opsv1alpha1.OpsRequestList{}
+	if err := r.Client.List(ctx, opsList, client.InNamespace(scaler.Namespace),
+		client.MatchingLabels{constant.ComponentAutoscalerLabelKey: scaler.Name}); err != nil {
+		return "", err
+	}
+	for i := range opsList.Items {
+		opsRequest := &opsList.Items[i]
+		if metav1.IsControlledBy(opsRequest, scaler) && !opsRequest.IsComplete() {
+			return opsRequest.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func (r *ComponentAutoscalerReconciler) fetchMetrics(ctx context.Context, scaler *experimental.ComponentAutoscaler) ([]metricValue, error) {
+	podList := &corev1.PodList{}
+	if err := r.Client.List(ctx, podList, client.InNamespace(scaler.Namespace),
+		client.MatchingLabels(constant.GetCompLabels(scaler.Spec.TargetClusterName, scaler.Spec.TargetComponentName))); err != nil {
+		return nil, err
+	}
+	var pods []corev1.Pod
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp.IsZero() && pod.Status.Phase == corev1.PodRunning {
+			pods = append(pods, pod)
+		}
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("no running pods of the component %s", scaler.Spec.TargetComponentName)
+	}
+
+	var values []metricValue
+	for _, metric := range scaler.Spec.Metrics {
+		v, err := fetchMetric(ctx, r.MetricsReader, scaler, metric, pods)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, *v)
+	}
+	return values, nil
+}
+
+func (r *ComponentAutoscalerReconciler) createOpsRequest(ctx context.Context, scaler *experimental.ComponentAutoscaler,
+	opsRequest *opsv1alpha1.OpsRequest, direction experimental.ScaleDirection) error {
+	if err := controllerutil.SetControllerReference(scaler, opsRequest, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Client.Create(ctx, opsRequest); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	scaler.Status.ActiveOpsRequest = opsRequest.Name
+	scaler.Status.LastScaleTime = &metav1.Time{Time: time.Now()}
+	scaler.Status.LastScaleDirection = direction
+	r.Recorder.Eventf(scaler, corev1.EventTypeNormal, string(direction), "created OpsRequest %s to scale the component %s",
+		opsRequest.Name, scaler.Spec.TargetComponentName)
+	return nil
+}
+
+func newAutoscalingOpsRequest(scaler *experimental.ComponentAutoscaler, direction experimental.ScaleDirection) *opsv1alpha1.OpsRequest {
+	return &opsv1alpha1.OpsRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-%d", scaler.Name, strings.ToLower(string(direction)), time.Now().Unix()),
+			Namespace: scaler.Namespace,
+			Labels: map[string]string{
+				constant.ComponentAutoscalerLabelKey: scaler.Name,
+			},
+		},
+		Spec: opsv1alpha1.OpsRequestSpec{
+			ClusterName: scaler.Spec.TargetClusterName,
+		},
+	}
+}
+
+func buildHorizontalScalingOpsRequest(scaler *experimental.ComponentAutoscaler, current, desired int32) *opsv1alpha1.OpsRequest {
+	hScaling := opsv1alpha1.HorizontalScaling{
+		ComponentOps: opsv1alpha1.ComponentOps{ComponentName: scaler.Spec.TargetComponentName},
+	}
+	direction := experimental.ScaleOut
+	if desired > current {
+		hScaling.ScaleOut = &opsv1alpha1.ScaleOut{
+			ReplicaChanger: opsv1alpha1.ReplicaChanger{ReplicaChanges: ptr.To(desired - current)},
+		}
+	} else {
+		direction = experimental.ScaleIn
+		hScaling.ScaleIn = &opsv1alpha1.ScaleIn{
+			ReplicaChanger: opsv1alpha1.ReplicaChanger{ReplicaChanges: ptr.To(current - desired)},
+		}
+	}
+	opsRequest := newAutoscalingOpsRequest(scaler, direction)
+	opsRequest.Spec.Type = opsv1alpha1.HorizontalScalingType
+	opsRequest.Spec.HorizontalScalingList = []opsv1alpha1.HorizontalScaling{hScaling}
+	return opsRequest
+}
+
+func buildVerticalScalingOpsRequest(scaler *experimental.ComponentAutoscaler,
+	direction experimental.ScaleDirection, resources corev1.ResourceRequirements) *opsv1alpha1.OpsRequest {
+	opsRequest := newAutoscalingOpsRequest(scaler, direction)
+	opsRequest.Spec.Type = opsv1alpha1.VerticalScalingType
+	opsRequest.Spec.VerticalScalingList = []opsv1alpha1.VerticalScaling{
+		{
+			ComponentOps:         opsv1alpha1.ComponentOps{ComponentName: scaler.Spec.TargetComponentName},
+			ResourceRequirements: resources,
+		},
+	}
+	return opsRequest
+}
+
+func setScalingActiveCondition(scaler *experimental.ComponentAutoscaler, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&scaler.Status.Conditions, metav1.Condition{
+		Type:               string(experimental.ScalingActive),
+		Status:             status,
+		ObservedGeneration: scaler.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	experimentalv1alpha1 "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+)
+
+var _ = Describe("component autoscaler controller test", func() {
+	It("adopts the in-progress OpsRequest missing from the status", func() {
+		scheme := runtime.NewScheme()
+		Expect(experimentalv1alpha1.AddToScheme(scheme)).Should(Succeed())
+		Expect(opsv1alpha1.AddToScheme(scheme)).Should(Succeed())
+
+		scaler := &experimentalv1alpha1.ComponentAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "mysql", UID: "scaler-uid"},
+		}
+		newOps := func(name string, phase opsv1alpha1.OpsPhase) *opsv1alpha1.OpsRequest {
+			opsRequest := newAutoscalingOpsRequest(scaler, experimentalv1alpha1.ScaleOut)
+			opsRequest.Name = name
+			opsRequest.Status.Phase = phase
+			Expect(controllerutil.SetControllerReference(scaler, opsRequest, scheme)).Should(Succeed())
+			return opsRequest
+		}
+		r := &ComponentAutoscalerReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(newOps("mysql-scaleout-1", opsv1alpha1.OpsSucceedPhase)).Build(),
+			Scheme:   scheme,
+			Recorder: record.NewFakeRecorder(10),
+		}
+
+		active, err := r.syncActiveOpsRequest(context.Background(), scaler)
+		Expect(err).Should(BeNil())
+		Expect(active).Should(BeFalse())
+		Expect(scaler.Status.ActiveOpsRequest).Should(BeEmpty())
+
+		Expect(r.Client.Create(context.Background(), newOps("mysql-scaleout-2", opsv1alpha1.OpsRunningPhase))).Should(Succeed())
+		active, err = r.syncActiveOpsRequest(context.Background(), scaler)
+		Expect(err).Should(BeNil())
+		Expect(active).Should(BeTrue())
+		Expect(scaler.Status.ActiveOpsRequest).Should(Equal("mysql-scaleout-2"))
+	})
+})
@@ -58,6 +58,7 @@ type EventReconciler struct {
 
 // events API only allows ready-only, create, patch
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;patch
+// +kubebuilder:rbac:groups=operations.kubeblocks.io,resources=opsrequests,verbs=get;create
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -112,14 +113,20 @@ func (r *EventReconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 func (r *EventReconciler) handlers() []eventHandler {
-	handlers := make([]eventHandler, 0, 3)
-	if r.AppsEnabled {
+	return eventHandlers(r.AppsEnabled, r.WorkloadsEnabled)
+}
+
+func eventHandlers(appsEnabled, workloadsEnabled bool) []eventHandler {
+	handlers := make([]eventHandler, 0, 5)
+	if appsEnabled {
 		handlers = append(handlers,
 			&component.AvailableEventHandler{},
 			&component.KBAgentTaskEventHandler{},
+			&component.VolumeExpansionEventHandler{},
+			&component.ReplicationLagEventHandler{},
 		)
 	}
-	if r.WorkloadsEnabled {
+	if workloadsEnabled {
 		handlers = append(handlers, &workloads.RoleEventHandler{})
 	}
 	return handlers
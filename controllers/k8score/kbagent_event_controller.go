@@ -0,0 +1,216 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package k8score
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/lifecycle"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	kbagt "github.com/apecloud/kubeblocks/pkg/kbagent"
+	kbacli "github.com/apecloud/kubeblocks/pkg/kbagent/client"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+const (
+	// kbagentEventResubscribeInterval is the interval to subscribe to the events of a kb-agent again after the stream is broken.
+	kbagentEventResubscribeInterval = 5 * time.Second
+)
+
+// KBAgentEventReconciler subscribes to the probe and task events of the kb-agents which serve the gRPC transport,
+// and handles the events received with the same handlers as the Events posted by the kb-agents.
+//
+// The kb-agents keep posting the Events, they are handled again by the EventReconciler and it is harmless since
+// the handlers are idempotent. The latest event of each probe is sent again on each subscription, so the events
+// failed to handle are retried by subscribing again.
+type KBAgentEventReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	Recorder         record.EventRecorder
+	AppsEnabled      bool
+	WorkloadsEnabled bool
+
+	mu       sync.Mutex
+	watchers map[types.NamespacedName]*kbagentEventWatcher
+}
+
+type kbagentEventWatcher struct {
+	uid    types.UID
+	podIP  string
+	cancel context.CancelFunc
+}
+
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+
+func (r *KBAgentEventReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("pod", req.NamespacedName)
+
+	pod := &corev1.Pod{}
+	if err := r.Client.Get(ctx, req.NamespacedName, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.unsubscribe(req.NamespacedName)
+			return intctrlutil.Reconciled()
+		}
+		return intctrlutil.CheckedRequeueWithError(err, logger, "getPodError")
+	}
+
+	if !pod.DeletionTimestamp.IsZero() || pod.Status.PodIP == "" || !hasKBAgentGRPCPort(pod) {
+		r.unsubscribe(req.NamespacedName)
+		return intctrlutil.Reconciled()
+	}
+	// the ctx of the reconciliation lives as long as the controller, the subscription is stopped with it.
+	r.subscribe(ctx, pod)
+	return intctrlutil.Reconciled()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KBAgentEventReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return intctrlutil.NewControllerManagedBy(mgr).
+		Named("kbagent-event").
+		For(&corev1.Pod{}).
+		WithEventFilter(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetLabels()[constant.AppManagedByLabelKey] == constant.AppName
+		})).
+		Complete(r)
+}
+
+func (r *KBAgentEventReconciler) subscribe(ctx context.Context, pod *corev1.Pod) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := client.ObjectKeyFromObject(pod)
+	if w, ok := r.watchers[key]; ok {
+		if w.uid == pod.UID && w.podIP == pod.Status.PodIP {
+			return
+		}
+		w.cancel() // the pod is recreated or restarted with a new IP
+	}
+	if r.watchers == nil {
+		r.watchers = make(map[types.NamespacedName]*kbagentEventWatcher)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	r.watchers[key] = &kbagentEventWatcher{uid: pod.UID, podIP: pod.Status.PodIP, cancel: cancel}
+
+	pod = pod.DeepCopy()
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		r.watch(ctx, pod, func(cli kbacli.EventClient) error {
+			return cli.WatchProbeEvents(ctx, proto.ProbeEventsRequest{}, func(event proto.ProbeEvent) error {
+				return r.handle(ctx, pod, event.Probe, event)
+			})
+		})
+	}, kbagentEventResubscribeInterval)
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		r.watch(ctx, pod, func(cli kbacli.EventClient) error {
+			return cli.WatchTaskEvents(ctx, proto.TaskEventsRequest{}, func(event proto.TaskEvent) error {
+				return r.handle(ctx, pod, "task", event)
+			})
+		})
+	}, kbagentEventResubscribeInterval)
+}
+
+func (r *KBAgentEventReconciler) unsubscribe(key types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if w, ok := r.watchers[key]; ok {
+		w.cancel()
+		delete(r.watchers, key)
+	}
+}
+
+func (r *KBAgentEventReconciler) watch(ctx context.Context, pod *corev1.Pod, watch func(kbacli.EventClient) error) {
+	logger := log.FromContext(ctx).WithValues("pod", client.ObjectKeyFromObject(pod))
+	cli, err := lifecycle.NewEventClient(ctx, r.Client, pod)
+	if err != nil {
+		logger.Error(err, "failed to create the kb-agent event client")
+		return
+	}
+	if cli == nil {
+		return
+	}
+	defer func() {
+		_ = cli.Close()
+	}()
+	if err = watch(cli); err != nil {
+		logger.Info("the kb-agent event subscription is broken, will subscribe again later", "error", err.Error())
+	}
+}
+
+// handle handles the event received as the Event posted by the kb-agent.
+func (r *KBAgentEventReconciler) handle(ctx context.Context, pod *corev1.Pod, reason string, event any) error {
+	message, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	now := metav1.Now()
+	k8sEvent := builder.NewEventBuilder(pod.Namespace, pod.Name+"."+reason).
+		SetInvolvedObject(corev1.ObjectReference{
+			Kind:      "Pod",
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			UID:       pod.UID,
+			FieldPath: proto.ProbeEventFieldPath,
+		}).
+		SetMessage(string(message)).
+		SetReason(reason).
+		SetType(corev1.EventTypeNormal).
+		SetFirstTimestamp(now).
+		SetLastTimestamp(now).
+		SetEventTime(metav1.NewMicroTime(now.Time)).
+		SetReportingController(proto.ProbeEventReportingController).
+		SetReportingInstance(pod.Name).
+		SetAction(reason).
+		GetObject()
+	k8sEvent.Count = 1
+
+	reqCtx := intctrlutil.RequestCtx{
+		Ctx: ctx,
+		Req: ctrl.Request{NamespacedName: client.ObjectKeyFromObject(pod)},
+		Log: log.FromContext(ctx).WithValues("pod", client.ObjectKeyFromObject(pod), "event", reason),
+	}
+	for _, handler := range eventHandlers(r.AppsEnabled, r.WorkloadsEnabled) {
+		if _, err = handler.Handle(r.Client, reqCtx, r.Recorder, k8sEvent); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func hasKBAgentGRPCPort(pod *corev1.Pod) bool {
+	_, err := intctrlutil.GetPortByName(*pod, kbagt.ContainerName, kbagt.DefaultGRPCPortName)
+	return err == nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package k8score
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	kbagt "github.com/apecloud/kubeblocks/pkg/kbagent"
+)
+
+func TestKBAgentEventReconcilerSubscription(t *testing.T) {
+	pod := builder.NewPodBuilder("default", "mysql-0").
+		SetUID(types.UID("uid-0")).
+		AddContainer(corev1.Container{
+			Name:  kbagt.ContainerName,
+			Ports: []corev1.ContainerPort{{Name: kbagt.DefaultGRPCPortName, ContainerPort: kbagt.DefaultGRPCPort}},
+		}).
+		GetObject()
+	pod.Status.PodIP = "10.0.0.1"
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).WithStatusSubresource(pod).Build()
+	r := &KBAgentEventReconciler{Client: cli, Scheme: scheme}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}}
+	reconcile := func() {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+	}
+
+	// the kb-agent serves the gRPC transport
+	reconcile()
+	w, ok := r.watchers[req.NamespacedName]
+	if !ok || w.uid != pod.UID || w.podIP != pod.Status.PodIP {
+		t.Fatalf("watchers = %v, want the pod subscribed", r.watchers)
+	}
+
+	// the pod is restarted with a new IP
+	pod.Status.PodIP = "10.0.0.2"
+	if err := cli.Status().Update(ctx, pod); err != nil {
+		t.Fatalf("update pod status: %v", err)
+	}
+	reconcile()
+	if w = r.watchers[req.NamespacedName]; w == nil || w.podIP != "10.0.0.2" {
+		t.Fatalf("watchers = %v, want the pod subscribed again", r.watchers)
+	}
+
+	// the pod is deleted
+	if err := cli.Delete(ctx, pod); err != nil {
+		t.Fatalf("delete pod: %v", err)
+	}
+	reconcile()
+	if len(r.watchers) != 0 {
+		t.Fatalf("watchers = %v, want none", r.watchers)
+	}
+}
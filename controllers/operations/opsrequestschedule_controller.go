@@ -0,0 +1,351 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
+	"github.com/apecloud/kubeblocks/pkg/operations"
+)
+
+// OpsRequestScheduleReconciler reconciles a OpsRequestSchedule object
+type OpsRequestScheduleReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=operations.kubeblocks.io,resources=opsrequestschedules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=operations.kubeblocks.io,resources=opsrequestschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operations.kubeblocks.io,resources=opsrequestschedules/finalizers,verbs=update
+
+// Reconcile creates the OpsRequests of the schedule when the scheduled time comes,
+// and cleans up the completed OpsRequests exceeding the history limits.
+func (r *OpsRequestScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqCtx := intctrlutil.RequestCtx{
+		Ctx:      ctx,
+		Req:      req,
+		Log:      log.FromContext(ctx).WithValues("opsRequestSchedule", req.NamespacedName),
+		Recorder: r.Recorder,
+	}
+
+	opsSchedule := &opsv1alpha1.OpsRequestSchedule{}
+	if err := r.Client.Get(reqCtx.Ctx, reqCtx.Req.NamespacedName, opsSchedule); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	if !opsSchedule.DeletionTimestamp.IsZero() {
+		// the created OpsRequests are deleted by the garbage collector.
+		return intctrlutil.Reconciled()
+	}
+
+	original := opsSchedule.DeepCopy()
+	requeueAfter, err := r.reconcileSchedule(reqCtx, opsSchedule)
+	if err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	opsSchedule.Status.ObservedGeneration = opsSchedule.Generation
+	if !reflect.DeepEqual(original.Status, opsSchedule.Status) {
+		if err = r.Client.Status().Patch(reqCtx.Ctx, opsSchedule, client.MergeFrom(original)); err != nil {
+			return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+		}
+	}
+	if requeueAfter > 0 {
+		return intctrlutil.RequeueAfter(requeueAfter, reqCtx.Log, "")
+	}
+	return intctrlutil.Reconciled()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *OpsRequestScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return intctrlutil.NewControllerManagedBy(mgr).
+		For(&opsv1alpha1.OpsRequestSchedule{}).
+		Owns(&opsv1alpha1.OpsRequest{}).
+		Complete(r)
+}
+
+// reconcileSchedule syncs the OpsRequests of the schedule and returns the duration to the next scheduled time.
+func (r *OpsRequestScheduleReconciler) reconcileSchedule(reqCtx intctrlutil.RequestCtx,
+	opsSchedule *opsv1alpha1.OpsRequestSchedule) (time.Duration, error) {
+	active, err := r.syncOpsRequests(reqCtx, opsSchedule)
+	if err != nil {
+		return 0, err
+	}
+
+	sched, err := parseOpsRequestSchedule(opsSchedule.Spec.Schedule, opsSchedule.Spec.TimeZone)
+	if err != nil {
+		opsSchedule.Status.Phase = opsv1alpha1.UnavailablePhase
+		opsSchedule.Status.Message = err.Error()
+		opsSchedule.Status.NextScheduleTime = nil
+		r.Recorder.Event(opsSchedule, corev1.EventTypeWarning, "InvalidSchedule", err.Error())
+		// wait for the spec to be updated.
+		return 0, nil
+	}
+	if err = validateConcurrencyPolicy(opsSchedule); err != nil {
+		opsSchedule.Status.Phase = opsv1alpha1.UnavailablePhase
+		opsSchedule.Status.Message = err.Error()
+		opsSchedule.Status.NextScheduleTime = nil
+		r.Recorder.Event(opsSchedule, corev1.EventTypeWarning, "InvalidConcurrencyPolicy", err.Error())
+		// wait for the spec to be updated.
+		return 0, nil
+	}
+	opsSchedule.Status.Phase = opsv1alpha1.AvailablePhase
+	opsSchedule.Status.Message = ""
+	if opsSchedule.IsSuspended() {
+		opsSchedule.Status.NextScheduleTime = nil
+		return 0, nil
+	}
+
+	now := time.Now()
+	scheduledTime, err := mostRecentScheduleTime(opsSchedule, sched, now)
+	if err != nil {
+		opsSchedule.Status.Message = err.Error()
+		r.Recorder.Event(opsSchedule, corev1.EventTypeWarning, "TooManyMissedTimes", err.Error())
+	} else if scheduledTime != nil {
+		if err = r.runSchedule(reqCtx, opsSchedule, active, *scheduledTime, now); err != nil {
+			return 0, err
+		}
+	}
+	next := sched.Next(now)
+	opsSchedule.Status.NextScheduleTime = &metav1.Time{Time: next}
+	// add a little delay to make sure the next scheduled time has passed when requeue.
+	return next.Sub(now) + 100*time.Millisecond, nil
+}
+
+// runSchedule creates the OpsRequest for the scheduled time according to the concurrency policy.
+func (r *OpsRequestScheduleReconciler) runSchedule(reqCtx intctrlutil.RequestCtx,
+	opsSchedule *opsv1alpha1.OpsRequestSchedule,
+	active []opsv1alpha1.OpsRequest,
+	scheduledTime, now time.Time) error {
+	if deadline := opsSchedule.Spec.StartingDeadlineSeconds; deadline != nil &&
+		scheduledTime.Add(time.Duration(*deadline)*time.Second).Before(now) {
+		r.Recorder.Eventf(opsSchedule, corev1.EventTypeWarning, "MissSchedule",
+			"missed the scheduled time %s as the starting deadline has passed", scheduledTime.Format(time.RFC3339))
+		opsSchedule.Status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
+		return nil
+	}
+	switch opsSchedule.Spec.ConcurrencyPolicy {
+	case opsv1alpha1.ForbidConcurrent:
+		if len(active) > 0 {
+			r.Recorder.Eventf(opsSchedule, corev1.EventTypeNormal, "SkipSchedule",
+				"skipped the scheduled time %s as the previous OpsRequest %s is still running", scheduledTime.Format(time.RFC3339), active[0].Name)
+			opsSchedule.Status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
+			return nil
+		}
+	case opsv1alpha1.ReplaceConcurrent:
+		for i := range active {
+			if err := r.cancelOpsRequest(reqCtx, opsSchedule, &active[i]); err != nil {
+				return err
+			}
+		}
+	}
+	opsRequest, err := buildScheduledOpsRequest(opsSchedule, scheduledTime, r.Scheme)
+	if err != nil {
+		return err
+	}
+	if err = r.Client.Create(reqCtx.Ctx, opsRequest); err != nil && !apierrors.IsAlreadyExists(err) {
+		r.Recorder.Eventf(opsSchedule, corev1.EventTypeWarning, "FailedCreate", "failed to create OpsRequest %s: %s", opsRequest.Name, err.Error())
+		return err
+	} else if err == nil {
+		r.Recorder.Eventf(opsSchedule, corev1.EventTypeNormal, "SuccessfulCreate", "created OpsRequest %s", opsRequest.Name)
+		opsSchedule.Status.Active = append(opsSchedule.Status.Active, opsRequestReference(opsRequest))
+	}
+	opsSchedule.Status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
+	return nil
+}
+
+func (r *OpsRequestScheduleReconciler) cancelOpsRequest(reqCtx intctrlutil.RequestCtx,
+	opsSchedule *opsv1alpha1.OpsRequestSchedule,
+	opsRequest *opsv1alpha1.OpsRequest) error {
+	if opsRequest.Spec.Cancel {
+		return nil
+	}
+	patch := client.MergeFrom(opsRequest.DeepCopy())
+	opsRequest.Spec.Cancel = true
+	if err := r.Client.Patch(reqCtx.Ctx, opsRequest, patch); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	r.Recorder.Eventf(opsSchedule, corev1.EventTypeNormal, "CancelOpsRequest", "cancel the running OpsRequest %s", opsRequest.Name)
+	return nil
+}
+
+// syncOpsRequests updates the status with the OpsRequests of the schedule, deletes the completed OpsRequests
+// exceeding the history limits and returns the active ones.
+func (r *OpsRequestScheduleReconciler) syncOpsRequests(reqCtx intctrlutil.RequestCtx,
+	opsSchedule *opsv1alpha1.OpsRequestSchedule) ([]opsv1alpha1.OpsRequest, error) {
+	opsRequestList := &opsv1alpha1.OpsRequestList{}
+	if err := r.Client.List(reqCtx.Ctx, opsRequestList, client.InNamespace(opsSchedule.Namespace),
+		client.MatchingLabels{constant.OpsRequestScheduleLabelKey: opsSchedule.Name}); err != nil {
+		return nil, err
+	}
+	var active, succeeded, failed []opsv1alpha1.OpsRequest
+	for _, opsRequest := range opsRequestList.Items {
+		if !metav1.IsControlledBy(&opsRequest, opsSchedule) {
+			continue
+		}
+		switch {
+		case !opsRequest.IsComplete():
+			active = append(active, opsRequest)
+		case opsRequest.Status.Phase == opsv1alpha1.OpsSucceedPhase:
+			succeeded = append(succeeded, opsRequest)
+			completionTime := opsRequest.Status.CompletionTimestamp
+			if last := opsSchedule.Status.LastSuccessfulTime; !completionTime.IsZero() && (last == nil || last.Before(&completionTime)) {
+				opsSchedule.Status.LastSuccessfulTime = completionTime.DeepCopy()
+			}
+		default:
+			failed = append(failed, opsRequest)
+		}
+	}
+	opsSchedule.Status.Active = nil
+	for i := range active {
+		opsSchedule.Status.Active = append(opsSchedule.Status.Active, opsRequestReference(&active[i]))
+	}
+	if err := r.cleanupHistory(reqCtx, succeeded, opsSchedule.Spec.SuccessfulHistoryLimit); err != nil {
+		return nil, err
+	}
+	if err := r.cleanupHistory(reqCtx, failed, opsSchedule.Spec.FailedHistoryLimit); err != nil {
+		return nil, err
+	}
+	return active, nil
+}
+
+// cleanupHistory deletes the oldest completed OpsRequests exceeding the limit.
+func (r *OpsRequestScheduleReconciler) cleanupHistory(reqCtx intctrlutil.RequestCtx, opsRequests []opsv1alpha1.OpsRequest, limit *int32) error {
+	if limit == nil || len(opsRequests) <= int(*limit) {
+		return nil
+	}
+	sort.Slice(opsRequests, func(i, j int) bool {
+		return opsRequests[i].CreationTimestamp.Before(&opsRequests[j].CreationTimestamp)
+	})
+	for i := 0; i < len(opsRequests)-int(*limit); i++ {
+		if err := intctrlutil.BackgroundDeleteObject(r.Client, reqCtx.Ctx, &opsRequests[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateConcurrencyPolicy checks that the OpsRequests of the schedule can be canceled with the Replace policy.
+func validateConcurrencyPolicy(opsSchedule *opsv1alpha1.OpsRequestSchedule) error {
+	if opsSchedule.Spec.ConcurrencyPolicy != opsv1alpha1.ReplaceConcurrent {
+		return nil
+	}
+	opsType := opsSchedule.Spec.OpsRequestTemplate.Spec.Type
+	if operations.GetOpsManager().OpsMap[opsType].CancelFunc == nil {
+		return fmt.Errorf("the concurrency policy %s is not supported as the OpsRequest type %s does not support cancellation",
+			opsv1alpha1.ReplaceConcurrent, opsType)
+	}
+	return nil
+}
+
+// parseOpsRequestSchedule parses the cron schedule in the specified time zone.
+func parseOpsRequestSchedule(schedule string, timeZone *string) (*dputils.CronSchedule, error) {
+	if timeZone != nil {
+		if strings.Contains(schedule, "TZ=") {
+			return nil, fmt.Errorf("the time zone should be specified by spec.timeZone rather than TZ or CRON_TZ in the schedule")
+		}
+		if _, err := time.LoadLocation(*timeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %s", *timeZone, err.Error())
+		}
+		schedule = fmt.Sprintf("CRON_TZ=%s %s", *timeZone, schedule)
+	}
+	sched, err := dputils.ParseCronSchedule(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %s", schedule, err.Error())
+	}
+	return sched, nil
+}
+
+// mostRecentScheduleTime returns the latest scheduled time which has not been run yet, the earlier ones are skipped.
+// An error is returned if too many scheduled times are missed, e.g. the controller is down for long.
+func mostRecentScheduleTime(opsSchedule *opsv1alpha1.OpsRequestSchedule, sched *dputils.CronSchedule, now time.Time) (*time.Time, error) {
+	earliest := opsSchedule.CreationTimestamp.Time
+	if opsSchedule.Status.LastScheduleTime != nil {
+		earliest = opsSchedule.Status.LastScheduleTime.Time
+	}
+	if deadline := opsSchedule.Spec.StartingDeadlineSeconds; deadline != nil {
+		// the scheduled times before the deadline will not be run anyway.
+		if start := now.Add(-time.Duration(*deadline) * time.Second); start.After(earliest) {
+			earliest = start
+		}
+	}
+	recent, err := dputils.MostRecentScheduleTime(sched, earliest, now)
+	if err != nil {
+		return nil, fmt.Errorf("%s, set or decrease spec.startingDeadlineSeconds or check the clock skew", err.Error())
+	}
+	return recent, nil
+}
+
+// buildScheduledOpsRequest builds the OpsRequest of the scheduled time from the template.
+func buildScheduledOpsRequest(opsSchedule *opsv1alpha1.OpsRequestSchedule, scheduledTime time.Time, scheme *runtime.Scheme) (*opsv1alpha1.OpsRequest, error) {
+	template := opsSchedule.Spec.OpsRequestTemplate
+	opsRequest := &opsv1alpha1.OpsRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			// use the scheduled time in minutes as the suffix to make the creation idempotent.
+			Name:        fmt.Sprintf("%s-%d", opsSchedule.Name, scheduledTime.Unix()/60),
+			Namespace:   opsSchedule.Namespace,
+			Labels:      map[string]string{},
+			Annotations: map[string]string{},
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+	for k, v := range template.Metadata.Labels {
+		opsRequest.Labels[k] = v
+	}
+	for k, v := range template.Metadata.Annotations {
+		opsRequest.Annotations[k] = v
+	}
+	opsRequest.Labels[constant.OpsRequestScheduleLabelKey] = opsSchedule.Name
+	opsRequest.Annotations[constant.OpsScheduledTimeAnnotationKey] = scheduledTime.UTC().Format(time.RFC3339)
+	// the scheduled OpsRequests should not be canceled from the template.
+	opsRequest.Spec.Cancel = false
+	if err := controllerutil.SetControllerReference(opsSchedule, opsRequest, scheme); err != nil {
+		return nil, err
+	}
+	return opsRequest, nil
+}
+
+func opsRequestReference(opsRequest *opsv1alpha1.OpsRequest) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: opsv1alpha1.GroupVersion.String(),
+		Kind:       opsv1alpha1.OpsRequestKind,
+		Namespace:  opsRequest.Namespace,
+		Name:       opsRequest.Name,
+		UID:        opsRequest.UID,
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+var _ = Describe("OpsRequestSchedule Controller", func() {
+	const (
+		scheduleName = "weekly-restart"
+		namespace    = "default"
+	)
+
+	newSchedule := func(schedule string) *opsv1alpha1.OpsRequestSchedule {
+		return &opsv1alpha1.OpsRequestSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         namespace,
+				UID:               types.UID("schedule-uid"),
+				Generation:        1,
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-90 * time.Second)),
+			},
+			Spec: opsv1alpha1.OpsRequestScheduleSpec{
+				Schedule:          schedule,
+				ConcurrencyPolicy: opsv1alpha1.ForbidConcurrent,
+				OpsRequestTemplate: opsv1alpha1.OpsRequestTemplate{
+					Metadata: opsv1alpha1.OpsRequestTemplateMeta{Labels: map[string]string{"team": "dba"}},
+					Spec: opsv1alpha1.OpsRequestSpec{
+						ClusterName: "mysql",
+						Type:        opsv1alpha1.RestartType,
+						SpecificOpsRequest: opsv1alpha1.SpecificOpsRequest{
+							RestartList: []opsv1alpha1.ComponentOps{{ComponentName: "mysql"}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newScheduledOps := func(opsSchedule *opsv1alpha1.OpsRequestSchedule, name string, phase opsv1alpha1.OpsPhase, age time.Duration) *opsv1alpha1.OpsRequest {
+		opsRequest := &opsv1alpha1.OpsRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+				Labels:            map[string]string{constant.OpsRequestScheduleLabelKey: opsSchedule.Name},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: opsv1alpha1.GroupVersion.String(),
+					Kind:       opsv1alpha1.OpsRequestScheduleKind,
+					Name:       opsSchedule.Name,
+					UID:        opsSchedule.UID,
+					Controller: pointer.Bool(true),
+				}},
+			},
+			Spec: opsSchedule.Spec.OpsRequestTemplate.Spec,
+		}
+		opsRequest.Status.Phase = phase
+		return opsRequest
+	}
+
+	reconcile := func(objs ...client.Object) (*OpsRequestScheduleReconciler, ctrl.Result) {
+		scheme := newOperationsTestScheme()
+		reconciler := &OpsRequestScheduleReconciler{
+			Client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&opsv1alpha1.OpsRequestSchedule{}, &opsv1alpha1.OpsRequest{}).
+				WithObjects(objs...).
+				Build(),
+			Scheme:   scheme,
+			Recorder: record.NewFakeRecorder(10),
+		}
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: scheduleName}})
+		Expect(err).ShouldNot(HaveOccurred())
+		return reconciler, result
+	}
+
+	listScheduledOps := func(reconciler *OpsRequestScheduleReconciler) []opsv1alpha1.OpsRequest {
+		opsList := &opsv1alpha1.OpsRequestList{}
+		Expect(reconciler.Client.List(ctx, opsList, client.InNamespace(namespace),
+			client.MatchingLabels{constant.OpsRequestScheduleLabelKey: scheduleName})).Should(Succeed())
+		return opsList.Items
+	}
+
+	getSchedule := func(reconciler *OpsRequestScheduleReconciler) *opsv1alpha1.OpsRequestSchedule {
+		opsSchedule := &opsv1alpha1.OpsRequestSchedule{}
+		Expect(reconciler.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: scheduleName}, opsSchedule)).Should(Succeed())
+		return opsSchedule
+	}
+
+	Context("Test OpsRequestSchedule", func() {
+		It("creates the OpsRequest from the template when the scheduled time comes", func() {
+			reconciler, result := reconcile(newSchedule("* * * * *"))
+			Expect(result.RequeueAfter).Should(BeNumerically(">", 0))
+			Expect(result.RequeueAfter).Should(BeNumerically("<=", time.Minute+time.Second))
+
+			opsRequests := listScheduledOps(reconciler)
+			Expect(opsRequests).Should(HaveLen(1))
+			opsRequest := opsRequests[0]
+			Expect(opsRequest.Labels).Should(HaveKeyWithValue("team", "dba"))
+			Expect(opsRequest.Annotations).Should(HaveKey(constant.OpsScheduledTimeAnnotationKey))
+			Expect(opsRequest.Spec.Type).Should(Equal(opsv1alpha1.RestartType))
+			Expect(opsRequest.OwnerReferences).Should(HaveLen(1))
+			Expect(opsRequest.OwnerReferences[0].Name).Should(Equal(scheduleName))
+
+			opsSchedule := getSchedule(reconciler)
+			Expect(opsSchedule.Status.Phase).Should(Equal(opsv1alpha1.AvailablePhase))
+			Expect(opsSchedule.Status.Active).Should(HaveLen(1))
+			Expect(opsSchedule.Status.LastScheduleTime).ShouldNot(BeNil())
+			Expect(opsSchedule.Status.NextScheduleTime).ShouldNot(BeNil())
+			Expect(opsSchedule.Status.ObservedGeneration).Should(Equal(int64(1)))
+
+			By("reconciling again should not create the OpsRequest of the same scheduled time")
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: scheduleName}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listScheduledOps(reconciler)).Should(HaveLen(1))
+		})
+
+		It("skips the scheduled run if the previous OpsRequest is running with Forbid policy", func() {
+			opsSchedule := newSchedule("* * * * *")
+			running := newScheduledOps(opsSchedule, "running-ops", opsv1alpha1.OpsRunningPhase, 10*time.Minute)
+			reconciler, _ := reconcile(opsSchedule, running)
+			Expect(listScheduledOps(reconciler)).Should(HaveLen(1))
+			Expect(getSchedule(reconciler).Status.LastScheduleTime).ShouldNot(BeNil())
+
+			By("the skipped run is not started after the previous OpsRequest completes")
+			running.Status.Phase = opsv1alpha1.OpsSucceedPhase
+			Expect(reconciler.Client.Status().Update(ctx, running)).Should(Succeed())
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: scheduleName}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listScheduledOps(reconciler)).Should(HaveLen(1))
+		})
+
+		It("marks the schedule unavailable with Replace policy if the OpsRequest does not support cancellation", func() {
+			opsSchedule := newSchedule("* * * * *")
+			opsSchedule.Spec.ConcurrencyPolicy = opsv1alpha1.ReplaceConcurrent
+			reconciler, _ := reconcile(opsSchedule)
+			Expect(listScheduledOps(reconciler)).Should(BeEmpty())
+			fetched := getSchedule(reconciler)
+			Expect(fetched.Status.Phase).Should(Equal(opsv1alpha1.UnavailablePhase))
+			Expect(fetched.Status.Message).Should(ContainSubstring("does not support cancellation"))
+		})
+
+		It("cancels the running OpsRequest with Replace policy", func() {
+			opsSchedule := newSchedule("* * * * *")
+			opsSchedule.Spec.ConcurrencyPolicy = opsv1alpha1.ReplaceConcurrent
+			opsSchedule.Spec.OpsRequestTemplate.Spec.Type = opsv1alpha1.VerticalScalingType
+			running := newScheduledOps(opsSchedule, "running-ops", opsv1alpha1.OpsRunningPhase, 10*time.Minute)
+			reconciler, _ := reconcile(opsSchedule, running)
+			opsRequests := listScheduledOps(reconciler)
+			Expect(opsRequests).Should(HaveLen(2))
+			for _, opsRequest := range opsRequests {
+				Expect(opsRequest.Spec.Cancel).Should(Equal(opsRequest.Name == running.Name))
+			}
+		})
+
+		It("deletes the completed OpsRequests exceeding the history limits", func() {
+			opsSchedule := newSchedule("0 0 1 1 *")
+			opsSchedule.Spec.SuccessfulHistoryLimit = pointer.Int32(1)
+			opsSchedule.Spec.FailedHistoryLimit = pointer.Int32(0)
+			reconciler, _ := reconcile(opsSchedule,
+				newScheduledOps(opsSchedule, "succeed-old", opsv1alpha1.OpsSucceedPhase, 3*time.Hour),
+				newScheduledOps(opsSchedule, "succeed-new", opsv1alpha1.OpsSucceedPhase, 2*time.Hour),
+				newScheduledOps(opsSchedule, "failed", opsv1alpha1.OpsFailedPhase, time.Hour),
+			)
+			opsRequests := listScheduledOps(reconciler)
+			Expect(opsRequests).Should(HaveLen(1))
+			Expect(opsRequests[0].Name).Should(Equal("succeed-new"))
+		})
+
+		It("does not create OpsRequests when the schedule is suspended", func() {
+			opsSchedule := newSchedule("* * * * *")
+			opsSchedule.Spec.Suspend = pointer.Bool(true)
+			reconciler, result := reconcile(opsSchedule)
+			Expect(result).Should(Equal(ctrl.Result{}))
+			Expect(listScheduledOps(reconciler)).Should(BeEmpty())
+			Expect(getSchedule(reconciler).Status.NextScheduleTime).Should(BeNil())
+		})
+
+		It("marks the schedule unavailable when the time zone is invalid", func() {
+			opsSchedule := newSchedule("* * * * *")
+			opsSchedule.Spec.TimeZone = pointer.String("Mars/Olympus")
+			reconciler, result := reconcile(opsSchedule)
+			Expect(result).Should(Equal(ctrl.Result{}))
+			Expect(listScheduledOps(reconciler)).Should(BeEmpty())
+			fetched := getSchedule(reconciler)
+			Expect(fetched.Status.Phase).Should(Equal(opsv1alpha1.UnavailablePhase))
+			Expect(fetched.Status.Message).Should(ContainSubstring("invalid time zone"))
+		})
+
+		It("computes the most recent scheduled time in the time zone", func() {
+			sched, err := parseOpsRequestSchedule("0 3 * * *", pointer.String("Asia/Shanghai"))
+			Expect(err).ShouldNot(HaveOccurred())
+			// 2024-01-02 03:00 in Asia/Shanghai is 2024-01-01 19:00 in UTC.
+			now := time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)
+			opsSchedule := newSchedule("0 3 * * *")
+			opsSchedule.CreationTimestamp = metav1.NewTime(now.Add(-72 * time.Hour))
+			scheduledTime, err := mostRecentScheduleTime(opsSchedule, sched, now)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(scheduledTime).ShouldNot(BeNil())
+			Expect(scheduledTime.UTC()).Should(Equal(time.Date(2024, 1, 1, 19, 0, 0, 0, time.UTC)))
+
+			By("the scheduled time before the starting deadline is ignored")
+			opsSchedule.Spec.StartingDeadlineSeconds = pointer.Int64(1800)
+			scheduledTime, err = mostRecentScheduleTime(opsSchedule, sched, now)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(scheduledTime).Should(BeNil())
+
+			By("too many scheduled times are missed")
+			sched, err = parseOpsRequestSchedule("* * * * *", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			opsSchedule.Spec.StartingDeadlineSeconds = nil
+			_, err = mostRecentScheduleTime(opsSchedule, sched, now)
+			Expect(err).Should(MatchError(ContainSubstring("too many missed start times")))
+
+			_, err = parseOpsRequestSchedule("CRON_TZ=UTC 0 3 * * *", pointer.String("Asia/Shanghai"))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -42,6 +42,7 @@ import (
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	"github.com/apecloud/kubeblocks/pkg/parameters"
 	"github.com/apecloud/kubeblocks/pkg/parameters/core"
+	"github.com/apecloud/kubeblocks/pkg/parameters/validate"
 )
 
 func reconcileConfigItemDetailsIntoSpec(ctx context.Context, cli client.Client, compParam *parametersv1alpha1.ComponentParameter, fetchTask *Task) (bool, error) {
@@ -152,7 +153,11 @@ func applyParameterInputs(spec *parametersv1alpha1.ComponentParameterSpec,
 			if item == nil {
 				return intctrlutil.NewErrorf(intctrlutil.ErrorTypeFatal, "not found config template item: %s", templateName)
 			}
-			if _, err := parameters.DoMerge(resolveBaseData(configmaps[templateName], paramsInFile), parameters.DerefMapValues(paramsInFile), paramsDefs, configDescriptions); err != nil {
+			merged, err := parameters.DoMerge(resolveBaseData(configmaps[templateName], paramsInFile), parameters.DerefMapValues(paramsInFile), paramsDefs, configDescriptions)
+			if err != nil {
+				return intctrlutil.NewErrorf(intctrlutil.ErrorTypeFatal, "%s", err.Error())
+			}
+			if err = parameters.ValidateParameterConstraints(merged, paramsDefs, configDescriptions, fetchTask.ComponentObj); err != nil {
 				return intctrlutil.NewErrorf(intctrlutil.ErrorTypeFatal, "%s", err.Error())
 			}
 			mergeItemParameters(item, parameters.DerefMapValues(paramsInFile), override)
@@ -505,8 +510,17 @@ func syncImpl(taskCtx *taskContext,
 		return failStatus(err)
 	}
 	updatedConfig = baseConfig
+	if updatedConfig, status.Tuning, err = applyTuningProfile(reconcileCtx, item, updatedConfig, configMap, taskCtx); err != nil {
+		return failStatus(err)
+	}
 	if len(item.ConfigFileParams) != 0 {
-		if updatedConfig, err = parameters.ApplyParameters(item, baseConfig, taskCtx.configDescs, taskCtx.paramsDefs); err != nil {
+		if updatedConfig, err = parameters.ApplyParameters(item, updatedConfig, taskCtx.configDescs, taskCtx.paramsDefs); err != nil {
+			return failStatus(err)
+		}
+	}
+	var tolerated validate.ConstraintViolations
+	if updatedConfig != baseConfig {
+		if tolerated, err = validateParameterConstraints(taskCtx, fetcher.ComponentObj, updatedConfig, configMap); err != nil {
 			return failStatus(err)
 		}
 	}
@@ -515,11 +529,72 @@ func syncImpl(taskCtx *taskContext,
 	}
 
 	status.Message = nil
+	if len(tolerated) > 0 {
+		status.Message = pointer.String(fmt.Sprintf("the running configuration violates the parameter constraints: %s", tolerated.Error()))
+	}
 	status.Phase = parametersv1alpha1.CMergedPhase
 	status.UpdateRevision = revision
 	return nil
 }
 
+// validateParameterConstraints validates the updated configuration against the parameter constraints. The violations
+// which the running configuration has as well are not introduced by the parameter changes but by the component,
+// e.g. the memory is lowered by a vertical scaling, they are tolerated and returned, to keep the sync from being stuck.
+func validateParameterConstraints(taskCtx *taskContext, comp *appsv1.Component, updatedConfig, running *corev1.ConfigMap) (validate.ConstraintViolations, error) {
+	err := parameters.ValidateParameterConstraints(updatedConfig.Data, taskCtx.paramsDefs, taskCtx.configDescs, comp)
+	if err == nil {
+		return nil, nil
+	}
+	var base error
+	if running != nil {
+		base = parameters.ValidateParameterConstraints(running.Data, taskCtx.paramsDefs, taskCtx.configDescs, comp)
+	}
+	added, existing, err := parameters.DiffConstraintViolations(err, base)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(added) > 0:
+		return nil, added
+	}
+	return existing, nil
+}
+
+func applyTuningProfile(reconcileCtx *render.ReconcileCtx,
+	item parametersv1alpha1.ConfigTemplateItemDetail,
+	baseConfig, running *corev1.ConfigMap,
+	taskCtx *taskContext) (*corev1.ConfigMap, *parametersv1alpha1.ParameterTuningStatus, error) {
+	tuning := resolveConfigTuning(reconcileCtx.Component, item.Name)
+	if tuning == nil {
+		return baseConfig, nil, nil
+	}
+	tuned, err := parameters.RenderTuningProfile(reconcileCtx, item, tuning.Profile, taskCtx.configDescs)
+	if err == nil {
+		var updated *corev1.ConfigMap
+		var status *parametersv1alpha1.ParameterTuningStatus
+		if updated, status, err = parameters.ApplyTuningProfile(tuning, tuned, baseConfig, running, taskCtx.configDescs, taskCtx.paramsDefs); err == nil {
+			return updated, status, nil
+		}
+	}
+	if !tuning.DryRun {
+		return nil, nil, err
+	}
+	// the profile is only previewed, report the error rather than blocking the merge
+	return baseConfig, &parametersv1alpha1.ParameterTuningStatus{
+		Profile: tuning.Profile,
+		DryRun:  true,
+		Message: err.Error(),
+	}, nil
+}
+
+func resolveConfigTuning(comp *appsv1.Component, templateName string) *appsv1.ConfigTuning {
+	for _, config := range comp.Spec.Configs {
+		if pointer.StringDeref(config.Name, "") == templateName {
+			return config.Tuning
+		}
+	}
+	return nil
+}
+
 func mergeAndApplyConfig(resourceCtx *render.ResourceCtx, expected, running *corev1.ConfigMap, owner client.Object,
 	item parametersv1alpha1.ConfigTemplateItemDetail, compGeneration int64, revision string) error {
 	fn := updateReconcileObject(item, owner, compGeneration, revision)
@@ -634,6 +709,7 @@ func updateRevision(revision configurationRevision, status *parametersv1alpha1.C
 			ExpectedCount:   revision.result.ExpectedCount,
 			ExecResult:      revision.result.ExecResult,
 			ErrMessage:      revision.result.Message,
+			Rollout:         revision.result.Rollout,
 		}
 	}
 }
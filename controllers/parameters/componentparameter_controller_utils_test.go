@@ -20,11 +20,18 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package parameters
 
 import (
+	"context"
+	"strings"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
 
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
 	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/render"
 	parampkg "github.com/apecloud/kubeblocks/pkg/parameters"
 )
 
@@ -167,3 +174,108 @@ func TestNilEmptyConfigItemDetailsEquivalence(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateParameterConstraints(t *testing.T) {
+	taskCtx := &taskContext{
+		configDescs: []parametersv1alpha1.ComponentConfigDescription{{
+			Name: "my.cnf",
+			FileFormatConfig: &parametersv1alpha1.FileFormatConfig{
+				Format: parametersv1alpha1.Ini,
+				FormatterAction: parametersv1alpha1.FormatterAction{
+					IniConfig: &parametersv1alpha1.IniConfig{SectionName: "mysqld"},
+				},
+			},
+		}},
+		paramsDefs: []*parametersv1alpha1.ParametersDefinition{{
+			Spec: parametersv1alpha1.ParametersDefinitionSpec{
+				FileName: "my.cnf",
+				Constraints: []parametersv1alpha1.ParameterConstraint{{
+					Name: "buffer-pool-size",
+					Rule: "quantity(parameters.innodb_buffer_pool_size) < component.resources.limits.memory",
+				}},
+			},
+		}},
+	}
+	comp := &appsv1.Component{
+		Spec: appsv1.ComponentSpec{
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+		},
+	}
+	configMap := func(bufferPoolSize string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{Data: map[string]string{
+			"my.cnf": "[mysqld]\ninnodb_buffer_pool_size=" + bufferPoolSize + "\nmax_connections=100\n",
+		}}
+	}
+
+	t.Run("the violation introduced by the parameter changes fails", func(t *testing.T) {
+		tolerated, err := validateParameterConstraints(taskCtx, comp, configMap("2Gi"), configMap("512Mi"))
+		if err == nil || len(tolerated) != 0 {
+			t.Fatalf("expected the violation to fail the sync, got %v, %v", tolerated, err)
+		}
+	})
+
+	t.Run("the violation introduced by the component is tolerated", func(t *testing.T) {
+		tolerated, err := validateParameterConstraints(taskCtx, comp, configMap("2Gi"), configMap("2Gi"))
+		if err != nil || len(tolerated) != 1 {
+			t.Fatalf("expected the violation to be tolerated, got %v, %v", tolerated, err)
+		}
+	})
+
+	t.Run("no violation", func(t *testing.T) {
+		tolerated, err := validateParameterConstraints(taskCtx, comp, configMap("512Mi"), nil)
+		if err != nil || len(tolerated) != 0 {
+			t.Fatalf("expected no violation, got %v, %v", tolerated, err)
+		}
+	})
+}
+
+func TestApplyTuningProfileDryRunError(t *testing.T) {
+	taskCtx := &taskContext{
+		ctx:       context.Background(),
+		component: &component.SynthesizedComponent{},
+		configDescs: []parametersv1alpha1.ComponentConfigDescription{{
+			Name:         "my.cnf",
+			TemplateName: "mysql-config",
+			FileFormatConfig: &parametersv1alpha1.FileFormatConfig{
+				Format: parametersv1alpha1.Ini,
+				FormatterAction: parametersv1alpha1.FormatterAction{
+					IniConfig: &parametersv1alpha1.IniConfig{SectionName: "mysqld"},
+				},
+			},
+			TuningProfiles: []parametersv1alpha1.TuningProfile{{
+				Name: "oltp",
+				Parameters: []parametersv1alpha1.TuningParameter{
+					{Name: "max_connections", Formula: `{{ fail "the memory is not set" }}`},
+				},
+			}},
+		}},
+	}
+	tuning := &appsv1.ConfigTuning{Profile: "oltp", DryRun: true}
+	reconcileCtx := &render.ReconcileCtx{
+		ResourceCtx: &render.ResourceCtx{Context: taskCtx.ctx},
+		Cluster:     &appsv1.Cluster{},
+		Component: &appsv1.Component{
+			Spec: appsv1.ComponentSpec{
+				Configs: []appsv1.ClusterComponentConfig{{Name: ptr.To("mysql-config"), Tuning: tuning}},
+			},
+		},
+		SynthesizedComponent: taskCtx.component,
+	}
+	item := parametersv1alpha1.ConfigTemplateItemDetail{Name: "mysql-config"}
+	baseConfig := &corev1.ConfigMap{Data: map[string]string{"my.cnf": "[mysqld]\nmax_connections=100\n"}}
+
+	updated, status, err := applyTuningProfile(reconcileCtx, item, baseConfig, nil, taskCtx)
+	if err != nil || updated != baseConfig {
+		t.Fatalf("expected the merge to continue with the base config, got %v, %v", updated, err)
+	}
+	if status == nil || !status.DryRun || !strings.Contains(status.Message, "the memory is not set") {
+		t.Fatalf("expected the formula error to be reported in the status, got %+v", status)
+	}
+
+	tuning.DryRun = false
+	if _, _, err = applyTuningProfile(reconcileCtx, item, baseConfig, nil, taskCtx); err == nil {
+		t.Fatal("expected the formula error to fail the merge if the profile is applied")
+	}
+}
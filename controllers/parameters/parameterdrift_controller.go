@@ -0,0 +1,409 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package parameters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/lifecycle"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/parameters"
+)
+
+const (
+	parametersDriftedCondition = "ParametersDrifted"
+
+	reasonParametersInSync        = "InSync"
+	reasonParametersDrifted       = "Drifted"
+	reasonDriftCheckFailed        = "DriftCheckFailed"
+	reasonParametersAutoCorrected = "ParametersAutoCorrected"
+
+	defaultDriftCheckPeriodSeconds = 300
+)
+
+// ParameterDriftReconciler periodically compares the live values of the parameters in the database engine
+// with the rendered configuration of a ComponentParameter.
+type ParameterDriftReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=parameters.kubeblocks.io,resources=componentparameters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=parameters.kubeblocks.io,resources=componentparameters/status,verbs=get;update;patch
+
+func (r *ParameterDriftReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqCtx := intctrlutil.RequestCtx{
+		Ctx:      ctx,
+		Req:      req,
+		Recorder: r.Recorder,
+		Log: log.FromContext(ctx).
+			WithName("ParameterDriftReconciler").
+			WithValues("Namespace", req.Namespace, "ComponentParameter", req.Name),
+	}
+
+	compParam := &parametersv1alpha1.ComponentParameter{}
+	if err := r.Client.Get(reqCtx.Ctx, reqCtx.Req.NamespacedName, compParam); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	if model.IsObjectDeleting(compParam) {
+		return intctrlutil.Reconciled()
+	}
+	if compParam.Spec.DriftDetection == nil {
+		return r.disableDriftDetection(reqCtx, compParam)
+	}
+
+	period := driftCheckPeriod(compParam.Spec.DriftDetection)
+	if drift := compParam.Status.Drift; drift != nil && drift.LastCheckTime != nil {
+		if elapsed := time.Since(drift.LastCheckTime.Time); elapsed < period {
+			return intctrlutil.RequeueAfter(period-elapsed, reqCtx.Log, "")
+		}
+	}
+	// the live values are expected to differ from the rendered ones while a change is in progress
+	if compParam.Status.Phase != parametersv1alpha1.CFinishedPhase {
+		return intctrlutil.RequeueAfter(period, reqCtx.Log, "configuration change is in progress, skip the drift check")
+	}
+
+	drifted, err := r.checkDrift(reqCtx, compParam)
+	if err := r.updateDriftStatus(reqCtx, compParam, drifted, err); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "failed to update the drift status")
+	}
+	return intctrlutil.RequeueAfter(period, reqCtx.Log, "")
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ParameterDriftReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return intctrlutil.NewControllerManagedBy(mgr).
+		Named("parameter-drift").
+		For(&parametersv1alpha1.ComponentParameter{}).
+		Complete(r)
+}
+
+func driftCheckPeriod(detection *parametersv1alpha1.ParameterDriftDetection) time.Duration {
+	seconds := detection.PeriodSeconds
+	if seconds <= 0 {
+		seconds = defaultDriftCheckPeriodSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (r *ParameterDriftReconciler) checkDrift(reqCtx intctrlutil.RequestCtx,
+	compParam *parametersv1alpha1.ComponentParameter) ([]parametersv1alpha1.DriftedParameter, error) {
+	fetchTask, err := prepareReconcileTask(reqCtx, r.Client, compParam)
+	if err != nil {
+		return nil, err
+	}
+	if fetchTask.ComponentObj == nil || model.IsObjectDeleting(fetchTask.ComponentObj) {
+		return nil, fmt.Errorf("component %s is not available", compParam.Spec.ComponentName)
+	}
+
+	synthesizedComp, err := component.BuildSynthesizedComponent(reqCtx.Ctx, r.Client, fetchTask.ComponentDefObj, fetchTask.ComponentObj)
+	if err == nil {
+		err = buildTemplateVars(reqCtx.Ctx, r.Client, fetchTask.ComponentDefObj, synthesizedComp)
+	}
+	if err != nil {
+		return nil, err
+	}
+	actions := synthesizedComp.LifecycleActions.ComponentLifecycleActions
+	if actions == nil || !actions.ParameterQuery.Defined() {
+		return nil, fmt.Errorf("the parameterQuery lifecycle action is not defined")
+	}
+
+	rendered, err := r.renderedValues(reqCtx.Ctx, compParam, fetchTask)
+	if err != nil || len(rendered.values) == 0 {
+		return nil, err
+	}
+	names := make([]string, 0)
+	for _, values := range rendered.values {
+		for name := range values {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	pods, err := component.ListOwnedInstances(reqCtx.Ctx, r.Client, fetchTask.ComponentObj)
+	if err != nil {
+		return nil, err
+	}
+	var its *workloads.InstanceSet
+	if compParam.Spec.DriftDetection.AutoCorrect {
+		its = &workloads.InstanceSet{}
+		itsKey := client.ObjectKey{
+			Namespace: compParam.Namespace,
+			Name:      constant.GenerateWorkloadNamePattern(compParam.Spec.ClusterName, compParam.Spec.ComponentName),
+		}
+		if err := r.Client.Get(reqCtx.Ctx, itsKey, its); err != nil {
+			return nil, err
+		}
+	}
+	var (
+		drifted []parametersv1alpha1.DriftedParameter
+		errs    []error
+	)
+	for _, pod := range pods {
+		lfa, err := lifecycle.New(synthesizedComp.Namespace, synthesizedComp.ClusterName, synthesizedComp.Name,
+			actions, synthesizedComp.TemplateVars, pod, pods)
+		if err != nil {
+			return nil, err
+		}
+		live, err := lfa.ParameterQuery(reqCtx.Ctx, r.Client, nil, names)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to query the parameters of %s: %s", pod.Name, err.Error()))
+			continue
+		}
+		podDrifted := parameters.DetectParameterDrift(rendered.values, live, rendered.paramsDefs)
+		if compParam.Spec.DriftDetection.AutoCorrect {
+			podDrifted, err = r.autoCorrect(reqCtx, compParam, synthesizedComp, its, rendered, lfa, pod, pods, podDrifted)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to correct the parameters of %s: %s", pod.Name, err.Error()))
+			}
+		}
+		for i := range podDrifted {
+			podDrifted[i].Instance = pod.Name
+		}
+		drifted = append(drifted, podDrifted...)
+	}
+	return drifted, errors.Join(errs...)
+}
+
+// renderedConfig is the rendered values of the parameters of a ComponentParameter.
+type renderedConfig struct {
+	// values is the rendered values of the parameters, keyed by the configuration file.
+	values map[string]map[string]string
+	// templates is the config template that each configuration file is rendered from.
+	templates  map[string]string
+	paramsDefs []*parametersv1alpha1.ParametersDefinition
+}
+
+func (r *ParameterDriftReconciler) renderedValues(ctx context.Context,
+	compParam *parametersv1alpha1.ComponentParameter, fetchTask *Task) (*renderedConfig, error) {
+	configDescs, paramsDefs, err := parameters.ResolveCmpdParametersDefs(ctx, r.Client, fetchTask.ComponentDefObj)
+	if err != nil {
+		return nil, err
+	}
+	configmaps, err := resolveComponentRefConfigMap(ctx, r.Client, compParam.Namespace, compParam.Spec.ClusterName, compParam.Spec.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	rendered := &renderedConfig{
+		values:     make(map[string]map[string]string),
+		templates:  make(map[string]string),
+		paramsDefs: paramsDefs,
+	}
+	for _, item := range compParam.Spec.ConfigItemDetails {
+		cm, ok := configmaps[item.Name]
+		if !ok {
+			continue
+		}
+		values, err := parameters.RenderedParameterValues(cm.Data, parameters.GetComponentConfigDescriptions(configDescs, item.Name), paramsDefs)
+		if err != nil {
+			return nil, err
+		}
+		for file, fileValues := range values {
+			rendered.values[file] = fileValues
+			rendered.templates[file] = item.Name
+		}
+	}
+	return rendered, nil
+}
+
+// autoCorrect applies the rendered values of the manually changed parameters to the replica again with the reconfigure
+// action of their config templates, and returns the parameters which are still drifted after querying the live values again.
+func (r *ParameterDriftReconciler) autoCorrect(reqCtx intctrlutil.RequestCtx, compParam *parametersv1alpha1.ComponentParameter,
+	synthesizedComp *component.SynthesizedComponent, its *workloads.InstanceSet, rendered *renderedConfig,
+	lfa lifecycle.Lifecycle, pod *corev1.Pod, pods []*corev1.Pod, drifted []parametersv1alpha1.DriftedParameter) ([]parametersv1alpha1.DriftedParameter, error) {
+	var (
+		toCorrect = make(map[string][]parametersv1alpha1.DriftedParameter)
+		remaining []parametersv1alpha1.DriftedParameter
+		applied   []parametersv1alpha1.DriftedParameter
+		errs      []error
+	)
+	for _, param := range drifted {
+		tpl, ok := rendered.templates[param.FileName]
+		if !ok || param.Reason != parametersv1alpha1.ParameterManuallyChanged {
+			remaining = append(remaining, param)
+			continue
+		}
+		toCorrect[tpl] = append(toCorrect[tpl], param)
+	}
+	for _, tpl := range slices.Sorted(maps.Keys(toCorrect)) {
+		if err := r.reconfigureTemplate(reqCtx.Ctx, synthesizedComp, its, tpl, pod, pods, toCorrect[tpl]); err != nil {
+			remaining = append(remaining, toCorrect[tpl]...)
+			errs = append(errs, err)
+			continue
+		}
+		applied = append(applied, toCorrect[tpl]...)
+	}
+
+	// the reconfigure action succeeding doesn't mean that the engine has taken the values, confirm them with the live values
+	if len(applied) > 0 {
+		names := make([]string, 0, len(applied))
+		for _, param := range applied {
+			names = append(names, param.Name)
+		}
+		live, err := lfa.ParameterQuery(reqCtx.Ctx, r.Client, nil, names)
+		if err != nil {
+			remaining = append(remaining, applied...)
+			errs = append(errs, fmt.Errorf("failed to query the corrected parameters: %s", err.Error()))
+		} else {
+			var corrected []string
+			for _, param := range applied {
+				if _, ok := live[param.Name]; !ok {
+					remaining = append(remaining, param)
+					continue
+				}
+				expected := map[string]map[string]string{param.FileName: {param.Name: param.ExpectedValue}}
+				if still := parameters.DetectParameterDrift(expected, live, rendered.paramsDefs); len(still) > 0 {
+					remaining = append(remaining, still...)
+					continue
+				}
+				corrected = append(corrected, param.Name)
+			}
+			if len(corrected) > 0 {
+				r.Recorder.Eventf(compParam, corev1.EventTypeNormal, reasonParametersAutoCorrected,
+					"the manually changed parameters of %s are corrected: %s", pod.Name, strings.Join(corrected, ","))
+			}
+		}
+	}
+	slices.SortFunc(remaining, func(a, b parametersv1alpha1.DriftedParameter) int {
+		if c := strings.Compare(a.FileName, b.FileName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return remaining, errors.Join(errs...)
+}
+
+// reconfigureTemplate calls the reconfigure action of the config template with the parameters as the arguments,
+// in the same way as the InstanceSet does to apply the changes of the template.
+func (r *ParameterDriftReconciler) reconfigureTemplate(ctx context.Context, synthesizedComp *component.SynthesizedComponent,
+	its *workloads.InstanceSet, tpl string, pod *corev1.Pod, pods []*corev1.Pod, params []parametersv1alpha1.DriftedParameter) error {
+	idx := slices.IndexFunc(its.Spec.Configs, func(config workloads.ConfigTemplate) bool {
+		return config.Name == tpl
+	})
+	if idx < 0 {
+		return fmt.Errorf("the config template %s is not found in the workload", tpl)
+	}
+	config := its.Spec.Configs[idx]
+	// the reconfigure action specified by the cluster, e.g. the legacy reload action, is built for the last change only
+	if idx := slices.IndexFunc(synthesizedComp.FileTemplates, func(t component.SynthesizedFileTemplate) bool {
+		return t.Name == tpl
+	}); idx >= 0 && config.ReconfigureActionName == component.UserReconfigureActionName(synthesizedComp.FileTemplates[idx]) {
+		return fmt.Errorf("the reconfigure action of template %s is specified by the cluster, which can not be used to correct the drifted parameters", tpl)
+	}
+
+	actions := synthesizedComp.LifecycleActions.ComponentLifecycleActions.DeepCopy()
+	actions.Reconfigure = config.Reconfigure
+	lfa, err := lifecycle.New(synthesizedComp.Namespace, synthesizedComp.ClusterName, synthesizedComp.Name,
+		actions, synthesizedComp.TemplateVars, pod, pods)
+	if err != nil {
+		return err
+	}
+	args := make([][]string, 0, len(params))
+	for _, param := range params {
+		args = append(args, []string{param.Name, param.ExpectedValue})
+	}
+	opts := &lifecycle.Options{Arguments: args}
+	if len(config.ReconfigureActionName) == 0 {
+		err = lfa.Reconfigure(ctx, r.Client, opts, config.Parameters)
+	} else {
+		err = lfa.UserDefined(ctx, r.Client, opts, config.ReconfigureActionName, config.Reconfigure, config.Parameters)
+	}
+	if errors.Is(err, lifecycle.ErrActionNotDefined) {
+		return fmt.Errorf("the reconfigure action of template %s is not defined, the drifted parameters can not be corrected: %s", tpl, err.Error())
+	}
+	return err
+}
+
+func (r *ParameterDriftReconciler) updateDriftStatus(reqCtx intctrlutil.RequestCtx,
+	compParam *parametersv1alpha1.ComponentParameter, drifted []parametersv1alpha1.DriftedParameter, checkErr error) error {
+	patch := client.MergeFrom(compParam.DeepCopy())
+	compParam.Status.Drift = &parametersv1alpha1.ParameterDriftStatus{
+		LastCheckTime: &metav1.Time{Time: time.Now()},
+		Parameters:    drifted,
+	}
+	condition := metav1.Condition{
+		Type:               parametersDriftedCondition,
+		ObservedGeneration: compParam.Generation,
+	}
+	switch {
+	case len(drifted) > 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reasonParametersDrifted
+		condition.Message = driftConditionMessage(drifted)
+	case checkErr != nil:
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = reasonDriftCheckFailed
+		condition.Message = checkErr.Error()
+	default:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonParametersInSync
+		condition.Message = "the live values of the parameters are consistent with the configuration"
+	}
+	if len(drifted) > 0 && checkErr != nil {
+		condition.Message = fmt.Sprintf("%s; %s", condition.Message, checkErr.Error())
+	}
+	meta.SetStatusCondition(&compParam.Status.Conditions, condition)
+	return r.Client.Status().Patch(reqCtx.Ctx, compParam, patch)
+}
+
+func (r *ParameterDriftReconciler) disableDriftDetection(reqCtx intctrlutil.RequestCtx,
+	compParam *parametersv1alpha1.ComponentParameter) (ctrl.Result, error) {
+	if compParam.Status.Drift == nil && meta.FindStatusCondition(compParam.Status.Conditions, parametersDriftedCondition) == nil {
+		return intctrlutil.Reconciled()
+	}
+	patch := client.MergeFrom(compParam.DeepCopy())
+	compParam.Status.Drift = nil
+	meta.RemoveStatusCondition(&compParam.Status.Conditions, parametersDriftedCondition)
+	if err := r.Client.Status().Patch(reqCtx.Ctx, compParam, patch); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "failed to clear the drift status")
+	}
+	return intctrlutil.Reconciled()
+}
+
+// driftConditionMessage lists the drifted parameters, e.g. "my.cnf:max_connections on mysql-0 is ManuallyChanged".
+func driftConditionMessage(drifted []parametersv1alpha1.DriftedParameter) string {
+	messages := make([]string, 0, len(drifted))
+	for _, param := range drifted {
+		messages = append(messages, fmt.Sprintf("%s:%s on %s is %s", param.FileName, param.Name, param.Instance, param.Reason))
+	}
+	return strings.Join(messages, "; ")
+}
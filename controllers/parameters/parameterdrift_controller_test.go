@@ -0,0 +1,282 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package parameters
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	kbacli "github.com/apecloud/kubeblocks/pkg/kbagent/client"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+	parameterscore "github.com/apecloud/kubeblocks/pkg/parameters/core"
+)
+
+const (
+	pdPodName           = "test-cluster-mysql-0"
+	pdReconfigureAction = "reconfigure-cmpd-" + templateName
+)
+
+func newParameterDriftTestReconciler(t *testing.T, objects ...runtime.Object) (*ParameterDriftReconciler, client.Client, *record.FakeRecorder) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme failed: %v", err)
+	}
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add apps scheme failed: %v", err)
+	}
+	if err := parametersv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add parameters scheme failed: %v", err)
+	}
+	if err := workloads.AddToScheme(scheme); err != nil {
+		t.Fatalf("add workloads scheme failed: %v", err)
+	}
+
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&parametersv1alpha1.ComponentParameter{}).
+		WithRuntimeObjects(objects...).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+
+	return &ParameterDriftReconciler{Client: cli, Scheme: scheme, Recorder: recorder}, cli, recorder
+}
+
+// newParameterDriftTestObjects builds a component whose max_connections is rendered as 100,
+// and whose config template is reconfigured by the given action of the InstanceSet.
+func newParameterDriftTestObjects(reconfigure *appsv1.Action, actionName string) []runtime.Object {
+	objects := newParameterViewTestObjectsWithOptions(parameterViewTestOptions{
+		fileFormat:        parametersv1alpha1.Properties,
+		runtimeContent:    "max_connections=100\n",
+		dynamicParameters: []string{"max_connections"},
+		mutate: []func(*parametersv1alpha1.ComponentParameter){
+			func(compParam *parametersv1alpha1.ComponentParameter) {
+				compParam.Spec.DriftDetection = &parametersv1alpha1.ParameterDriftDetection{AutoCorrect: true}
+				compParam.Status.Phase = parametersv1alpha1.CFinishedPhase
+			},
+		},
+	})[1:] // no ParameterView
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *appsv1.Component:
+			o.Labels = constant.GetCompLabels(pvClusterName, pvComponentName)
+			o.Annotations = map[string]string{constant.KBAppClusterUIDKey: "test-cluster-uid"}
+		case *appsv1.ComponentDefinition:
+			o.Spec.LifecycleActions = &appsv1.ComponentLifecycleActions{
+				ParameterQuery: &appsv1.Action{Exec: &appsv1.ExecAction{Command: []string{"query"}}},
+			}
+		case *corev1.ConfigMap:
+			// the rendered configmaps are listed by the labels of the config template
+			if o.Name == parameterscore.GetComponentCfgName(pvClusterName, pvComponentName, templateName) {
+				maps.Copy(o.Labels, map[string]string{
+					constant.CMConfigurationTemplateNameLabelKey: templateName,
+					constant.CMConfigurationTypeLabelKey:         "config",
+					constant.CMConfigurationSpecProviderLabelKey: templateName,
+				})
+			}
+		}
+	}
+
+	its := &workloads.InstanceSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constant.GenerateWorkloadNamePattern(pvClusterName, pvComponentName),
+			Namespace: parameterViewNamespace,
+		},
+		Spec: workloads.InstanceSetSpec{
+			Configs: []workloads.ConfigTemplate{{
+				Name:                  templateName,
+				Reconfigure:           reconfigure,
+				ReconfigureActionName: actionName,
+				Parameters:            map[string]string{"KB_CONFIG_FILES_UPDATED": fileName + ":checksum"},
+			}},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pdPodName,
+			Namespace: parameterViewNamespace,
+			Labels:    constant.GetCompLabels(pvClusterName, pvComponentName),
+		},
+	}
+	return append(objects, its, pod)
+}
+
+// mockParameterDriftKBAgent serves the parameterQuery action with the live values, which are set to the arguments
+// of the reconfigure action if applied is true.
+func mockParameterDriftKBAgent(t *testing.T, live map[string]string, applied bool) *[]proto.ActionRequest {
+	t.Helper()
+
+	var reconfigures []proto.ActionRequest
+	cli := kbacli.NewMockClient(gomock.NewController(t))
+	cli.EXPECT().Action(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req proto.ActionRequest) (proto.ActionResponse, error) {
+		if req.Action == "parameterQuery" {
+			var values []string
+			for _, name := range strings.Split(req.Parameters["KB_PARAMETER_NAMES"], ",") {
+				values = append(values, fmt.Sprintf("%q: %q", name, live[name]))
+			}
+			return proto.ActionResponse{Output: []byte("{" + strings.Join(values, ",") + "}")}, nil
+		}
+		reconfigures = append(reconfigures, req)
+		if applied {
+			for _, arg := range req.Arguments {
+				live[arg[0]] = arg[1]
+			}
+		}
+		return proto.ActionResponse{}, nil
+	}).AnyTimes()
+	kbacli.SetMockClient(cli, nil)
+	t.Cleanup(kbacli.UnsetMockClient)
+	return &reconfigures
+}
+
+func reconcileParameterDrift(t *testing.T, reconciler *ParameterDriftReconciler, cli client.Client) *parametersv1alpha1.ComponentParameter {
+	t.Helper()
+
+	key := client.ObjectKey{Namespace: parameterViewNamespace, Name: componentParameterName}
+	if _, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("reconcile failed: %v", err)
+	}
+	compParam := &parametersv1alpha1.ComponentParameter{}
+	if err := cli.Get(context.Background(), key, compParam); err != nil {
+		t.Fatalf("get component parameter failed: %v", err)
+	}
+	return compParam
+}
+
+func TestParameterDriftReconcileAutoCorrect(t *testing.T) {
+	reconfigure := &appsv1.Action{Exec: &appsv1.ExecAction{Command: []string{"reconfigure"}}}
+
+	t.Run("corrected with the template action", func(t *testing.T) {
+		reconfigures := mockParameterDriftKBAgent(t, map[string]string{"max_connections": "200"}, true)
+		reconciler, cli, recorder := newParameterDriftTestReconciler(t, newParameterDriftTestObjects(reconfigure, pdReconfigureAction)...)
+
+		compParam := reconcileParameterDrift(t, reconciler, cli)
+		if len(*reconfigures) != 1 {
+			t.Fatalf("expected the reconfigure action to be called once, got %d", len(*reconfigures))
+		}
+		req := (*reconfigures)[0]
+		if req.Action != "udf-"+pdReconfigureAction {
+			t.Fatalf("expected the action of the template to be called, got %q", req.Action)
+		}
+		if !reflect.DeepEqual(req.Arguments, [][]string{{"max_connections", "100"}}) {
+			t.Fatalf("unexpected arguments: %v", req.Arguments)
+		}
+		if req.Parameters["KB_CONFIG_FILES_UPDATED"] != fileName+":checksum" {
+			t.Fatalf("expected the parameters of the template to be passed, got %v", req.Parameters)
+		}
+		if len(compParam.Status.Drift.Parameters) != 0 {
+			t.Fatalf("expected no drifted parameters, got %v", compParam.Status.Drift.Parameters)
+		}
+		assertParameterDriftCondition(t, compParam, metav1.ConditionFalse, reasonParametersInSync, "")
+		select {
+		case event := <-recorder.Events:
+			if !strings.Contains(event, reasonParametersAutoCorrected) || !strings.Contains(event, "max_connections") {
+				t.Fatalf("unexpected event: %s", event)
+			}
+		default:
+			t.Fatalf("expected the corrected event")
+		}
+	})
+
+	t.Run("not corrected after the re-query", func(t *testing.T) {
+		reconfigures := mockParameterDriftKBAgent(t, map[string]string{"max_connections": "200"}, false)
+		reconciler, cli, recorder := newParameterDriftTestReconciler(t, newParameterDriftTestObjects(reconfigure, pdReconfigureAction)...)
+
+		compParam := reconcileParameterDrift(t, reconciler, cli)
+		if len(*reconfigures) != 1 {
+			t.Fatalf("expected the reconfigure action to be called once, got %d", len(*reconfigures))
+		}
+		drifted := compParam.Status.Drift.Parameters
+		if len(drifted) != 1 || drifted[0].Name != "max_connections" || drifted[0].LiveValue != "200" || drifted[0].Instance != pdPodName {
+			t.Fatalf("expected max_connections to be still drifted, got %v", drifted)
+		}
+		assertParameterDriftCondition(t, compParam, metav1.ConditionTrue, reasonParametersDrifted, "")
+		select {
+		case event := <-recorder.Events:
+			t.Fatalf("unexpected event: %s", event)
+		default:
+		}
+	})
+
+	t.Run("reconfigure action not defined", func(t *testing.T) {
+		reconfigures := mockParameterDriftKBAgent(t, map[string]string{"max_connections": "200"}, true)
+		reconciler, cli, _ := newParameterDriftTestReconciler(t, newParameterDriftTestObjects(nil, pdReconfigureAction)...)
+
+		compParam := reconcileParameterDrift(t, reconciler, cli)
+		if len(*reconfigures) != 0 {
+			t.Fatalf("expected no reconfigure action to be called, got %d", len(*reconfigures))
+		}
+		if len(compParam.Status.Drift.Parameters) != 1 {
+			t.Fatalf("expected max_connections to be still drifted, got %v", compParam.Status.Drift.Parameters)
+		}
+		assertParameterDriftCondition(t, compParam, metav1.ConditionTrue, reasonParametersDrifted,
+			fmt.Sprintf("the reconfigure action of template %s is not defined", templateName))
+	})
+
+	t.Run("reconfigure action specified by the cluster", func(t *testing.T) {
+		reconfigures := mockParameterDriftKBAgent(t, map[string]string{"max_connections": "200"}, true)
+		reconciler, cli, _ := newParameterDriftTestReconciler(t, newParameterDriftTestObjects(reconfigure, "reconfigure-user-"+templateName)...)
+
+		compParam := reconcileParameterDrift(t, reconciler, cli)
+		if len(*reconfigures) != 0 {
+			t.Fatalf("expected no reconfigure action to be called, got %d", len(*reconfigures))
+		}
+		if len(compParam.Status.Drift.Parameters) != 1 {
+			t.Fatalf("expected max_connections to be still drifted, got %v", compParam.Status.Drift.Parameters)
+		}
+		assertParameterDriftCondition(t, compParam, metav1.ConditionTrue, reasonParametersDrifted,
+			fmt.Sprintf("the reconfigure action of template %s is specified by the cluster", templateName))
+	})
+}
+
+func assertParameterDriftCondition(t *testing.T, compParam *parametersv1alpha1.ComponentParameter,
+	status metav1.ConditionStatus, reason, message string) {
+	t.Helper()
+	condition := meta.FindStatusCondition(compParam.Status.Conditions, parametersDriftedCondition)
+	if condition == nil {
+		t.Fatalf("drift condition not found")
+	}
+	if condition.Status != status || condition.Reason != reason {
+		t.Fatalf("expected condition %s/%s, got %s/%s: %s", status, reason, condition.Status, condition.Reason, condition.Message)
+	}
+	if !strings.Contains(condition.Message, message) {
+		t.Fatalf("expected condition message to contain %q, got %q", message, condition.Message)
+	}
+}
@@ -118,6 +118,9 @@ func (r *ParametersDefinitionReconciler) validate(reqCtx intctrlutil.RequestCtx,
 	if err := validateSchema(parametersDef); err != nil {
 		return err
 	}
+	if err := validate.CompileParameterConstraints(parametersDef.Spec.Constraints); err != nil {
+		return err
+	}
 	if err := r.validateTemplateName(reqCtx.Ctx, parametersDef); err != nil {
 		return err
 	}
@@ -254,6 +254,17 @@ func extractMarkerLineParameterName(format parametersv1alpha1.CfgFileFormat, lin
 			return "", false
 		}
 		return splitConfigKey(line)
+	case parametersv1alpha1.Nginx:
+		if strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "}") || !strings.HasSuffix(trimmed, ";") {
+			return "", false
+		}
+		return strings.TrimRight(strings.Fields(trimmed)[0], ";"), true
+	case parametersv1alpha1.HOCON:
+		if strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "}") ||
+			strings.HasPrefix(trimmed, "include ") || strings.HasSuffix(trimmed, "{") {
+			return "", false
+		}
+		return splitConfigKey(line)
 	case parametersv1alpha1.YAML:
 		matches := yamlMarkerLinePattern.FindStringSubmatch(line)
 		if len(matches) != 2 {
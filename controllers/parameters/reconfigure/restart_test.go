@@ -21,6 +21,7 @@ package reconfigure
 
 import (
 	"context"
+	"time"
 
 	"github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -130,5 +131,64 @@ var _ = ginkgo.Describe("restartPolicy test", func() {
 			Expect(status.SucceedCount).Should(BeEquivalentTo(int32(2)))
 			Expect(status.ExpectedCount).Should(BeEquivalentTo(int32(2)))
 		})
+
+		ginkgo.It("should hold the restart until the maintenance window opens", func() {
+			configHash := "test-hash"
+			now := time.Now().UTC()
+			mockParam := Context{
+				RequestCtx: intctrlutil.RequestCtx{
+					Ctx: context.Background(),
+					Log: log.FromContext(context.Background()),
+				},
+				ConfigTemplate: appsv1.ComponentFileTemplate{
+					Name: cfgName,
+				},
+				ConfigHash: &configHash,
+				Cluster: &appsv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-cluster",
+						Namespace: "default",
+					},
+					Spec: appsv1.ClusterSpec{
+						MaintenanceWindowPolicy: &appsv1.MaintenanceWindowPolicy{
+							BlackoutPeriods: []appsv1.BlackoutPeriod{
+								{
+									Start: metav1.NewTime(now.Add(-time.Hour)),
+									End:   metav1.NewTime(now.Add(time.Hour)),
+								},
+							},
+						},
+					},
+				},
+				ClusterComponent: &appsv1.ClusterComponentSpec{
+					Name:     "test-component",
+					Replicas: 2,
+					Configs: []appsv1.ClusterComponentConfig{
+						{
+							Name: ptr.To(cfgName),
+						},
+					},
+				},
+				ITS: &workloads.InstanceSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-instanceset",
+						Namespace: "default",
+					},
+				},
+			}
+
+			status, err := restartPolicy(mockParam)
+			Expect(err).Should(Succeed())
+			Expect(status.Status).Should(BeEquivalentTo(StatusRetry))
+			Expect(status.Reason).Should(ContainSubstring("wait for the maintenance window"))
+			Expect(mockParam.ClusterComponent.Configs[0].ConfigHash).Should(BeNil())
+
+			// the restart is applied once the blackout period is over
+			mockParam.Cluster.Spec.MaintenanceWindowPolicy.BlackoutPeriods[0].End = metav1.NewTime(now.Add(-time.Minute))
+			status, err = restartPolicy(mockParam)
+			Expect(err).Should(Succeed())
+			Expect(status.Reason).Should(Equal("apply changes to cluster API"))
+			Expect(mockParam.ClusterComponent.Configs[0].ConfigHash).Should(Equal(mockParam.getTargetConfigHash()))
+		})
 	})
 })
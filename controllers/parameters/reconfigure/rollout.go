@@ -0,0 +1,241 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package reconfigure
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1"
+)
+
+const (
+	defaultRolloutBatchSize       = 1
+	defaultRolloutDeadlineSeconds = 600
+	rolloutCanaryBatchSize        = 1
+	rolloutReasonPrefix           = "staged rollout"
+)
+
+// rolloutNow is replaceable in tests.
+var rolloutNow = time.Now
+
+// stagedRollout tells whether the changes should be rolled out to the replicas in stages.
+func stagedRollout(ctx Context) bool {
+	return ctx.RolloutStrategy != nil && ctx.ITS != nil && ctx.getTargetReplicas() > 1
+}
+
+// startRollout limits the changes just applied to the cluster API to the canary replica.
+func startRollout(ctx Context, config *appsv1.ClusterComponentConfig) Status {
+	var (
+		replicas   = int32(ctx.getTargetReplicas())
+		instances  = ctx.ITS.ActivePresentInstanceStatuses()
+		configHash = ctx.getTargetConfigHash()
+	)
+	// some replicas are running the configuration already, e.g. the changes are reverted, roll it out at once.
+	for _, inst := range instances {
+		if isInstanceConfigUpdated(ctx, inst, configHash) {
+			return makeStatus(StatusRetry, withReason("apply changes to cluster API"), withExpected(replicas), withSucceed(0))
+		}
+	}
+	ordered := rolloutOrder(ctx.RolloutStrategy, ctx.ITS)
+	if len(ordered) == 0 {
+		return makeStatus(StatusRetry, withReason("apply changes to cluster API"), withExpected(replicas), withSucceed(0))
+	}
+	config.Instances = ordered[:rolloutCanaryBatchSize]
+	rollout := &parametersv1alpha1.ParameterRolloutStatus{
+		Instances:      slices.Clone(config.Instances),
+		BatchStartTime: ptr.To(metav1.NewTime(rolloutNow())),
+	}
+	return makeStatus(StatusRetry,
+		withReason(fmt.Sprintf("%s: apply changes to the canary %s", rolloutReasonPrefix, strings.Join(config.Instances, ","))),
+		withExpected(replicas), withSucceed(0), withRollout(rollout))
+}
+
+// continueRollout checks the replicas which the changes have been rolled out to, and moves on to the next batch
+// once they have applied the changes and stayed healthy for the bake time. The replicas of the previous batches
+// which have been baked are checked on every pass, and the rollout fails once any of them degrades.
+func continueRollout(ctx Context, config *appsv1.ClusterComponentConfig) Status {
+	var (
+		now        = rolloutNow()
+		strategy   = ctx.RolloutStrategy
+		replicas   = int32(ctx.getTargetReplicas())
+		configHash = ctx.getTargetConfigHash()
+	)
+
+	rollout := &parametersv1alpha1.ParameterRolloutStatus{}
+	if ctx.Rollout != nil {
+		rollout = ctx.Rollout.DeepCopy()
+	}
+	rollout.Instances = slices.Clone(config.Instances)
+	if rollout.BatchStartTime == nil {
+		rollout.BatchStartTime = ptr.To(metav1.NewTime(now))
+	}
+
+	statuses := make(map[string]*workloads.InstanceStatus)
+	rollout.UpdatedInstances = nil
+	for _, inst := range ctx.ITS.ActivePresentInstanceStatuses() {
+		statuses[inst.PodName] = inst
+		if isInstanceConfigUpdated(ctx, inst, configHash) {
+			rollout.UpdatedInstances = append(rollout.UpdatedInstances, inst.PodName)
+		}
+	}
+	slices.Sort(rollout.UpdatedInstances)
+	succeed := int32(len(rollout.UpdatedInstances))
+
+	var pending, degraded []string
+	for _, name := range config.Instances {
+		inst, ok := statuses[name]
+		switch {
+		case !ok || !isInstanceConfigUpdated(ctx, inst, configHash):
+			pending = append(pending, name)
+		case !isInstanceHealthy(ctx.ITS, inst):
+			if rollout.BakeStartTime != nil || slices.Contains(rollout.BakedInstances, name) {
+				degraded = append(degraded, name)
+			} else {
+				pending = append(pending, name)
+			}
+		}
+	}
+	if len(degraded) > 0 {
+		return makeStatus(StatusFailed,
+			withReason(fmt.Sprintf("%s: the instances %s degraded after applying the changes", rolloutReasonPrefix, strings.Join(degraded, ","))),
+			withExpected(replicas), withSucceed(succeed), withRollout(rollout), withRevert())
+	}
+	if len(pending) > 0 {
+		deadline := rollout.BatchStartTime.Add(rolloutDeadline(strategy))
+		if now.After(deadline) {
+			return makeStatus(StatusFailed,
+				withReason(fmt.Sprintf("%s: the instances %s did not apply the changes and become available in %s",
+					rolloutReasonPrefix, strings.Join(pending, ","), rolloutDeadline(strategy))),
+				withExpected(replicas), withSucceed(succeed), withRollout(rollout), withRevert())
+		}
+		return makeStatus(StatusRetry,
+			withReason(fmt.Sprintf("%s: wait for the instances %s to apply the changes", rolloutReasonPrefix, strings.Join(pending, ","))),
+			withExpected(replicas), withSucceed(succeed), withRollout(rollout))
+	}
+
+	if rollout.BakeStartTime == nil {
+		rollout.BakeStartTime = ptr.To(metav1.NewTime(now))
+	}
+	bake := time.Duration(strategy.BakeSeconds) * time.Second
+	if now.Before(rollout.BakeStartTime.Add(bake)) {
+		return makeStatus(StatusRetry,
+			withReason(fmt.Sprintf("%s: bake the instances %s until %s", rolloutReasonPrefix,
+				strings.Join(config.Instances, ","), rollout.BakeStartTime.Add(bake).Format(time.RFC3339))),
+			withExpected(replicas), withSucceed(succeed), withRollout(rollout))
+	}
+
+	next := nextRolloutBatch(strategy, ctx.ITS, config.Instances)
+	if len(next) == 0 {
+		// all the replicas have been covered, apply the changes to all of them.
+		config.Instances = nil
+		rollout.Instances = nil
+		rollout.BatchStartTime = nil
+		rollout.BakeStartTime = nil
+		rollout.BakedInstances = nil
+		status := syncReconfigureStatus(ctx)
+		status.Rollout = rollout
+		return status
+	}
+	rollout.BakedInstances = slices.Clone(config.Instances)
+	config.Instances = append(config.Instances, next...)
+	rollout.Instances = slices.Clone(config.Instances)
+	rollout.BatchStartTime = ptr.To(metav1.NewTime(now))
+	rollout.BakeStartTime = nil
+	return makeStatus(StatusRetry,
+		withReason(fmt.Sprintf("%s: apply changes to the instances %s", rolloutReasonPrefix, strings.Join(next, ","))),
+		withExpected(replicas), withSucceed(succeed), withRollout(rollout))
+}
+
+// nextRolloutBatch returns the replicas to roll out to next, which are not covered by the instances yet.
+func nextRolloutBatch(strategy *parametersv1alpha1.ParameterRolloutStrategy, its *workloads.InstanceSet, instances []string) []string {
+	size := int(strategy.BatchSize)
+	if size <= 0 {
+		size = defaultRolloutBatchSize
+	}
+	var next []string
+	for _, name := range rolloutOrder(strategy, its) {
+		if len(next) >= size {
+			break
+		}
+		if !slices.Contains(instances, name) {
+			next = append(next, name)
+		}
+	}
+	return next
+}
+
+// rolloutOrder returns the replicas in the order to roll out to: the replicas with the canary role first,
+// then the others in the order of their role update priority, from low to high.
+func rolloutOrder(strategy *parametersv1alpha1.ParameterRolloutStrategy, its *workloads.InstanceSet) []string {
+	priority := func(inst *workloads.InstanceStatus) int {
+		if strategy != nil && strategy.CanaryRole != "" && inst.Role == strategy.CanaryRole {
+			return -1
+		}
+		for _, role := range its.Spec.Roles {
+			if role.Name == inst.Role {
+				return role.UpdatePriority
+			}
+		}
+		return 0
+	}
+	instances := its.ActivePresentInstanceStatuses()
+	slices.SortStableFunc(instances, func(a, b *workloads.InstanceStatus) int {
+		if pa, pb := priority(a), priority(b); pa != pb {
+			return pa - pb
+		}
+		return strings.Compare(a.PodName, b.PodName)
+	})
+	names := make([]string, 0, len(instances))
+	for _, inst := range instances {
+		names = append(names, inst.PodName)
+	}
+	return names
+}
+
+func rolloutDeadline(strategy *parametersv1alpha1.ParameterRolloutStrategy) time.Duration {
+	seconds := strategy.ProgressDeadlineSeconds
+	if seconds <= 0 {
+		seconds = defaultRolloutDeadlineSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func isInstanceConfigUpdated(ctx Context, inst *workloads.InstanceStatus, configHash *string) bool {
+	idx := slices.IndexFunc(inst.Configs, func(cfg workloads.InstanceConfigStatus) bool {
+		return cfg.Name == ctx.ConfigTemplate.Name
+	})
+	return idx >= 0 && ptr.Equal(inst.Configs[idx].ConfigHash, configHash)
+}
+
+// isInstanceHealthy checks the instance through the results of the available probe and the role probe.
+func isInstanceHealthy(its *workloads.InstanceSet, inst *workloads.InstanceStatus) bool {
+	if !inst.Available || inst.Failed {
+		return false
+	}
+	return len(its.Spec.Roles) == 0 || inst.Role != ""
+}
@@ -0,0 +1,222 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package reconfigure
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1"
+)
+
+func newRolloutContext(strategy *parametersv1alpha1.ParameterRolloutStrategy) Context {
+	instance := func(name, role string) workloads.InstanceStatus {
+		return workloads.InstanceStatus{
+			PodName:      name,
+			DesiredState: workloads.InstanceDesiredStateActive,
+			CurrentState: workloads.InstanceCurrentStatePresent,
+			Available:    true,
+			Role:         role,
+			Configs: []workloads.InstanceConfigStatus{{
+				Name:       "my.cnf",
+				ConfigHash: ptr.To("old-hash"),
+			}},
+		}
+	}
+	return Context{
+		ConfigTemplate: appsv1.ComponentFileTemplate{Name: "my.cnf"},
+		ConfigHash:     ptr.To("new-hash"),
+		ClusterComponent: &appsv1.ClusterComponentSpec{
+			Replicas: 3,
+			Configs:  []appsv1.ClusterComponentConfig{{Name: ptr.To("my.cnf")}},
+		},
+		ITS: &workloads.InstanceSet{
+			Spec: workloads.InstanceSetSpec{
+				Roles: []workloads.ReplicaRole{
+					{Name: "primary", UpdatePriority: 2},
+					{Name: "secondary", UpdatePriority: 1},
+				},
+			},
+			Status: workloads.InstanceSetStatus{
+				InstanceStatus: []workloads.InstanceStatus{
+					instance("mysql-0", "primary"),
+					instance("mysql-1", "secondary"),
+					instance("mysql-2", "secondary"),
+				},
+			},
+		},
+		RolloutStrategy: strategy,
+	}
+}
+
+// applyRolloutToInstances mocks the instances which the changes are rolled out to.
+func applyRolloutToInstances(ctx Context) {
+	config := ctx.ClusterComponent.Configs[0]
+	for i, inst := range ctx.ITS.Status.InstanceStatus {
+		if len(config.Instances) == 0 || slices.Contains(config.Instances, inst.PodName) {
+			ctx.ITS.Status.InstanceStatus[i].Configs[0].ConfigHash = config.ConfigHash
+		}
+	}
+}
+
+func setRolloutNow(t *testing.T, now *time.Time) {
+	origin := rolloutNow
+	rolloutNow = func() time.Time { return *now }
+	t.Cleanup(func() { rolloutNow = origin })
+}
+
+func TestRolloutOrder(t *testing.T) {
+	ctx := newRolloutContext(&parametersv1alpha1.ParameterRolloutStrategy{})
+	if got := rolloutOrder(ctx.RolloutStrategy, ctx.ITS); !slices.Equal(got, []string{"mysql-1", "mysql-2", "mysql-0"}) {
+		t.Fatalf("unexpected order by update priority: %v", got)
+	}
+
+	ctx.RolloutStrategy.CanaryRole = "primary"
+	if got := rolloutOrder(ctx.RolloutStrategy, ctx.ITS); !slices.Equal(got, []string{"mysql-0", "mysql-1", "mysql-2"}) {
+		t.Fatalf("unexpected order with the canary role: %v", got)
+	}
+}
+
+func TestStagedRollout(t *testing.T) {
+	now := time.Now()
+	setRolloutNow(t, &now)
+
+	ctx := newRolloutContext(&parametersv1alpha1.ParameterRolloutStrategy{
+		BatchSize:               2,
+		BakeSeconds:             60,
+		ProgressDeadlineSeconds: 600,
+	})
+	status, err := submit(ctx, nil, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config := &ctx.ClusterComponent.Configs[0]
+	if status.Status != StatusRetry || !slices.Equal(config.Instances, []string{"mysql-1"}) {
+		t.Fatalf("expected the canary only, got status %s and instances %v", status.Status, config.Instances)
+	}
+
+	// the canary has not applied the changes yet
+	ctx.Rollout = status.Rollout
+	status, _ = submit(ctx, nil, true)
+	if status.Status != StatusRetry || status.Rollout.BakeStartTime != nil {
+		t.Fatalf("expected to wait for the canary, got status %s", status.Status)
+	}
+
+	// the canary applied the changes, bake it
+	applyRolloutToInstances(ctx)
+	ctx.Rollout = status.Rollout
+	status, _ = submit(ctx, nil, true)
+	if status.Status != StatusRetry || status.Rollout.BakeStartTime == nil || len(config.Instances) != 1 {
+		t.Fatalf("expected to bake the canary, got status %s and instances %v", status.Status, config.Instances)
+	}
+
+	// the bake time passed, move on to the next batch
+	now = now.Add(61 * time.Second)
+	ctx.Rollout = status.Rollout
+	status, _ = submit(ctx, nil, true)
+	if status.Status != StatusRetry || !slices.Equal(config.Instances, []string{"mysql-1", "mysql-2", "mysql-0"}) {
+		t.Fatalf("expected the next batch, got status %s and instances %v", status.Status, config.Instances)
+	}
+	if status.SucceedCount != 1 || !slices.Equal(status.Rollout.UpdatedInstances, []string{"mysql-1"}) {
+		t.Fatalf("unexpected progress: %d, %v", status.SucceedCount, status.Rollout.UpdatedInstances)
+	}
+
+	// all the replicas applied the changes and baked
+	applyRolloutToInstances(ctx)
+	ctx.Rollout = status.Rollout
+	status, _ = submit(ctx, nil, true)
+	now = now.Add(61 * time.Second)
+	ctx.Rollout = status.Rollout
+	status, _ = submit(ctx, nil, true)
+	if status.Status != StatusNone || len(config.Instances) != 0 || status.SucceedCount != 3 {
+		t.Fatalf("expected the rollout completed, got status %s and instances %v", status.Status, config.Instances)
+	}
+}
+
+func TestStagedRolloutRevert(t *testing.T) {
+	now := time.Now()
+	setRolloutNow(t, &now)
+
+	t.Run("degraded while baking", func(t *testing.T) {
+		ctx := newRolloutContext(&parametersv1alpha1.ParameterRolloutStrategy{BakeSeconds: 60, ProgressDeadlineSeconds: 600})
+		status, _ := submit(ctx, nil, false)
+		applyRolloutToInstances(ctx)
+		ctx.Rollout = status.Rollout
+		status, _ = submit(ctx, nil, false)
+		if status.Rollout.BakeStartTime == nil {
+			t.Fatalf("expected to bake the canary")
+		}
+
+		ctx.ITS.Status.InstanceStatus[1].Available = false
+		ctx.Rollout = status.Rollout
+		status, _ = submit(ctx, nil, false)
+		if status.Status != StatusFailed || !status.Revert {
+			t.Fatalf("expected to revert, got status %s", status.Status)
+		}
+	})
+
+	t.Run("baked instance degraded while rolling out the next batch", func(t *testing.T) {
+		ctx := newRolloutContext(&parametersv1alpha1.ParameterRolloutStrategy{BakeSeconds: 60, ProgressDeadlineSeconds: 600})
+		status, _ := submit(ctx, nil, false)
+		applyRolloutToInstances(ctx)
+		ctx.Rollout = status.Rollout
+		status, _ = submit(ctx, nil, false)
+		now = now.Add(61 * time.Second)
+		ctx.Rollout = status.Rollout
+		status, _ = submit(ctx, nil, false)
+		if status.Status != StatusRetry || !slices.Equal(status.Rollout.BakedInstances, []string{"mysql-1"}) {
+			t.Fatalf("expected the canary baked, got status %s and baked instances %v", status.Status, status.Rollout.BakedInstances)
+		}
+
+		// the next batch has not applied the changes yet, and the canary degrades
+		ctx.ITS.Status.InstanceStatus[1].Available = false
+		ctx.Rollout = status.Rollout
+		status, _ = submit(ctx, nil, false)
+		if status.Status != StatusFailed || !status.Revert {
+			t.Fatalf("expected to revert, got status %s", status.Status)
+		}
+	})
+
+	t.Run("progress deadline exceeded", func(t *testing.T) {
+		ctx := newRolloutContext(&parametersv1alpha1.ParameterRolloutStrategy{BakeSeconds: 60, ProgressDeadlineSeconds: 600})
+		status, _ := submit(ctx, nil, false)
+		ctx.Rollout = status.Rollout
+		ctx.Rollout.BatchStartTime = ptr.To(metav1.NewTime(now.Add(-601 * time.Second)))
+		status, _ = submit(ctx, nil, false)
+		if status.Status != StatusFailed || !status.Revert {
+			t.Fatalf("expected to revert, got status %s", status.Status)
+		}
+	})
+
+	t.Run("roll out the reverted changes at once", func(t *testing.T) {
+		ctx := newRolloutContext(&parametersv1alpha1.ParameterRolloutStrategy{})
+		ctx.ITS.Status.InstanceStatus[0].Configs[0].ConfigHash = ctx.ConfigHash
+		status, _ := submit(ctx, nil, false)
+		if status.Status != StatusRetry || status.Rollout != nil || len(ctx.ClusterComponent.Configs[0].Instances) != 0 {
+			t.Fatalf("expected to apply the changes to all the replicas, got status %s", status.Status)
+		}
+	})
+}
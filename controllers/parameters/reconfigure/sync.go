@@ -27,6 +27,7 @@ import (
 	"slices"
 	"sort"
 	"strconv"
+	"time"
 
 	"k8s.io/utils/ptr"
 
@@ -112,11 +113,44 @@ func submit(ctx Context, parameters map[string]string, restart bool) (Status, er
 		config = &ctx.ClusterComponent.Configs[len(ctx.ClusterComponent.Configs)-1]
 	}
 	if !ptr.Equal(config.ConfigHash, ctx.getTargetConfigHash()) {
-		return applyChangesToCluster(ctx, config, parameters, restart), nil
+		if restart {
+			if status, err := checkMaintenanceWindow(ctx); status != nil || err != nil {
+				return *status, err
+			}
+		}
+		status := applyChangesToCluster(ctx, config, parameters, restart)
+		if status.Status == StatusRetry && stagedRollout(ctx) {
+			return startRollout(ctx, config), nil
+		}
+		return status, nil
+	}
+	if len(config.Instances) > 0 {
+		if stagedRollout(ctx) {
+			return continueRollout(ctx, config), nil
+		}
+		config.Instances = nil // the rollout strategy is removed, apply the changes to all the replicas
 	}
 	return syncReconfigureStatus(ctx), nil
 }
 
+// checkMaintenanceWindow holds the restart until the maintenance window of the cluster opens,
+// it returns nil if the restart is allowed.
+func checkMaintenanceWindow(ctx Context) (*Status, error) {
+	if ctx.Cluster == nil {
+		return nil, nil
+	}
+	allowed, next, err := ctx.Cluster.Spec.MaintenanceWindowPolicy.Allows(appsv1.ReconfigureRestartOperation, time.Now())
+	if err != nil {
+		status := makeStatus(StatusFailedAndRetry, withReason(err.Error()))
+		return &status, err
+	}
+	if !allowed {
+		status := makeStatus(StatusRetry, withReason(fmt.Sprintf("wait for the maintenance window which opens at %s", next.Format(time.RFC3339))))
+		return &status, nil
+	}
+	return nil, nil
+}
+
 func applyChangesToCluster(ctx Context, config *appsv1.ClusterComponentConfig, params map[string]string, restart bool) Status {
 	if !shouldBuildLegacyReconfigureAction(ctx, params, restart) && shouldRejectTemplateReconfigureAction(ctx, params, restart) {
 		return makeStatus(StatusFailed, withReason("parameter update reconfigure currently supports only exec actions"))
@@ -126,6 +160,7 @@ func applyChangesToCluster(ctx Context, config *appsv1.ClusterComponentConfig, p
 		systemParams = buildUpdatedConfigFileChecksums(ctx)
 	}
 	config.ConfigHash = ctx.getTargetConfigHash()
+	config.Instances = nil
 	// Keep restart explicit so an old persisted `restart: true` is actively cleared.
 	config.Restart = ptr.To(restart)
 	config.Reconfigure = ptr.To(false)
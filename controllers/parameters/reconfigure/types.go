@@ -43,6 +43,9 @@ type Status struct {
 	Reason        string
 	ExpectedCount int32
 	SucceedCount  int32
+
+	Rollout *parametersv1alpha1.ParameterRolloutStatus
+	Revert  bool // the staged rollout failed and the changes should be reverted
 }
 
 func makeStatus(status string, ops ...func(status *Status)) Status {
@@ -75,6 +78,18 @@ func withExpected(expectedCount int32) func(status *Status) {
 	}
 }
 
+func withRollout(rollout *parametersv1alpha1.ParameterRolloutStatus) func(status *Status) {
+	return func(status *Status) {
+		status.Rollout = rollout
+	}
+}
+
+func withRevert() func(status *Status) {
+	return func(status *Status) {
+		status.Revert = true
+	}
+}
+
 type Context struct {
 	intctrlutil.RequestCtx
 	Client client.Client
@@ -90,6 +105,9 @@ type Context struct {
 	ConfigDescription *parametersv1alpha1.ComponentConfigDescription
 	ParametersDef     *parametersv1alpha1.ParametersDefinitionSpec
 	Patch             *core.ConfigPatchInfo
+
+	RolloutStrategy *parametersv1alpha1.ParameterRolloutStrategy
+	Rollout         *parametersv1alpha1.ParameterRolloutStatus // the progress of the staged rollout of the current revision
 }
 
 func (c *Context) getTargetConfigHash() *string {
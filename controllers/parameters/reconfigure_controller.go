@@ -23,8 +23,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -120,7 +123,7 @@ func (r *ReconfigureReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return updateConfigPhase(r.Client, reqCtx, config, parametersv1alpha1.CFinishedPhase, configurationNoChangedMessage)
 	}
 
-	configSpec, err := r.getConfigSpec(reqCtx, config)
+	configSpec, compParam, err := r.getConfigSpec(reqCtx, config)
 	if err != nil {
 		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log,
 			errors.Wrap(err, "failed to fetch related resources").Error())
@@ -135,7 +138,7 @@ func (r *ReconfigureReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return updateConfigPhase(r.Client, reqCtx, config, parametersv1alpha1.CFinishedPhase, configurationNotRelatedComponentMessage)
 	}
 
-	return r.sync(reqCtx, config, configSpec)
+	return r.sync(reqCtx, config, configSpec, compParam)
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -169,10 +172,10 @@ func checkConfigurationObject(object client.Object) bool {
 	return checkEnableCfgUpgrade(object)
 }
 
-func (r *ReconfigureReconciler) getConfigSpec(reqCtx intctrlutil.RequestCtx, cm *corev1.ConfigMap) (*appsv1.ComponentFileTemplate, error) {
+func (r *ReconfigureReconciler) getConfigSpec(reqCtx intctrlutil.RequestCtx, cm *corev1.ConfigMap) (*appsv1.ComponentFileTemplate, *parametersv1alpha1.ComponentParameter, error) {
 	configSpecName, ok := cm.Labels[constant.CMConfigurationSpecProviderLabelKey]
 	if !ok {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	key := client.ObjectKey{
@@ -181,17 +184,18 @@ func (r *ReconfigureReconciler) getConfigSpec(reqCtx intctrlutil.RequestCtx, cm
 	}
 	obj := &parametersv1alpha1.ComponentParameter{}
 	if err := r.Client.Get(reqCtx.Ctx, key, obj); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	configSpec := parameters.GetConfigTemplateItem(&obj.Spec, configSpecName)
 	if configSpec == nil {
-		return nil, fmt.Errorf("not found config spec: %s in configuration[%s]", configSpecName, obj.Name)
+		return nil, nil, fmt.Errorf("not found config spec: %s in configuration[%s]", configSpecName, obj.Name)
 	}
-	return configSpec.ConfigSpec, nil
+	return configSpec.ConfigSpec, obj, nil
 }
 
-func (r *ReconfigureReconciler) sync(reqCtx intctrlutil.RequestCtx, configMap *corev1.ConfigMap, configSpec *appsv1.ComponentFileTemplate) (ctrl.Result, error) {
+func (r *ReconfigureReconciler) sync(reqCtx intctrlutil.RequestCtx, configMap *corev1.ConfigMap,
+	configSpec *appsv1.ComponentFileTemplate, compParam *parametersv1alpha1.ComponentParameter) (ctrl.Result, error) {
 	rctx := newReconcileContext(reqCtx, &render.ResourceCtx{
 		Context:       reqCtx.Ctx,
 		Client:        r.Client,
@@ -202,6 +206,10 @@ func (r *ReconfigureReconciler) sync(reqCtx intctrlutil.RequestCtx, configMap *c
 	if err := rctx.objects(); err != nil {
 		return intctrlutil.RequeueWithErrorAndRecordEvent(configMap, r.Recorder, err, reqCtx.Log)
 	}
+	rctx.componentParameter = compParam
+	if revision := configMap.Annotations[constant.ConfigurationRevision]; revision != "" {
+		rctx.rollout = parseResult(configMap.Annotations[core.GenerateRevisionPhaseKey(revision)], revision).Rollout
+	}
 
 	// Assumption: It is required that the cluster must have a component.
 	if rctx.ClusterComObj == nil {
@@ -329,6 +337,8 @@ func (r *ReconfigureReconciler) buildReloadTask(policy reconfigure.Policy,
 		ConfigDescription: configDescription,
 		ParametersDef:     &pd.Spec,
 		Patch:             patch,
+		RolloutStrategy:   rctx.rolloutStrategy(),
+		Rollout:           rctx.rollout,
 	}
 	return reconfigure.Task{Policy: policy, Ctx: reCtx}
 }
@@ -344,6 +354,8 @@ func (r *ReconfigureReconciler) buildRestartTask(configTemplate *appsv1.Componen
 			Cluster:          rctx.ClusterObj,
 			ClusterComponent: rctx.ClusterComObj,
 			ITS:              rctx.its,
+			RolloutStrategy:  rctx.rolloutStrategy(),
+			Rollout:          rctx.rollout,
 		},
 	}
 }
@@ -452,6 +464,9 @@ func (r *ReconfigureReconciler) status(rctx *reconcileContext, policy string, st
 	case reconfigure.StatusRetry:
 		return updatePhase(parametersv1alpha1.CUpgradingPhase)
 	case reconfigure.StatusFailed:
+		if status.Revert {
+			return r.revert(rctx, policy, status)
+		}
 		return updatePhase(parametersv1alpha1.CFailedAndPausePhase, withFailed(err, false))
 	case reconfigure.StatusNone:
 		return r.succeed(rctx, policy, status)
@@ -470,6 +485,73 @@ func (r *ReconfigureReconciler) succeed(rctx *reconcileContext, policy string, s
 	return r.updateConfigCMStatus(rctx.RequestCtx, rctx.configMap, policy, &result)
 }
 
+// revert reverts the parameters to the last applied configuration when the staged rollout fails,
+// or pauses the reconfiguring if the auto revert is disabled.
+func (r *ReconfigureReconciler) revert(rctx *reconcileContext, policy string, status reconfigure.Status) (ctrl.Result, error) {
+	var (
+		cm     = rctx.configMap
+		reason = errors.New(status.Reason)
+		pause  = func(err error) (ctrl.Result, error) {
+			rctx.Recorder.Event(cm, corev1.EventTypeWarning, reasonReconfigureFailed, err.Error())
+			return updateConfigPhaseWithResult(rctx.Client, rctx.RequestCtx, cm,
+				reconciled(status, policy, parametersv1alpha1.CFailedAndPausePhase, withFailed(err, false)))
+		}
+	)
+	strategy := rctx.rolloutStrategy()
+	if strategy == nil || !ptr.Deref(strategy.AutoRevert, true) {
+		return pause(reason)
+	}
+
+	lastConfig, err := getLastVersionConfig(cm)
+	if err != nil {
+		return pause(errors.Wrapf(err, "%s, and failed to get the last applied configuration", status.Reason))
+	}
+	params, err := parameters.RollbackParameters(cm.Data, lastConfig, rctx.configDescs)
+	if err != nil {
+		return pause(errors.Wrapf(err, "%s, and failed to revert the parameters", status.Reason))
+	}
+	if len(params) == 0 {
+		return pause(reason)
+	}
+
+	// take the current configuration as applied, so that the reverted one will be reconfigured to the replicas
+	configData, err := json.Marshal(cm.Data)
+	if err != nil {
+		return intctrlutil.RequeueWithError(err, rctx.Log, "")
+	}
+	patch := client.MergeFrom(cm.DeepCopy())
+	cm.Annotations[constant.LastAppliedConfigAnnotationKey] = string(configData)
+	if err = rctx.Client.Patch(rctx.Ctx, cm, patch); err != nil {
+		return intctrlutil.RequeueWithError(err, rctx.Log, "")
+	}
+
+	compParam := rctx.componentParameter
+	compParamPatch := client.MergeFrom(compParam.DeepCopy())
+	if compParam.Spec.Desired == nil {
+		compParam.Spec.Desired = &parametersv1alpha1.ParameterInputs{}
+	}
+	if compParam.Spec.Desired.Assignments == nil {
+		compParam.Spec.Desired.Assignments = map[string]*string{}
+	}
+	for key, value := range params {
+		compParam.Spec.Desired.Assignments[key] = value
+	}
+	if err = rctx.Client.Patch(rctx.Ctx, compParam, compParamPatch); err != nil {
+		return intctrlutil.RequeueWithError(err, rctx.Log, "")
+	}
+
+	reverted := slices.Sorted(maps.Keys(params))
+	reason = errors.Errorf("%s, revert the parameters to the last applied configuration, "+
+		"the previous values of %s are set as explicit assignments in spec.desired", status.Reason, strings.Join(reverted, ","))
+	rctx.Recorder.Event(cm, corev1.EventTypeWarning, reasonReconfigureFailed, reason.Error())
+	if status.Rollout != nil {
+		status.Rollout.Reverted = true
+		status.Rollout.RevertedParameters = reverted
+	}
+	return updateConfigPhaseWithResult(rctx.Client, rctx.RequestCtx, cm,
+		reconciled(status, policy, parametersv1alpha1.CFailedPhase, withFailed(reason, true)))
+}
+
 func computeTargetConfigHash(reqCtx *intctrlutil.RequestCtx, data map[string]string) *string {
 	hash, err := intctrlutil.ComputeHash(data)
 	if err != nil {
@@ -557,6 +639,7 @@ func reconciled(status reconfigure.Status, policy string, phase parametersv1alph
 		SucceedCount:  status.SucceedCount,
 		Retry:         true,
 		Message:       status.Reason,
+		Rollout:       status.Rollout,
 	}
 	for _, option := range options {
 		option(&result)
@@ -633,6 +716,11 @@ func updateConfigPhaseWithResult(cli client.Client, ctx intctrlutil.RequestCtx,
 		b, _ := json.Marshal(result)
 		config.ObjectMeta.Annotations[core.GenerateRevisionPhaseKey(revision)] = string(b)
 	}
+	if result.Phase == parametersv1alpha1.CFinishedPhase {
+		if err := parameters.SetRevisionSnapshot(config, revision); err != nil {
+			return intctrlutil.RequeueWithError(err, ctx.Log, "")
+		}
+	}
 
 	if err := cli.Patch(ctx.Ctx, config, patch); err != nil {
 		return intctrlutil.RequeueWithError(err, ctx.Log, "")
@@ -674,6 +762,10 @@ func updateAppliedConfigs(cli client.Client, ctx intctrlutil.RequestCtx, config
 		result.Revision = revision
 		b, _ := json.Marshal(result)
 		config.ObjectMeta.Annotations[core.GenerateRevisionPhaseKey(revision)] = string(b)
+		// the snapshot of the applied revision is used to roll back to it later
+		if err := parameters.SetRevisionSnapshot(config, revision); err != nil {
+			return false, err
+		}
 	}
 	config.ObjectMeta.Annotations[constant.LastAppliedConfigAnnotationKey] = string(configData)
 	hash, err := intctrlutil.ComputeHash(config.Data)
@@ -49,6 +49,8 @@ func gcConfigRevision(configObj *corev1.ConfigMap) {
 	if len(revisions) > 0 {
 		for _, v := range revisions {
 			delete(configObj.Annotations, core.GenerateRevisionPhaseKey(v.strRevision))
+			delete(configObj.Annotations, core.GenerateRevisionSnapshotKey(v.strRevision))
+			delete(configObj.Annotations, core.GenerateRevisionParametersKey(v.strRevision))
 		}
 	}
 }
@@ -71,8 +71,11 @@ func TestGcConfigRevision(t *testing.T) {
 		AddAnnotations(core.GenerateRevisionPhaseKey("9"), "Finished").
 		AddAnnotations(core.GenerateRevisionPhaseKey("10"), "Finished").
 		AddAnnotations(core.GenerateRevisionPhaseKey("11"), "Finished").
+		AddAnnotations(constant.ConfigAppliedVersionAnnotationKey, `{"name":"test","configFileParams":{"my.cnf":{"parameters":{"max_connections":"100"}}}}`).
 		AddAnnotations(core.GenerateRevisionPhaseKey("12"), `{"Phase":"Finished","Revision":"12","Policy":"","ExecResult":"","SucceedCount":0,"ExpectedCount":0,"Retry":false,"Failed":false,"Message":"the configuration file has not been modified, skip reconfigure"}`).
 		GetObject()
+	assert.NoError(t, parameters.SetRevisionSnapshot(cm, "1"))
+	assert.NoError(t, parameters.SetRevisionSnapshot(cm, "12"))
 
 	assert.Equal(t, 12, len(retrieveRevision(cm.GetAnnotations())))
 
@@ -83,6 +86,10 @@ func TestGcConfigRevision(t *testing.T) {
 
 	gcConfigRevision(cm)
 	assert.Equal(t, 10, len(retrieveRevision(cm.GetAnnotations())))
+	assert.NotContains(t, cm.GetAnnotations(), core.GenerateRevisionSnapshotKey("1"))
+	assert.NotContains(t, cm.GetAnnotations(), core.GenerateRevisionParametersKey("1"))
+	assert.Contains(t, cm.GetAnnotations(), core.GenerateRevisionSnapshotKey("12"))
+	assert.Contains(t, cm.GetAnnotations(), core.GenerateRevisionParametersKey("12"))
 }
 
 func TestParseRevision(t *testing.T) {
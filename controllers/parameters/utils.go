@@ -40,6 +40,9 @@ type reconcileContext struct {
 	its            *workloads.InstanceSet
 	configDescs    []parametersv1alpha1.ComponentConfigDescription
 	parametersDefs map[string]*parametersv1alpha1.ParametersDefinition
+
+	componentParameter *parametersv1alpha1.ComponentParameter
+	rollout            *parametersv1alpha1.ParameterRolloutStatus
 }
 
 func newReconcileContext(reqCtx intctrlutil.RequestCtx, resource *render.ResourceCtx, cm *corev1.ConfigMap, cluster *appsv1.Cluster) *reconcileContext {
@@ -53,6 +56,13 @@ func newReconcileContext(reqCtx intctrlutil.RequestCtx, resource *render.Resourc
 	return rctx.Init(resource, &rctx)
 }
 
+func (c *reconcileContext) rolloutStrategy() *parametersv1alpha1.ParameterRolloutStrategy {
+	if c.componentParameter == nil {
+		return nil
+	}
+	return c.componentParameter.Spec.RolloutStrategy
+}
+
 func (c *reconcileContext) objects() error {
 	return c.Cluster().
 		ComponentAndComponentDef().
@@ -0,0 +1,178 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	scheme "github.com/apecloud/kubeblocks/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// BackupCatalogsGetter has a method to return a BackupCatalogInterface.
+// A group's client should implement this interface.
+type BackupCatalogsGetter interface {
+	BackupCatalogs(namespace string) BackupCatalogInterface
+}
+
+// BackupCatalogInterface has methods to work with BackupCatalog resources.
+type BackupCatalogInterface interface {
+	Create(ctx context.Context, backupCatalog *v1alpha1.BackupCatalog, opts v1.CreateOptions) (*v1alpha1.BackupCatalog, error)
+	Update(ctx context.Context, backupCatalog *v1alpha1.BackupCatalog, opts v1.UpdateOptions) (*v1alpha1.BackupCatalog, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.BackupCatalog, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.BackupCatalogList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BackupCatalog, err error)
+	BackupCatalogExpansion
+}
+
+// backupCatalogs implements BackupCatalogInterface
+type backupCatalogs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newBackupCatalogs returns a BackupCatalogs
+func newBackupCatalogs(c *DataprotectionV1alpha1Client, namespace string) *backupCatalogs {
+	return &backupCatalogs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the backupCatalog, and returns the corresponding backupCatalog object, and an error if there is any.
+func (c *backupCatalogs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.BackupCatalog, err error) {
+	result = &v1alpha1.BackupCatalog{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("backupcatalogs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of BackupCatalogs that match those selectors.
+func (c *backupCatalogs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BackupCatalogList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.BackupCatalogList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("backupcatalogs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested backupCatalogs.
+func (c *backupCatalogs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("backupcatalogs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a backupCatalog and creates it.  Returns the server's representation of the backupCatalog, and an error, if there is any.
+func (c *backupCatalogs) Create(ctx context.Context, backupCatalog *v1alpha1.BackupCatalog, opts v1.CreateOptions) (result *v1alpha1.BackupCatalog, err error) {
+	result = &v1alpha1.BackupCatalog{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("backupcatalogs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(backupCatalog).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a backupCatalog and updates it. Returns the server's representation of the backupCatalog, and an error, if there is any.
+func (c *backupCatalogs) Update(ctx context.Context, backupCatalog *v1alpha1.BackupCatalog, opts v1.UpdateOptions) (result *v1alpha1.BackupCatalog, err error) {
+	result = &v1alpha1.BackupCatalog{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("backupcatalogs").
+		Name(backupCatalog.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(backupCatalog).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the backupCatalog and deletes it. Returns an error if one occurs.
+func (c *backupCatalogs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("backupcatalogs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *backupCatalogs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("backupcatalogs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched backupCatalog.
+func (c *backupCatalogs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BackupCatalog, err error) {
+	result = &v1alpha1.BackupCatalog{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("backupcatalogs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
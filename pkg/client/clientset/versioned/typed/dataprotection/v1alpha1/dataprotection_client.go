@@ -30,10 +30,12 @@ type DataprotectionV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ActionSetsGetter
 	BackupsGetter
+	BackupCatalogsGetter
 	BackupPoliciesGetter
 	BackupPolicyTemplatesGetter
 	BackupReposGetter
 	BackupSchedulesGetter
+	BackupVerificationsGetter
 	RestoresGetter
 	StorageProvidersGetter
 }
@@ -51,6 +53,10 @@ func (c *DataprotectionV1alpha1Client) Backups(namespace string) BackupInterface
 	return newBackups(c, namespace)
 }
 
+func (c *DataprotectionV1alpha1Client) BackupCatalogs(namespace string) BackupCatalogInterface {
+	return newBackupCatalogs(c, namespace)
+}
+
 func (c *DataprotectionV1alpha1Client) BackupPolicies(namespace string) BackupPolicyInterface {
 	return newBackupPolicies(c, namespace)
 }
@@ -67,6 +73,10 @@ func (c *DataprotectionV1alpha1Client) BackupSchedules(namespace string) BackupS
 	return newBackupSchedules(c, namespace)
 }
 
+func (c *DataprotectionV1alpha1Client) BackupVerifications(namespace string) BackupVerificationInterface {
+	return newBackupVerifications(c, namespace)
+}
+
 func (c *DataprotectionV1alpha1Client) Restores(namespace string) RestoreInterface {
 	return newRestores(c, namespace)
 }
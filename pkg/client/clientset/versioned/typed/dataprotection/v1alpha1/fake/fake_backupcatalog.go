@@ -0,0 +1,129 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeBackupCatalogs implements BackupCatalogInterface
+type FakeBackupCatalogs struct {
+	Fake *FakeDataprotectionV1alpha1
+	ns   string
+}
+
+var backupcatalogsResource = v1alpha1.SchemeGroupVersion.WithResource("backupcatalogs")
+
+var backupcatalogsKind = v1alpha1.SchemeGroupVersion.WithKind("BackupCatalog")
+
+// Get takes name of the backupCatalog, and returns the corresponding backupCatalog object, and an error if there is any.
+func (c *FakeBackupCatalogs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.BackupCatalog, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(backupcatalogsResource, c.ns, name), &v1alpha1.BackupCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackupCatalog), err
+}
+
+// List takes label and field selectors, and returns the list of BackupCatalogs that match those selectors.
+func (c *FakeBackupCatalogs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BackupCatalogList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(backupcatalogsResource, backupcatalogsKind, c.ns, opts), &v1alpha1.BackupCatalogList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.BackupCatalogList{ListMeta: obj.(*v1alpha1.BackupCatalogList).ListMeta}
+	for _, item := range obj.(*v1alpha1.BackupCatalogList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested backupCatalogs.
+func (c *FakeBackupCatalogs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(backupcatalogsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a backupCatalog and creates it.  Returns the server's representation of the backupCatalog, and an error, if there is any.
+func (c *FakeBackupCatalogs) Create(ctx context.Context, backupCatalog *v1alpha1.BackupCatalog, opts v1.CreateOptions) (result *v1alpha1.BackupCatalog, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(backupcatalogsResource, c.ns, backupCatalog), &v1alpha1.BackupCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackupCatalog), err
+}
+
+// Update takes the representation of a backupCatalog and updates it. Returns the server's representation of the backupCatalog, and an error, if there is any.
+func (c *FakeBackupCatalogs) Update(ctx context.Context, backupCatalog *v1alpha1.BackupCatalog, opts v1.UpdateOptions) (result *v1alpha1.BackupCatalog, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(backupcatalogsResource, c.ns, backupCatalog), &v1alpha1.BackupCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackupCatalog), err
+}
+
+// Delete takes name of the backupCatalog and deletes it. Returns an error if one occurs.
+func (c *FakeBackupCatalogs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(backupcatalogsResource, c.ns, name, opts), &v1alpha1.BackupCatalog{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeBackupCatalogs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(backupcatalogsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.BackupCatalogList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched backupCatalog.
+func (c *FakeBackupCatalogs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BackupCatalog, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(backupcatalogsResource, c.ns, name, pt, data, subresources...), &v1alpha1.BackupCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackupCatalog), err
+}
@@ -0,0 +1,141 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeBackupVerifications implements BackupVerificationInterface
+type FakeBackupVerifications struct {
+	Fake *FakeDataprotectionV1alpha1
+	ns   string
+}
+
+var backupverificationsResource = v1alpha1.SchemeGroupVersion.WithResource("backupverifications")
+
+var backupverificationsKind = v1alpha1.SchemeGroupVersion.WithKind("BackupVerification")
+
+// Get takes name of the backupVerification, and returns the corresponding backupVerification object, and an error if there is any.
+func (c *FakeBackupVerifications) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.BackupVerification, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(backupverificationsResource, c.ns, name), &v1alpha1.BackupVerification{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackupVerification), err
+}
+
+// List takes label and field selectors, and returns the list of BackupVerifications that match those selectors.
+func (c *FakeBackupVerifications) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BackupVerificationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(backupverificationsResource, backupverificationsKind, c.ns, opts), &v1alpha1.BackupVerificationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.BackupVerificationList{ListMeta: obj.(*v1alpha1.BackupVerificationList).ListMeta}
+	for _, item := range obj.(*v1alpha1.BackupVerificationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested backupVerifications.
+func (c *FakeBackupVerifications) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(backupverificationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a backupVerification and creates it.  Returns the server's representation of the backupVerification, and an error, if there is any.
+func (c *FakeBackupVerifications) Create(ctx context.Context, backupVerification *v1alpha1.BackupVerification, opts v1.CreateOptions) (result *v1alpha1.BackupVerification, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(backupverificationsResource, c.ns, backupVerification), &v1alpha1.BackupVerification{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackupVerification), err
+}
+
+// Update takes the representation of a backupVerification and updates it. Returns the server's representation of the backupVerification, and an error, if there is any.
+func (c *FakeBackupVerifications) Update(ctx context.Context, backupVerification *v1alpha1.BackupVerification, opts v1.UpdateOptions) (result *v1alpha1.BackupVerification, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(backupverificationsResource, c.ns, backupVerification), &v1alpha1.BackupVerification{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackupVerification), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeBackupVerifications) UpdateStatus(ctx context.Context, backupVerification *v1alpha1.BackupVerification, opts v1.UpdateOptions) (*v1alpha1.BackupVerification, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(backupverificationsResource, "status", c.ns, backupVerification), &v1alpha1.BackupVerification{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackupVerification), err
+}
+
+// Delete takes name of the backupVerification and deletes it. Returns an error if one occurs.
+func (c *FakeBackupVerifications) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(backupverificationsResource, c.ns, name, opts), &v1alpha1.BackupVerification{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeBackupVerifications) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(backupverificationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.BackupVerificationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched backupVerification.
+func (c *FakeBackupVerifications) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BackupVerification, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(backupverificationsResource, c.ns, name, pt, data, subresources...), &v1alpha1.BackupVerification{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackupVerification), err
+}
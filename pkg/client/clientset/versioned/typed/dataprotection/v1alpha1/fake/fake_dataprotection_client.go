@@ -36,6 +36,10 @@ func (c *FakeDataprotectionV1alpha1) Backups(namespace string) v1alpha1.BackupIn
 	return &FakeBackups{c, namespace}
 }
 
+func (c *FakeDataprotectionV1alpha1) BackupCatalogs(namespace string) v1alpha1.BackupCatalogInterface {
+	return &FakeBackupCatalogs{c, namespace}
+}
+
 func (c *FakeDataprotectionV1alpha1) BackupPolicies(namespace string) v1alpha1.BackupPolicyInterface {
 	return &FakeBackupPolicies{c, namespace}
 }
@@ -52,6 +56,10 @@ func (c *FakeDataprotectionV1alpha1) BackupSchedules(namespace string) v1alpha1.
 	return &FakeBackupSchedules{c, namespace}
 }
 
+func (c *FakeDataprotectionV1alpha1) BackupVerifications(namespace string) v1alpha1.BackupVerificationInterface {
+	return &FakeBackupVerifications{c, namespace}
+}
+
 func (c *FakeDataprotectionV1alpha1) Restores(namespace string) v1alpha1.RestoreInterface {
 	return &FakeRestores{c, namespace}
 }
@@ -22,6 +22,8 @@ type ActionSetExpansion interface{}
 
 type BackupExpansion interface{}
 
+type BackupCatalogExpansion interface{}
+
 type BackupPolicyExpansion interface{}
 
 type BackupPolicyTemplateExpansion interface{}
@@ -30,6 +32,8 @@ type BackupRepoExpansion interface{}
 
 type BackupScheduleExpansion interface{}
 
+type BackupVerificationExpansion interface{}
+
 type RestoreExpansion interface{}
 
 type StorageProviderExpansion interface{}
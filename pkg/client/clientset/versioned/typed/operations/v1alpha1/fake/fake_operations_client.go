@@ -36,6 +36,10 @@ func (c *FakeOperationsV1alpha1) OpsRequests(namespace string) v1alpha1.OpsReque
 	return &FakeOpsRequests{c, namespace}
 }
 
+func (c *FakeOperationsV1alpha1) OpsRequestSchedules(namespace string) v1alpha1.OpsRequestScheduleInterface {
+	return &FakeOpsRequestSchedules{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeOperationsV1alpha1) RESTClient() rest.Interface {
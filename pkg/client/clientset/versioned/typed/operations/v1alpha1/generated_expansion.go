@@ -21,3 +21,5 @@ package v1alpha1
 type OpsDefinitionExpansion interface{}
 
 type OpsRequestExpansion interface{}
+
+type OpsRequestScheduleExpansion interface{}
@@ -0,0 +1,195 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	scheme "github.com/apecloud/kubeblocks/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// OpsRequestSchedulesGetter has a method to return a OpsRequestScheduleInterface.
+// A group's client should implement this interface.
+type OpsRequestSchedulesGetter interface {
+	OpsRequestSchedules(namespace string) OpsRequestScheduleInterface
+}
+
+// OpsRequestScheduleInterface has methods to work with OpsRequestSchedule resources.
+type OpsRequestScheduleInterface interface {
+	Create(ctx context.Context, opsRequestSchedule *v1alpha1.OpsRequestSchedule, opts v1.CreateOptions) (*v1alpha1.OpsRequestSchedule, error)
+	Update(ctx context.Context, opsRequestSchedule *v1alpha1.OpsRequestSchedule, opts v1.UpdateOptions) (*v1alpha1.OpsRequestSchedule, error)
+	UpdateStatus(ctx context.Context, opsRequestSchedule *v1alpha1.OpsRequestSchedule, opts v1.UpdateOptions) (*v1alpha1.OpsRequestSchedule, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.OpsRequestSchedule, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.OpsRequestScheduleList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.OpsRequestSchedule, err error)
+	OpsRequestScheduleExpansion
+}
+
+// opsRequestSchedules implements OpsRequestScheduleInterface
+type opsRequestSchedules struct {
+	client rest.Interface
+	ns     string
+}
+
+// newOpsRequestSchedules returns a OpsRequestSchedules
+func newOpsRequestSchedules(c *OperationsV1alpha1Client, namespace string) *opsRequestSchedules {
+	return &opsRequestSchedules{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the opsRequestSchedule, and returns the corresponding opsRequestSchedule object, and an error if there is any.
+func (c *opsRequestSchedules) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.OpsRequestSchedule, err error) {
+	result = &v1alpha1.OpsRequestSchedule{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("opsrequestschedules").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of OpsRequestSchedules that match those selectors.
+func (c *opsRequestSchedules) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.OpsRequestScheduleList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.OpsRequestScheduleList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("opsrequestschedules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested opsRequestSchedules.
+func (c *opsRequestSchedules) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("opsrequestschedules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a opsRequestSchedule and creates it.  Returns the server's representation of the opsRequestSchedule, and an error, if there is any.
+func (c *opsRequestSchedules) Create(ctx context.Context, opsRequestSchedule *v1alpha1.OpsRequestSchedule, opts v1.CreateOptions) (result *v1alpha1.OpsRequestSchedule, err error) {
+	result = &v1alpha1.OpsRequestSchedule{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("opsrequestschedules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(opsRequestSchedule).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a opsRequestSchedule and updates it. Returns the server's representation of the opsRequestSchedule, and an error, if there is any.
+func (c *opsRequestSchedules) Update(ctx context.Context, opsRequestSchedule *v1alpha1.OpsRequestSchedule, opts v1.UpdateOptions) (result *v1alpha1.OpsRequestSchedule, err error) {
+	result = &v1alpha1.OpsRequestSchedule{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("opsrequestschedules").
+		Name(opsRequestSchedule.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(opsRequestSchedule).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *opsRequestSchedules) UpdateStatus(ctx context.Context, opsRequestSchedule *v1alpha1.OpsRequestSchedule, opts v1.UpdateOptions) (result *v1alpha1.OpsRequestSchedule, err error) {
+	result = &v1alpha1.OpsRequestSchedule{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("opsrequestschedules").
+		Name(opsRequestSchedule.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(opsRequestSchedule).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the opsRequestSchedule and deletes it. Returns an error if one occurs.
+func (c *opsRequestSchedules) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("opsrequestschedules").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *opsRequestSchedules) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("opsrequestschedules").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched opsRequestSchedule.
+func (c *opsRequestSchedules) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.OpsRequestSchedule, err error) {
+	result = &v1alpha1.OpsRequestSchedule{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("opsrequestschedules").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -28,6 +28,8 @@ type Interface interface {
 	ActionSets() ActionSetInformer
 	// Backups returns a BackupInformer.
 	Backups() BackupInformer
+	// BackupCatalogs returns a BackupCatalogInformer.
+	BackupCatalogs() BackupCatalogInformer
 	// BackupPolicies returns a BackupPolicyInformer.
 	BackupPolicies() BackupPolicyInformer
 	// BackupPolicyTemplates returns a BackupPolicyTemplateInformer.
@@ -36,6 +38,8 @@ type Interface interface {
 	BackupRepos() BackupRepoInformer
 	// BackupSchedules returns a BackupScheduleInformer.
 	BackupSchedules() BackupScheduleInformer
+	// BackupVerifications returns a BackupVerificationInformer.
+	BackupVerifications() BackupVerificationInformer
 	// Restores returns a RestoreInformer.
 	Restores() RestoreInformer
 	// StorageProviders returns a StorageProviderInformer.
@@ -63,6 +67,11 @@ func (v *version) Backups() BackupInformer {
 	return &backupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BackupCatalogs returns a BackupCatalogInformer.
+func (v *version) BackupCatalogs() BackupCatalogInformer {
+	return &backupCatalogInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // BackupPolicies returns a BackupPolicyInformer.
 func (v *version) BackupPolicies() BackupPolicyInformer {
 	return &backupPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -83,6 +92,11 @@ func (v *version) BackupSchedules() BackupScheduleInformer {
 	return &backupScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BackupVerifications returns a BackupVerificationInformer.
+func (v *version) BackupVerifications() BackupVerificationInformer {
+	return &backupVerificationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Restores returns a RestoreInformer.
 func (v *version) Restores() RestoreInformer {
 	return &restoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
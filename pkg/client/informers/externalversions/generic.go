@@ -92,6 +92,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Dataprotection().V1alpha1().ActionSets().Informer()}, nil
 	case dataprotectionv1alpha1.SchemeGroupVersion.WithResource("backups"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Dataprotection().V1alpha1().Backups().Informer()}, nil
+	case dataprotectionv1alpha1.SchemeGroupVersion.WithResource("backupcatalogs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Dataprotection().V1alpha1().BackupCatalogs().Informer()}, nil
 	case dataprotectionv1alpha1.SchemeGroupVersion.WithResource("backuppolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Dataprotection().V1alpha1().BackupPolicies().Informer()}, nil
 	case dataprotectionv1alpha1.SchemeGroupVersion.WithResource("backuppolicytemplates"):
@@ -100,6 +102,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Dataprotection().V1alpha1().BackupRepos().Informer()}, nil
 	case dataprotectionv1alpha1.SchemeGroupVersion.WithResource("backupschedules"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Dataprotection().V1alpha1().BackupSchedules().Informer()}, nil
+	case dataprotectionv1alpha1.SchemeGroupVersion.WithResource("backupverifications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Dataprotection().V1alpha1().BackupVerifications().Informer()}, nil
 	case dataprotectionv1alpha1.SchemeGroupVersion.WithResource("restores"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Dataprotection().V1alpha1().Restores().Informer()}, nil
 	case dataprotectionv1alpha1.SchemeGroupVersion.WithResource("storageproviders"):
@@ -114,6 +118,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha1().OpsDefinitions().Informer()}, nil
 	case operationsv1alpha1.SchemeGroupVersion.WithResource("opsrequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha1().OpsRequests().Informer()}, nil
+	case operationsv1alpha1.SchemeGroupVersion.WithResource("opsrequestschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha1().OpsRequestSchedules().Informer()}, nil
 
 		// Group=parameters.kubeblocks.io, Version=v1alpha1
 	case parametersv1alpha1.SchemeGroupVersion.WithResource("componentparameters"):
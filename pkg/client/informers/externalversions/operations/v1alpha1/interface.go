@@ -28,6 +28,8 @@ type Interface interface {
 	OpsDefinitions() OpsDefinitionInformer
 	// OpsRequests returns a OpsRequestInformer.
 	OpsRequests() OpsRequestInformer
+	// OpsRequestSchedules returns a OpsRequestScheduleInformer.
+	OpsRequestSchedules() OpsRequestScheduleInformer
 }
 
 type version struct {
@@ -50,3 +52,8 @@ func (v *version) OpsDefinitions() OpsDefinitionInformer {
 func (v *version) OpsRequests() OpsRequestInformer {
 	return &opsRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// OpsRequestSchedules returns a OpsRequestScheduleInformer.
+func (v *version) OpsRequestSchedules() OpsRequestScheduleInformer {
+	return &opsRequestScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// BackupCatalogLister helps list BackupCatalogs.
+// All objects returned here must be treated as read-only.
+type BackupCatalogLister interface {
+	// List lists all BackupCatalogs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.BackupCatalog, err error)
+	// BackupCatalogs returns an object that can list and get BackupCatalogs.
+	BackupCatalogs(namespace string) BackupCatalogNamespaceLister
+	BackupCatalogListerExpansion
+}
+
+// backupCatalogLister implements the BackupCatalogLister interface.
+type backupCatalogLister struct {
+	indexer cache.Indexer
+}
+
+// NewBackupCatalogLister returns a new BackupCatalogLister.
+func NewBackupCatalogLister(indexer cache.Indexer) BackupCatalogLister {
+	return &backupCatalogLister{indexer: indexer}
+}
+
+// List lists all BackupCatalogs in the indexer.
+func (s *backupCatalogLister) List(selector labels.Selector) (ret []*v1alpha1.BackupCatalog, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.BackupCatalog))
+	})
+	return ret, err
+}
+
+// BackupCatalogs returns an object that can list and get BackupCatalogs.
+func (s *backupCatalogLister) BackupCatalogs(namespace string) BackupCatalogNamespaceLister {
+	return backupCatalogNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// BackupCatalogNamespaceLister helps list and get BackupCatalogs.
+// All objects returned here must be treated as read-only.
+type BackupCatalogNamespaceLister interface {
+	// List lists all BackupCatalogs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.BackupCatalog, err error)
+	// Get retrieves the BackupCatalog from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.BackupCatalog, error)
+	BackupCatalogNamespaceListerExpansion
+}
+
+// backupCatalogNamespaceLister implements the BackupCatalogNamespaceLister
+// interface.
+type backupCatalogNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all BackupCatalogs in the indexer for a given namespace.
+func (s backupCatalogNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.BackupCatalog, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.BackupCatalog))
+	})
+	return ret, err
+}
+
+// Get retrieves the BackupCatalog from the indexer for a given namespace and name.
+func (s backupCatalogNamespaceLister) Get(name string) (*v1alpha1.BackupCatalog, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("backupcatalog"), name)
+	}
+	return obj.(*v1alpha1.BackupCatalog), nil
+}
@@ -30,6 +30,14 @@ type BackupListerExpansion interface{}
 // BackupNamespaceLister.
 type BackupNamespaceListerExpansion interface{}
 
+// BackupCatalogListerExpansion allows custom methods to be added to
+// BackupCatalogLister.
+type BackupCatalogListerExpansion interface{}
+
+// BackupCatalogNamespaceListerExpansion allows custom methods to be added to
+// BackupCatalogNamespaceLister.
+type BackupCatalogNamespaceListerExpansion interface{}
+
 // BackupPolicyListerExpansion allows custom methods to be added to
 // BackupPolicyLister.
 type BackupPolicyListerExpansion interface{}
@@ -54,6 +62,14 @@ type BackupScheduleListerExpansion interface{}
 // BackupScheduleNamespaceLister.
 type BackupScheduleNamespaceListerExpansion interface{}
 
+// BackupVerificationListerExpansion allows custom methods to be added to
+// BackupVerificationLister.
+type BackupVerificationListerExpansion interface{}
+
+// BackupVerificationNamespaceListerExpansion allows custom methods to be added to
+// BackupVerificationNamespaceLister.
+type BackupVerificationNamespaceListerExpansion interface{}
+
 // RestoreListerExpansion allows custom methods to be added to
 // RestoreLister.
 type RestoreListerExpansion interface{}
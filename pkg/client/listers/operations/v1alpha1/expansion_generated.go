@@ -29,3 +29,11 @@ type OpsRequestListerExpansion interface{}
 // OpsRequestNamespaceListerExpansion allows custom methods to be added to
 // OpsRequestNamespaceLister.
 type OpsRequestNamespaceListerExpansion interface{}
+
+// OpsRequestScheduleListerExpansion allows custom methods to be added to
+// OpsRequestScheduleLister.
+type OpsRequestScheduleListerExpansion interface{}
+
+// OpsRequestScheduleNamespaceListerExpansion allows custom methods to be added to
+// OpsRequestScheduleNamespaceLister.
+type OpsRequestScheduleNamespaceListerExpansion interface{}
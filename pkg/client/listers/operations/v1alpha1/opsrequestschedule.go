@@ -0,0 +1,99 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// OpsRequestScheduleLister helps list OpsRequestSchedules.
+// All objects returned here must be treated as read-only.
+type OpsRequestScheduleLister interface {
+	// List lists all OpsRequestSchedules in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.OpsRequestSchedule, err error)
+	// OpsRequestSchedules returns an object that can list and get OpsRequestSchedules.
+	OpsRequestSchedules(namespace string) OpsRequestScheduleNamespaceLister
+	OpsRequestScheduleListerExpansion
+}
+
+// opsRequestScheduleLister implements the OpsRequestScheduleLister interface.
+type opsRequestScheduleLister struct {
+	indexer cache.Indexer
+}
+
+// NewOpsRequestScheduleLister returns a new OpsRequestScheduleLister.
+func NewOpsRequestScheduleLister(indexer cache.Indexer) OpsRequestScheduleLister {
+	return &opsRequestScheduleLister{indexer: indexer}
+}
+
+// List lists all OpsRequestSchedules in the indexer.
+func (s *opsRequestScheduleLister) List(selector labels.Selector) (ret []*v1alpha1.OpsRequestSchedule, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.OpsRequestSchedule))
+	})
+	return ret, err
+}
+
+// OpsRequestSchedules returns an object that can list and get OpsRequestSchedules.
+func (s *opsRequestScheduleLister) OpsRequestSchedules(namespace string) OpsRequestScheduleNamespaceLister {
+	return opsRequestScheduleNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// OpsRequestScheduleNamespaceLister helps list and get OpsRequestSchedules.
+// All objects returned here must be treated as read-only.
+type OpsRequestScheduleNamespaceLister interface {
+	// List lists all OpsRequestSchedules in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.OpsRequestSchedule, err error)
+	// Get retrieves the OpsRequestSchedule from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.OpsRequestSchedule, error)
+	OpsRequestScheduleNamespaceListerExpansion
+}
+
+// opsRequestScheduleNamespaceLister implements the OpsRequestScheduleNamespaceLister
+// interface.
+type opsRequestScheduleNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all OpsRequestSchedules in the indexer for a given namespace.
+func (s opsRequestScheduleNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.OpsRequestSchedule, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.OpsRequestSchedule))
+	})
+	return ret, err
+}
+
+// Get retrieves the OpsRequestSchedule from the indexer for a given namespace and name.
+func (s opsRequestScheduleNamespaceLister) Get(name string) (*v1alpha1.OpsRequestSchedule, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("opsrequestschedule"), name)
+	}
+	return obj.(*v1alpha1.OpsRequestSchedule), nil
+}
@@ -70,6 +70,10 @@ const (
 	RestoreComponentAnnotationKey       = "apps.kubeblocks.io/restore-component"
 	RestoreVolumeTemplateAnnotationKey  = "apps.kubeblocks.io/restore-volume-template"
 
+	// StandbyBootstrappedAnnotationKey marks a standby cluster whose data is already in place, so it is not
+	// bootstrapped from a backup of the primary cluster.
+	StandbyBootstrappedAnnotationKey = "apps.kubeblocks.io/standby-bootstrapped"
+
 	// These annoations serve in a transition period when existing clusters can adopt
 	// new serviceaccount naming rules.
 	// They will be removed in the future.
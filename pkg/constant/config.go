@@ -23,6 +23,11 @@ const (
 	// TODO support multi version
 	ConfigurationRevision          = "config.kubeblocks.io/configuration-revision"
 	LastConfigurationRevisionPhase = "config.kubeblocks.io/revision-reconcile-phase"
+	// ConfigurationRevisionSnapshot records the compressed configuration data of a revision for rollback
+	ConfigurationRevisionSnapshot = "config.kubeblocks.io/revision-snapshot"
+	// ConfigurationRevisionParameters records the parameters assigned explicitly in a revision, which are used to
+	// roll back to the revision if its snapshot is not kept
+	ConfigurationRevisionParameters = "config.kubeblocks.io/revision-parameters"
 )
 
 const (
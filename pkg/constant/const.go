@@ -31,6 +31,12 @@ const (
 	KBToolsImage         = "KUBEBLOCKS_TOOLS_IMAGE"
 	KBImagePullPolicy    = "KUBEBLOCKS_IMAGE_PULL_POLICY"
 	KBImagePullSecrets   = "KUBEBLOCKS_IMAGE_PULL_SECRETS"
+
+	// KBAgentAuthMode is the mode the kb-agent authenticates the callers of its services: none, token or mtls.
+	KBAgentAuthMode = "KBAGENT_AUTH_MODE"
+
+	// KBAgentTransport is the transport the controllers call the kb-agent with: http or grpc.
+	KBAgentTransport = "KBAGENT_TRANSPORT"
 )
 
 const (
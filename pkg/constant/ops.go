@@ -25,6 +25,9 @@ const (
 	OpsRequestNameLabelKey      = "operations.kubeblocks.io/ops-name"
 	OpsRequestNamespaceLabelKey = "operations.kubeblocks.io/ops-namespace"
 	OpsRequestUIDAnnotationKey  = "operations.kubeblocks.io/ops-uid"
+	OpsRequestScheduleLabelKey  = "operations.kubeblocks.io/ops-schedule"
+	ComponentAutoscalerLabelKey = "operations.kubeblocks.io/component-autoscaler"
+	VolumeAutoExpansionLabelKey = "operations.kubeblocks.io/volume-auto-expansion"
 )
 
 // annotations
@@ -34,4 +37,5 @@ const (
 	RelatedOpsAnnotationKey            = "operations.kubeblocks.io/related-ops"
 	OpsDependentOnSuccessfulOpsAnnoKey = "operations.kubeblocks.io/dependent-on-successful-ops" // OpsDependentOnSuccessfulOpsAnnoKey wait for the dependent ops to succeed before executing the current ops. If it fails, this ops will also fail.
 	IgnoreHscaleValidateAnnoKey        = "apps.kubeblocks.io/ignore-strict-horizontal-scale-validation"
+	OpsScheduledTimeAnnotationKey      = "operations.kubeblocks.io/scheduled-time"
 )
@@ -136,6 +136,16 @@ func (builder *ComponentBuilder) SetPVCRetentionPolicy(retentionPolicy *appsv1.P
 	return builder
 }
 
+func (builder *ComponentBuilder) SetVolumeAutoExpansion(policy *appsv1.VolumeAutoExpansionPolicy) *ComponentBuilder {
+	builder.get().Spec.VolumeAutoExpansion = policy
+	return builder
+}
+
+func (builder *ComponentBuilder) SetReplication(replication *appsv1.ComponentReplication) *ComponentBuilder {
+	builder.get().Spec.Replication = replication
+	return builder
+}
+
 func (builder *ComponentBuilder) SetVolumes(volumes []corev1.Volume) *ComponentBuilder {
 	builder.get().Spec.Volumes = volumes
 	return builder
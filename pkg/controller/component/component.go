@@ -82,6 +82,8 @@ func BuildComponent(cluster *appsv1.Cluster, compSpec *appsv1.ClusterComponentSp
 		SetInstanceUpdateStrategy(compSpec.InstanceUpdateStrategy).
 		SetVolumeClaimTemplates(compSpec.VolumeClaimTemplates).
 		SetPVCRetentionPolicy(compSpec.PersistentVolumeClaimRetentionPolicy).
+		SetVolumeAutoExpansion(compSpec.VolumeAutoExpansion).
+		SetReplication(replication4Component(cluster, compSpec.Name, labels)).
 		SetVolumes(compSpec.Volumes).
 		SetNetwork(compSpec.Network).
 		SetServices(compSpec.Services).
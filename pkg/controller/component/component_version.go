@@ -301,7 +301,10 @@ func actionsToResolveImage(compDef *appsv1.ComponentDefinition) map[string]*apps
 		normalize("dataDump"):         compDef.Spec.LifecycleActions.DataDump,
 		normalize("dataLoad"):         compDef.Spec.LifecycleActions.DataLoad,
 		normalize("reconfigure"):      compDef.Spec.LifecycleActions.Reconfigure,
+		normalize("parameterQuery"):   compDef.Spec.LifecycleActions.ParameterQuery,
 		normalize("accountProvision"): compDef.Spec.LifecycleActions.AccountProvision,
+		normalize("replicationSetup"): compDef.Spec.LifecycleActions.ReplicationSetup,
+		normalize("promote"):          compDef.Spec.LifecycleActions.Promote,
 	}
 	if compDef.Spec.LifecycleActions.RoleProbe != nil {
 		actions[normalize("roleProbe")] = &compDef.Spec.LifecycleActions.RoleProbe.Action
@@ -309,6 +312,9 @@ func actionsToResolveImage(compDef *appsv1.ComponentDefinition) map[string]*apps
 	if compDef.Spec.LifecycleActions.AvailableProbe != nil {
 		actions[normalize("availableProbe")] = &compDef.Spec.LifecycleActions.AvailableProbe.Action
 	}
+	if compDef.Spec.LifecycleActions.ReplicationLagProbe != nil {
+		actions[normalize("replicationLagProbe")] = &compDef.Spec.LifecycleActions.ReplicationLagProbe.Action
+	}
 	return actions
 }
 
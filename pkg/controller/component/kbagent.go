@@ -84,6 +84,7 @@ func UpdateKBAgentContainer4HostNetwork(synthesizedComp *SynthesizedComponent) {
 	}
 	updatePortInArgs("--port", httpPort)
 	updatePortInArgs("--streaming-port", port(kbagent.DefaultStreamingPortName))
+	updatePortInArgs(kbagent.GRPCPortArg, port(kbagent.DefaultGRPCPortName))
 
 	// update startup probe
 	if c.StartupProbe != nil && c.StartupProbe.TCPSocket != nil {
@@ -145,8 +146,11 @@ func buildKBAgentContainer(synthesizedComp *SynthesizedComponent) error {
 	}
 
 	container, err := newContainer(kbagent.ContainerName, func(b *builder.ContainerBuilder) error {
-		ports, err1 := getAvailablePorts(synthesizedComp.PodSpec.Containers,
-			[]int32{int32(kbagent.DefaultHTTPPort), int32(kbagent.DefaultStreamingPort)})
+		containerPorts := []int32{int32(kbagent.DefaultHTTPPort), int32(kbagent.DefaultStreamingPort)}
+		if grpcTransportEnabled() {
+			containerPorts = append(containerPorts, int32(kbagent.DefaultGRPCPort))
+		}
+		ports, err1 := getAvailablePorts(synthesizedComp.PodSpec.Containers, containerPorts)
 		if err1 != nil {
 			return err1
 		}
@@ -168,6 +172,15 @@ func buildKBAgentContainer(synthesizedComp *SynthesizedComponent) error {
 				ProbeHandler: corev1.ProbeHandler{
 					TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(httpPort)},
 				}})
+		if len(ports) > 2 {
+			grpcPort := int(ports[2])
+			b.AddArgs(kbagent.GRPCArgs(grpcPort)...).
+				AddPorts(corev1.ContainerPort{
+					ContainerPort: int32(grpcPort),
+					Name:          kbagent.DefaultGRPCPortName,
+					Protocol:      corev1.ProtocolTCP,
+				})
+		}
 		return nil
 	})
 	if err != nil {
@@ -190,6 +203,10 @@ func buildKBAgentContainer(synthesizedComp *SynthesizedComponent) error {
 		return err
 	}
 
+	mountKBAgentCredentials(synthesizedComp, container, workerContainer)
+
+	mountVolumes4VolumeStats(synthesizedComp, container)
+
 	// set kb-agent container ports to host network
 	if synthesizedComp.HostNetwork != nil {
 		if synthesizedComp.HostNetwork.ContainerPorts == nil {
@@ -207,6 +224,13 @@ func buildKBAgentContainer(synthesizedComp *SynthesizedComponent) error {
 					Ports:     []string{kbagent.DefaultStreamingPortName},
 				},
 			}...)
+		if grpcTransportEnabled() {
+			synthesizedComp.HostNetwork.ContainerPorts = append(synthesizedComp.HostNetwork.ContainerPorts,
+				appsv1.HostNetworkContainerPort{
+					Container: container.Name,
+					Ports:     []string{kbagent.DefaultGRPCPortName},
+				})
+		}
 	}
 
 	synthesizedComp.PodSpec.Containers = append(synthesizedComp.PodSpec.Containers, *container)
@@ -215,6 +239,26 @@ func buildKBAgentContainer(synthesizedComp *SynthesizedComponent) error {
 	return nil
 }
 
+// grpcTransportEnabled returns whether the controllers call the kb-agent through the gRPC transport.
+func grpcTransportEnabled() bool {
+	return viper.GetString(constant.KBAgentTransport) == "grpc"
+}
+
+// mountKBAgentCredentials runs the kb-agent with the auth mode configured, and mounts the credentials
+// generated for the component to the kb-agent containers.
+func mountKBAgentCredentials(synthesizedComp *SynthesizedComponent, containers ...*corev1.Container) {
+	mode := proto.AuthMode(viper.GetString(constant.KBAgentAuthMode))
+	if mode == "" || mode == proto.AuthModeNone {
+		return
+	}
+	for _, c := range containers {
+		c.Args = append(c.Args, kbagent.AuthArgs(mode)...)
+		c.VolumeMounts = append(c.VolumeMounts, kbagent.AuthVolumeMount())
+	}
+	synthesizedComp.PodSpec.Volumes = append(synthesizedComp.PodSpec.Volumes,
+		kbagent.AuthVolume(kbagent.AuthSecretName(synthesizedComp.ClusterName, synthesizedComp.Name)))
+}
+
 func mountPodRoleLabelFile(synthesizedComp *SynthesizedComponent, container *corev1.Container) error {
 	volume := corev1.Volume{
 		Name: roleLabelVolumeName,
@@ -284,13 +328,19 @@ func mergedActionEnv4KBAgent(synthesizedComp *SynthesizedComponent) []corev1.Env
 			synthesizedComp.LifecycleActions.DataDump,
 			synthesizedComp.LifecycleActions.DataLoad,
 			synthesizedComp.LifecycleActions.Reconfigure,
+			synthesizedComp.LifecycleActions.ParameterQuery,
 			synthesizedComp.LifecycleActions.AccountProvision,
+			synthesizedComp.LifecycleActions.ReplicationSetup,
+			synthesizedComp.LifecycleActions.Promote,
 		} {
 			checkedAppend(action)
 		}
 		if synthesizedComp.LifecycleActions.RoleProbe != nil {
 			checkedAppend(&synthesizedComp.LifecycleActions.RoleProbe.Action)
 		}
+		if synthesizedComp.LifecycleActions.ReplicationLagProbe != nil {
+			checkedAppend(&synthesizedComp.LifecycleActions.ReplicationLagProbe.Action)
+		}
 	}
 
 	traverseUserDefinedActions(synthesizedComp, func(_ string, action *appsv1.Action) {
@@ -340,9 +390,18 @@ func buildKBAgentStartupEnvs(synthesizedComp *SynthesizedComponent) ([]corev1.En
 		if a := buildAction4KBAgent(synthesizedComp.LifecycleActions.Reconfigure, "reconfigure"); a != nil {
 			actions = append(actions, *a)
 		}
+		if a := buildAction4KBAgent(synthesizedComp.LifecycleActions.ParameterQuery, "parameterQuery"); a != nil {
+			actions = append(actions, *a)
+		}
 		if a := buildAction4KBAgent(synthesizedComp.LifecycleActions.AccountProvision, "accountProvision"); a != nil {
 			actions = append(actions, *a)
 		}
+		if a := buildAction4KBAgent(synthesizedComp.LifecycleActions.ReplicationSetup, "replicationSetup"); a != nil {
+			actions = append(actions, *a)
+		}
+		if a := buildAction4KBAgent(synthesizedComp.LifecycleActions.Promote, "promote"); a != nil {
+			actions = append(actions, *a)
+		}
 
 		if a, p := buildProbe4KBAgent(synthesizedComp.LifecycleActions.RoleProbe, "roleProbe", synthesizedComp.FullCompName); a != nil && p != nil {
 			p.ReportOnFileChange = []string{podMetadataMountPath}
@@ -356,6 +415,16 @@ func buildKBAgentStartupEnvs(synthesizedComp *SynthesizedComponent) ([]corev1.En
 			actions = append(actions, *a)
 			probes = append(probes, *p)
 		}
+		if a, p := buildProbe4KBAgent(synthesizedComp.LifecycleActions.ReplicationLagProbe, replicationLagProbe, synthesizedComp.FullCompName); a != nil && p != nil {
+			p.ReportPeriodSeconds = probeReportPeriodSeconds(p.PeriodSeconds)
+			actions = append(actions, *a)
+			probes = append(probes, *p)
+		}
+	}
+
+	if a, p := buildVolumeStatsProbe4KBAgent(synthesizedComp); a != nil && p != nil {
+		actions = append(actions, *a)
+		probes = append(probes, *p)
 	}
 
 	traverseUserDefinedActions(synthesizedComp, func(name string, action *appsv1.Action) {
@@ -492,11 +561,17 @@ func customExecActionImageNContainer(synthesizedComp *SynthesizedComponent) (str
 			synthesizedComp.LifecycleActions.DataDump,
 			synthesizedComp.LifecycleActions.DataLoad,
 			synthesizedComp.LifecycleActions.Reconfigure,
+			synthesizedComp.LifecycleActions.ParameterQuery,
 			synthesizedComp.LifecycleActions.AccountProvision,
+			synthesizedComp.LifecycleActions.ReplicationSetup,
+			synthesizedComp.LifecycleActions.Promote,
 		}...)
 		if synthesizedComp.LifecycleActions.RoleProbe != nil && synthesizedComp.LifecycleActions.RoleProbe.Defined() {
 			actions = append(actions, &synthesizedComp.LifecycleActions.RoleProbe.Action)
 		}
+		if synthesizedComp.LifecycleActions.ReplicationLagProbe != nil && synthesizedComp.LifecycleActions.ReplicationLagProbe.Defined() {
+			actions = append(actions, &synthesizedComp.LifecycleActions.ReplicationLagProbe.Action)
+		}
 	}
 	traverseUserDefinedActions(synthesizedComp, func(_ string, action *appsv1.Action) {
 		actions = append(actions, action)
@@ -593,7 +668,7 @@ func hasActionDefined(synthesizedComp *SynthesizedComponent) bool {
 			return true
 		}
 	}
-	return false
+	return len(volumesToStat(synthesizedComp)) > 0
 }
 
 func traverseUserDefinedActions(synthesizedComp *SynthesizedComponent, f func(name string, action *appsv1.Action)) {
@@ -27,6 +27,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/apecloud/kubeblocks/pkg/controller/lifecycle"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
 )
@@ -55,5 +56,8 @@ func (h *KBAgentTaskEventHandler) handleEvent(reqCtx intctrlutil.RequestCtx, cli
 	if event.Task == newReplicaTask {
 		return handleNewReplicaTaskEvent(reqCtx.Log, reqCtx.Ctx, cli, namespace, event)
 	}
+	if event.Task == lifecycle.DataLoadTask {
+		return nil // the progress of the data load task is polled by its submitter
+	}
 	return fmt.Errorf("unsupported kind of task event: %s", event.Task)
 }
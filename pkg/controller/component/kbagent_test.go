@@ -29,6 +29,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
@@ -175,6 +176,43 @@ var _ = Describe("kb-agent", func() {
 			Expect(c.Env).Should(HaveLen(6)) // 4 + 2
 		})
 
+		It("auth", func() {
+			viperx.Set(constant.KBAgentAuthMode, string(proto.AuthModeMTLS))
+			defer viperx.Set(constant.KBAgentAuthMode, string(proto.AuthModeNone))
+
+			err := buildKBAgentContainer(synthesizedComp)
+			Expect(err).Should(BeNil())
+
+			c := kbAgentContainer()
+			Expect(c).ShouldNot(BeNil())
+			Expect(c.Args).Should(ContainElements(kbagent.AuthArgs(proto.AuthModeMTLS)))
+			Expect(c.VolumeMounts).Should(ContainElement(kbagent.AuthVolumeMount()))
+			Expect(kbagent.AuthModeOf(synthesizedComp.PodSpec)).Should(Equal(proto.AuthModeMTLS))
+			Expect(synthesizedComp.PodSpec.Volumes).Should(ContainElement(
+				kbagent.AuthVolume(kbagent.AuthSecretName(synthesizedComp.ClusterName, synthesizedComp.Name))))
+			for _, ic := range synthesizedComp.PodSpec.InitContainers {
+				if ic.Name == kbagent.ContainerName4Worker {
+					Expect(ic.Args).Should(ContainElements(kbagent.AuthArgs(proto.AuthModeMTLS)))
+					Expect(ic.VolumeMounts).Should(ContainElement(kbagent.AuthVolumeMount()))
+				}
+			}
+		})
+
+		It("grpc transport", func() {
+			viperx.Set(constant.KBAgentTransport, "grpc")
+			defer viperx.Set(constant.KBAgentTransport, "http")
+
+			err := buildKBAgentContainer(synthesizedComp)
+			Expect(err).Should(BeNil())
+
+			c := kbAgentContainer()
+			Expect(c).ShouldNot(BeNil())
+			Expect(c.Ports).Should(HaveLen(3))
+			Expect(c.Ports[2].Name).Should(Equal(kbagent.DefaultGRPCPortName))
+			Expect(c.Ports[2].ContainerPort).Should(Equal(int32(kbagent.DefaultGRPCPort)))
+			Expect(c.Args).Should(ContainElements(kbagent.GRPCArgs(kbagent.DefaultGRPCPort)))
+		})
+
 		It("normalizes explicit retry seconds before serializing kbagent actions", func() {
 			err := buildKBAgentContainer(synthesizedComp)
 			Expect(err).Should(BeNil())
@@ -286,6 +324,49 @@ var _ = Describe("kb-agent", func() {
 			}))
 		})
 
+		It("volume stats probe of the auto-expansion", func() {
+			synthesizedComp.LifecycleActions.ComponentLifecycleActions = nil
+			synthesizedComp.LifecycleActions.CustomActions = nil
+			synthesizedComp.FullCompName = "test-cluster-comp"
+			synthesizedComp.VolumeClaimTemplates = []corev1.PersistentVolumeClaimTemplate{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+			}
+			synthesizedComp.VolumeAutoExpansion = &appsv1.VolumeAutoExpansionPolicy{
+				Volumes: []string{"data", "not-exist"},
+			}
+
+			err := buildKBAgentContainer(synthesizedComp)
+			Expect(err).Should(BeNil())
+
+			c := kbAgentContainer()
+			Expect(c).ShouldNot(BeNil())
+			Expect(c.VolumeMounts).Should(ContainElement(corev1.VolumeMount{
+				Name:      "data",
+				MountPath: volumeStatsMountPath + "/data",
+				ReadOnly:  true,
+			}))
+			envs := map[string]string{}
+			for _, e := range c.Env {
+				envs[e.Name] = e.Value
+			}
+
+			actions := make([]proto.Action, 0)
+			Expect(json.Unmarshal([]byte(envs["KB_AGENT_ACTION"]), &actions)).Should(Succeed())
+			Expect(actions).Should(ContainElement(proto.Action{
+				Name: volumeStatsProbe,
+				VolumeStats: &proto.VolumeStatsAction{
+					Volumes: map[string]string{"data": volumeStatsMountPath + "/data"},
+				},
+			}))
+			probes := make([]proto.Probe, 0)
+			Expect(json.Unmarshal([]byte(envs["KB_AGENT_PROBE"]), &probes)).Should(Succeed())
+			Expect(probes).Should(ContainElement(proto.Probe{
+				Instance:            "test-cluster-comp",
+				Action:              volumeStatsProbe,
+				ReportPeriodSeconds: defaultProbeReportPeriodSeconds,
+			}))
+		})
+
 		It("action env", func() {
 			env := []corev1.EnvVar{
 				{
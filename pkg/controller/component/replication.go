@@ -0,0 +1,148 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+const (
+	replicationLagProbe = "replicationLagProbe"
+)
+
+// StandbyPrimaryKey returns the key of the primary Cluster of a standby Cluster.
+func StandbyPrimaryKey(cluster *appsv1.Cluster) types.NamespacedName {
+	if cluster.Spec.Standby == nil {
+		return types.NamespacedName{}
+	}
+	namespace := cluster.Spec.Standby.Namespace
+	if len(namespace) == 0 {
+		namespace = cluster.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: cluster.Spec.Standby.PrimaryCluster}
+}
+
+// StandbyBootstrapPending checks whether a standby Cluster is waiting for the restore source to be resolved,
+// that is, neither the restore source is specified nor the Cluster is marked as bootstrapped.
+func StandbyBootstrapPending(cluster *appsv1.Cluster) bool {
+	if cluster.Spec.Standby == nil || cluster.Spec.Restore != nil {
+		return false
+	}
+	_, ok := cluster.Annotations[constant.StandbyBootstrappedAnnotationKey]
+	return !ok
+}
+
+// ReplicationSourceKey returns the key of a replication source, in the format of "namespace/cluster/component".
+func ReplicationSourceKey(source *appsv1.ComponentReplicationSource) string {
+	if source == nil {
+		return ""
+	}
+	return strings.Join([]string{source.Namespace, source.ClusterName, source.ComponentName}, "/")
+}
+
+// replication4Component derives the disaster-recovery replication of a Component from its Cluster,
+// the Component replicates from the Component with the same name in the primary Cluster.
+func replication4Component(cluster *appsv1.Cluster, compName string, labels map[string]string) *appsv1.ComponentReplication {
+	if cluster.Spec.Standby == nil && !cluster.Spec.Fenced {
+		return nil
+	}
+	replication := &appsv1.ComponentReplication{
+		Fenced: cluster.Spec.Fenced,
+	}
+	// the shards are named randomly, there is no counterpart in the primary Cluster to replicate from
+	if _, ok := labels[constant.KBAppShardingNameLabelKey]; !ok && cluster.Spec.Standby != nil {
+		primary := StandbyPrimaryKey(cluster)
+		replication.Source = &appsv1.ComponentReplicationSource{
+			Namespace:     primary.Namespace,
+			ClusterName:   primary.Name,
+			ComponentName: compName,
+		}
+	}
+	return replication
+}
+
+// ReplicationLagEventHandler records the replication lag reported by the replicationLagProbe of a standby Component.
+type ReplicationLagEventHandler struct{}
+
+func (h *ReplicationLagEventHandler) Handle(cli client.Client, reqCtx intctrlutil.RequestCtx, recorder record.EventRecorder, event *corev1.Event) (bool, error) {
+	if !h.isReplicationLagEvent(event) {
+		return false, nil
+	}
+
+	ppEvent := &proto.ProbeEvent{}
+	if err := json.Unmarshal([]byte(event.Message), ppEvent); err != nil {
+		return true, err
+	}
+	if ppEvent.Code != 0 {
+		return true, nil // the lag is unknown, wait for the next report
+	}
+	lag, err := parseReplicationLag(ppEvent.Output)
+	if err != nil {
+		return true, err
+	}
+
+	comp := &appsv1.Component{}
+	compKey := types.NamespacedName{
+		Namespace: event.InvolvedObject.Namespace,
+		Name:      ppEvent.Instance,
+	}
+	if err = cli.Get(reqCtx.Ctx, compKey, comp); err != nil {
+		return true, err
+	}
+	if comp.Status.Replication == nil || comp.Status.Replication.Role != appsv1.StandbyReplicationRole {
+		return true, nil
+	}
+
+	compCopy := comp.DeepCopy()
+	comp.Status.Replication.LagSeconds = &lag
+	probeTime := event.LastTimestamp
+	if probeTime.IsZero() {
+		probeTime = metav1.Now()
+	}
+	comp.Status.Replication.LastLagProbeTime = &probeTime
+	return true, cli.Status().Patch(reqCtx.Ctx, comp, client.MergeFrom(compCopy))
+}
+
+func (h *ReplicationLagEventHandler) isReplicationLagEvent(event *corev1.Event) bool {
+	return event.ReportingController == proto.ProbeEventReportingController &&
+		event.Reason == replicationLagProbe && event.InvolvedObject.FieldPath == proto.ProbeEventFieldPath
+}
+
+func parseReplicationLag(output []byte) (int64, error) {
+	lag, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+	if err != nil || lag < 0 {
+		return 0, fmt.Errorf("invalid replication lag: %q", string(output))
+	}
+	return lag, nil
+}
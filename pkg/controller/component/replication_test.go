@@ -0,0 +1,98 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package component
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+var _ = Describe("disaster-recovery replication", func() {
+	var cluster *appsv1.Cluster
+
+	BeforeEach(func() {
+		cluster = &appsv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "dr",
+				Name:      "standby",
+			},
+		}
+	})
+
+	Context("replication4Component", func() {
+		It("no replication", func() {
+			Expect(replication4Component(cluster, "mysql", nil)).Should(BeNil())
+		})
+
+		It("standby", func() {
+			cluster.Spec.Standby = &appsv1.ClusterStandby{PrimaryCluster: "primary"}
+			replication := replication4Component(cluster, "mysql", nil)
+			Expect(replication).ShouldNot(BeNil())
+			Expect(replication.Fenced).Should(BeFalse())
+			Expect(replication.Source).Should(Equal(&appsv1.ComponentReplicationSource{
+				Namespace:     "dr",
+				ClusterName:   "primary",
+				ComponentName: "mysql",
+			}))
+			Expect(ReplicationSourceKey(replication.Source)).Should(Equal("dr/primary/mysql"))
+		})
+
+		It("standby of the primary in another namespace", func() {
+			cluster.Spec.Standby = &appsv1.ClusterStandby{PrimaryCluster: "primary", Namespace: "prod"}
+			Expect(StandbyPrimaryKey(cluster).String()).Should(Equal("prod/primary"))
+			Expect(replication4Component(cluster, "mysql", nil).Source.Namespace).Should(Equal("prod"))
+		})
+
+		It("shards don't replicate", func() {
+			cluster.Spec.Standby = &appsv1.ClusterStandby{PrimaryCluster: "primary"}
+			cluster.Spec.Fenced = true
+			labels := map[string]string{constant.KBAppShardingNameLabelKey: "shard"}
+			replication := replication4Component(cluster, "shard-abc", labels)
+			Expect(replication).ShouldNot(BeNil())
+			Expect(replication.Source).Should(BeNil())
+			Expect(replication.Fenced).Should(BeTrue())
+		})
+
+		It("fenced", func() {
+			cluster.Spec.Fenced = true
+			Expect(replication4Component(cluster, "mysql", nil)).Should(Equal(&appsv1.ComponentReplication{Fenced: true}))
+		})
+	})
+
+	Context("parseReplicationLag", func() {
+		It("ok", func() {
+			lag, err := parseReplicationLag([]byte("12\n"))
+			Expect(err).Should(Succeed())
+			Expect(lag).Should(Equal(int64(12)))
+		})
+
+		It("invalid", func() {
+			_, err := parseReplicationLag([]byte("-1"))
+			Expect(err).Should(HaveOccurred())
+			_, err = parseReplicationLag([]byte("unknown"))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
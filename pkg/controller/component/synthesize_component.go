@@ -275,6 +275,7 @@ func buildVolumeClaimTemplates(synthesizeComp *SynthesizedComponent, comp *appsv
 	if comp.Spec.PersistentVolumeClaimRetentionPolicy != nil {
 		synthesizeComp.PVCRetentionPolicy = *comp.Spec.PersistentVolumeClaimRetentionPolicy
 	}
+	synthesizeComp.VolumeAutoExpansion = comp.Spec.VolumeAutoExpansion
 	if len(synthesizeComp.PVCRetentionPolicy.WhenDeleted) == 0 {
 		synthesizeComp.PVCRetentionPolicy.WhenDeleted = defaultPVCRetentionPolicy.WhenDeleted
 	}
@@ -486,6 +487,7 @@ func synthesizeFileTemplate(comp *appsv1.Component, tpl appsv1.ComponentFileTemp
 		stpl.Variables = utpl.Variables
 		stpl.ConfigHash = utpl.ConfigHash
 		stpl.ReconfigureArgs = utpl.ReconfigureArgs
+		stpl.Instances = utpl.Instances
 
 		// if restartOnFileChange is not specified as required, use the user specified value
 		if !ptr.Deref(stpl.RestartOnFileChange, false) {
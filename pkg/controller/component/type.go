@@ -47,6 +47,7 @@ type SynthesizedComponent struct {
 	SidecarVars                      []kbappsv1.EnvVar                      // vars defined by sidecars
 	VolumeClaimTemplates             []corev1.PersistentVolumeClaimTemplate `json:"volumeClaimTemplates,omitempty"`
 	PVCRetentionPolicy               kbappsv1.PersistentVolumeClaimRetentionPolicy
+	VolumeAutoExpansion              *kbappsv1.VolumeAutoExpansionPolicy
 	FileTemplates                    []SynthesizedFileTemplate
 	Configs                          []workloads.ConfigTemplate
 	LogConfigs                       []kbappsv1.LogConfig                   `json:"logConfigs,omitempty"`
@@ -96,6 +97,7 @@ type SynthesizedFileTemplate struct {
 	ReconfigureRequired *bool
 	ReconfigureAction   *kbappsv1.Action
 	ReconfigureArgs     [][]string
+	Instances           []string
 }
 
 type SynthesizedLifecycleActions struct {
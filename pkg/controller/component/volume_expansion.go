@@ -0,0 +1,346 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+const (
+	volumeStatsProbe     = "volumeStats"
+	volumeStatsMountPath = "/etc/kubeblocks/volumes"
+
+	defaultVolumeExpansionThreshold = 80
+	defaultVolumeExpansionStep      = "20%"
+	maxVolumeExpansionRecords       = 10
+)
+
+// volumesToStat returns the volumes to report the stats for the auto-expansion.
+func volumesToStat(synthesizedComp *SynthesizedComponent) []string {
+	if synthesizedComp.VolumeAutoExpansion == nil {
+		return nil
+	}
+	volumes := make([]string, 0)
+	for _, name := range synthesizedComp.VolumeAutoExpansion.Volumes {
+		for _, vct := range synthesizedComp.VolumeClaimTemplates {
+			if vct.Name == name {
+				volumes = append(volumes, name)
+				break
+			}
+		}
+	}
+	return volumes
+}
+
+func buildVolumeStatsProbe4KBAgent(synthesizedComp *SynthesizedComponent) (*proto.Action, *proto.Probe) {
+	volumes := volumesToStat(synthesizedComp)
+	if len(volumes) == 0 {
+		return nil, nil
+	}
+	a := &proto.Action{
+		Name: volumeStatsProbe,
+		VolumeStats: &proto.VolumeStatsAction{
+			Volumes: map[string]string{},
+		},
+	}
+	for _, name := range volumes {
+		a.VolumeStats.Volumes[name] = filepath.Join(volumeStatsMountPath, name)
+	}
+	p := &proto.Probe{
+		Instance:            synthesizedComp.FullCompName,
+		Action:              volumeStatsProbe,
+		ReportPeriodSeconds: defaultProbeReportPeriodSeconds,
+	}
+	return a, p
+}
+
+func mountVolumes4VolumeStats(synthesizedComp *SynthesizedComponent, container *corev1.Container) {
+	for _, name := range volumesToStat(synthesizedComp) {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: filepath.Join(volumeStatsMountPath, name),
+			ReadOnly:  true,
+		})
+	}
+}
+
+// VolumeExpansionEventHandler expands the volumes of a Component by VolumeExpansion OpsRequests
+// when the volume stats reported by kbagent cross the threshold of the auto-expansion policy.
+type VolumeExpansionEventHandler struct{}
+
+func (h *VolumeExpansionEventHandler) Handle(cli client.Client, reqCtx intctrlutil.RequestCtx, recorder record.EventRecorder, event *corev1.Event) (bool, error) {
+	if !h.isVolumeStatsEvent(event) {
+		return false, nil
+	}
+
+	ppEvent := &proto.ProbeEvent{}
+	if err := json.Unmarshal([]byte(event.Message), ppEvent); err != nil {
+		return true, err
+	}
+	if ppEvent.Code != 0 {
+		return true, nil // the stats are unavailable, wait for the next report
+	}
+	stats := make([]proto.VolumeStats, 0)
+	if err := json.Unmarshal(ppEvent.Output, &stats); err != nil {
+		return true, err
+	}
+
+	comp := &appsv1.Component{}
+	compKey := types.NamespacedName{
+		Namespace: event.InvolvedObject.Namespace,
+		Name:      ppEvent.Instance,
+	}
+	if err := cli.Get(reqCtx.Ctx, compKey, comp); err != nil {
+		return true, err
+	}
+	if comp.Spec.VolumeAutoExpansion == nil || comp.Status.Phase != appsv1.RunningComponentPhase {
+		return true, nil
+	}
+	if inProgress, err := h.expansionInProgress(reqCtx, cli, comp); err != nil || inProgress {
+		return true, err
+	}
+
+	compDef := &appsv1.ComponentDefinition{}
+	if err := cli.Get(reqCtx.Ctx, types.NamespacedName{Name: comp.Spec.CompDef}, compDef); err != nil {
+		return true, err
+	}
+	vcts, records, exhausted, err := planVolumeExpansion(comp, compDef, stats, time.Now())
+	if err != nil {
+		return true, err
+	}
+	for _, name := range exhausted {
+		recorder.Eventf(comp, corev1.EventTypeWarning, "VolumeAutoExpansion",
+			"the utilization of volume %s crosses the threshold, but it has reached the max size", name)
+	}
+	if len(vcts) == 0 {
+		return true, nil
+	}
+
+	opsRequest, err := buildVolumeExpansionOpsRequest(comp, vcts)
+	if err != nil {
+		return true, err
+	}
+	if err = cli.Create(reqCtx.Ctx, opsRequest); err != nil {
+		return true, err
+	}
+
+	compCopy := comp.DeepCopy()
+	for i := range records {
+		records[i].OpsRequest = opsRequest.Name
+		recorder.Eventf(comp, corev1.EventTypeNormal, "VolumeAutoExpansion",
+			"expand volume %s from %s to %s with the utilization %d%%, ops: %s",
+			records[i].Volume, records[i].From.String(), records[i].To.String(), records[i].Utilization, opsRequest.Name)
+	}
+	comp.Status.VolumeExpansions = append(comp.Status.VolumeExpansions, records...)
+	if len(comp.Status.VolumeExpansions) > maxVolumeExpansionRecords {
+		comp.Status.VolumeExpansions = comp.Status.VolumeExpansions[len(comp.Status.VolumeExpansions)-maxVolumeExpansionRecords:]
+	}
+	return true, cli.Status().Patch(reqCtx.Ctx, comp, client.MergeFrom(compCopy))
+}
+
+func (h *VolumeExpansionEventHandler) isVolumeStatsEvent(event *corev1.Event) bool {
+	return event.ReportingController == proto.ProbeEventReportingController &&
+		event.Reason == volumeStatsProbe && event.InvolvedObject.FieldPath == proto.ProbeEventFieldPath
+}
+
+// expansionInProgress checks whether any OpsRequest issued for the component is still running, the OpsRequests
+// are listed by the label rather than the status records, which may fail to be patched after the creation.
+func (h *VolumeExpansionEventHandler) expansionInProgress(reqCtx intctrlutil.RequestCtx, cli client.Client, comp *appsv1.Component) (bool, error) {
+	opsList := &opsv1alpha1.OpsRequestList{}
+	if err := cli.List(reqCtx.Ctx, opsList, client.InNamespace(comp.Namespace),
+		client.MatchingLabels{constant.VolumeAutoExpansionLabelKey: comp.Name}); err != nil {
+		return false, err
+	}
+	for i := range opsList.Items {
+		if !opsList.Items[i].IsComplete() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// planVolumeExpansion returns the volumes to expand and the records of the expansions, and the volumes
+// that cross the threshold but have reached the max size.
+func planVolumeExpansion(comp *appsv1.Component, compDef *appsv1.ComponentDefinition, stats []proto.VolumeStats,
+	now time.Time) ([]opsv1alpha1.OpsRequestVolumeClaimTemplate, []appsv1.VolumeExpansionRecord, []string, error) {
+	var (
+		policy    = comp.Spec.VolumeAutoExpansion
+		vcts      []opsv1alpha1.OpsRequestVolumeClaimTemplate
+		records   []appsv1.VolumeExpansionRecord
+		exhausted []string
+	)
+	for _, name := range policy.Volumes {
+		var current *resource.Quantity
+		for _, vct := range comp.Spec.VolumeClaimTemplates {
+			if vct.Name == name {
+				if storage, ok := vct.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+					current = &storage
+				}
+				break
+			}
+		}
+		if current == nil {
+			continue
+		}
+
+		utilization := int32(-1)
+		for _, s := range stats {
+			if s.Name == name {
+				utilization = max(utilization, s.Utilization)
+			}
+		}
+		if utilization < volumeExpansionThreshold(policy, compDef, name) {
+			continue
+		}
+		if inVolumeExpansionCooldown(policy, comp.Status.VolumeExpansions, name, now) {
+			continue
+		}
+		if current.Cmp(policy.MaxSize) >= 0 {
+			exhausted = append(exhausted, name)
+			continue
+		}
+
+		target, err := volumeExpansionTarget(policy, *current)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		vcts = append(vcts, opsv1alpha1.OpsRequestVolumeClaimTemplate{
+			Name:    name,
+			Storage: target,
+		})
+		records = append(records, appsv1.VolumeExpansionRecord{
+			Volume:      name,
+			From:        *current,
+			To:          target,
+			Utilization: utilization,
+			Time:        metav1.NewTime(now),
+		})
+	}
+	return vcts, records, exhausted, nil
+}
+
+func volumeExpansionThreshold(policy *appsv1.VolumeAutoExpansionPolicy, compDef *appsv1.ComponentDefinition, name string) int32 {
+	if policy.Threshold != nil {
+		return *policy.Threshold
+	}
+	for _, vol := range compDef.Spec.Volumes {
+		if vol.Name == name && vol.HighWatermark > 0 {
+			return int32(vol.HighWatermark)
+		}
+	}
+	return defaultVolumeExpansionThreshold
+}
+
+func inVolumeExpansionCooldown(policy *appsv1.VolumeAutoExpansionPolicy, records []appsv1.VolumeExpansionRecord, name string, now time.Time) bool {
+	cooldown := time.Duration(ptr.Deref(policy.CooldownSeconds, 0)) * time.Second
+	for i := len(records) - 1; i >= 0; i-- {
+		if records[i].Volume == name {
+			return now.Before(records[i].Time.Add(cooldown))
+		}
+	}
+	return false
+}
+
+// volumeExpansionTarget returns the size to expand the volume to, which is rounded up to Gi and capped by the max size.
+func volumeExpansionTarget(policy *appsv1.VolumeAutoExpansionPolicy, current resource.Quantity) (resource.Quantity, error) {
+	step := policy.Step
+	if len(step) == 0 {
+		step = defaultVolumeExpansionStep
+	}
+	var stepBytes int64
+	if strings.HasSuffix(step, "%") {
+		percent, err := strconv.ParseInt(strings.TrimSuffix(step, "%"), 10, 64)
+		if err != nil {
+			return resource.Quantity{}, fmt.Errorf("invalid volume expansion step %s: %s", step, err.Error())
+		}
+		stepBytes = int64(math.Ceil(float64(current.Value()) * float64(percent) / 100))
+	} else {
+		quantity, err := resource.ParseQuantity(step)
+		if err != nil {
+			return resource.Quantity{}, fmt.Errorf("invalid volume expansion step %s: %s", step, err.Error())
+		}
+		stepBytes = quantity.Value()
+	}
+	if stepBytes <= 0 {
+		return resource.Quantity{}, fmt.Errorf("invalid volume expansion step %s", step)
+	}
+
+	const gi = int64(1) << 30
+	target := current.Value() + stepBytes
+	target = (target + gi - 1) / gi * gi
+	if target >= policy.MaxSize.Value() {
+		return policy.MaxSize.DeepCopy(), nil
+	}
+	return *resource.NewQuantity(target, resource.BinarySI), nil
+}
+
+func buildVolumeExpansionOpsRequest(comp *appsv1.Component, vcts []opsv1alpha1.OpsRequestVolumeClaimTemplate) (*opsv1alpha1.OpsRequest, error) {
+	clusterName, err := GetClusterName(comp)
+	if err != nil {
+		return nil, err
+	}
+	compName, ok := comp.Labels[constant.KBAppShardingNameLabelKey]
+	if !ok {
+		if compName, err = GetComponentName(comp); err != nil {
+			return nil, err
+		}
+	}
+	return &opsv1alpha1.OpsRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-volumeexpansion-%d", comp.Name, time.Now().Unix()),
+			Namespace: comp.Namespace,
+			Labels: map[string]string{
+				constant.VolumeAutoExpansionLabelKey: comp.Name,
+			},
+		},
+		Spec: opsv1alpha1.OpsRequestSpec{
+			ClusterName: clusterName,
+			Type:        opsv1alpha1.VolumeExpansionType,
+			SpecificOpsRequest: opsv1alpha1.SpecificOpsRequest{
+				VolumeExpansionList: []opsv1alpha1.VolumeExpansion{
+					{
+						ComponentOps:         opsv1alpha1.ComponentOps{ComponentName: compName},
+						VolumeClaimTemplates: vcts,
+					},
+				},
+			},
+		},
+	}, nil
+}
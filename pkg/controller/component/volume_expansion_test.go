@@ -0,0 +1,201 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package component
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+var _ = Describe("volume auto-expansion", func() {
+	var (
+		comp    *appsv1.Component
+		compDef *appsv1.ComponentDefinition
+		now     time.Time
+	)
+
+	newVCT := func(name, storage string) appsv1.PersistentVolumeClaimTemplate {
+		return appsv1.PersistentVolumeClaimTemplate{
+			Name: name,
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(storage)},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		compDef = &appsv1.ComponentDefinition{
+			Spec: appsv1.ComponentDefinitionSpec{
+				Volumes: []appsv1.ComponentVolume{{Name: "data", HighWatermark: 90}},
+			},
+		}
+		comp = &appsv1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test-cluster-comp",
+				Labels: map[string]string{
+					constant.AppInstanceLabelKey:    "test-cluster",
+					constant.KBAppComponentLabelKey: "comp",
+				},
+			},
+			Spec: appsv1.ComponentSpec{
+				VolumeClaimTemplates: []appsv1.PersistentVolumeClaimTemplate{newVCT("data", "10Gi"), newVCT("log", "1Gi")},
+				VolumeAutoExpansion: &appsv1.VolumeAutoExpansionPolicy{
+					Volumes:         []string{"data", "log"},
+					Step:            "20%",
+					MaxSize:         resource.MustParse("20Gi"),
+					CooldownSeconds: ptr.To[int32](3600),
+				},
+			},
+		}
+	})
+
+	Context("plan", func() {
+		It("uses the high watermark of the volume as the default threshold", func() {
+			stats := []proto.VolumeStats{{Name: "data", Utilization: 85}, {Name: "log", Utilization: 85}}
+			vcts, records, exhausted, err := planVolumeExpansion(comp, compDef, stats, now)
+			Expect(err).Should(BeNil())
+			Expect(exhausted).Should(BeEmpty())
+			Expect(vcts).Should(HaveLen(1))
+			Expect(vcts[0].Name).Should(Equal("log"))
+			Expect(vcts[0].Storage.String()).Should(Equal("2Gi"))
+			Expect(records).Should(HaveLen(1))
+			Expect(records[0].Volume).Should(Equal("log"))
+			Expect(records[0].From.String()).Should(Equal("1Gi"))
+			Expect(records[0].To.String()).Should(Equal("2Gi"))
+			Expect(records[0].Utilization).Should(BeEquivalentTo(85))
+		})
+
+		It("uses the threshold of the policy", func() {
+			comp.Spec.VolumeAutoExpansion.Threshold = ptr.To[int32](70)
+			stats := []proto.VolumeStats{{Name: "data", Utilization: 75}, {Name: "log", Utilization: 60}}
+			vcts, _, _, err := planVolumeExpansion(comp, compDef, stats, now)
+			Expect(err).Should(BeNil())
+			Expect(vcts).Should(HaveLen(1))
+			Expect(vcts[0].Name).Should(Equal("data"))
+			Expect(vcts[0].Storage.String()).Should(Equal("12Gi"))
+		})
+
+		It("respects the cool-down and the max size", func() {
+			comp.Spec.VolumeClaimTemplates[0] = newVCT("data", "20Gi")
+			comp.Status.VolumeExpansions = []appsv1.VolumeExpansionRecord{
+				{Volume: "log", Time: metav1.NewTime(now.Add(-30 * time.Minute))},
+			}
+			stats := []proto.VolumeStats{{Name: "data", Utilization: 95}, {Name: "log", Utilization: 95}}
+			vcts, records, exhausted, err := planVolumeExpansion(comp, compDef, stats, now)
+			Expect(err).Should(BeNil())
+			Expect(vcts).Should(BeEmpty())
+			Expect(records).Should(BeEmpty())
+			Expect(exhausted).Should(Equal([]string{"data"}))
+
+			vcts, _, _, err = planVolumeExpansion(comp, compDef, stats, now.Add(time.Hour))
+			Expect(err).Should(BeNil())
+			Expect(vcts).Should(HaveLen(1))
+			Expect(vcts[0].Name).Should(Equal("log"))
+		})
+	})
+
+	Context("target size", func() {
+		It("grows by an absolute step and caps at the max size", func() {
+			policy := comp.Spec.VolumeAutoExpansion
+			policy.Step = "5Gi"
+			target, err := volumeExpansionTarget(policy, resource.MustParse("10Gi"))
+			Expect(err).Should(BeNil())
+			Expect(target.String()).Should(Equal("15Gi"))
+
+			target, err = volumeExpansionTarget(policy, resource.MustParse("18Gi"))
+			Expect(err).Should(BeNil())
+			Expect(target.String()).Should(Equal("20Gi"))
+		})
+
+		It("rejects an invalid step", func() {
+			policy := comp.Spec.VolumeAutoExpansion
+			policy.Step = "0%"
+			_, err := volumeExpansionTarget(policy, resource.MustParse("10Gi"))
+			Expect(err).ShouldNot(BeNil())
+		})
+	})
+
+	Context("ops request", func() {
+		It("expands the volumes of the cluster component", func() {
+			vcts := []opsv1alpha1.OpsRequestVolumeClaimTemplate{{Name: "data", Storage: resource.MustParse("12Gi")}}
+			opsRequest, err := buildVolumeExpansionOpsRequest(comp, vcts)
+			Expect(err).Should(BeNil())
+			Expect(opsRequest.Namespace).Should(Equal("default"))
+			Expect(opsRequest.Labels).Should(HaveKeyWithValue(constant.VolumeAutoExpansionLabelKey, "test-cluster-comp"))
+			Expect(opsRequest.Spec.ClusterName).Should(Equal("test-cluster"))
+			Expect(opsRequest.Spec.Type).Should(Equal(opsv1alpha1.VolumeExpansionType))
+			Expect(opsRequest.Spec.VolumeExpansionList).Should(HaveLen(1))
+			Expect(opsRequest.Spec.VolumeExpansionList[0].ComponentName).Should(Equal("comp"))
+			Expect(opsRequest.Spec.VolumeExpansionList[0].VolumeClaimTemplates).Should(Equal(vcts))
+
+			comp.Labels[constant.KBAppShardingNameLabelKey] = "shard"
+			opsRequest, err = buildVolumeExpansionOpsRequest(comp, vcts)
+			Expect(err).Should(BeNil())
+			Expect(opsRequest.Spec.VolumeExpansionList[0].ComponentName).Should(Equal("shard"))
+		})
+	})
+
+	Context("in progress", func() {
+		It("checks the labeled OpsRequests rather than the status records", func() {
+			scheme := runtime.NewScheme()
+			Expect(opsv1alpha1.AddToScheme(scheme)).Should(Succeed())
+			opsRequest, err := buildVolumeExpansionOpsRequest(comp, []opsv1alpha1.OpsRequestVolumeClaimTemplate{
+				{Name: "data", Storage: resource.MustParse("12Gi")},
+			})
+			Expect(err).Should(BeNil())
+			opsRequest.Name = "test-cluster-comp-volumeexpansion"
+			opsRequest.Status.Phase = opsv1alpha1.OpsRunningPhase
+			cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(opsRequest).WithStatusSubresource(opsRequest).Build()
+			reqCtx := intctrlutil.RequestCtx{Ctx: context.Background()}
+
+			handler := &VolumeExpansionEventHandler{}
+			inProgress, err := handler.expansionInProgress(reqCtx, cli, comp)
+			Expect(err).Should(BeNil())
+			Expect(comp.Status.VolumeExpansions).Should(BeEmpty())
+			Expect(inProgress).Should(BeTrue())
+
+			opsRequest.Status.Phase = opsv1alpha1.OpsSucceedPhase
+			Expect(cli.Status().Update(context.Background(), opsRequest)).Should(Succeed())
+			inProgress, err = handler.expansionInProgress(reqCtx, cli, comp)
+			Expect(err).Should(BeNil())
+			Expect(inProgress).Should(BeFalse())
+		})
+	})
+})
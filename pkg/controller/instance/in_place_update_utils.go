@@ -340,7 +340,7 @@ func getPodUpdatePolicy(inst *workloads.Instance, pod *corev1.Pod) (podUpdatePol
 		return recreatePolicy, inst.Spec.PodUpdatePolicy, nil
 	}
 
-	newPod, err := buildInstancePod(inst, getPodRevision(pod))
+	newPod, err := buildInstancePodForUpdate(inst, pod)
 	if err != nil {
 		return noOpsPolicy, "", err
 	}
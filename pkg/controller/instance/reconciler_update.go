@@ -148,7 +148,7 @@ func (r *updateReconciler) Reconcile(tree *kubebuilderx.ObjectTree) (kubebuilder
 
 		switch updatePolicy {
 		case inPlaceUpdatePolicy:
-			newPod, err := buildInstancePod(inst, getPodRevision(pod))
+			newPod, err := buildInstancePodForUpdate(inst, pod)
 			if err != nil {
 				return kubebuilderx.Continue, err
 			}
@@ -195,6 +195,17 @@ func buildInstancePod(inst *workloads.Instance, revision string) (*corev1.Pod, e
 	return pod, nil
 }
 
+func buildInstancePodForUpdate(inst *workloads.Instance, oldPod *corev1.Pod) (*corev1.Pod, error) {
+	newPod, err := buildInstancePod(inst, getPodRevision(oldPod))
+	if err != nil {
+		return nil, err
+	}
+	if err = stagedConfigsToPod(inst.Spec.Configs, oldPod, newPod); err != nil {
+		return nil, err
+	}
+	return newPod, nil
+}
+
 func buildInstancePVCs(inst *workloads.Instance) ([]*corev1.PersistentVolumeClaim, error) {
 	var pvcs []*corev1.PersistentVolumeClaim
 	labels := getMatchLabels(inst.Name)
@@ -268,6 +279,35 @@ func configsFromPod(pod *corev1.Pod) ([]workloads.ConfigTemplate, error) {
 	return configs, nil
 }
 
+// stagedConfigsToPod keeps the config hashes of the old pod for configs that are being rolled out in stages
+// and do not target the pod yet, so the pod will not be treated as updated before the config is applied to it.
+func stagedConfigsToPod(configs []workloads.ConfigTemplate, oldPod, newPod *corev1.Pod) error {
+	oldConfigs, err := configsFromPod(oldPod)
+	if err != nil {
+		return err
+	}
+	staged := false
+	merged := make([]workloads.ConfigTemplate, 0, len(configs))
+	for _, config := range configs {
+		if len(config.Instances) > 0 && !slices.Contains(config.Instances, oldPod.Name) {
+			staged = true
+			idx := slices.IndexFunc(oldConfigs, func(cfg workloads.ConfigTemplate) bool {
+				return cfg.Name == config.Name
+			})
+			if idx < 0 {
+				continue
+			}
+			config = oldConfigs[idx]
+		}
+		merged = append(merged, config)
+	}
+	if !staged {
+		return nil
+	}
+	delete(newPod.Annotations, constant.CMInsConfigurationHashLabelKey)
+	return configsToPod(merged, newPod)
+}
+
 func configsToUpdate(inst *workloads.Instance, pod *corev1.Pod) ([]workloads.ConfigTemplate, error) {
 	configs, err := configsFromPod(pod)
 	if err != nil {
@@ -275,6 +315,9 @@ func configsToUpdate(inst *workloads.Instance, pod *corev1.Pod) ([]workloads.Con
 	}
 	toUpdate := make([]workloads.ConfigTemplate, 0)
 	for i, config := range inst.Spec.Configs {
+		if len(config.Instances) > 0 && !slices.Contains(config.Instances, pod.Name) {
+			continue // the config is being rolled out in stages, and the pod is not in the current stage
+		}
 		idx := slices.IndexFunc(configs, func(cfg workloads.ConfigTemplate) bool {
 			return cfg.Name == config.Name
 		})
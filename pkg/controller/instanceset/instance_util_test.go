@@ -121,6 +121,40 @@ var _ = Describe("instance util test", func() {
 			Expect(toUpdate[0].Name).Should(Equal("valkey-replication-config"))
 		})
 
+		It("skips the config staged to other instances", func() {
+			its := builder.NewInstanceSetBuilder(namespace, name).
+				SetConfigs([]workloads.ConfigTemplate{{
+					Name:       "valkey-replication-config",
+					ConfigHash: ptr.To("desired-hash"),
+					Instances:  []string{name + "-1"},
+				}}).
+				GetObject()
+			pod := builder.NewPodBuilder(namespace, name+"-0").GetObject()
+			Expect(configsToPod([]workloads.ConfigTemplate{{
+				Name:       "valkey-replication-config",
+				ConfigHash: ptr.To("old-hash"),
+			}}, pod)).Should(Succeed())
+
+			toUpdate, err := configsToUpdate(its, pod)
+			Expect(err).Should(BeNil())
+			Expect(toUpdate).Should(BeEmpty())
+
+			By("keep the config hash of the pod when it's rebuilt for update")
+			newPod := pod.DeepCopy()
+			Expect(configsToPod(its.Spec.Configs, newPod)).Should(Succeed())
+			Expect(stagedConfigsToPod(its.Spec.Configs, pod, newPod)).Should(Succeed())
+			configs, err := configsFromPod(newPod)
+			Expect(err).Should(BeNil())
+			Expect(configs).Should(HaveLen(1))
+			Expect(configs[0].ConfigHash).Should(Equal(ptr.To("old-hash")))
+
+			By("update the pod once it's staged")
+			its.Spec.Configs[0].Instances = append(its.Spec.Configs[0].Instances, pod.Name)
+			toUpdate, err = configsToUpdate(its, pod)
+			Expect(err).Should(BeNil())
+			Expect(toUpdate).Should(HaveLen(1))
+		})
+
 		It("identifies safe metadata-only in-place updates per process-impact semantic", func() {
 			basePod := builder.NewPodBuilder(namespace, name+"-0").
 				AddAnnotations("kept", "value").
@@ -44,6 +44,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/controller/lifecycle"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	"github.com/apecloud/kubeblocks/pkg/kbagent"
+	kbaproto "github.com/apecloud/kubeblocks/pkg/kbagent/proto"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
 )
 
@@ -856,15 +857,43 @@ func (s *lifecycleCallSpy) MemberLeave(_ context.Context, _ client.Reader, _ *li
 	return nil
 }
 
+func (s *lifecycleCallSpy) Readonly(_ context.Context, _ client.Reader, _ *lifecycle.Options) error {
+	return nil
+}
+
+func (s *lifecycleCallSpy) Readwrite(_ context.Context, _ client.Reader, _ *lifecycle.Options) error {
+	return nil
+}
+
 func (s *lifecycleCallSpy) Reconfigure(_ context.Context, _ client.Reader, _ *lifecycle.Options, _ map[string]string) error {
 	s.reconfigureCalls++
 	return nil
 }
 
+func (s *lifecycleCallSpy) ParameterQuery(_ context.Context, _ client.Reader, _ *lifecycle.Options, _ []string) (map[string]string, error) {
+	return nil, nil
+}
+
 func (s *lifecycleCallSpy) AccountProvision(_ context.Context, _ client.Reader, _ *lifecycle.Options, _, _, _ string) error {
 	return nil
 }
 
+func (s *lifecycleCallSpy) ReplicationSetup(_ context.Context, _ client.Reader, _ *lifecycle.Options, _ kbappsv1.ComponentReplicationSource) error {
+	return nil
+}
+
+func (s *lifecycleCallSpy) Promote(_ context.Context, _ client.Reader, _ *lifecycle.Options) error {
+	return nil
+}
+
+func (s *lifecycleCallSpy) DataLoad(_ context.Context, _ client.Reader, _ *lifecycle.Options, _ string, _ lifecycle.DataSource, _ map[string]string) ([]kbaproto.TaskEvent, error) {
+	return nil, nil
+}
+
+func (s *lifecycleCallSpy) DataLoadStatus(_ context.Context, _ client.Reader, _ string) ([]kbaproto.TaskEvent, error) {
+	return nil, nil
+}
+
 func (s *lifecycleCallSpy) UserDefined(_ context.Context, _ client.Reader, _ *lifecycle.Options, _ string, _ *kbappsv1.Action, _ map[string]string) error {
 	return nil
 }
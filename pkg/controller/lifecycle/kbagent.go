@@ -21,6 +21,7 @@ package lifecycle
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -117,6 +118,24 @@ func (a *kbagent) MemberLeave(ctx context.Context, cli client.Reader, opts *Opti
 	return a.ignoreOutput(a.checkedCallAction(ctx, cli, a.lifecycleActions.MemberLeave, lfa, opts))
 }
 
+func (a *kbagent) Readonly(ctx context.Context, cli client.Reader, opts *Options) error {
+	lfa := &readonly{
+		namespace:   a.namespace,
+		clusterName: a.clusterName,
+		compName:    a.compName,
+	}
+	return a.ignoreOutput(a.checkedCallAction(ctx, cli, a.lifecycleActions.Readonly, lfa, opts))
+}
+
+func (a *kbagent) Readwrite(ctx context.Context, cli client.Reader, opts *Options) error {
+	lfa := &readwrite{
+		namespace:   a.namespace,
+		clusterName: a.clusterName,
+		compName:    a.compName,
+	}
+	return a.ignoreOutput(a.checkedCallAction(ctx, cli, a.lifecycleActions.Readwrite, lfa, opts))
+}
+
 func (a *kbagent) Reconfigure(ctx context.Context, cli client.Reader, opts *Options, args map[string]string) error {
 	lfa := &reconfigure{
 		args: args,
@@ -124,6 +143,17 @@ func (a *kbagent) Reconfigure(ctx context.Context, cli client.Reader, opts *Opti
 	return a.ignoreOutput(a.checkedCallAction(ctx, cli, a.lifecycleActions.Reconfigure, lfa, opts))
 }
 
+func (a *kbagent) ParameterQuery(ctx context.Context, cli client.Reader, opts *Options, names []string) (map[string]string, error) {
+	lfa := &parameterQuery{
+		names: names,
+	}
+	output, err := a.checkedCallAction(ctx, cli, a.lifecycleActions.ParameterQuery, lfa, opts)
+	if err != nil {
+		return nil, err
+	}
+	return parseParameterQueryOutput(output)
+}
+
 func (a *kbagent) AccountProvision(ctx context.Context, cli client.Reader, opts *Options, statement, user, password string) error {
 	lfa := &accountProvision{
 		statement: statement,
@@ -133,6 +163,154 @@ func (a *kbagent) AccountProvision(ctx context.Context, cli client.Reader, opts
 	return a.ignoreOutput(a.checkedCallAction(ctx, cli, a.lifecycleActions.AccountProvision, lfa, opts))
 }
 
+func (a *kbagent) ReplicationSetup(ctx context.Context, cli client.Reader, opts *Options, source appsv1.ComponentReplicationSource) error {
+	lfa := &replicationSetup{
+		namespace:   a.namespace,
+		clusterName: a.clusterName,
+		compName:    a.compName,
+		source:      source,
+	}
+	return a.ignoreOutput(a.checkedCallAction(ctx, cli, a.lifecycleActions.ReplicationSetup, lfa, opts))
+}
+
+func (a *kbagent) Promote(ctx context.Context, cli client.Reader, opts *Options) error {
+	lfa := &promote{
+		namespace:   a.namespace,
+		clusterName: a.clusterName,
+		compName:    a.compName,
+	}
+	return a.ignoreOutput(a.checkedCallAction(ctx, cli, a.lifecycleActions.Promote, lfa, opts))
+}
+
+func (a *kbagent) DataLoad(ctx context.Context, cli client.Reader, opts *Options, uid string, source DataSource, args map[string]string) ([]proto.TaskEvent, error) {
+	lfa := &dataLoad{args: args}
+	spec := a.lifecycleActions.DataLoad
+	if !spec.Defined() {
+		return nil, errors.Wrap(ErrActionNotDefined, lfa.name())
+	}
+	if err := a.precondition(ctx, cli, spec, func() client.MatchingLabels {
+		if opts == nil || opts.PreConditionObjectSelector == nil {
+			return nil
+		}
+		return opts.PreConditionObjectSelector
+	}()); err != nil {
+		return nil, err
+	}
+	task, err := a.buildDataLoadTask(ctx, cli, lfa, uid, source)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := a.selectTargetPods(spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("no available pod to execute action %s", lfa.name())
+	}
+	return a.callTask(ctx, cli, lfa, pods, func(pod *corev1.Pod) proto.TaskRequest {
+		podTask := *task
+		podTask.Replicas = pod.Name
+		newReplica := *task.NewReplica
+		newReplica.Replicas = pod.Name
+		podTask.NewReplica = &newReplica
+		return proto.TaskRequest{UID: uid, Task: &podTask}
+	})
+}
+
+func (a *kbagent) DataLoadStatus(ctx context.Context, cli client.Reader, uid string) ([]proto.TaskEvent, error) {
+	return a.callTask(ctx, cli, &dataLoad{}, a.pods, func(*corev1.Pod) proto.TaskRequest {
+		return proto.TaskRequest{UID: uid}
+	})
+}
+
+func (a *kbagent) buildDataLoadTask(ctx context.Context, cli client.Reader, lfa lifecycleAction, uid string, source DataSource) (*proto.Task, error) {
+	if source.Pod == nil {
+		return nil, fmt.Errorf("the source pod is required to execute action %s", lfa.name())
+	}
+	port, err := intctrlutil.GetPortByName(*source.Pod, kbagt.ContainerName, kbagt.DefaultStreamingPortName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "the source pod %s is unavailable to dump data", source.Pod.Name)
+	}
+	authorization, err := a.sourceAuthorization(ctx, cli, source)
+	if err != nil {
+		return nil, err
+	}
+	parameters, err := lfa.parameters(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.Task{
+		Instance:            constant.GenerateClusterComponentName(a.clusterName, a.compName),
+		Task:                DataLoadTask,
+		UID:                 uid,
+		NotifyAtFinish:      true,
+		ReportPeriodSeconds: dataLoadTaskReportPeriodSeconds,
+		NewReplica: &proto.NewReplicaTask{
+			Remote:        intctrlutil.PodFQDN(source.Namespace, constant.GenerateClusterComponentName(source.ClusterName, source.CompName), source.Pod.Name),
+			Port:          port,
+			Parameters:    parameters,
+			Authorization: authorization,
+		},
+	}, nil
+}
+
+// sourceAuthorization returns the authorization presented to the kb-agent of the source, the kb-agents of the same
+// component share the credentials, and the certificates of different components are not trusted by each other.
+func (a *kbagent) sourceAuthorization(ctx context.Context, cli client.Reader, source DataSource) (string, error) {
+	if source.Namespace == a.namespace && source.ClusterName == a.clusterName && source.CompName == a.compName {
+		return "", nil
+	}
+	creds, err := podCredentials(ctx, cli, source.Namespace, source.ClusterName, source.CompName, source.Pod)
+	if err != nil || creds == nil {
+		return "", err
+	}
+	if creds.Mode == proto.AuthModeMTLS {
+		return "", fmt.Errorf("the kb-agent of the source pod %s authenticates with the %s mode, which is not supported across components",
+			source.Pod.Name, proto.AuthModeMTLS)
+	}
+	return creds.Authorization(), nil
+}
+
+// callTask sends the task requests to the kb-agents of the pods, and returns the latest events of the task.
+func (a *kbagent) callTask(ctx context.Context, cli client.Reader, lfa lifecycleAction,
+	pods []*corev1.Pod, request func(*corev1.Pod) proto.TaskRequest) ([]proto.TaskEvent, error) {
+	var events []proto.TaskEvent
+	for _, pod := range pods {
+		agent, err := a.newClient(ctx, cli, pod, lfa)
+		if err != nil {
+			return nil, err
+		}
+		if agent == nil {
+			continue // not kb-agent container and port defined, for test only
+		}
+		taskAgent, ok := agent.(kbacli.TaskClient)
+		if !ok {
+			_ = agent.Close()
+			return nil, errors.Wrapf(ErrActionNotImplemented, "the kb-agent client of pod %s does not support tasks", pod.Name)
+		}
+
+		req := request(pod)
+		rsp, err := taskAgent.Task(ctx, req)
+		_ = agent.Close()
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "http error occurred when executing action %s at pod %s", lfa.name(), pod.Name)
+		}
+		if len(rsp.Error) > 0 {
+			if req.Task == nil && errors.Is(proto.Type2Error(rsp.Error), proto.ErrNotDefined) {
+				continue // the pod has no such task
+			}
+			return nil, a.formatError(lfa, rsp, pod.Name)
+		}
+		event := proto.TaskEvent{}
+		if err = json.Unmarshal(rsp.Output, &event); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the event of task %s at pod %s", req.UID, pod.Name)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
 func (a *kbagent) UserDefined(ctx context.Context, cli client.Reader, opts *Options, name string, action *appsv1.Action, args map[string]string) error {
 	lfa := &udf{
 		uname: name,
@@ -261,7 +439,7 @@ func (a *kbagent) callAction(ctx context.Context, cli client.Reader, spec *appsv
 	if err1 != nil {
 		return nil, err1
 	}
-	return a.callActionWithSelector(ctx, spec, lfa, req)
+	return a.callActionWithSelector(ctx, cli, spec, lfa, req)
 }
 
 // BuildKBAgentRetryPolicy normalizes the API retry policy into the kbagent wire contract.
@@ -378,7 +556,7 @@ func (a *kbagent) templateVarsParameters() (map[string]string, error) {
 	return m, nil
 }
 
-func (a *kbagent) callActionWithSelector(ctx context.Context, spec *appsv1.Action, lfa lifecycleAction, req *proto.ActionRequest) ([]byte, error) {
+func (a *kbagent) callActionWithSelector(ctx context.Context, cli client.Reader, spec *appsv1.Action, lfa lifecycleAction, req *proto.ActionRequest) ([]byte, error) {
 	pods, err := a.selectTargetPods(spec)
 	if err != nil {
 		return nil, err
@@ -395,22 +573,7 @@ func (a *kbagent) callActionWithSelector(ctx context.Context, spec *appsv1.Actio
 	var output []byte
 	var actionErrors []error
 	for _, pod := range pods {
-		endpoint := func() (string, int32, error) {
-			host, port, err := a.serverEndpoint(pod)
-			if err != nil {
-				return "", 0, errors.Wrapf(err, "pod %s is unavailable to execute action %s", pod.Name, lfa.name())
-			}
-			return host, port, nil
-		}
-		var cli kbacli.Client
-		_, err := rest.InClusterConfig()
-		if err != nil {
-			// If kb is not run in a k8s cluster, using pod ip to call kb-agent would fail.
-			// So we use a client that utilizes k8s' portforward ability.
-			cli, err = kbacli.NewPortForwardClient(pod, endpoint)
-		} else {
-			cli, err = kbacli.NewClient(endpoint)
-		}
+		agent, err := a.newClient(ctx, cli, pod, lfa)
 		if err != nil {
 			if !aggregateErrors {
 				return nil, err // mock client error
@@ -418,12 +581,12 @@ func (a *kbagent) callActionWithSelector(ctx context.Context, spec *appsv1.Actio
 			actionErrors = append(actionErrors, errors.Wrapf(err, "error creating client to execute action %s at pod %s", lfa.name(), pod.Name))
 			continue
 		}
-		if cli == nil {
+		if agent == nil {
 			continue // not kb-agent container and port defined, for test only
 		}
 
-		rsp, err := cli.Action(ctx, *req)
-		_ = cli.Close()
+		rsp, err := agent.Action(ctx, *req)
+		_ = agent.Close()
 
 		if err != nil {
 			actionErr := errors.Wrapf(err, "http error occurred when executing action %s at pod %s", lfa.name(), pod.Name)
@@ -452,6 +615,79 @@ func (a *kbagent) callActionWithSelector(ctx context.Context, spec *appsv1.Actio
 	return output, nil
 }
 
+// newClient returns the client to call the kb-agent of the pod, nil if the pod has no kb-agent defined.
+func (a *kbagent) newClient(ctx context.Context, cli client.Reader, pod *corev1.Pod, lfa lifecycleAction) (kbacli.Client, error) {
+	endpoint := func() (string, int32, error) {
+		host, port, err := a.serverEndpoint(pod)
+		if err != nil {
+			return "", 0, errors.Wrapf(err, "pod %s is unavailable to execute action %s", pod.Name, lfa.name())
+		}
+		return host, port, nil
+	}
+	creds, err := a.credentials(ctx, cli, pod)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = rest.InClusterConfig(); err != nil {
+		// If kb is not run in a k8s cluster, using pod ip to call kb-agent would fail.
+		// So we use a client that utilizes k8s' portforward ability.
+		return kbacli.NewPortForwardClientWithCredentials(pod, endpoint, creds, "localhost")
+	}
+	serverName := intctrlutil.PodFQDN(a.namespace, constant.GenerateClusterComponentName(a.clusterName, a.compName), pod.Name)
+	if grpcPort, err := intctrlutil.GetPortByName(*pod, kbagt.ContainerName, kbagt.DefaultGRPCPortName); err == nil {
+		// the kb-agent serves the gRPC transport
+		grpcEndpoint := func() (string, int32, error) {
+			host, _, err := endpoint()
+			return host, grpcPort, err
+		}
+		return kbacli.NewGRPCClient(grpcEndpoint, creds, serverName)
+	}
+	return kbacli.NewClientWithCredentials(endpoint, creds, serverName)
+}
+
+// NewEventClient returns the client to subscribe to the probe and task events of the kb-agent of the pod,
+// nil if the kb-agent doesn't serve the gRPC transport or the operator runs out of the k8s cluster.
+func NewEventClient(ctx context.Context, cli client.Reader, pod *corev1.Pod) (kbacli.EventClient, error) {
+	grpcPort, err := intctrlutil.GetPortByName(*pod, kbagt.ContainerName, kbagt.DefaultGRPCPortName)
+	if err != nil {
+		return nil, nil
+	}
+	if _, err = rest.InClusterConfig(); err != nil {
+		return nil, nil
+	}
+	if pod.Status.PodIP == "" {
+		return nil, fmt.Errorf("pod %v has no ip", pod.Name)
+	}
+	clusterName, compName := pod.Labels[constant.AppInstanceLabelKey], pod.Labels[constant.KBAppComponentLabelKey]
+	creds, err := podCredentials(ctx, cli, pod.Namespace, clusterName, compName, pod)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := func() (string, int32, error) {
+		return pod.Status.PodIP, grpcPort, nil
+	}
+	serverName := intctrlutil.PodFQDN(pod.Namespace, constant.GenerateClusterComponentName(clusterName, compName), pod.Name)
+	return kbacli.NewGRPCClient(endpoint, creds, serverName)
+}
+
+// credentials returns the credentials presented to the kb-agent of the pod, nil if the kb-agent doesn't authenticate its callers.
+func (a *kbagent) credentials(ctx context.Context, cli client.Reader, pod *corev1.Pod) (*proto.Credentials, error) {
+	return podCredentials(ctx, cli, a.namespace, a.clusterName, a.compName, pod)
+}
+
+func podCredentials(ctx context.Context, cli client.Reader, namespace, clusterName, compName string, pod *corev1.Pod) (*proto.Credentials, error) {
+	mode := kbagt.AuthModeOf(&pod.Spec)
+	if mode == "" || mode == proto.AuthModeNone {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: namespace, Name: kbagt.AuthSecretName(clusterName, compName)}
+	if err := cli.Get(ctx, key, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the kb-agent credentials of pod %s", pod.Name)
+	}
+	return proto.NewCredentials(mode, secret.Data)
+}
+
 func (a *kbagent) selectTargetPods(spec *appsv1.Action) ([]*corev1.Pod, error) {
 	return SelectTargetPods(a.pods, a.pod, spec)
 }
@@ -0,0 +1,49 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package lifecycle
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DataLoadTask is the name of the kb-agent task which loads the data streamed from the dataDump action.
+	DataLoadTask = "dataLoad"
+
+	dataLoadTaskReportPeriodSeconds = 30
+)
+
+type dataLoad struct {
+	args map[string]string
+}
+
+var _ lifecycleAction = &dataLoad{}
+
+func (a *dataLoad) name() string {
+	return "dataLoad"
+}
+
+func (a *dataLoad) parameters(ctx context.Context, cli client.Reader) (map[string]string, error) {
+	// The args are passed to both the dataDump action of the source and the dataLoad action,
+	// the template vars are not included to keep the variables of the source from being overridden.
+	return a.args, nil
+}
@@ -20,7 +20,11 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package lifecycle
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -29,6 +33,8 @@ const (
 	configFilesCreated = "KB_CONFIG_FILES_CREATED"
 	configFilesRemoved = "KB_CONFIG_FILES_REMOVED"
 	configFilesUpdated = "KB_CONFIG_FILES_UPDATED"
+
+	parameterNames = "KB_PARAMETER_NAMES"
 )
 
 func FileTemplateChanges(created, removed, updated string) map[string]string {
@@ -57,3 +63,76 @@ func (a *reconfigure) parameters(ctx context.Context, cli client.Reader) (map[st
 	// - KB_CONFIG_FILES_UPDATED: file1:checksum1,file2:checksum2...
 	return a.args, nil
 }
+
+type parameterQuery struct {
+	names []string
+}
+
+var _ lifecycleAction = &parameterQuery{}
+
+func (a *parameterQuery) name() string {
+	return "parameterQuery"
+}
+
+func (a *parameterQuery) parameters(ctx context.Context, cli client.Reader) (map[string]string, error) {
+	// The container executing this action has access to following variables:
+	//
+	// - KB_PARAMETER_NAMES: name1,name2...
+	return map[string]string{
+		parameterNames: strings.Join(a.names, ","),
+	}, nil
+}
+
+// parseParameterQueryOutput parses the JSON object output of the parameterQuery action,
+// the non-string values are formatted as they are in JSON.
+func parseParameterQueryOutput(output []byte) (map[string]string, error) {
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(bytes.TrimSpace(output), &values); err != nil {
+		return nil, fmt.Errorf("invalid output of the parameterQuery action: %s", err.Error())
+	}
+	result := make(map[string]string, len(values))
+	for name, raw := range values {
+		if string(raw) == "null" {
+			continue
+		}
+		var str string
+		if err := json.Unmarshal(raw, &str); err == nil {
+			result[name] = str
+			continue
+		}
+		result[name] = string(raw)
+	}
+	return result, nil
+}
+
+type readonly struct {
+	namespace   string
+	clusterName string
+	compName    string
+}
+
+var _ lifecycleAction = &readonly{}
+
+func (a *readonly) name() string {
+	return "readonly"
+}
+
+func (a *readonly) parameters(ctx context.Context, cli client.Reader) (map[string]string, error) {
+	return nil, nil
+}
+
+type readwrite struct {
+	namespace   string
+	clusterName string
+	compName    string
+}
+
+var _ lifecycleAction = &readwrite{}
+
+func (a *readwrite) name() string {
+	return "readwrite"
+}
+
+func (a *readwrite) parameters(ctx context.Context, cli client.Reader) (map[string]string, error) {
+	return nil, nil
+}
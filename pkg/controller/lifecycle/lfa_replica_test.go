@@ -0,0 +1,44 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package lifecycle
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseParameterQueryOutput(t *testing.T) {
+	values, err := parseParameterQueryOutput([]byte(`{"max_connections": "100", "long_query_time": 2.5, "read_only": false, "unknown": null}` + "\n"))
+	if err != nil {
+		t.Fatalf("failed to parse output: %v", err)
+	}
+	expected := map[string]string{
+		"max_connections": "100",
+		"long_query_time": "2.5",
+		"read_only":       "false",
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("unexpected values: %v", values)
+	}
+
+	if _, err = parseParameterQueryOutput([]byte("max_connections=100")); err == nil {
+		t.Fatalf("expected error for invalid output")
+	}
+}
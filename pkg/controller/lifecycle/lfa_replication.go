@@ -0,0 +1,92 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package lifecycle
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+const (
+	replicationSourceNamespace   = "KB_REPLICATION_SOURCE_NAMESPACE"
+	replicationSourceClusterName = "KB_REPLICATION_SOURCE_CLUSTER_NAME"
+	replicationSourceCompName    = "KB_REPLICATION_SOURCE_COMP_NAME"
+	replicationSourceHost        = "KB_REPLICATION_SOURCE_HOST"
+	replicationSourcePlacement   = "KB_REPLICATION_SOURCE_PLACEMENT"
+)
+
+type replicationSetup struct {
+	namespace   string
+	clusterName string
+	compName    string
+	source      appsv1.ComponentReplicationSource
+}
+
+var _ lifecycleAction = &replicationSetup{}
+
+func (a *replicationSetup) name() string {
+	return "replicationSetup"
+}
+
+func (a *replicationSetup) parameters(ctx context.Context, cli client.Reader) (map[string]string, error) {
+	// The container executing this action has access to following variables:
+	//
+	// - KB_REPLICATION_SOURCE_NAMESPACE: The namespace of the source Cluster.
+	// - KB_REPLICATION_SOURCE_CLUSTER_NAME: The name of the source Cluster.
+	// - KB_REPLICATION_SOURCE_COMP_NAME: The name of the source Component.
+	// - KB_REPLICATION_SOURCE_HOST: The host of the default Service of the source Component.
+	// - KB_REPLICATION_SOURCE_PLACEMENT: The data plane the source Cluster is placed in, if any.
+	params := map[string]string{
+		replicationSourceNamespace:   a.source.Namespace,
+		replicationSourceClusterName: a.source.ClusterName,
+		replicationSourceCompName:    a.source.ComponentName,
+		replicationSourceHost: intctrlutil.ServiceFQDN(a.source.Namespace,
+			constant.GenerateDefaultComponentServiceName(a.source.ClusterName, a.source.ComponentName)),
+	}
+	cluster := &appsv1.Cluster{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: a.source.Namespace, Name: a.source.ClusterName}, cluster); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	if placement := cluster.Annotations[constant.KBAppMultiClusterPlacementKey]; len(placement) > 0 {
+		params[replicationSourcePlacement] = placement
+	}
+	return params, nil
+}
+
+type promote struct {
+	namespace   string
+	clusterName string
+	compName    string
+}
+
+var _ lifecycleAction = &promote{}
+
+func (a *promote) name() string {
+	return "promote"
+}
+
+func (a *promote) parameters(ctx context.Context, cli client.Reader) (map[string]string, error) {
+	return nil, nil
+}
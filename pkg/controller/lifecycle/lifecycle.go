@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
 )
 
 type Options struct {
@@ -37,6 +38,14 @@ type Options struct {
 	PreConditionObjectSelector client.MatchingLabels
 }
 
+// DataSource is the replica of a Component which the data loaded by the dataLoad action is dumped from.
+type DataSource struct {
+	Namespace   string
+	ClusterName string
+	CompName    string
+	Pod         *corev1.Pod
+}
+
 type Lifecycle interface {
 	PostProvision(ctx context.Context, cli client.Reader, opts *Options) error
 
@@ -50,14 +59,30 @@ type Lifecycle interface {
 
 	MemberLeave(ctx context.Context, cli client.Reader, opts *Options) error
 
-	// Readonly(ctx context.Context, cli client.Reader, opts *Options) error
+	Readonly(ctx context.Context, cli client.Reader, opts *Options) error
 
-	// Readwrite(ctx context.Context, cli client.Reader, opts *Options) error
+	Readwrite(ctx context.Context, cli client.Reader, opts *Options) error
 
 	Reconfigure(ctx context.Context, cli client.Reader, opts *Options, args map[string]string) error
 
+	// ParameterQuery returns the live values of the parameters from the replica, the parameters unknown
+	// to the engine are absent from the result.
+	ParameterQuery(ctx context.Context, cli client.Reader, opts *Options, names []string) (map[string]string, error)
+
 	AccountProvision(ctx context.Context, cli client.Reader, opts *Options, statement, user, password string) error
 
+	ReplicationSetup(ctx context.Context, cli client.Reader, opts *Options, source appsv1.ComponentReplicationSource) error
+
+	Promote(ctx context.Context, cli client.Reader, opts *Options) error
+
+	// DataLoad submits the task to load the data dumped by the dataDump action of the source to the target pods
+	// of the dataLoad action, and returns the latest events of the task. The task runs in the background, and
+	// it is submitted only once for the same uid.
+	DataLoad(ctx context.Context, cli client.Reader, opts *Options, uid string, source DataSource, args map[string]string) ([]proto.TaskEvent, error)
+
+	// DataLoadStatus returns the latest events of the data load task of the uid, the pods which have no such task are ignored.
+	DataLoadStatus(ctx context.Context, cli client.Reader, uid string) ([]proto.TaskEvent, error)
+
 	UserDefined(ctx context.Context, cli client.Reader, opts *Options, name string, action *appsv1.Action, args map[string]string) error
 }
 
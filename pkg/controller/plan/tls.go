@@ -30,6 +30,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 )
 
 // TLSSecretKeys identifies where generated certificate data is stored in a Secret.
@@ -46,22 +47,24 @@ func ComposeTLSCertsWithSecret(synthesizedComp component.SynthesizedComponent,
 		namespace   = synthesizedComp.Namespace
 		clusterName = synthesizedComp.ClusterName
 		compName    = synthesizedComp.Name
+		// the pod FQDNs under the headless service, the same as the server names the clients verify
+		podFQDNs = "*." + intctrlutil.ServiceFQDN(namespace, fmt.Sprintf("%s-%s-headless", clusterName, compName))
 	)
 
 	// TODO: should avoid using Go template to call a function, this is too hacky & costly, should just call underlying registered Go template function.
 	// use ca gen cert
 	// IP: 127.0.0.1 and ::1
-	// DNS: localhost and *.<clusterName>-<compName>-headless.<namespace>.svc.cluster.local
+	// DNS: localhost and *.<clusterName>-<compName>-headless.<namespace>.svc.<clusterDomain>
 	const spliter = "___spliter___"
 	SignedCertTpl := fmt.Sprintf(`
 	{{- $ca := genCA "KubeBlocks" 36500 -}}
-	{{- $cert := genSignedCert "%s peer" (list "127.0.0.1" "::1") (list "localhost" "*.%s-%s-headless.%s.svc.cluster.local") 36500 $ca -}}
+	{{- $cert := genSignedCert "%s peer" (list "127.0.0.1" "::1") (list "localhost" "%s") 36500 $ca -}}
 	{{- $ca.Cert -}}
 	{{- print "%s" -}}
 	{{- $cert.Cert -}}
 	{{- print "%s" -}}
 	{{- $cert.Key -}}
-`, compName, clusterName, compName, namespace, spliter, spliter)
+`, compName, podFQDNs, spliter, spliter)
 	out, err := buildFromTemplate(SignedCertTpl, nil)
 	if err != nil {
 		return nil, err
@@ -20,6 +20,9 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package plan
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -27,7 +30,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	"github.com/apecloud/kubeblocks/pkg/constant"
 	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
 )
 
 var _ = Describe("TLS test", func() {
@@ -56,4 +61,26 @@ var _ = Describe("TLS test", func() {
 		Expect(secret.Data[*keys.Cert]).ShouldNot(BeZero())
 		Expect(secret.Data[*keys.Key]).ShouldNot(BeZero())
 	})
+
+	It("uses the cluster domain in the SANs", func() {
+		viper.Set(constant.KubernetesClusterDomainEnv, "example.org")
+		defer viper.Set(constant.KubernetesClusterDomainEnv, constant.DefaultDNSDomain)
+
+		keys := TLSSecretKeys{Cert: ptr.To("cert.pem")}
+		synthesizedComp := component.SynthesizedComponent{
+			Namespace:   "default",
+			ClusterName: "foo",
+			Name:        "bar",
+		}
+		secret := &corev1.Secret{Data: map[string][]byte{}}
+		_, err := ComposeTLSCertsWithSecret(synthesizedComp, keys, secret)
+		Expect(err).Should(BeNil())
+
+		block, _ := pem.Decode(secret.Data[*keys.Cert])
+		Expect(block).ShouldNot(BeNil())
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).Should(BeNil())
+		Expect(cert.DNSNames).Should(ConsistOf("localhost", "*.foo-bar-headless.default.svc.example.org"))
+		Expect(cert.VerifyHostname("foo-bar-0.foo-bar-headless.default.svc.example.org")).Should(Succeed())
+	})
 })
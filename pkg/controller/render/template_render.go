@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -115,6 +116,19 @@ func (r *templateRenderWrapper) RenderConfigMapTemplate(templateSpec appsv1.Comp
 	return renderedData, nil
 }
 
+// RenderExpressions evaluates expressions using template engine, and trims the surrounding whitespaces of the results.
+func (r *templateRenderWrapper) RenderExpressions(name string, expressions map[string]string) (map[string]string, error) {
+	r.setTemplateName(name)
+	rendered, err := r.render(expressions)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range rendered {
+		rendered[key] = strings.TrimSpace(value)
+	}
+	return rendered, nil
+}
+
 func (r *templateRenderWrapper) setTemplateName(templateName string) {
 	r.templateName = templateName
 }
@@ -72,6 +72,17 @@ type TemplateRender interface {
 	RenderComponentTemplate(templateSpec appsv1.ComponentFileTemplate,
 		cmName string,
 		dataValidator RenderedValidator) (*corev1.ConfigMap, error)
+
+	// RenderExpressions evaluates expressions with the same built-in objects and functions as the templates.
+	//
+	// Parameters:
+	// - name: The name used to identify the expressions in errors.
+	// - expressions: The expressions to be evaluated, keyed by an arbitrary identifier.
+	//
+	// Returns:
+	// - A map containing the trimmed results, keyed by the same identifiers.
+	// - An error if any of the expressions fails.
+	RenderExpressions(name string, expressions map[string]string) (map[string]string, error)
 }
 
 type RenderedValidator = func(map[string]string) error
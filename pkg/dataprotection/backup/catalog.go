@@ -0,0 +1,114 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+)
+
+// BackupCatalogManifestKey is the key of the catalog manifest in the ConfigMap
+// created by the backup manager container.
+const BackupCatalogManifestKey = "catalog.json"
+
+// backupCatalogManifest is the manifest emitted by the backup action to the
+// file ${DP_BACKUP_CATALOG_FILE}, for example:
+//
+//	{"entries":[{"kind":"Database","name":"db1","size":"1Gi"},{"kind":"Table","database":"db1","name":"t1"}],
+//	 "restorableKinds":["Database","Table"]}
+type backupCatalogManifest struct {
+	Entries         []dpv1alpha1.BackupCatalogEntry     `json:"entries,omitempty"`
+	RestorableKinds []dpv1alpha1.BackupCatalogEntryKind `json:"restorableKinds,omitempty"`
+}
+
+// BuildBackupCatalogSpec merges the catalog manifests emitted by the backup actions
+// of all target pods into the spec of the BackupCatalog. The entries are deduplicated,
+// and only the kinds restorable from every target are kept restorable.
+func BuildBackupCatalogSpec(backupName string, manifests []string) (*dpv1alpha1.BackupCatalogSpec, error) {
+	spec := &dpv1alpha1.BackupCatalogSpec{BackupName: backupName}
+	entrySet := map[dpv1alpha1.BackupCatalogEntry]struct{}{}
+	var restorableKinds []dpv1alpha1.BackupCatalogEntryKind
+	for i, data := range manifests {
+		manifest := &backupCatalogManifest{}
+		if err := json.Unmarshal([]byte(data), manifest); err != nil {
+			return nil, fmt.Errorf("failed to parse the backup catalog manifest: %w", err)
+		}
+		for _, entry := range manifest.Entries {
+			if err := validateBackupCatalogEntry(entry); err != nil {
+				return nil, err
+			}
+			if _, ok := entrySet[entry]; ok {
+				continue
+			}
+			entrySet[entry] = struct{}{}
+			spec.Entries = append(spec.Entries, entry)
+		}
+		for _, kind := range manifest.RestorableKinds {
+			if !isValidBackupCatalogEntryKind(kind) {
+				return nil, fmt.Errorf("invalid restorable kind %q in the backup catalog manifest", kind)
+			}
+		}
+		if i == 0 {
+			restorableKinds = manifest.RestorableKinds
+			continue
+		}
+		restorableKinds = intersectEntryKinds(restorableKinds, manifest.RestorableKinds)
+	}
+	spec.RestorableKinds = restorableKinds
+	return spec, nil
+}
+
+func validateBackupCatalogEntry(entry dpv1alpha1.BackupCatalogEntry) error {
+	if !isValidBackupCatalogEntryKind(entry.Kind) {
+		return fmt.Errorf("invalid kind %q of the backup catalog entry %q", entry.Kind, entry.Name)
+	}
+	if entry.Name == "" {
+		return fmt.Errorf("the name of the backup catalog entry is empty")
+	}
+	if entry.Kind == dpv1alpha1.BackupCatalogEntryKindTable && entry.Database == "" {
+		return fmt.Errorf("the database of the table %q is empty", entry.Name)
+	}
+	return nil
+}
+
+func isValidBackupCatalogEntryKind(kind dpv1alpha1.BackupCatalogEntryKind) bool {
+	switch kind {
+	case dpv1alpha1.BackupCatalogEntryKindDatabase,
+		dpv1alpha1.BackupCatalogEntryKindTable,
+		dpv1alpha1.BackupCatalogEntryKindFile:
+		return true
+	}
+	return false
+}
+
+func intersectEntryKinds(a, b []dpv1alpha1.BackupCatalogEntryKind) []dpv1alpha1.BackupCatalogEntryKind {
+	var result []dpv1alpha1.BackupCatalogEntryKind
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				result = append(result, x)
+				break
+			}
+		}
+	}
+	return result
+}
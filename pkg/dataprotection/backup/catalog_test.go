@@ -0,0 +1,51 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+)
+
+func TestBuildBackupCatalogSpec(t *testing.T) {
+	manifests := []string{
+		`{"entries":[{"kind":"Database","name":"db1","size":"1Gi"},{"kind":"Table","database":"db1","name":"t1"}],"restorableKinds":["Database","Table"]}`,
+		`{"entries":[{"kind":"Database","name":"db1","size":"1Gi"},{"kind":"Database","name":"db2"}],"restorableKinds":["Database"]}`,
+	}
+	spec, err := BuildBackupCatalogSpec("backup", manifests)
+	assert.NoError(t, err)
+	assert.Equal(t, "backup", spec.BackupName)
+	assert.Len(t, spec.Entries, 3)
+	assert.Equal(t, []dpv1alpha1.BackupCatalogEntryKind{dpv1alpha1.BackupCatalogEntryKindDatabase}, spec.RestorableKinds)
+
+	for _, manifest := range []string{
+		`{"entries":[`,
+		`{"entries":[{"kind":"Schema","name":"s1"}]}`,
+		`{"entries":[{"kind":"Table","name":"t1"}]}`,
+		`{"entries":[{"kind":"Database"}]}`,
+		`{"restorableKinds":["Schema"]}`,
+	} {
+		_, err = BuildBackupCatalogSpec("backup", []string{manifest})
+		assert.Error(t, err, manifest)
+	}
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/common"
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	ctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/kms"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
@@ -95,23 +96,8 @@ func (d *Deleter) DeleteBackupFiles(backup *dpv1alpha1.Backup) (DeletionStatus,
 		return DeletionStatusSucceeded, nil
 	}
 	jobKey := BuildDeleteBackupFilesJobKey(backup, false)
-	job := &batchv1.Job{}
-	exists, err := ctrlutil.CheckResourceExists(d.Ctx, d.Client, jobKey, job)
-	if err != nil {
-		return DeletionStatusUnknown, err
-	}
-
-	// if deletion job exists, check its status
-	if exists {
-		_, finishedType, msg := utils.IsJobFinished(job)
-		switch finishedType {
-		case batchv1.JobComplete:
-			return DeletionStatusSucceeded, nil
-		case batchv1.JobFailed:
-			return DeletionStatusFailed,
-				fmt.Errorf("deletion backup files job \"%s\" failed, you can delete it to re-delete the backup files, %s", job.Name, msg)
-		}
-		return DeletionStatusDeleting, nil
+	if exists, status, err := d.checkDeletionJob(jobKey); err != nil || exists {
+		return status, err
 	}
 
 	if backup.Status.BackupRepoName == "" {
@@ -119,7 +105,7 @@ func (d *Deleter) DeleteBackupFiles(backup *dpv1alpha1.Backup) (DeletionStatus,
 		return DeletionStatusSucceeded, nil
 	}
 	backupRepo := &dpv1alpha1.BackupRepo{}
-	if err = d.Client.Get(d.Ctx, client.ObjectKey{Name: backup.Status.BackupRepoName}, backupRepo); err != nil {
+	if err := d.Client.Get(d.Ctx, client.ObjectKey{Name: backup.Status.BackupRepoName}, backupRepo); err != nil {
 		if apierrors.IsNotFound(err) {
 			return DeletionStatusSucceeded, nil
 		}
@@ -168,6 +154,49 @@ func (d *Deleter) DeleteBackupFiles(backup *dpv1alpha1.Backup) (DeletionStatus,
 	return DeletionStatusDeleting, d.createDeleteBackupFilesJob(jobKey, backup, backupRepo)
 }
 
+// DeleteBackupCopyFiles builds a job to delete the files of the backup copy stored in the
+// specified backup repository, and returns the deletion status. The pre-delete action of the
+// backup method is not executed for the copies.
+func (d *Deleter) DeleteBackupCopyFiles(backup *dpv1alpha1.Backup, backupRepoName string) (DeletionStatus, error) {
+	jobKey := BuildDeleteBackupCopyFilesJobKey(backup, backupRepoName)
+	if exists, status, err := d.checkDeletionJob(jobKey); err != nil || exists {
+		return status, err
+	}
+
+	backupRepo := &dpv1alpha1.BackupRepo{}
+	if err := d.Client.Get(d.Ctx, client.ObjectKey{Name: backupRepoName}, backupRepo); err != nil {
+		if apierrors.IsNotFound(err) {
+			return DeletionStatusSucceeded, nil
+		}
+		return DeletionStatusUnknown, err
+	}
+	backupFilePath := backup.Status.Path
+	if backupFilePath == "" || (!strings.Contains(backupFilePath, backup.Name)) {
+		d.Log.Info("skip deleting backup copy files because backup file path is invalid",
+			"backupFilePath", backupFilePath, "backup", backup.Name, "backupRepo", backupRepoName)
+		return DeletionStatusSucceeded, nil
+	}
+	return DeletionStatusDeleting, d.createDeleteBackupFilesJob(jobKey, backup, backupRepo)
+}
+
+// checkDeletionJob checks whether the deletion job exists, and returns the deletion status if it exists.
+func (d *Deleter) checkDeletionJob(jobKey client.ObjectKey) (bool, DeletionStatus, error) {
+	job := &batchv1.Job{}
+	exists, err := ctrlutil.CheckResourceExists(d.Ctx, d.Client, jobKey, job)
+	if err != nil || !exists {
+		return false, DeletionStatusUnknown, err
+	}
+	_, finishedType, msg := utils.IsJobFinished(job)
+	switch finishedType {
+	case batchv1.JobComplete:
+		return true, DeletionStatusSucceeded, nil
+	case batchv1.JobFailed:
+		return true, DeletionStatusFailed,
+			fmt.Errorf("deletion backup files job \"%s\" failed, you can delete it to re-delete the backup files, %s", job.Name, msg)
+	}
+	return true, DeletionStatusDeleting, nil
+}
+
 func (d *Deleter) buildDeleteBackupFilesScript(backupPath string) string {
 
 	// this script first deletes the directory where the backup is located (including files
@@ -265,6 +294,11 @@ func (d *Deleter) createDeleteJob(container corev1.Container,
 	}
 	kopiaRepoPath := backup.Status.KopiaRepoPath
 	encryptionConfig := backup.Status.EncryptionConfig
+	if kms.UseKeyManagement(encryptionConfig) {
+		// the data key is not required to delete the backup files, so do not
+		// unwrap it, which makes the backup deletable even if the KEK is lost.
+		encryptionConfig = nil
+	}
 	utils.InjectDatasafed(&podSpec, backupRepo, RepoVolumeMountPath, encryptionConfig, kopiaRepoPath)
 
 	// build job
@@ -484,6 +518,14 @@ func BuildDeleteBackupFilesJobKey(backup *dpv1alpha1.Backup, isPreDelete bool) c
 	return client.ObjectKey{Namespace: backup.Namespace, Name: jobName}
 }
 
+func BuildDeleteBackupCopyFilesJobKey(backup *dpv1alpha1.Backup, backupRepoName string) client.ObjectKey {
+	jobName := fmt.Sprintf("%s-%scopy-%s-%s", backup.UID[:8], deleteBackupFilesJobNamePrefix, backupRepoName, backup.Name)
+	if len(jobName) > 63 {
+		jobName = strings.TrimSuffix(jobName[:63], "-")
+	}
+	return client.ObjectKey{Namespace: backup.Namespace, Name: jobName}
+}
+
 func buildTargetPreDeleteJobKey(backup *dpv1alpha1.Backup, targetName string, targetIndex int) client.ObjectKey {
 	jobName := fmt.Sprintf("%s-pre%s%d-%s-%s", backup.UID[:8], deleteBackupFilesJobNamePrefix,
 		targetIndex, targetName, backup.Name)
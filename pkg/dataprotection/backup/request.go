@@ -33,6 +33,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/action"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/kms"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
@@ -187,7 +188,7 @@ func (r *Request) buildBackupDataAction(targetPod *corev1.Pod, name string) (act
 		r.InjectManagerContainer(podSpec, backupDataAct.SyncProgress, r.buildSyncProgressCommand())
 		return &action.JobAction{
 			Name:         name,
-			ObjectMeta:   *buildBackupJobObjMeta(r.Backup, name),
+			ObjectMeta:   *r.buildJobObjMeta(name),
 			Owner:        r.Backup,
 			PodSpec:      podSpec,
 			BackOffLimit: r.BackupPolicy.Spec.BackoffLimit,
@@ -307,13 +308,24 @@ func (r *Request) buildJobAction(targetPod *corev1.Pod,
 	}
 	return &action.JobAction{
 		Name:         name,
-		ObjectMeta:   *buildBackupJobObjMeta(r.Backup, name),
+		ObjectMeta:   *r.buildJobObjMeta(name),
 		Owner:        r.Backup,
 		PodSpec:      podSpec,
 		BackOffLimit: r.BackupPolicy.Spec.BackoffLimit,
 	}, nil
 }
 
+// buildJobObjMeta builds the object meta of the backup job, with the bandwidth limits
+// of the throttle policies.
+func (r *Request) buildJobObjMeta(name string) *metav1.ObjectMeta {
+	objMeta := buildBackupJobObjMeta(r.Backup, name)
+	if r.BackupRepo != nil {
+		utils.SetBandwidthAnnotations(objMeta,
+			utils.MergeThrottlePolicies(r.BackupRepo.Spec.Throttle, r.BackupPolicy.Spec.Throttle))
+	}
+	return objMeta
+}
+
 func (r *Request) BuildJobActionPodSpec(targetPod *corev1.Pod,
 	name string,
 	job *dpv1alpha1.JobActionSpec) (*corev1.PodSpec, error) {
@@ -345,6 +357,10 @@ func (r *Request) BuildJobActionPodSpec(targetPod *corev1.Pod,
 				Name:  dptypes.DPBackupInfoFile,
 				Value: managerSharedMountPath + "/" + BackupInfoFileName,
 			},
+			{
+				Name:  dptypes.DPBackupCatalogFile,
+				Value: managerSharedMountPath + "/" + BackupCatalogFileName,
+			},
 			{
 				Name:  dptypes.DPTTL,
 				Value: r.Spec.RetentionPeriod.String(),
@@ -480,7 +496,7 @@ func (r *Request) BuildJobActionPodSpec(targetPod *corev1.Pod,
 	}
 
 	utils.InjectDatasafed(podSpec, r.BackupRepo, RepoVolumeMountPath,
-		r.Status.EncryptionConfig, r.Status.KopiaRepoPath)
+		kms.BuildJobEncryptionConfig(r.Backup), r.Status.KopiaRepoPath)
 	return podSpec, nil
 }
 
@@ -527,7 +543,20 @@ kubectl -n "$namespace" patch backups.dataprotection.kubeblocks.io "$backup_name
 
 # save the backup CR object to the backup repo
 kubectl -n "$namespace" get backups.dataprotection.kubeblocks.io "$backup_name" -o json | datasafed push - "/kubeblocks-backup.json"
-`, dptypes.DPBackupInfoFile, dptypes.DPCheckInterval, r.Backup.Namespace, r.Backup.Name)
+
+# save the catalog manifest emitted by the backup action to the backup repo,
+# and hand it over to the controller to create the backup catalog.
+catalog_file="${%s}"
+if [ -f "$catalog_file" ]; then
+  datasafed push "$catalog_file" "/kubeblocks-catalog.json"
+  kubectl -n "$namespace" create configmap "${backup_name}-catalog-${%s}" \
+    --from-file=%s="$catalog_file" --dry-run=client -o yaml \
+    | kubectl label --local -f - %s="$backup_name" %s=true -o yaml \
+    | kubectl apply -f -
+fi
+`, dptypes.DPBackupInfoFile, dptypes.DPCheckInterval, r.Backup.Namespace, r.Backup.Name,
+		dptypes.DPBackupCatalogFile, dptypes.DPTargetPodName, BackupCatalogManifestKey,
+		dptypes.BackupNameLabelKey, dptypes.BackupCatalogLabelKey)
 }
 
 func (r *Request) buildContinuousSyncProgressCommand() string {
@@ -0,0 +1,182 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
+)
+
+type retentionTier struct {
+	reason dpv1alpha1.BackupRetentionReason
+	count  *int32
+	period func(t time.Time) string
+}
+
+func retentionTiers(policy *dpv1alpha1.TieredRetentionPolicy) []retentionTier {
+	return []retentionTier{
+		{
+			reason: dpv1alpha1.BackupRetentionReasonHourly,
+			count:  policy.Hourly,
+			period: func(t time.Time) string { return t.Format("2006-01-02T15") },
+		},
+		{
+			reason: dpv1alpha1.BackupRetentionReasonDaily,
+			count:  policy.Daily,
+			period: func(t time.Time) string { return t.Format("2006-01-02") },
+		},
+		{
+			reason: dpv1alpha1.BackupRetentionReasonWeekly,
+			count:  policy.Weekly,
+			period: func(t time.Time) string {
+				year, week := t.ISOWeek()
+				return fmt.Sprintf("%d-W%02d", year, week)
+			},
+		},
+		{
+			reason: dpv1alpha1.BackupRetentionReasonMonthly,
+			count:  policy.Monthly,
+			period: func(t time.Time) string { return t.Format("2006-01") },
+		},
+		{
+			reason: dpv1alpha1.BackupRetentionReasonYearly,
+			count:  policy.Yearly,
+			period: func(t time.Time) string { return t.Format("2006") },
+		},
+	}
+}
+
+// EvaluateTieredRetention evaluates the tiered retention policy for the backups created by a backup schedule,
+// and returns the retention status of each completed backup, keyed by the backup name.
+//
+// The latest backup of each of the most recent N periods is kept for every tier. A backup is also kept if
+// a kept backup depends on it (as the parent or base backup), or if it is the earliest backup within the
+// time range of a continuous backup, which means the PITR window starts from it.
+func EvaluateTieredRetention(policy *dpv1alpha1.TieredRetentionPolicy,
+	backups []*dpv1alpha1.Backup) map[string]*dpv1alpha1.BackupRetentionStatus {
+	var (
+		completed  []*dpv1alpha1.Backup
+		continuous []*dpv1alpha1.Backup
+		byName     = map[string]*dpv1alpha1.Backup{}
+	)
+	for _, backup := range backups {
+		if backup.Labels[dptypes.BackupTypeLabelKey] == string(dpv1alpha1.BackupTypeContinuous) {
+			continuous = append(continuous, backup)
+			continue
+		}
+		if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted || backup.GetEndTime().IsZero() {
+			continue
+		}
+		completed = append(completed, backup)
+		byName[backup.Name] = backup
+	}
+	// sort by stop time in descending order
+	sort.Slice(completed, func(i, j int) bool {
+		return dputils.CompareWithBackupStopTime(*completed[j], *completed[i])
+	})
+
+	reasons := map[string][]dpv1alpha1.BackupRetentionReason{}
+	messages := map[string][]string{}
+	keep := func(backup *dpv1alpha1.Backup, reason dpv1alpha1.BackupRetentionReason, message string) bool {
+		for _, r := range reasons[backup.Name] {
+			if r == reason {
+				return false
+			}
+		}
+		reasons[backup.Name] = append(reasons[backup.Name], reason)
+		if message != "" {
+			messages[backup.Name] = append(messages[backup.Name], message)
+		}
+		return true
+	}
+
+	for _, tier := range retentionTiers(policy) {
+		if tier.count == nil || *tier.count <= 0 {
+			continue
+		}
+		periods := map[string]bool{}
+		for _, backup := range completed {
+			period := tier.period(backup.GetEndTime().UTC())
+			if periods[period] {
+				continue
+			}
+			if len(periods) >= int(*tier.count) {
+				break
+			}
+			periods[period] = true
+			keep(backup, tier.reason, "")
+		}
+	}
+
+	// keep the backup the PITR window of each continuous backup starts from.
+	for _, backup := range continuous {
+		timeRange := backup.Status.TimeRange
+		if timeRange == nil || timeRange.Start == nil || timeRange.End == nil {
+			continue
+		}
+		for i := len(completed) - 1; i >= 0; i-- {
+			endTime := completed[i].GetEndTime()
+			if endTime.Before(timeRange.Start) || timeRange.End.Before(endTime) {
+				continue
+			}
+			keep(completed[i], dpv1alpha1.BackupRetentionReasonPITRBase,
+				fmt.Sprintf("the PITR window of continuous backup %s starts from it", backup.Name))
+			break
+		}
+	}
+
+	// keep the backups which the kept backups depend on.
+	for _, backup := range completed {
+		if len(reasons[backup.Name]) == 0 {
+			continue
+		}
+		for _, name := range []string{backup.Status.ParentBackupName, backup.Status.BaseBackupName} {
+			for dependency := byName[name]; dependency != nil; dependency = byName[dependency.Status.ParentBackupName] {
+				if !keep(dependency, dpv1alpha1.BackupRetentionReasonDependency,
+					fmt.Sprintf("kept backup %s depends on it", backup.Name)) {
+					break
+				}
+			}
+		}
+	}
+
+	result := map[string]*dpv1alpha1.BackupRetentionStatus{}
+	for _, backup := range completed {
+		if len(reasons[backup.Name]) == 0 {
+			result[backup.Name] = &dpv1alpha1.BackupRetentionStatus{
+				Decision: dpv1alpha1.BackupRetentionDecisionExpire,
+				Message:  "it is not the latest backup of any retained period, and no kept backup or PITR window depends on it",
+			}
+			continue
+		}
+		result[backup.Name] = &dpv1alpha1.BackupRetentionStatus{
+			Decision: dpv1alpha1.BackupRetentionDecisionKeep,
+			Reasons:  reasons[backup.Name],
+			Message:  strings.Join(messages[backup.Name], "; "),
+		}
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+func newRetentionTestBackup(name string, end time.Time) *dpv1alpha1.Backup {
+	return &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: dpv1alpha1.BackupStatus{
+			Phase:               dpv1alpha1.BackupPhaseCompleted,
+			CompletionTimestamp: &metav1.Time{Time: end},
+		},
+	}
+}
+
+func TestEvaluateTieredRetention(t *testing.T) {
+	base := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	// one backup every 6 hours within the last 3 days
+	var backups []*dpv1alpha1.Backup
+	for i := 0; i < 12; i++ {
+		backups = append(backups, newRetentionTestBackup(string(rune('a'+i)), base.Add(-time.Duration(i)*6*time.Hour)))
+	}
+	failed := newRetentionTestBackup("failed", base.Add(time.Hour))
+	failed.Status.Phase = dpv1alpha1.BackupPhaseFailed
+	backups = append(backups, failed)
+
+	result := EvaluateTieredRetention(&dpv1alpha1.TieredRetentionPolicy{
+		Hourly: ptr.To[int32](2),
+		Daily:  ptr.To[int32](3),
+	}, backups)
+
+	assert.NotContains(t, result, "failed")
+	assert.Len(t, result, 12)
+	// a: 03-10 12:00, b: 03-10 06:00, c: 03-10 00:00, d: 03-09 18:00, h: 03-08 18:00
+	assert.Equal(t, []dpv1alpha1.BackupRetentionReason{dpv1alpha1.BackupRetentionReasonHourly, dpv1alpha1.BackupRetentionReasonDaily},
+		result["a"].Reasons)
+	assert.Equal(t, []dpv1alpha1.BackupRetentionReason{dpv1alpha1.BackupRetentionReasonHourly}, result["b"].Reasons)
+	assert.Equal(t, dpv1alpha1.BackupRetentionDecisionExpire, result["c"].Decision)
+	assert.Equal(t, []dpv1alpha1.BackupRetentionReason{dpv1alpha1.BackupRetentionReasonDaily}, result["d"].Reasons)
+	assert.Equal(t, []dpv1alpha1.BackupRetentionReason{dpv1alpha1.BackupRetentionReasonDaily}, result["h"].Reasons)
+	for _, name := range []string{"e", "f", "g", "i", "j", "k", "l"} {
+		assert.Equal(t, dpv1alpha1.BackupRetentionDecisionExpire, result[name].Decision, name)
+	}
+}
+
+func TestEvaluateTieredRetentionDependencies(t *testing.T) {
+	base := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	full := newRetentionTestBackup("full", base.Add(-72*time.Hour))
+	inc1 := newRetentionTestBackup("inc1", base.Add(-48*time.Hour))
+	inc1.Status.ParentBackupName, inc1.Status.BaseBackupName = "full", "full"
+	inc2 := newRetentionTestBackup("inc2", base.Add(-24*time.Hour))
+	inc2.Status.ParentBackupName, inc2.Status.BaseBackupName = "inc1", "full"
+	old := newRetentionTestBackup("old", base.Add(-96*time.Hour))
+	oldest := newRetentionTestBackup("oldest", base.Add(-120*time.Hour))
+	continuous := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "continuous",
+			Labels: map[string]string{dptypes.BackupTypeLabelKey: string(dpv1alpha1.BackupTypeContinuous)},
+		},
+		Status: dpv1alpha1.BackupStatus{
+			Phase: dpv1alpha1.BackupPhaseRunning,
+			TimeRange: &dpv1alpha1.BackupTimeRange{
+				Start: &metav1.Time{Time: base.Add(-100 * time.Hour)},
+				End:   &metav1.Time{Time: base},
+			},
+		},
+	}
+
+	result := EvaluateTieredRetention(&dpv1alpha1.TieredRetentionPolicy{Daily: ptr.To[int32](1)},
+		[]*dpv1alpha1.Backup{full, inc1, inc2, old, oldest, continuous})
+
+	assert.NotContains(t, result, "continuous")
+	assert.Equal(t, []dpv1alpha1.BackupRetentionReason{dpv1alpha1.BackupRetentionReasonDaily}, result["inc2"].Reasons)
+	assert.Equal(t, []dpv1alpha1.BackupRetentionReason{dpv1alpha1.BackupRetentionReasonDependency}, result["inc1"].Reasons)
+	assert.Equal(t, []dpv1alpha1.BackupRetentionReason{dpv1alpha1.BackupRetentionReasonDependency}, result["full"].Reasons)
+	assert.Equal(t, "kept backup inc2 depends on it", result["inc1"].Message)
+	// the PITR window of the continuous backup starts from the earliest backup within its time range
+	assert.Equal(t, []dpv1alpha1.BackupRetentionReason{dpv1alpha1.BackupRetentionReasonPITRBase}, result["old"].Reasons)
+	assert.Equal(t, dpv1alpha1.BackupRetentionDecisionExpire, result["oldest"].Decision)
+}
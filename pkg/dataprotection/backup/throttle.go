@@ -0,0 +1,223 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+// EvaluateBackupThrottle checks whether the backup can be started under the throttle policies of the
+// backup repository and the backup policies, and returns the reason why the backup should wait, or an
+// empty string if it can be started. The throttle policies of the backup policies are keyed by BackupPolicyKey.
+//
+// The limits of the backup repository are evaluated against all the backups stored in the repository,
+// and the limits of the backup policy are evaluated against the backups of the policy only.
+// The running backups and the backups admitted in the repository but not running yet occupy the
+// concurrency slots. The pending backups queued before the backup occupy the slots in the queue order,
+// except the ones held by the limits of their own backup policies, which would not be started even if
+// the slots of the repository are free. Continuous backups are not throttled since they keep running
+// all the time.
+func EvaluateBackupThrottle(repoThrottle *dpv1alpha1.ThrottlePolicy,
+	policyThrottles map[string]*dpv1alpha1.ThrottlePolicy,
+	backup *dpv1alpha1.Backup,
+	backups []*dpv1alpha1.Backup,
+	admitted sets.Set[string]) string {
+	if isContinuousBackup(backup) {
+		return ""
+	}
+	var occupied, queued []*dpv1alpha1.Backup
+	for _, b := range backups {
+		if BackupKey(b) == BackupKey(backup) || isContinuousBackup(b) {
+			continue
+		}
+		switch {
+		case b.Status.Phase == dpv1alpha1.BackupPhaseRunning, admitted.Has(BackupKey(b)) && !isBackupFinished(b):
+			occupied = append(occupied, b)
+		case b.Status.Phase == dpv1alpha1.BackupPhasePending && queuedBefore(b, backup):
+			queued = append(queued, b)
+		}
+	}
+	sort.Slice(queued, func(i, j int) bool {
+		return queuedBefore(queued[i], queued[j])
+	})
+	for _, b := range queued {
+		if evaluateConcurrencyLimits(policyThrottles[BackupPolicyKey(b)], b, policyBackups(occupied, b)) == "" {
+			occupied = append(occupied, b)
+		}
+	}
+	if reason := evaluateConcurrencyLimits(repoThrottle, backup, occupied); reason != "" {
+		return reason
+	}
+	if reason := evaluateConcurrencyLimits(policyThrottles[BackupPolicyKey(backup)], backup,
+		policyBackups(occupied, backup)); reason != "" {
+		return fmt.Sprintf("%s of backup policy %s", reason, backup.Spec.BackupPolicyName)
+	}
+	return ""
+}
+
+// policyBackups returns the backups of the same backup policy as the backup.
+func policyBackups(backups []*dpv1alpha1.Backup, backup *dpv1alpha1.Backup) []*dpv1alpha1.Backup {
+	var result []*dpv1alpha1.Backup
+	for _, b := range backups {
+		if BackupPolicyKey(b) == BackupPolicyKey(backup) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+func evaluateConcurrencyLimits(throttle *dpv1alpha1.ThrottlePolicy,
+	backup *dpv1alpha1.Backup,
+	occupied []*dpv1alpha1.Backup) string {
+	if throttle == nil {
+		return ""
+	}
+	if limit := throttle.MaxConcurrentBackups; limit != nil && len(occupied) >= int(*limit) {
+		return fmt.Sprintf("the number of concurrent backups reaches the limit %d", *limit)
+	}
+	if limit := throttle.MaxConcurrentBackupsPerNamespace; limit != nil {
+		count := 0
+		for _, b := range occupied {
+			if b.Namespace == backup.Namespace {
+				count++
+			}
+		}
+		if count >= int(*limit) {
+			return fmt.Sprintf("the number of concurrent backups in namespace %s reaches the limit %d",
+				backup.Namespace, *limit)
+		}
+	}
+	if limit := throttle.MaxConcurrentBackupsPerNode; limit != nil {
+		for _, node := range GetBackupTargetNodes(backup) {
+			count := 0
+			for _, b := range occupied {
+				for _, n := range GetBackupTargetNodes(b) {
+					if n == node {
+						count++
+						break
+					}
+				}
+			}
+			if count >= int(*limit) {
+				return fmt.Sprintf("the number of concurrent backups on node %s reaches the limit %d", node, *limit)
+			}
+		}
+	}
+	return ""
+}
+
+// HasConcurrencyLimits returns true if any of the throttle policies limits the concurrent backups.
+func HasConcurrencyLimits(policies ...*dpv1alpha1.ThrottlePolicy) bool {
+	for _, p := range policies {
+		if p != nil && (p.MaxConcurrentBackups != nil || p.MaxConcurrentBackupsPerNamespace != nil ||
+			p.MaxConcurrentBackupsPerNode != nil) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAdmittedBackups returns the keys of the backups admitted by the throttle policies, which are
+// recorded in the annotation of the backup repository.
+func GetAdmittedBackups(repo *dpv1alpha1.BackupRepo) sets.Set[string] {
+	value := repo.Annotations[dptypes.BackupRepoAdmittedBackupsAnnotationKey]
+	if value == "" {
+		return sets.New[string]()
+	}
+	return sets.New(strings.Split(value, ",")...)
+}
+
+// SetAdmittedBackups records the keys of the admitted backups in the annotation of the backup repository.
+// The keys of the backups which are finished or no longer exist are removed.
+func SetAdmittedBackups(repo *dpv1alpha1.BackupRepo, admitted sets.Set[string], backups []*dpv1alpha1.Backup) {
+	alive := sets.New[string]()
+	for _, b := range backups {
+		if !isBackupFinished(b) {
+			alive.Insert(BackupKey(b))
+		}
+	}
+	if repo.Annotations == nil {
+		repo.Annotations = map[string]string{}
+	}
+	repo.Annotations[dptypes.BackupRepoAdmittedBackupsAnnotationKey] = strings.Join(sets.List(admitted.Intersection(alive)), ",")
+}
+
+// BackupKey returns the key of the backup used in the admitted backups.
+func BackupKey(backup *dpv1alpha1.Backup) string {
+	return backup.Namespace + "/" + backup.Name
+}
+
+// BackupPolicyKey returns the key of the backup policy of the backup used in evaluating the throttle policies.
+func BackupPolicyKey(backup *dpv1alpha1.Backup) string {
+	return backup.Namespace + "/" + backup.Spec.BackupPolicyName
+}
+
+// GetBackupTargetNodes returns the node names of the backup target pods recorded in the annotation.
+func GetBackupTargetNodes(backup *dpv1alpha1.Backup) []string {
+	value := backup.Annotations[dptypes.BackupTargetNodesAnnotationKey]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// GetBackupPriority returns the priority of the backup recorded in the annotation.
+func GetBackupPriority(backup *dpv1alpha1.Backup) int32 {
+	priority, err := strconv.ParseInt(backup.Annotations[dptypes.BackupPriorityAnnotationKey], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(priority)
+}
+
+// queuedBefore returns true if the backup a is queued before the backup b. The backup with higher
+// priority is queued first, and the backups with the same priority are queued in the order of creation.
+func queuedBefore(a, b *dpv1alpha1.Backup) bool {
+	if pa, pb := GetBackupPriority(a), GetBackupPriority(b); pa != pb {
+		return pa > pb
+	}
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+func isBackupFinished(backup *dpv1alpha1.Backup) bool {
+	switch backup.Status.Phase {
+	case "", dpv1alpha1.BackupPhaseNew, dpv1alpha1.BackupPhasePending, dpv1alpha1.BackupPhaseRunning:
+		return !backup.DeletionTimestamp.IsZero()
+	}
+	return true
+}
+
+func isContinuousBackup(backup *dpv1alpha1.Backup) bool {
+	return backup.Labels[dptypes.BackupTypeLabelKey] == string(dpv1alpha1.BackupTypeContinuous)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+func newThrottledBackup(namespace, name string, phase dpv1alpha1.BackupPhase, created time.Time,
+	priority, nodes string) *dpv1alpha1.Backup {
+	return &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created),
+			Annotations: map[string]string{
+				dptypes.BackupPriorityAnnotationKey:    priority,
+				dptypes.BackupTargetNodesAnnotationKey: nodes,
+			},
+		},
+		Status: dpv1alpha1.BackupStatus{Phase: phase},
+	}
+}
+
+func TestEvaluateBackupThrottle(t *testing.T) {
+	now := time.Now()
+	backup := newThrottledBackup("ns1", "backup", dpv1alpha1.BackupPhaseNew, now, "0", "node1")
+	running := newThrottledBackup("ns2", "running", dpv1alpha1.BackupPhaseRunning, now.Add(-time.Hour), "0", "node2")
+	continuous := newThrottledBackup("ns1", "continuous", dpv1alpha1.BackupPhaseRunning, now.Add(-time.Hour), "0", "node1")
+	continuous.Labels = map[string]string{dptypes.BackupTypeLabelKey: string(dpv1alpha1.BackupTypeContinuous)}
+	completed := newThrottledBackup("ns1", "completed", dpv1alpha1.BackupPhaseCompleted, now.Add(-time.Hour), "0", "node1")
+	backups := []*dpv1alpha1.Backup{backup, running, continuous, completed}
+
+	assert.Empty(t, EvaluateBackupThrottle(nil, nil, backup, backups, nil))
+	assert.Empty(t, EvaluateBackupThrottle(&dpv1alpha1.ThrottlePolicy{MaxConcurrentBackups: pointer.Int32(2)}, nil, backup, backups, nil))
+	assert.Contains(t, EvaluateBackupThrottle(&dpv1alpha1.ThrottlePolicy{MaxConcurrentBackups: pointer.Int32(1)}, nil, backup, backups, nil),
+		"limit 1")
+	// the running backup is in another namespace and on another node
+	assert.Empty(t, EvaluateBackupThrottle(&dpv1alpha1.ThrottlePolicy{
+		MaxConcurrentBackupsPerNamespace: pointer.Int32(1),
+		MaxConcurrentBackupsPerNode:      pointer.Int32(1),
+	}, nil, backup, backups, nil))
+
+	// a pending backup queued before occupies the slot
+	pendingBefore := newThrottledBackup("ns1", "pending-before", dpv1alpha1.BackupPhasePending, now.Add(-time.Minute), "0", "node1")
+	throttle := &dpv1alpha1.ThrottlePolicy{MaxConcurrentBackupsPerNode: pointer.Int32(1)}
+	assert.Contains(t, EvaluateBackupThrottle(throttle, nil, backup, append(backups, pendingBefore), nil), "node node1")
+	assert.Contains(t, EvaluateBackupThrottle(&dpv1alpha1.ThrottlePolicy{MaxConcurrentBackupsPerNamespace: pointer.Int32(1)}, nil,
+		backup, append(backups, pendingBefore), nil), "namespace ns1")
+
+	// the pending backup with lower priority or created later does not block the backup
+	pendingLower := newThrottledBackup("ns1", "pending-lower", dpv1alpha1.BackupPhasePending, now.Add(-time.Minute), "-1", "node1")
+	pendingLater := newThrottledBackup("ns1", "pending-later", dpv1alpha1.BackupPhasePending, now.Add(time.Minute), "0", "node1")
+	assert.Empty(t, EvaluateBackupThrottle(throttle, nil, backup, append(backups, pendingLower, pendingLater), nil))
+
+	// the backup with higher priority jumps the queue
+	backup.Annotations[dptypes.BackupPriorityAnnotationKey] = "10"
+	assert.Empty(t, EvaluateBackupThrottle(throttle, nil, backup, append(backups, pendingBefore), nil))
+
+	// the admitted backup occupies the slot before it is running
+	admitted := newThrottledBackup("ns2", "admitted", dpv1alpha1.BackupPhaseNew, now, "0", "node2")
+	limitOne := &dpv1alpha1.ThrottlePolicy{MaxConcurrentBackups: pointer.Int32(1)}
+	assert.Empty(t, EvaluateBackupThrottle(limitOne, nil, backup, []*dpv1alpha1.Backup{backup, admitted}, nil))
+	assert.Contains(t, EvaluateBackupThrottle(limitOne, nil, backup, []*dpv1alpha1.Backup{backup, admitted},
+		sets.New(BackupKey(admitted))), "limit 1")
+
+	// the limits of the backup policy only count the backups of the policy
+	backup.Spec.BackupPolicyName = "policy"
+	policyLimitOne := map[string]*dpv1alpha1.ThrottlePolicy{"ns1/policy": limitOne}
+	assert.Empty(t, EvaluateBackupThrottle(nil, policyLimitOne, backup, backups, nil))
+	samePolicy := newThrottledBackup("ns1", "same-policy", dpv1alpha1.BackupPhaseRunning, now.Add(-time.Hour), "0", "node3")
+	samePolicy.Spec.BackupPolicyName = "policy"
+	assert.Contains(t, EvaluateBackupThrottle(nil, policyLimitOne, backup, append(backups, samePolicy), nil),
+		"backup policy policy")
+
+	// continuous backups are not throttled
+	assert.Empty(t, EvaluateBackupThrottle(&dpv1alpha1.ThrottlePolicy{MaxConcurrentBackups: pointer.Int32(1)}, nil, continuous, backups, nil))
+}
+
+func TestEvaluateBackupThrottlePolicyHeldBackups(t *testing.T) {
+	now := time.Now()
+	newPolicyBackup := func(name, policy string, phase dpv1alpha1.BackupPhase, created time.Time) *dpv1alpha1.Backup {
+		b := newThrottledBackup("ns1", name, phase, created, "0", "node1")
+		b.Spec.BackupPolicyName = policy
+		return b
+	}
+	running := newPolicyBackup("p1-running", "p1", dpv1alpha1.BackupPhaseRunning, now.Add(-time.Hour))
+	pending1 := newPolicyBackup("p1-pending-1", "p1", dpv1alpha1.BackupPhasePending, now.Add(-time.Minute*2))
+	pending2 := newPolicyBackup("p1-pending-2", "p1", dpv1alpha1.BackupPhasePending, now.Add(-time.Minute))
+	backup := newPolicyBackup("p2-backup", "p2", dpv1alpha1.BackupPhaseNew, now)
+	backups := []*dpv1alpha1.Backup{running, pending1, pending2, backup}
+	repoThrottle := &dpv1alpha1.ThrottlePolicy{MaxConcurrentBackups: pointer.Int32(2)}
+	policyThrottles := map[string]*dpv1alpha1.ThrottlePolicy{
+		"ns1/p1": {MaxConcurrentBackups: pointer.Int32(1)},
+	}
+
+	// the pending backups of p1 are held by the limit of p1, they do not occupy the slots of the repository
+	assert.Empty(t, EvaluateBackupThrottle(repoThrottle, policyThrottles, backup, backups, nil))
+	// the pending backups of p1 are not held by p1 anymore, they are started first
+	policyThrottles["ns1/p1"] = &dpv1alpha1.ThrottlePolicy{MaxConcurrentBackups: pointer.Int32(2)}
+	assert.Contains(t, EvaluateBackupThrottle(repoThrottle, policyThrottles, backup, backups, nil), "limit 2")
+	// the pending backups of p1 are evaluated by p1 in the queue order, only one of them would be started
+	repoThrottle.MaxConcurrentBackups = pointer.Int32(3)
+	assert.Empty(t, EvaluateBackupThrottle(repoThrottle, policyThrottles, backup, backups, nil))
+	// the pending backups of p1 are held by the node limit of p1 only
+	policyThrottles["ns1/p1"] = &dpv1alpha1.ThrottlePolicy{MaxConcurrentBackupsPerNode: pointer.Int32(1)}
+	repoThrottle.MaxConcurrentBackups = pointer.Int32(2)
+	assert.Empty(t, EvaluateBackupThrottle(repoThrottle, policyThrottles, backup, backups, nil))
+}
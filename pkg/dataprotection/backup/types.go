@@ -28,4 +28,7 @@ const (
 
 	// BackupInfoFileName is the backup info file name in the backup path.
 	BackupInfoFileName = "backup.info"
+
+	// BackupCatalogFileName is the catalog manifest file name emitted by the backup action.
+	BackupCatalogFileName = "backup.catalog"
 )
@@ -0,0 +1,216 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+)
+
+const (
+	awsAccessKeyIDKey     = "accessKeyId"
+	awsSecretAccessKeyKey = "secretAccessKey"
+	awsSessionTokenKey    = "sessionToken"
+
+	awsKMSService = "kms"
+)
+
+// awsKMSProvider wraps the data keys with the AWS Key Management Service. The
+// requests are sent to the JSON API of KMS and signed with the signature version 4.
+type awsKMSProvider struct {
+	endpoint        string
+	region          string
+	keyID           string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+var _ Provider = &awsKMSProvider{}
+
+func newAWSKMSProvider(ctx context.Context, cli client.Client, namespace string,
+	config *dpv1alpha1.KeyManagementConfig) (Provider, error) {
+	awsKMS := config.AWSKMS
+	if awsKMS == nil || awsKMS.Region == "" || awsKMS.CredentialSecretName == "" {
+		return nil, fmt.Errorf("region and credential secret are required for the %s KMS provider", config.Provider)
+	}
+	accessKeyID, err := getSecretValue(ctx, cli, namespace, awsKMS.CredentialSecretName, awsAccessKeyIDKey)
+	if err != nil {
+		return nil, err
+	}
+	secretAccessKey, err := getSecretValue(ctx, cli, namespace, awsKMS.CredentialSecretName, awsSecretAccessKeyKey)
+	if err != nil {
+		return nil, err
+	}
+	// the session token is optional
+	sessionToken, _ := getSecretValue(ctx, cli, namespace, awsKMS.CredentialSecretName, awsSessionTokenKey)
+	endpoint := strings.TrimSuffix(awsKMS.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://kms.%s.amazonaws.com", awsKMS.Region)
+	}
+	return &awsKMSProvider{
+		endpoint:        endpoint,
+		region:          awsKMS.Region,
+		keyID:           config.KeyID,
+		accessKeyID:     string(accessKeyID),
+		secretAccessKey: string(secretAccessKey),
+		sessionToken:    string(sessionToken),
+	}, nil
+}
+
+func (p *awsKMSProvider) Wrap(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	var resp struct {
+		CiphertextBlob string `json:"CiphertextBlob"`
+		KeyID          string `json:"KeyId"`
+	}
+	req := map[string]string{
+		"KeyId":     p.keyID,
+		"Plaintext": base64.StdEncoding.EncodeToString(dataKey),
+	}
+	if err := p.do(ctx, "Encrypt", req, &resp); err != nil {
+		return "", nil, err
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(resp.CiphertextBlob)
+	if err != nil {
+		return "", nil, err
+	}
+	keyID := resp.KeyID
+	if keyID == "" {
+		keyID = p.keyID
+	}
+	return keyID, wrapped, nil
+}
+
+func (p *awsKMSProvider) Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	var resp struct {
+		Plaintext string `json:"Plaintext"`
+	}
+	req := map[string]string{
+		"KeyId":          keyID,
+		"CiphertextBlob": base64.StdEncoding.EncodeToString(wrapped),
+	}
+	if err := p.do(ctx, "Decrypt", req, &resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
+
+func (p *awsKMSProvider) do(ctx context.Context, op string, body any, result any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+op)
+	p.sign(req, data, time.Now().UTC())
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("AWS KMS %s failed with status %d: %s", op, resp.StatusCode, string(respBody))
+	}
+	return json.Unmarshal(respBody, result)
+}
+
+// sign signs the request with the AWS signature version 4.
+func (p *awsKMSProvider) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	if p.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", p.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		"/",
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		hashHex(body),
+	}, "\n")
+	scope := strings.Join([]string{dateStamp, p.region, awsKMSService, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+p.secretAccessKey), dateStamp)
+	signingKey = hmacSHA256(signingKey, p.region)
+	signingKey = hmacSHA256(signingKey, awsKMSService)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		p.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+const (
+	// DataKeySecretKey is the key of the plaintext data key in the data key secret.
+	DataKeySecretKey = "dataKey"
+
+	dataKeyLabelKey = "dataprotection.kubeblocks.io/data-key"
+)
+
+// UseKeyManagement checks if the backup data is encrypted by the envelope encryption.
+func UseKeyManagement(config *dpv1alpha1.EncryptionConfig) bool {
+	return config != nil && config.KeyManagement != nil
+}
+
+// DataKeySecretName returns the name of the secret which stores the plaintext
+// data key of the backup for the jobs accessing the backup data.
+func DataKeySecretName(backup *dpv1alpha1.Backup) string {
+	return fmt.Sprintf("dp-datakey-%s", backup.UID)
+}
+
+// BuildJobEncryptionConfig returns the encryption config injected into the jobs
+// accessing the backup data. If the envelope encryption is used, the passphrase
+// refers to the data key secret of the backup.
+func BuildJobEncryptionConfig(backup *dpv1alpha1.Backup) *dpv1alpha1.EncryptionConfig {
+	config := backup.Status.EncryptionConfig
+	if !UseKeyManagement(config) {
+		return config
+	}
+	return &dpv1alpha1.EncryptionConfig{
+		Algorithm: config.Algorithm,
+		PassPhraseSecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: DataKeySecretName(backup)},
+			Key:                  DataKeySecretKey,
+		},
+	}
+}
+
+// NewBackupEncryptionKey generates the data key of the backup. The backups that
+// depend on the parent backup share the data key of the parent backup.
+func NewBackupEncryptionKey(ctx context.Context, cli client.Client, namespace string,
+	config *dpv1alpha1.EncryptionConfig, parent *dpv1alpha1.Backup) (*dpv1alpha1.BackupEncryptionKey, error) {
+	if !UseKeyManagement(config) {
+		return nil, nil
+	}
+	if parent != nil && parent.Status.EncryptionKey != nil {
+		return parent.Status.EncryptionKey.DeepCopy(), nil
+	}
+	provider, err := NewProvider(ctx, cli, namespace, config.KeyManagement)
+	if err != nil {
+		return nil, err
+	}
+	return GenerateDataKey(ctx, provider, config.KeyManagement.Provider)
+}
+
+// EnsureDataKeySecret unwraps the data key of the backup and saves it to a secret
+// in the namespace, the secret is owned by the owner object. The secret is shared
+// by the owners in the same namespace, and it is deleted when all of the owners
+// release it.
+func EnsureDataKeySecret(ctx context.Context, cli client.Client, backup *dpv1alpha1.Backup,
+	namespace string, owner client.Object) error {
+	if !UseKeyManagement(backup.Status.EncryptionConfig) {
+		return nil
+	}
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Namespace: namespace, Name: DataKeySecretName(backup)}
+	err := cli.Get(ctx, secretKey, secret)
+	if err == nil {
+		if isOwnedBy(secret, owner) {
+			return nil
+		}
+		patch := client.MergeFrom(secret.DeepCopy())
+		if err = controllerutil.SetOwnerReference(owner, secret, cli.Scheme()); err != nil {
+			return err
+		}
+		return cli.Patch(ctx, secret, patch)
+	}
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+	dataKey, err := resolveDataKey(ctx, cli, backup)
+	if err != nil {
+		return err
+	}
+	secret = &corev1.Secret{}
+	secret.Name = secretKey.Name
+	secret.Namespace = secretKey.Namespace
+	secret.Labels = map[string]string{
+		constant.AppManagedByLabelKey: dptypes.AppName,
+		dptypes.BackupNameLabelKey:    backup.Name,
+		dataKeyLabelKey:               "true",
+	}
+	secret.Data = map[string][]byte{
+		DataKeySecretKey: []byte(PassPhrase(dataKey)),
+	}
+	if err = controllerutil.SetOwnerReference(owner, secret, cli.Scheme()); err != nil {
+		return err
+	}
+	if err = cli.Create(ctx, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// ReleaseDataKeySecrets removes the owner from the data key secrets in the namespace,
+// and deletes the secrets which are not owned by any objects.
+func ReleaseDataKeySecrets(ctx context.Context, cli client.Client, namespace string, owner client.Object) error {
+	secretList := &corev1.SecretList{}
+	if err := cli.List(ctx, secretList, client.InNamespace(namespace),
+		client.MatchingLabels{dataKeyLabelKey: "true"}); err != nil {
+		return err
+	}
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		if !isOwnedBy(secret, owner) {
+			continue
+		}
+		if len(secret.OwnerReferences) == 1 {
+			if err := cli.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			continue
+		}
+		patch := client.MergeFrom(secret.DeepCopy())
+		if err := controllerutil.RemoveOwnerReference(owner, secret, cli.Scheme()); err != nil {
+			return err
+		}
+		if err := cli.Patch(ctx, secret, patch); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isOwnedBy(obj client.Object, owner client.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveDataKey unwraps the data key of the backup with the KEK recorded in the
+// backup status, the secrets of the KMS provider are read from the namespace of
+// the backup.
+func resolveDataKey(ctx context.Context, cli client.Client, backup *dpv1alpha1.Backup) ([]byte, error) {
+	key := backup.Status.EncryptionKey
+	if key == nil {
+		return nil, fmt.Errorf("encryption key of backup %s/%s is not found", backup.Namespace, backup.Name)
+	}
+	config := backup.Status.EncryptionConfig.KeyManagement.DeepCopy()
+	config.Provider = key.Provider
+	provider, err := NewProvider(ctx, cli, backup.Namespace, config)
+	if err != nil {
+		return nil, err
+	}
+	return UnwrapDataKey(ctx, provider, key)
+}
+
+// RewrapBackupEncryptionKey wraps the data key of the backup with the current KEK
+// of the key management config. The backup data is not re-encrypted.
+func RewrapBackupEncryptionKey(ctx context.Context, cli client.Client, backup *dpv1alpha1.Backup,
+	config *dpv1alpha1.KeyManagementConfig) (*dpv1alpha1.BackupEncryptionKey, error) {
+	if !UseKeyManagement(backup.Status.EncryptionConfig) {
+		return nil, fmt.Errorf("backup %s/%s is not encrypted by the key management service", backup.Namespace, backup.Name)
+	}
+	dataKey, err := resolveDataKey(ctx, cli, backup)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := NewProvider(ctx, cli, backup.Namespace, config)
+	if err != nil {
+		return nil, err
+	}
+	return wrapDataKey(ctx, provider, config.Provider, dataKey)
+}
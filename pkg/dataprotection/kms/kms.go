@@ -0,0 +1,129 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kms
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+)
+
+const (
+	// dataKeyLength is the length of the random data key in bytes.
+	dataKeyLength = 32
+)
+
+// Provider wraps and unwraps the data keys with the key encryption keys (KEKs)
+// managed by a key management service.
+type Provider interface {
+	// Wrap encrypts the data key with the current KEK, and returns the identifier
+	// of the KEK which can be used to unwrap the data key later.
+	Wrap(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+
+	// Unwrap decrypts the data key wrapped by the KEK identified by keyID.
+	Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// NewProvider builds the provider of the key management service. The secrets
+// referenced by the config are read from the namespace.
+func NewProvider(ctx context.Context, cli client.Client, namespace string,
+	config *dpv1alpha1.KeyManagementConfig) (Provider, error) {
+	if config == nil {
+		return nil, fmt.Errorf("key management config is empty")
+	}
+	switch config.Provider {
+	case dpv1alpha1.KMSProviderSecret:
+		return newSecretProvider(ctx, cli, namespace, config)
+	case dpv1alpha1.KMSProviderVault:
+		return newVaultProvider(ctx, cli, namespace, config)
+	case dpv1alpha1.KMSProviderAWSKMS:
+		return newAWSKMSProvider(ctx, cli, namespace, config)
+	default:
+		return nil, fmt.Errorf("unsupported KMS provider: %s", config.Provider)
+	}
+}
+
+// GenerateDataKey generates a random data key and wraps it with the current KEK.
+func GenerateDataKey(ctx context.Context, provider Provider,
+	providerType dpv1alpha1.KMSProvider) (*dpv1alpha1.BackupEncryptionKey, error) {
+	dataKey := make([]byte, dataKeyLength)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	return wrapDataKey(ctx, provider, providerType, dataKey)
+}
+
+// UnwrapDataKey returns the plaintext data key, which is used as the passphrase
+// of the encryption.
+func UnwrapDataKey(ctx context.Context, provider Provider, key *dpv1alpha1.BackupEncryptionKey) ([]byte, error) {
+	if key == nil {
+		return nil, fmt.Errorf("encryption key is empty")
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(key.WrappedDataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode wrapped data key: %w", err)
+	}
+	dataKey, err := provider.Unwrap(ctx, key.KeyID, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with KEK %s: %w", key.KeyID, err)
+	}
+	return dataKey, nil
+}
+
+func wrapDataKey(ctx context.Context, provider Provider, providerType dpv1alpha1.KMSProvider,
+	dataKey []byte) (*dpv1alpha1.BackupEncryptionKey, error) {
+	keyID, wrapped, err := provider.Wrap(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+	now := metav1.Now()
+	return &dpv1alpha1.BackupEncryptionKey{
+		Provider:       providerType,
+		KeyID:          keyID,
+		WrappedDataKey: base64.StdEncoding.EncodeToString(wrapped),
+		WrappedAt:      &now,
+	}, nil
+}
+
+// PassPhrase encodes the data key as the passphrase consumed by datasafed.
+func PassPhrase(dataKey []byte) string {
+	return hex.EncodeToString(dataKey)
+}
+
+// getSecretValue reads the value of the key in the secret.
+func getSecretValue(ctx context.Context, cli client.Client, namespace, name, key string) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+		return nil, err
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in secret %s/%s", key, namespace, name)
+	}
+	return value, nil
+}
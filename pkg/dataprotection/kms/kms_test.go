@@ -0,0 +1,313 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+)
+
+const testNamespace = "default"
+
+func newFakeClient(t *testing.T, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, dpv1alpha1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newSecret(name string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: name},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func newEncryptedBackup(config *dpv1alpha1.KeyManagementConfig, key *dpv1alpha1.BackupEncryptionKey) *dpv1alpha1.Backup {
+	return &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "backup", UID: "backup-uid"},
+		Status: dpv1alpha1.BackupStatus{
+			EncryptionConfig: &dpv1alpha1.EncryptionConfig{
+				Algorithm:     dpv1alpha1.DefaultEncryptionAlgorithm,
+				KeyManagement: config,
+			},
+			EncryptionKey: key,
+		},
+	}
+}
+
+func TestSecretProviderRotation(t *testing.T) {
+	ctx := context.Background()
+	cli := newFakeClient(t, newSecret("kek", map[string]string{"v1": "old-kek", "v2": "new-kek"}))
+	config := &dpv1alpha1.KeyManagementConfig{
+		Provider: dpv1alpha1.KMSProviderSecret,
+		KeyID:    "v1",
+		Secret:   &dpv1alpha1.SecretKMSConfig{SecretName: "kek"},
+	}
+	encryptionConfig := &dpv1alpha1.EncryptionConfig{KeyManagement: config}
+
+	key, err := NewBackupEncryptionKey(ctx, cli, testNamespace, encryptionConfig, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", key.KeyID)
+	assert.Equal(t, dpv1alpha1.KMSProviderSecret, key.Provider)
+	backup := newEncryptedBackup(config, key)
+	dataKey, err := resolveDataKey(ctx, cli, backup)
+	assert.NoError(t, err)
+	assert.Len(t, dataKey, dataKeyLength)
+
+	// the child backup shares the data key of the parent backup
+	childKey, err := NewBackupEncryptionKey(ctx, cli, testNamespace, encryptionConfig, backup)
+	assert.NoError(t, err)
+	assert.Equal(t, key, childKey)
+
+	// rotate the KEK, the old backup is still resolvable
+	rotated := config.DeepCopy()
+	rotated.KeyID = "v2"
+	provider, err := NewProvider(ctx, cli, testNamespace, rotated)
+	assert.NoError(t, err)
+	unwrapped, err := UnwrapDataKey(ctx, provider, key)
+	assert.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	// rewrap the data key with the new KEK
+	rewrapped, err := RewrapBackupEncryptionKey(ctx, cli, backup, rotated)
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", rewrapped.KeyID)
+	assert.NotEqual(t, key.WrappedDataKey, rewrapped.WrappedDataKey)
+	backup.Status.EncryptionConfig.KeyManagement = rotated
+	backup.Status.EncryptionKey = rewrapped
+	unwrapped, err = resolveDataKey(ctx, cli, backup)
+	assert.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	// the data key can not be unwrapped by a wrong KEK
+	wrong := *key
+	wrong.KeyID = "v2"
+	_, err = UnwrapDataKey(ctx, provider, &wrong)
+	assert.Error(t, err)
+
+	// the current KEK must exist
+	rotated.KeyID = "v3"
+	_, err = NewProvider(ctx, cli, testNamespace, rotated)
+	assert.Error(t, err)
+}
+
+// fakeVaultTransit is a local stand-in of the transit secrets engine of Vault.
+type fakeVaultTransit struct {
+	sync.Mutex
+	token   string
+	version int
+	// ciphertext -> plaintext
+	store map[string]string
+}
+
+func (v *fakeVaultTransit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.Lock()
+	defer v.Unlock()
+	if r.Header.Get("X-Vault-Token") != v.token {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	var req map[string]string
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	var data map[string]any
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/transit/encrypt/kek"):
+		ciphertext := fmt.Sprintf("vault:v%d:%d", v.version, len(v.store))
+		v.store[ciphertext] = req["plaintext"]
+		data = map[string]any{"ciphertext": ciphertext, "key_version": v.version}
+	case strings.HasPrefix(r.URL.Path, "/v1/transit/decrypt/kek"):
+		plaintext, ok := v.store[req["ciphertext"]]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data = map[string]any{"plaintext": plaintext}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func TestVaultProvider(t *testing.T) {
+	ctx := context.Background()
+	transit := &fakeVaultTransit{token: "root", version: 1, store: map[string]string{}}
+	server := httptest.NewServer(transit)
+	defer server.Close()
+
+	cli := newFakeClient(t, newSecret("vault-token", map[string]string{"token": "root\n"}))
+	config := &dpv1alpha1.KeyManagementConfig{
+		Provider: dpv1alpha1.KMSProviderVault,
+		KeyID:    "kek",
+		Vault: &dpv1alpha1.VaultKMSConfig{
+			Address: server.URL,
+			TokenSecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "vault-token"},
+				Key:                  "token",
+			},
+		},
+	}
+	provider, err := NewProvider(ctx, cli, testNamespace, config)
+	assert.NoError(t, err)
+	key, err := GenerateDataKey(ctx, provider, config.Provider)
+	assert.NoError(t, err)
+	assert.Equal(t, "kek:v1", key.KeyID)
+
+	// rotate the transit key, the data key wrapped by the old version is still resolvable
+	transit.version = 2
+	backup := newEncryptedBackup(config, key)
+	dataKey, err := resolveDataKey(ctx, cli, backup)
+	assert.NoError(t, err)
+	assert.Len(t, dataKey, dataKeyLength)
+
+	rewrapped, err := RewrapBackupEncryptionKey(ctx, cli, backup, config)
+	assert.NoError(t, err)
+	assert.Equal(t, "kek:v2", rewrapped.KeyID)
+	unwrapped, err := UnwrapDataKey(ctx, provider, rewrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	// invalid token
+	transit.token = "another"
+	_, err = GenerateDataKey(ctx, provider, config.Provider)
+	assert.Error(t, err)
+}
+
+func TestAWSKMSProvider(t *testing.T) {
+	ctx := context.Background()
+	const keyARN = "arn:aws:kms:us-west-2:111122223333:key/1234abcd"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/") ||
+			!strings.Contains(auth, "/us-west-2/kms/aws4_request") ||
+			!strings.Contains(auth, "x-amz-security-token") ||
+			r.Header.Get("X-Amz-Security-Token") != "session" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var req map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		var resp map[string]string
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.Encrypt":
+			blob := base64.StdEncoding.EncodeToString([]byte(req["KeyId"] + "|" + req["Plaintext"]))
+			resp = map[string]string{"CiphertextBlob": blob, "KeyId": keyARN}
+		case "TrentService.Decrypt":
+			blob, _ := base64.StdEncoding.DecodeString(req["CiphertextBlob"])
+			_, plaintext, _ := strings.Cut(string(blob), "|")
+			resp = map[string]string{"Plaintext": plaintext, "KeyId": keyARN}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	cli := newFakeClient(t, newSecret("aws-credential", map[string]string{
+		awsAccessKeyIDKey:     "AKID",
+		awsSecretAccessKeyKey: "SECRET",
+		awsSessionTokenKey:    "session",
+	}))
+	config := &dpv1alpha1.KeyManagementConfig{
+		Provider: dpv1alpha1.KMSProviderAWSKMS,
+		KeyID:    "alias/backup",
+		AWSKMS: &dpv1alpha1.AWSKMSConfig{
+			Region:               "us-west-2",
+			Endpoint:             server.URL,
+			CredentialSecretName: "aws-credential",
+		},
+	}
+	provider, err := NewProvider(ctx, cli, testNamespace, config)
+	assert.NoError(t, err)
+	key, err := GenerateDataKey(ctx, provider, config.Provider)
+	assert.NoError(t, err)
+	assert.Equal(t, keyARN, key.KeyID)
+	dataKey, err := UnwrapDataKey(ctx, provider, key)
+	assert.NoError(t, err)
+	assert.Len(t, dataKey, dataKeyLength)
+}
+
+func TestDataKeySecret(t *testing.T) {
+	ctx := context.Background()
+	config := &dpv1alpha1.KeyManagementConfig{
+		Provider: dpv1alpha1.KMSProviderSecret,
+		KeyID:    "v1",
+		Secret:   &dpv1alpha1.SecretKMSConfig{SecretName: "kek"},
+	}
+	cli := newFakeClient(t, newSecret("kek", map[string]string{"v1": "kek"}))
+	key, err := NewBackupEncryptionKey(ctx, cli, testNamespace, &dpv1alpha1.EncryptionConfig{KeyManagement: config}, nil)
+	assert.NoError(t, err)
+	backup := newEncryptedBackup(config, key)
+	restore := &dpv1alpha1.Restore{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "restore", UID: "restore-uid"}}
+
+	jobConfig := BuildJobEncryptionConfig(backup)
+	assert.Nil(t, jobConfig.KeyManagement)
+	assert.Equal(t, DataKeySecretName(backup), jobConfig.PassPhraseSecretKeyRef.Name)
+	assert.Equal(t, DataKeySecretKey, jobConfig.PassPhraseSecretKeyRef.Key)
+
+	assert.NoError(t, EnsureDataKeySecret(ctx, cli, backup, testNamespace, backup))
+	assert.NoError(t, EnsureDataKeySecret(ctx, cli, backup, testNamespace, restore))
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Namespace: testNamespace, Name: DataKeySecretName(backup)}
+	assert.NoError(t, cli.Get(ctx, secretKey, secret))
+	assert.Len(t, secret.OwnerReferences, 2)
+	dataKey, err := resolveDataKey(ctx, cli, backup)
+	assert.NoError(t, err)
+	assert.Equal(t, PassPhrase(dataKey), string(secret.Data[DataKeySecretKey]))
+
+	// the secret is kept until all owners release it
+	assert.NoError(t, ReleaseDataKeySecrets(ctx, cli, testNamespace, backup))
+	assert.NoError(t, cli.Get(ctx, secretKey, secret))
+	assert.Len(t, secret.OwnerReferences, 1)
+	assert.NoError(t, ReleaseDataKeySecrets(ctx, cli, testNamespace, restore))
+	assert.True(t, apierrors.IsNotFound(cli.Get(ctx, secretKey, secret)))
+
+	// the passphrase is used directly without the key management service
+	backup.Status.EncryptionConfig = &dpv1alpha1.EncryptionConfig{
+		PassPhraseSecretKeyRef: &corev1.SecretKeySelector{Key: "password"},
+	}
+	assert.Equal(t, backup.Status.EncryptionConfig, BuildJobEncryptionConfig(backup))
+	assert.NoError(t, EnsureDataKeySecret(ctx, cli, backup, testNamespace, backup))
+	assert.True(t, apierrors.IsNotFound(cli.Get(ctx, secretKey, secret)))
+}
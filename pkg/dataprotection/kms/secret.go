@@ -0,0 +1,107 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+)
+
+// secretProvider uses the keys of a Kubernetes secret as the KEKs, the data
+// keys are wrapped locally with AES-256-GCM.
+type secretProvider struct {
+	cli        client.Client
+	namespace  string
+	secretName string
+	keyID      string
+}
+
+var _ Provider = &secretProvider{}
+
+func newSecretProvider(ctx context.Context, cli client.Client, namespace string,
+	config *dpv1alpha1.KeyManagementConfig) (Provider, error) {
+	if config.Secret == nil || config.Secret.SecretName == "" {
+		return nil, fmt.Errorf("secret name is required for the %s KMS provider", config.Provider)
+	}
+	p := &secretProvider{
+		cli:        cli,
+		namespace:  namespace,
+		secretName: config.Secret.SecretName,
+		keyID:      config.KeyID,
+	}
+	// make sure the current KEK exists
+	if _, err := p.getKEK(ctx, p.keyID); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *secretProvider) Wrap(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	gcm, err := p.newGCM(ctx, p.keyID)
+	if err != nil {
+		return "", nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return p.keyID, gcm.Seal(nonce, nonce, dataKey, []byte(p.keyID)), nil
+}
+
+func (p *secretProvider) Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	gcm, err := p.newGCM(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, fmt.Errorf("wrapped data key is too short")
+	}
+	nonce, ciphertext := wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, []byte(keyID))
+}
+
+func (p *secretProvider) getKEK(ctx context.Context, keyID string) ([]byte, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("key ID is empty")
+	}
+	return getSecretValue(ctx, p.cli, p.namespace, p.secretName, keyID)
+}
+
+func (p *secretProvider) newGCM(ctx context.Context, keyID string) (cipher.AEAD, error) {
+	kek, err := p.getKEK(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	// derive a 256-bit key from the secret value
+	key := sha256.Sum256(kek)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
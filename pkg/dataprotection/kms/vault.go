@@ -0,0 +1,145 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+)
+
+const defaultVaultTransitMountPath = "transit"
+
+var (
+	// for testing
+	httpClient = &http.Client{Timeout: 30 * time.Second}
+)
+
+// vaultProvider wraps the data keys with the transit secrets engine of HashiCorp Vault.
+// The key version is included in the ciphertext returned by Vault, so the data keys
+// wrapped by the old versions can still be unwrapped after the transit key is rotated.
+type vaultProvider struct {
+	address    string
+	mountPath  string
+	vaultNS    string
+	token      string
+	transitKey string
+}
+
+var _ Provider = &vaultProvider{}
+
+func newVaultProvider(ctx context.Context, cli client.Client, namespace string,
+	config *dpv1alpha1.KeyManagementConfig) (Provider, error) {
+	vault := config.Vault
+	if vault == nil || vault.Address == "" {
+		return nil, fmt.Errorf("vault address is required for the %s KMS provider", config.Provider)
+	}
+	if vault.TokenSecretKeyRef == nil {
+		return nil, fmt.Errorf("vault token is required for the %s KMS provider", config.Provider)
+	}
+	token, err := getSecretValue(ctx, cli, namespace, vault.TokenSecretKeyRef.Name, vault.TokenSecretKeyRef.Key)
+	if err != nil {
+		return nil, err
+	}
+	mountPath := strings.Trim(vault.TransitMountPath, "/")
+	if mountPath == "" {
+		mountPath = defaultVaultTransitMountPath
+	}
+	return &vaultProvider{
+		address:    strings.TrimSuffix(vault.Address, "/"),
+		mountPath:  mountPath,
+		vaultNS:    vault.Namespace,
+		token:      strings.TrimSpace(string(token)),
+		transitKey: config.KeyID,
+	}, nil
+}
+
+func (p *vaultProvider) Wrap(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+			KeyVersion int    `json:"key_version"`
+		} `json:"data"`
+	}
+	req := map[string]string{"plaintext": base64.StdEncoding.EncodeToString(dataKey)}
+	if err := p.do(ctx, "encrypt", p.transitKey, req, &resp); err != nil {
+		return "", nil, err
+	}
+	keyID := p.transitKey
+	if resp.Data.KeyVersion > 0 {
+		keyID = fmt.Sprintf("%s:v%d", p.transitKey, resp.Data.KeyVersion)
+	}
+	return keyID, []byte(resp.Data.Ciphertext), nil
+}
+
+func (p *vaultProvider) Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	// the key ID is in the format of <transit key>:v<version>
+	transitKey, _, _ := strings.Cut(keyID, ":")
+	req := map[string]string{"ciphertext": string(wrapped)}
+	if err := p.do(ctx, "decrypt", transitKey, req, &resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+}
+
+func (p *vaultProvider) do(ctx context.Context, op, transitKey string, body any, result any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", p.address, p.mountPath, op, transitKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", p.token)
+	if p.vaultNS != "" {
+		req.Header.Set("X-Vault-Namespace", p.vaultNS)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vault transit %s failed with status %d: %s", op, resp.StatusCode, string(respBody))
+	}
+	return json.Unmarshal(respBody, result)
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/common"
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/kms"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
@@ -212,6 +213,15 @@ func (r *restoreJobBuilder) addCommonEnv(sourceTargetPodName string) *restoreJob
 		}
 		r.env = append(r.env, corev1.EnvVar{Name: dptypes.DPAncestorIncrementalBackupNames, Value: strings.Join(ancestorIncrementalBackupNames, ",")})
 	}
+	// add the scope env for selective restore
+	if scope := r.restore.Spec.Scope; scope != nil {
+		if len(scope.Databases) > 0 {
+			r.env = append(r.env, corev1.EnvVar{Name: DPRestoreDatabases, Value: strings.Join(scope.Databases, ",")})
+		}
+		if len(scope.Tables) > 0 {
+			r.env = append(r.env, corev1.EnvVar{Name: DPRestoreTables, Value: strings.Join(scope.Tables, ",")})
+		}
+	}
 	// add time env
 	actionSetEnv := r.backupSet.ActionSet.Spec.Env
 	timeFormat := getTimeFormat(actionSetEnv)
@@ -431,9 +441,10 @@ func (r *restoreJobBuilder) build() *batchv1.Job {
 	if r.buildWithRepo {
 		mountPath := "/backupdata"
 		kopiaRepoPath := r.backupSet.Backup.Status.KopiaRepoPath
-		encryptionConfig := r.backupSet.Backup.Status.EncryptionConfig
+		encryptionConfig := kms.BuildJobEncryptionConfig(r.backupSet.Backup)
 		utils.InjectDatasafed(&job.Spec.Template.Spec, r.backupRepo, mountPath,
 			encryptionConfig, kopiaRepoPath)
+		utils.SetBandwidthAnnotations(&job.Spec.Template.ObjectMeta, r.backupRepo.Spec.Throttle)
 	}
 	return job
 }
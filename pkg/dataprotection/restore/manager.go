@@ -167,6 +167,14 @@ func (r *RestoreManager) BuildContinuousRestoreManager(reqCtx intctrlutil.Reques
 	if err := checkRestoreTime(); err != nil {
 		return err
 	}
+	// check if the restore time is recoverable with the base backups.
+	window, err := GetRecoverableWindow(reqCtx.Ctx, cli, continuousBackup, continuousBackupSet.ActionSet)
+	if err != nil {
+		return err
+	}
+	if err = ValidateRecoverableTime(restoreTime, continuousBackup.Name, window); err != nil {
+		return intctrlutil.NewFatalError(err.Error())
+	}
 
 	if continuousBackupSet.ActionSet.Spec.Restore != nil {
 		if baseBackupRequired := continuousBackupSet.ActionSet.Spec.Restore.BaseBackupRequired; boolptr.IsSetToFalse(baseBackupRequired) {
@@ -268,6 +276,12 @@ func (r *RestoreManager) getBaseBackupActionSetForContinuous(reqCtx intctrlutil.
 }
 
 func (r *RestoreManager) listCompletedBackups(reqCtx intctrlutil.RequestCtx, cli client.Client, continuousBackup *dpv1alpha1.Backup, backupType dpv1alpha1.BackupType) ([]dpv1alpha1.Backup, error) {
+	return listCompletedBackupsForContinuous(reqCtx.Ctx, cli, continuousBackup, backupType)
+}
+
+// listCompletedBackupsForContinuous lists the completed backups of the backup type, which belong to
+// the same cluster component as the continuous backup.
+func listCompletedBackupsForContinuous(ctx context.Context, cli client.Reader, continuousBackup *dpv1alpha1.Backup, backupType dpv1alpha1.BackupType) ([]dpv1alpha1.Backup, error) {
 	matchingLabels := map[string]string{
 		dptypes.BackupTypeLabelKey: string(backupType),
 	}
@@ -285,7 +299,7 @@ func (r *RestoreManager) listCompletedBackups(reqCtx intctrlutil.RequestCtx, cli
 		matchingLabels[dptypes.BackupPolicyLabelKey] = continuousBackup.Spec.BackupPolicyName
 	}
 	backups := dpv1alpha1.BackupList{}
-	if err := cli.List(reqCtx.Ctx, &backups,
+	if err := cli.List(ctx, &backups,
 		client.InNamespace(continuousBackup.Namespace),
 		client.MatchingLabels(matchingLabels),
 	); err != nil {
@@ -442,9 +456,13 @@ func (r *RestoreManager) prepareBackupRepo(reqCtx intctrlutil.RequestCtx, cli cl
 	if backupSet.Backup.Status.BackupRepoName == "" {
 		return nil, intctrlutil.NewFatalError(fmt.Sprintf("backup %s has no backup repository", backupSet.Backup.Name))
 	}
-	backupRepo := &dpv1alpha1.BackupRepo{}
-	err := cli.Get(reqCtx.Ctx, client.ObjectKey{Name: backupSet.Backup.Status.BackupRepoName}, backupRepo)
+	// restore from a copy of the backup if the primary backup repository is unavailable.
+	repoName, err := GetBackupRepoNameForRestore(reqCtx.Ctx, cli, backupSet.Backup)
 	if err != nil {
+		return nil, err
+	}
+	backupRepo := &dpv1alpha1.BackupRepo{}
+	if err = cli.Get(reqCtx.Ctx, client.ObjectKey{Name: repoName}, backupRepo); err != nil {
 		if apierrors.IsNotFound(err) {
 			err = intctrlutil.NewFatalError(err.Error())
 		}
@@ -0,0 +1,145 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
+)
+
+// GetRecoverableWindow returns the time window within which the data can be restored to any point in
+// time with the continuous backup, or nil if there is no recoverable point in time.
+//
+// If the actionSet of the continuous backup requires a base backup, the window starts from the earliest
+// completed full or incremental backup which is completed after the continuous backup started.
+func GetRecoverableWindow(ctx context.Context,
+	cli client.Reader,
+	continuousBackup *dpv1alpha1.Backup,
+	actionSet *dpv1alpha1.ActionSet) (*dpv1alpha1.RecoverableWindow, error) {
+	baseBackupRequired := true
+	if actionSet != nil && actionSet.Spec.Restore != nil &&
+		boolptr.IsSetToFalse(actionSet.Spec.Restore.BaseBackupRequired) {
+		baseBackupRequired = false
+	}
+	var baseBackups []dpv1alpha1.Backup
+	if baseBackupRequired {
+		for _, backupType := range []dpv1alpha1.BackupType{dpv1alpha1.BackupTypeFull, dpv1alpha1.BackupTypeIncremental} {
+			backups, err := listCompletedBackupsForContinuous(ctx, cli, continuousBackup, backupType)
+			if err != nil {
+				return nil, err
+			}
+			baseBackups = append(baseBackups, backups...)
+		}
+	}
+	return buildRecoverableWindow(continuousBackup, baseBackups, baseBackupRequired), nil
+}
+
+func buildRecoverableWindow(continuousBackup *dpv1alpha1.Backup,
+	baseBackups []dpv1alpha1.Backup,
+	baseBackupRequired bool) *dpv1alpha1.RecoverableWindow {
+	startTime, endTime := continuousBackup.GetStartTime(), continuousBackup.GetEndTime()
+	if startTime.IsZero() || endTime.IsZero() || endTime.Before(startTime) {
+		return nil
+	}
+	window := &dpv1alpha1.RecoverableWindow{
+		Start:                 *startTime,
+		End:                   *endTime,
+		ContinuousBackupNames: []string{continuousBackup.Name},
+	}
+	if !baseBackupRequired {
+		return window
+	}
+	// the window starts from the earliest base backup whose stop time is within the continuous backup,
+	// see the rules of getBaseBackupActionSetForContinuous.
+	var earliest *metav1.Time
+	for i := range baseBackups {
+		stopTime := baseBackups[i].GetEndTime()
+		if stopTime.IsZero() || stopTime.Before(startTime) || endTime.Before(stopTime) {
+			continue
+		}
+		if earliest == nil || stopTime.Before(earliest) {
+			earliest = stopTime
+		}
+	}
+	if earliest == nil {
+		return nil
+	}
+	window.Start = *earliest
+	return window
+}
+
+// MergeRecoverableWindows merges the overlapping recoverable windows, and returns the merged windows
+// sorted by the start time and the gaps between them.
+func MergeRecoverableWindows(windows []dpv1alpha1.RecoverableWindow) ([]dpv1alpha1.RecoverableWindow, []dpv1alpha1.RecoverableGap) {
+	if len(windows) == 0 {
+		return nil, nil
+	}
+	sorted := make([]dpv1alpha1.RecoverableWindow, len(windows))
+	for i := range windows {
+		windows[i].DeepCopyInto(&sorted[i])
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(&sorted[j].Start)
+	})
+	var (
+		merged = []dpv1alpha1.RecoverableWindow{sorted[0]}
+		gaps   []dpv1alpha1.RecoverableGap
+	)
+	for _, window := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if window.Start.After(last.End.Time) {
+			gaps = append(gaps, dpv1alpha1.RecoverableGap{Start: last.End, End: window.Start})
+			merged = append(merged, window)
+			continue
+		}
+		if last.End.Before(&window.End) {
+			last.End = window.End
+		}
+		last.ContinuousBackupNames = append(last.ContinuousBackupNames, window.ContinuousBackupNames...)
+	}
+	return merged, gaps
+}
+
+// ValidateRecoverableTime checks whether the restore time is within the recoverable window of the
+// continuous backup, and returns an error with the nearest recoverable point in time if not.
+func ValidateRecoverableTime(restoreTime time.Time, backupName string, window *dpv1alpha1.RecoverableWindow) error {
+	if window == nil {
+		return fmt.Errorf(`no recoverable point in time found for backup "%s", the continuous backup requires `+
+			`a completed full or incremental backup which is completed during the time range of it`, backupName)
+	}
+	if isTimeInRange(restoreTime, window.Start.Time, window.End.Time) {
+		return nil
+	}
+	nearest := window.Start
+	if restoreTime.After(window.End.Time) {
+		nearest = window.End
+	}
+	return fmt.Errorf(`restore time "%s" is not recoverable with backup "%s", the recoverable window is [%s, %s], `+
+		`the nearest recoverable point in time is "%s"`, restoreTime.UTC().Format(time.RFC3339), backupName,
+		window.Start.UTC().Format(time.RFC3339), window.End.UTC().Format(time.RFC3339), nearest.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+func newTimelineBackup(name string, backupType dpv1alpha1.BackupType, start, end time.Time) *dpv1alpha1.Backup {
+	backup := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			Labels: map[string]string{
+				dptypes.BackupTypeLabelKey:   string(backupType),
+				constant.AppInstanceLabelKey: "cluster",
+			},
+		},
+		Status: dpv1alpha1.BackupStatus{Phase: dpv1alpha1.BackupPhaseCompleted},
+	}
+	if backupType == dpv1alpha1.BackupTypeContinuous {
+		backup.Status.Phase = dpv1alpha1.BackupPhaseRunning
+		backup.Status.TimeRange = &dpv1alpha1.BackupTimeRange{
+			Start: &metav1.Time{Time: start},
+			End:   &metav1.Time{Time: end},
+		}
+	} else {
+		backup.Status.CompletionTimestamp = &metav1.Time{Time: end}
+	}
+	return backup
+}
+
+func TestGetRecoverableWindow(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, dpv1alpha1.AddToScheme(scheme))
+
+	base := time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC)
+	continuous := newTimelineBackup("continuous", dpv1alpha1.BackupTypeContinuous, base.Add(time.Hour), base.Add(5*time.Hour))
+	beforeStart := newTimelineBackup("before-start", dpv1alpha1.BackupTypeFull, time.Time{}, base.Add(30*time.Minute))
+	full := newTimelineBackup("full", dpv1alpha1.BackupTypeFull, time.Time{}, base.Add(2*time.Hour))
+	incremental := newTimelineBackup("incremental", dpv1alpha1.BackupTypeIncremental, time.Time{}, base.Add(90*time.Minute))
+	ctx := context.Background()
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(continuous, beforeStart).Build()
+	window, err := GetRecoverableWindow(ctx, cli, continuous, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, window)
+	assert.ErrorContains(t, ValidateRecoverableTime(base.Add(2*time.Hour), continuous.Name, window), "no recoverable point in time")
+
+	// the window starts from the earliest base backup completed after the continuous backup started
+	cli = fake.NewClientBuilder().WithScheme(scheme).WithObjects(continuous, beforeStart, full, incremental).Build()
+	window, err = GetRecoverableWindow(ctx, cli, continuous, nil)
+	assert.NoError(t, err)
+	assert.True(t, window.Start.Equal(&metav1.Time{Time: base.Add(90 * time.Minute)}))
+	assert.True(t, window.End.Equal(continuous.GetEndTime()))
+	assert.Equal(t, []string{continuous.Name}, window.ContinuousBackupNames)
+
+	assert.NoError(t, ValidateRecoverableTime(base.Add(3*time.Hour), continuous.Name, window))
+	assert.ErrorContains(t, ValidateRecoverableTime(base.Add(time.Hour), continuous.Name, window),
+		`the nearest recoverable point in time is "2026-05-04T01:30:00Z"`)
+	assert.ErrorContains(t, ValidateRecoverableTime(base.Add(6*time.Hour), continuous.Name, window),
+		`the nearest recoverable point in time is "2026-05-04T05:00:00Z"`)
+
+	// the base backup is not required
+	baseBackupRequired := false
+	actionSet := &dpv1alpha1.ActionSet{Spec: dpv1alpha1.ActionSetSpec{
+		Restore: &dpv1alpha1.RestoreActionSpec{BaseBackupRequired: &baseBackupRequired},
+	}}
+	window, err = GetRecoverableWindow(ctx, cli, continuous, actionSet)
+	assert.NoError(t, err)
+	assert.True(t, window.Start.Equal(continuous.GetStartTime()))
+}
+
+func TestMergeRecoverableWindows(t *testing.T) {
+	windows, gaps := MergeRecoverableWindows(nil)
+	assert.Nil(t, windows)
+	assert.Nil(t, gaps)
+
+	base := time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC)
+	newWindow := func(name string, start, end time.Duration) dpv1alpha1.RecoverableWindow {
+		return dpv1alpha1.RecoverableWindow{
+			Start:                 metav1.NewTime(base.Add(start)),
+			End:                   metav1.NewTime(base.Add(end)),
+			ContinuousBackupNames: []string{name},
+		}
+	}
+	windows, gaps = MergeRecoverableWindows([]dpv1alpha1.RecoverableWindow{
+		newWindow("c3", 6*time.Hour, 8*time.Hour),
+		newWindow("c1", time.Hour, 3*time.Hour),
+		newWindow("c2", 2*time.Hour, 4*time.Hour),
+	})
+	assert.Len(t, windows, 2)
+	assert.Equal(t, base.Add(time.Hour), windows[0].Start.Time)
+	assert.Equal(t, base.Add(4*time.Hour), windows[0].End.Time)
+	assert.Equal(t, []string{"c1", "c2"}, windows[0].ContinuousBackupNames)
+	assert.Equal(t, []string{"c3"}, windows[1].ContinuousBackupNames)
+	assert.Equal(t, []dpv1alpha1.RecoverableGap{{
+		Start: metav1.NewTime(base.Add(4 * time.Hour)),
+		End:   metav1.NewTime(base.Add(6 * time.Hour)),
+	}}, gaps)
+}
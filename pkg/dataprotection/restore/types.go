@@ -67,6 +67,10 @@ const (
 	DPBaseBackupStartTimestamp = "DP_BASE_BACKUP_START_TIMESTAMP"
 	DPBaseBackupStopTime       = "DP_BASE_BACKUP_STOP_TIME"
 	DPBaseBackupStopTimestamp  = "DP_BASE_BACKUP_STOP_TIMESTAMP"
+	// DPRestoreDatabases the comma-separated databases to be restored selectively
+	DPRestoreDatabases = "DP_RESTORE_DATABASES"
+	// DPRestoreTables the comma-separated tables to be restored selectively, in the format of <database>.<table>
+	DPRestoreTables = "DP_RESTORE_TABLES"
 )
 
 // Restore constant
@@ -20,6 +20,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package restore
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"slices"
@@ -29,6 +30,7 @@ import (
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -257,6 +259,11 @@ func ValidateAndInitRestoreMGR(reqCtx intctrlutil.RequestCtx,
 		return err
 	}
 
+	// validate the scope of selective restore against the backup catalog.
+	if err = ValidateRestoreScope(reqCtx.Ctx, cli, restoreMgr.Restore.Spec.Scope, backupSet.Backup); err != nil {
+		return err
+	}
+
 	// build backupActionSets of prepareData and postReady stage based on the specified backup's type.
 	switch backupType {
 	case dpv1alpha1.BackupTypeFull, dpv1alpha1.BackupTypeSelective:
@@ -297,14 +304,43 @@ func FormatRestoreTimeAndValidate(restoreTimeStr string, continuousBackup *dpv1a
 		}
 	}
 	restoreTimeStr = restoreTime.UTC().Format(time.RFC3339)
-	// TODO: check with Recoverable time
 
 	if continuousBackup.Status.TimeRange == nil || continuousBackup.GetStartTime().IsZero() || continuousBackup.GetEndTime().IsZero() {
 		return restoreTimeStr, fmt.Errorf("invalid timeRange of the backup")
 	}
-	if !isTimeInRange(restoreTime, continuousBackup.GetStartTime().Time, continuousBackup.GetEndTime().Time) {
-		return restoreTimeStr, fmt.Errorf("restore-to-time is out of time range, you can view the recoverable time: \n"+
-			"\tkbcli cluster describe %s -n %s", continuousBackup.Labels[constant.AppInstanceLabelKey], continuousBackup.Namespace)
+	window := buildRecoverableWindow(continuousBackup, nil, false)
+	return restoreTimeStr, ValidateRecoverableTime(restoreTime, continuousBackup.Name, window)
+}
+
+// ValidateRestorePointInTime formats the restore time and validates whether it is recoverable with the
+// continuous backup and its base backups, and returns the formatted restore time. The restore time of the
+// other backups is not validated. A fatal error is returned if the restore time is not recoverable.
+func ValidateRestorePointInTime(ctx context.Context, cli client.Reader,
+	restoreTimeStr string, backup *dpv1alpha1.Backup) (string, error) {
+	if restoreTimeStr == "" || backup.Labels[dptypes.BackupTypeLabelKey] != string(dpv1alpha1.BackupTypeContinuous) {
+		return restoreTimeStr, nil
+	}
+	restoreTimeStr, err := FormatRestoreTimeAndValidate(restoreTimeStr, backup)
+	if err != nil {
+		return restoreTimeStr, intctrlutil.NewFatalError(err.Error())
+	}
+	restoreTime, err := time.Parse(time.RFC3339, restoreTimeStr)
+	if err != nil {
+		return restoreTimeStr, intctrlutil.NewFatalError(err.Error())
+	}
+	var actionSet *dpv1alpha1.ActionSet
+	if backup.Status.BackupMethod != nil && backup.Status.BackupMethod.ActionSetName != "" {
+		actionSet = &dpv1alpha1.ActionSet{}
+		if err = cli.Get(ctx, client.ObjectKey{Name: backup.Status.BackupMethod.ActionSetName}, actionSet); err != nil {
+			return restoreTimeStr, err
+		}
+	}
+	window, err := GetRecoverableWindow(ctx, cli, backup, actionSet)
+	if err != nil {
+		return restoreTimeStr, err
+	}
+	if err = ValidateRecoverableTime(restoreTime, backup.Name, window); err != nil {
+		return restoreTimeStr, intctrlutil.NewFatalError(err.Error())
 	}
 	return restoreTimeStr, nil
 }
@@ -426,6 +462,38 @@ func GetTargetRelativePath(targetName, targetPodName string) string {
 	return targetRelativePath
 }
 
+// GetBackupRepoNameForRestore returns the name of the backup repository to restore the backup from.
+// The primary backup repository of the backup is preferred. If it is unavailable, the backup repository
+// of the first completed copy that is ready will be used. If no copy is available, the primary backup
+// repository is returned.
+func GetBackupRepoNameForRestore(ctx context.Context, cli client.Client, backup *dpv1alpha1.Backup) (string, error) {
+	primaryRepoName := backup.Status.BackupRepoName
+	if primaryRepoName == "" {
+		return "", nil
+	}
+	isRepoReady := func(repoName string) (bool, error) {
+		repo := &dpv1alpha1.BackupRepo{}
+		if err := cli.Get(ctx, client.ObjectKey{Name: repoName}, repo); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return repo.Status.Phase == dpv1alpha1.BackupRepoReady, nil
+	}
+	if ready, err := isRepoReady(primaryRepoName); err != nil || ready {
+		return primaryRepoName, err
+	}
+	for _, backupCopy := range backup.Status.Copies {
+		if backupCopy.Phase != dpv1alpha1.BackupCopyPhaseCompleted || backupCopy.BackupRepoName == primaryRepoName {
+			continue
+		}
+		if ready, err := isRepoReady(backupCopy.BackupRepoName); err != nil {
+			return "", err
+		} else if ready {
+			return backupCopy.BackupRepoName, nil
+		}
+	}
+	return primaryRepoName, nil
+}
+
 // BackupFilePathEnv returns the envs for backup root path and target relative path.
 func BackupFilePathEnv(filePath, targetName, targetPodName string) []corev1.EnvVar {
 	envs := []corev1.EnvVar{}
@@ -450,3 +518,35 @@ func BackupFilePathEnv(filePath, targetName, targetPodName string) []corev1.EnvV
 	}...)
 	return envs
 }
+
+// ValidateRestoreScope validates the scope of a selective restore against the catalog of the backup.
+func ValidateRestoreScope(ctx context.Context, cli client.Client, scope *dpv1alpha1.RestoreScope, backup *dpv1alpha1.Backup) error {
+	if scope == nil {
+		return nil
+	}
+	catalog := &dpv1alpha1.BackupCatalog{}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(backup), catalog); err != nil {
+		if apierrors.IsNotFound(err) {
+			return intctrlutil.NewFatalError(fmt.Sprintf(`backup "%s" has no catalog, the selective restore is not supported`, backup.Name))
+		}
+		return err
+	}
+	if len(scope.Databases) > 0 && !catalog.IsRestorable(dpv1alpha1.BackupCatalogEntryKindDatabase) {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`the databases of backup "%s" can not be restored selectively`, backup.Name))
+	}
+	for _, db := range scope.Databases {
+		if !catalog.HasEntry(dpv1alpha1.BackupCatalogEntryKindDatabase, "", db) {
+			return intctrlutil.NewFatalError(fmt.Sprintf(`database "%s" is not found in the catalog of backup "%s"`, db, backup.Name))
+		}
+	}
+	if len(scope.Tables) > 0 && !catalog.IsRestorable(dpv1alpha1.BackupCatalogEntryKindTable) {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`the tables of backup "%s" can not be restored selectively`, backup.Name))
+	}
+	for _, table := range scope.Tables {
+		db, name, ok := strings.Cut(table, ".")
+		if !ok || !catalog.HasEntry(dpv1alpha1.BackupCatalogEntryKindTable, db, name) {
+			return intctrlutil.NewFatalError(fmt.Sprintf(`table "%s" is not found in the catalog of backup "%s"`, table, backup.Name))
+		}
+	}
+	return nil
+}
@@ -186,6 +186,51 @@ func TestFormatRestoreTimeAndValidate(t *testing.T) {
 	assert.Equal(t, "", func() string { got, _ := FormatRestoreTimeAndValidate("", backup); return got }())
 }
 
+func TestValidateRestorePointInTime(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, dpv1alpha1.AddToScheme(scheme))
+
+	start := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(start.Add(2 * time.Hour))
+	baseEnd := metav1.NewTime(start.Add(time.Hour))
+	continuous := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Name: "continuous", Namespace: "ns", Labels: map[string]string{
+			constant.AppInstanceLabelKey: "cluster",
+			dptypes.BackupTypeLabelKey:   string(dpv1alpha1.BackupTypeContinuous),
+		}},
+		Status: dpv1alpha1.BackupStatus{TimeRange: &dpv1alpha1.BackupTimeRange{Start: &start, End: &end}},
+	}
+	full := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Name: "full", Namespace: "ns", Labels: map[string]string{
+			constant.AppInstanceLabelKey: "cluster",
+			dptypes.BackupTypeLabelKey:   string(dpv1alpha1.BackupTypeFull),
+		}},
+		Status: dpv1alpha1.BackupStatus{
+			Phase:     dpv1alpha1.BackupPhaseCompleted,
+			TimeRange: &dpv1alpha1.BackupTimeRange{Start: &start, End: &baseEnd},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(continuous, full).Build()
+	ctx := context.Background()
+
+	got, err := ValidateRestorePointInTime(ctx, cli, "Jan 01,2026 01:30:00 UTC+0000", continuous)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-01T01:30:00Z", got)
+
+	// the restore time is within the continuous backup but before the base backup is completed
+	_, err = ValidateRestorePointInTime(ctx, cli, "2026-01-01T00:30:00Z", continuous)
+	assert.True(t, intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal))
+	assert.ErrorContains(t, err, `the nearest recoverable point in time is "2026-01-01T01:00:00Z"`)
+
+	_, err = ValidateRestorePointInTime(ctx, cli, "2026-01-02T00:00:00Z", continuous)
+	assert.True(t, intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal))
+
+	// the restore time of the other backups is not validated
+	got, err = ValidateRestorePointInTime(ctx, cli, "not a time", full)
+	assert.NoError(t, err)
+	assert.Equal(t, "not a time", got)
+}
+
 func TestRestoreSourcePodAndSnapshotHelpers(t *testing.T) {
 	target := &dpv1alpha1.BackupStatusTarget{
 		BackupTarget:       dpv1alpha1.BackupTarget{PodSelector: &dpv1alpha1.PodSelector{Strategy: dpv1alpha1.PodSelectionStrategyAny}},
@@ -320,6 +365,53 @@ func TestValidateAndInitRestoreMGRFullBackup(t *testing.T) {
 	assert.Error(t, ValidateAndInitRestoreMGR(reqCtx, cli, mgr))
 }
 
+func TestGetBackupRepoNameForRestore(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, dpv1alpha1.AddToScheme(scheme))
+
+	newRepo := func(name string, phase dpv1alpha1.BackupRepoPhase) *dpv1alpha1.BackupRepo {
+		return &dpv1alpha1.BackupRepo{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     dpv1alpha1.BackupRepoStatus{Phase: phase},
+		}
+	}
+	backup := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "ns"},
+		Status: dpv1alpha1.BackupStatus{
+			BackupRepoName: "primary",
+			Copies: []dpv1alpha1.BackupCopyStatus{
+				{BackupRepoName: "expired", Phase: dpv1alpha1.BackupCopyPhaseExpired},
+				{BackupRepoName: "failed", Phase: dpv1alpha1.BackupCopyPhaseCompleted},
+				{BackupRepoName: "replica", Phase: dpv1alpha1.BackupCopyPhaseCompleted},
+			},
+		},
+	}
+	primary := newRepo("primary", dpv1alpha1.BackupRepoReady)
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(primary).WithObjects(primary,
+		newRepo("expired", dpv1alpha1.BackupRepoReady),
+		newRepo("failed", dpv1alpha1.BackupRepoFailed),
+		newRepo("replica", dpv1alpha1.BackupRepoReady)).Build()
+	ctx := context.Background()
+
+	// the primary repo is preferred
+	repoName, err := GetBackupRepoNameForRestore(ctx, cli, backup)
+	assert.NoError(t, err)
+	assert.Equal(t, "primary", repoName)
+
+	// the first healthy copy is used if the primary repo is unavailable
+	primary.Status.Phase = dpv1alpha1.BackupRepoFailed
+	assert.NoError(t, cli.Status().Update(ctx, primary))
+	repoName, err = GetBackupRepoNameForRestore(ctx, cli, backup)
+	assert.NoError(t, err)
+	assert.Equal(t, "replica", repoName)
+
+	// fall back to the primary repo if there is no healthy copy
+	backup.Status.Copies = backup.Status.Copies[:2]
+	repoName, err = GetBackupRepoNameForRestore(ctx, cli, backup)
+	assert.NoError(t, err)
+	assert.Equal(t, "primary", repoName)
+}
+
 func TestRestoreManagerStopsManagerContainer(t *testing.T) {
 	scheme := runtime.NewScheme()
 	assert.NoError(t, corev1.AddToScheme(scheme))
@@ -346,3 +438,47 @@ func TestRestoreManagerStopsManagerContainer(t *testing.T) {
 	assert.NoError(t, mgr.StopManagerContainer(got))
 	assert.NoError(t, mgr.StopManagerContainerByJob(job))
 }
+
+func TestValidateRestoreScope(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, dpv1alpha1.AddToScheme(scheme))
+
+	backup := &dpv1alpha1.Backup{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "ns"}}
+	ctx := context.Background()
+	scope := &dpv1alpha1.RestoreScope{Databases: []string{"db1"}, Tables: []string{"db1.t1"}}
+
+	// no catalog
+	cli := fake.NewClientBuilder().WithScheme(scheme).Build()
+	assert.NoError(t, ValidateRestoreScope(ctx, cli, nil, backup))
+	err := ValidateRestoreScope(ctx, cli, scope, backup)
+	assert.True(t, intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal))
+
+	catalog := &dpv1alpha1.BackupCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "ns"},
+		Spec: dpv1alpha1.BackupCatalogSpec{
+			BackupName: "backup",
+			Entries: []dpv1alpha1.BackupCatalogEntry{
+				{Kind: dpv1alpha1.BackupCatalogEntryKindDatabase, Name: "db1"},
+				{Kind: dpv1alpha1.BackupCatalogEntryKindTable, Database: "db1", Name: "t1"},
+			},
+			RestorableKinds: []dpv1alpha1.BackupCatalogEntryKind{dpv1alpha1.BackupCatalogEntryKindDatabase, dpv1alpha1.BackupCatalogEntryKindTable},
+		},
+	}
+	cli = fake.NewClientBuilder().WithScheme(scheme).WithObjects(catalog).Build()
+	assert.NoError(t, ValidateRestoreScope(ctx, cli, scope, backup))
+	for _, invalid := range []*dpv1alpha1.RestoreScope{
+		{Databases: []string{"db2"}},
+		{Tables: []string{"db1.t2"}},
+		{Tables: []string{"db2.t1"}},
+	} {
+		err = ValidateRestoreScope(ctx, cli, invalid, backup)
+		assert.True(t, intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal), invalid)
+	}
+
+	// tables are not restorable
+	catalog.Spec.RestorableKinds = []dpv1alpha1.BackupCatalogEntryKind{dpv1alpha1.BackupCatalogEntryKindDatabase}
+	cli = fake.NewClientBuilder().WithScheme(scheme).WithObjects(catalog).Build()
+	assert.NoError(t, ValidateRestoreScope(ctx, cli, &dpv1alpha1.RestoreScope{Databases: []string{"db1"}}, backup))
+	err = ValidateRestoreScope(ctx, cli, &dpv1alpha1.RestoreScope{Tables: []string{"db1.t1"}}, backup)
+	assert.True(t, intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal))
+}
@@ -35,6 +35,9 @@ const (
 	CfgKeyWorkerClusterRoleName = "WORKER_CLUSTER_ROLE_NAME"
 	// CfgDataProtectionReconcileWorkers the max reconcile workers for MaxConcurrentReconciles
 	CfgDataProtectionReconcileWorkers = "DATAPROTECTION_RECONCILE_WORKERS"
+	// CfgKeyEnableBackupWebhook is the key of enabling the validating webhook for backups,
+	// which rejects the deletion of the locked backups
+	CfgKeyEnableBackupWebhook = "ENABLE_BACKUP_WEBHOOK"
 )
 
 // config default values
@@ -74,6 +77,22 @@ const (
 	SourceTargetNameAnnotationKey = "dataprotection.kubeblocks.io/source-target-name"
 	// SourceTargetPodNameAnnotationKey records the source target pod name for Backup dataSource PVC restores.
 	SourceTargetPodNameAnnotationKey = "dataprotection.kubeblocks.io/source-target-pod-name"
+	// BackupTargetNodesAnnotationKey records the comma-separated node names of the backup target pods,
+	// which is used to evaluate the per-node concurrency limit of the throttle policy.
+	BackupTargetNodesAnnotationKey = "dataprotection.kubeblocks.io/target-node-names"
+	// BackupRepoAdmittedBackupsAnnotationKey records the comma-separated keys of the backups admitted by the
+	// throttle policy in the backup repository, which reserves the concurrency slots before the backups run.
+	BackupRepoAdmittedBackupsAnnotationKey = "dataprotection.kubeblocks.io/admitted-backups"
+	// PodIngressBandwidthAnnotationKey and PodEgressBandwidthAnnotationKey limit the bandwidth of the pod in bits
+	// per second, which are enforced by the CNI bandwidth plugin.
+	PodIngressBandwidthAnnotationKey = "kubernetes.io/ingress-bandwidth"
+	PodEgressBandwidthAnnotationKey  = "kubernetes.io/egress-bandwidth"
+	// BackupPriorityAnnotationKey records the priority of the backup inherited from the backup policy,
+	// which is used to order the pending backups.
+	BackupPriorityAnnotationKey = "dataprotection.kubeblocks.io/backup-priority"
+	// RewrapDataKeyAnnotationKey requests to wrap the data key of the backup with the current KEK
+	// of the key management service, the annotation is removed once the data key is rewrapped.
+	RewrapDataKeyAnnotationKey = "dataprotection.kubeblocks.io/rewrap-data-key"
 	// VolumeRestorePolicyParameterKey records the volume restore policy for Backup dataSource PVC restores.
 	VolumeRestorePolicyParameterKey = "dataprotection.kubeblocks.io/volume-restore-policy"
 	// RestoreEnvParameterKey records restore env for Backup dataSource PVC restores.
@@ -102,6 +121,12 @@ const (
 	AutoBackupLabelKey = "dataprotection.kubeblocks.io/autobackup"
 	// BackupTargetPodLabelKey specifies the backup target pod label key.
 	BackupTargetPodLabelKey = "dataprotection.kubeblocks.io/target-pod-name"
+	// BackupVerificationLabelKey specifies the backup verification label key.
+	BackupVerificationLabelKey = "dataprotection.kubeblocks.io/backup-verification"
+	// BackupVerificationNamespaceLabelKey specifies the backup verification namespace label key.
+	BackupVerificationNamespaceLabelKey = "dataprotection.kubeblocks.io/backup-verification-namespace"
+	// BackupCatalogLabelKey specifies the label key of the ConfigMaps which carry the backup catalog manifests.
+	BackupCatalogLabelKey = "dataprotection.kubeblocks.io/backup-catalog"
 )
 
 // env names
@@ -130,6 +155,8 @@ const (
 	DPTargetRelativePath = "DP_TARGET_RELATIVE_PATH"
 	// DPBackupName backup CR name
 	DPBackupName = "DP_BACKUP_NAME"
+	// DPBackupNamespace backup CR namespace
+	DPBackupNamespace = "DP_BACKUP_NAMESPACE"
 	// DPParentBackupName backup CR name
 	DPParentBackupName = "DP_PARENT_BACKUP_NAME"
 	// DPBaseBackupName backup CR name
@@ -144,6 +171,9 @@ const (
 	DPCheckInterval = "DP_CHECK_INTERVAL"
 	// DPBackupInfoFile the file name which retains the backup.status info
 	DPBackupInfoFile = "DP_BACKUP_INFO_FILE"
+	// DPBackupCatalogFile the file name which retains the catalog manifest of the backup content,
+	// it should be written by the backup action before the backup info file
+	DPBackupCatalogFile = "DP_BACKUP_CATALOG_FILE"
 	// DPTimeFormat golang time format string
 	DPTimeFormat = "DP_TIME_FORMAT"
 	// DPTimeZone golang time zone string
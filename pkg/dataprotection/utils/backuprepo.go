@@ -21,8 +21,11 @@ package utils
 
 import (
 	"fmt"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
@@ -37,6 +40,8 @@ const (
 	defaultDatasafedImage    = "apecloud/datasafed:latest"
 	datasafedBinMountPath    = "/bin/datasafed"
 	datasafedConfigMountPath = "/etc/datasafed"
+
+	replicaDatasafedConfigMountPath = "/etc/datasafed-replica"
 )
 
 func InjectDatasafed(podSpec *corev1.PodSpec, repo *dpv1alpha1.BackupRepo, repoVolumeMountPath string,
@@ -49,6 +54,59 @@ func InjectDatasafed(podSpec *corev1.PodSpec, repo *dpv1alpha1.BackupRepo, repoV
 	injectEncryptionEnvs(podSpec, encryptionConfig)
 }
 
+// SetBandwidthAnnotations sets the bandwidth limits of the throttle policy to the annotations of the pod,
+// the upload bandwidth limits the egress traffic of the pod and the download bandwidth limits the ingress
+// traffic. The limits are enforced by the CNI bandwidth plugin, and they are ignored if the plugin is
+// not enabled in the cluster.
+func SetBandwidthAnnotations(objMeta *metav1.ObjectMeta, throttle *dpv1alpha1.ThrottlePolicy) {
+	if throttle == nil || (throttle.UploadBandwidth == nil && throttle.DownloadBandwidth == nil) {
+		return
+	}
+	if objMeta.Annotations == nil {
+		objMeta.Annotations = map[string]string{}
+	}
+	// the bandwidth of the throttle policy is in bytes per second, and the annotations are in bits per second.
+	if throttle.UploadBandwidth != nil {
+		objMeta.Annotations[dptypes.PodEgressBandwidthAnnotationKey] = strconv.FormatInt(throttle.UploadBandwidth.Value()*8, 10)
+	}
+	if throttle.DownloadBandwidth != nil {
+		objMeta.Annotations[dptypes.PodIngressBandwidthAnnotationKey] = strconv.FormatInt(throttle.DownloadBandwidth.Value()*8, 10)
+	}
+}
+
+// MergeThrottlePolicies merges the throttle policies, the smaller limit wins.
+// It returns nil if none of the policies is set.
+func MergeThrottlePolicies(policies ...*dpv1alpha1.ThrottlePolicy) *dpv1alpha1.ThrottlePolicy {
+	var merged *dpv1alpha1.ThrottlePolicy
+	minQuantity := func(a, b *resource.Quantity) *resource.Quantity {
+		if a == nil || (b != nil && b.Cmp(*a) < 0) {
+			return b
+		}
+		return a
+	}
+	minInt32 := func(a, b *int32) *int32 {
+		if a == nil || (b != nil && *b < *a) {
+			return b
+		}
+		return a
+	}
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		if merged == nil {
+			merged = policy.DeepCopy()
+			continue
+		}
+		merged.UploadBandwidth = minQuantity(merged.UploadBandwidth, policy.UploadBandwidth)
+		merged.DownloadBandwidth = minQuantity(merged.DownloadBandwidth, policy.DownloadBandwidth)
+		merged.MaxConcurrentBackups = minInt32(merged.MaxConcurrentBackups, policy.MaxConcurrentBackups)
+		merged.MaxConcurrentBackupsPerNode = minInt32(merged.MaxConcurrentBackupsPerNode, policy.MaxConcurrentBackupsPerNode)
+		merged.MaxConcurrentBackupsPerNamespace = minInt32(merged.MaxConcurrentBackupsPerNamespace, policy.MaxConcurrentBackupsPerNamespace)
+	}
+	return merged.DeepCopy()
+}
+
 func injectEncryptionEnvs(podSpec *corev1.PodSpec, encryptionConfig *dpv1alpha1.EncryptionConfig) {
 	if encryptionConfig == nil {
 		return
@@ -125,6 +183,56 @@ func InjectDatasafedWithConfig(podSpec *corev1.PodSpec, configSecretName string,
 	injectDatasafedInstaller(podSpec)
 }
 
+// InjectReplicaDatasafed mounts a secondary backup repository into the pod which has been injected
+// with datasafed by InjectDatasafed, and returns the command to run datasafed against the secondary
+// backup repository. An empty command is returned if the repository can not be accessed.
+func InjectReplicaDatasafed(podSpec *corev1.PodSpec, repo *dpv1alpha1.BackupRepo, repoVolumeMountPath string) string {
+	var (
+		volume      corev1.Volume
+		volumeMount corev1.VolumeMount
+		command     string
+	)
+	switch {
+	case repo.AccessByMount():
+		volumeName := "dp-replica-backup-data"
+		volume = corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: repo.Status.BackupPVCName,
+				},
+			},
+		}
+		volumeMount = corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: repoVolumeMountPath,
+		}
+		command = fmt.Sprintf("%s=%s datasafed", dptypes.DPDatasafedLocalBackendPath, repoVolumeMountPath)
+	case repo.AccessByTool():
+		volumeName := "dp-replica-datasafed-config"
+		volume = corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: repo.Status.ToolConfigSecretName,
+				},
+			},
+		}
+		volumeMount = corev1.VolumeMount{
+			Name:      volumeName,
+			ReadOnly:  true,
+			MountPath: replicaDatasafedConfigMountPath,
+		}
+		// the local backend path of the primary repository must not take effect.
+		command = fmt.Sprintf("env -u %s datasafed --conf %s/datasafed.conf",
+			dptypes.DPDatasafedLocalBackendPath, replicaDatasafedConfigMountPath)
+	default:
+		return ""
+	}
+	injectElements(podSpec, toSlice(volume), toSlice(volumeMount), nil)
+	return command
+}
+
 func injectDatasafedInstaller(podSpec *corev1.PodSpec) {
 	sharedVolumeName := "dp-datasafed-bin"
 	sharedVolume := corev1.Volume{
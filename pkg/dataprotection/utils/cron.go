@@ -0,0 +1,283 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxMissedScheduleTimes is the max number of the missed scheduled times to check, as the CronJob controller does.
+const maxMissedScheduleTimes = 100
+
+// CronSchedule is a schedule parsed from the standard cron expression, with the five fields of minute, hour,
+// day of month, month and day of week, the descriptors such as "@daily", and the "CRON_TZ=" or "TZ=" prefix.
+type CronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	location                      *time.Location
+}
+
+type cronField struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	cronMinute = cronField{min: 0, max: 59}
+	cronHour   = cronField{min: 0, max: 23}
+	cronDom    = cronField{min: 1, max: 31}
+	cronMonth  = cronField{min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	cronDow = cronField{min: 0, max: 6, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// starBit marks the field of day is specified with "*" or "?".
+const starBit = 1 << 63
+
+// ParseCronSchedule parses the standard cron expression, the schedule is in the local time zone of
+// the time passed to Next if no time zone is specified.
+func ParseCronSchedule(spec string) (*CronSchedule, error) {
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("empty cron expression")
+	}
+	sched := &CronSchedule{}
+	if strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=") {
+		i := strings.Index(spec, " ")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid cron expression %q", spec)
+		}
+		loc, err := time.LoadLocation(spec[strings.Index(spec, "=")+1 : i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone in cron expression %q: %s", spec, err.Error())
+		}
+		sched.location = loc
+		spec = strings.TrimSpace(spec[i:])
+	}
+	if strings.HasPrefix(spec, "@") {
+		expr, ok := cronDescriptors[spec]
+		if !ok {
+			return nil, fmt.Errorf("unrecognized descriptor %q", spec)
+		}
+		spec = expr
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected exactly 5 fields, found %d: %q", len(fields), spec)
+	}
+	var err error
+	for i, f := range []struct {
+		bits  *uint64
+		field cronField
+	}{
+		{&sched.minute, cronMinute},
+		{&sched.hour, cronHour},
+		{&sched.dom, cronDom},
+		{&sched.month, cronMonth},
+		{&sched.dow, cronDow},
+	} {
+		if *f.bits, err = parseCronField(fields[i], f.field); err != nil {
+			return nil, err
+		}
+	}
+	return sched, nil
+}
+
+// parseCronField parses a comma-separated list of ranges, e.g. "1-5/2,10,*/15".
+func parseCronField(field string, r cronField) (uint64, error) {
+	var bits uint64
+	for _, expr := range strings.Split(field, ",") {
+		b, err := parseCronRange(expr, r)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func parseCronRange(expr string, r cronField) (uint64, error) {
+	rangeAndStep := strings.Split(expr, "/")
+	lowAndHigh := strings.Split(rangeAndStep[0], "-")
+	if len(rangeAndStep) > 2 || len(lowAndHigh) > 2 {
+		return 0, fmt.Errorf("invalid cron field %q", expr)
+	}
+
+	var start, end, step uint = 0, 0, 1
+	var extra uint64
+	var err error
+	if lowAndHigh[0] == "*" || lowAndHigh[0] == "?" {
+		if len(lowAndHigh) > 1 {
+			return 0, fmt.Errorf("invalid cron field %q", expr)
+		}
+		start, end = r.min, r.max
+		extra = starBit
+	} else {
+		if start, err = parseCronValue(lowAndHigh[0], r); err != nil {
+			return 0, err
+		}
+		end = start
+		if len(lowAndHigh) == 2 {
+			if end, err = parseCronValue(lowAndHigh[1], r); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(rangeAndStep) == 2 {
+		n, err := strconv.ParseUint(rangeAndStep[1], 10, 32)
+		if err != nil || n == 0 {
+			return 0, fmt.Errorf("invalid step in cron field %q", expr)
+		}
+		step = uint(n)
+		extra = 0
+		if len(lowAndHigh) == 1 && lowAndHigh[0] != "*" && lowAndHigh[0] != "?" {
+			end = r.max // "N/step" means "N-max/step"
+		}
+	}
+	if start < r.min || end > r.max || start > end {
+		return 0, fmt.Errorf("cron field %q is out of the range [%d, %d]", expr, r.min, r.max)
+	}
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << i
+	}
+	return bits | extra, nil
+}
+
+func parseCronValue(s string, r cronField) (uint, error) {
+	if v, ok := r.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in cron field", s)
+	}
+	if r.max == cronDow.max && n == 7 {
+		n = 0 // both 0 and 7 are Sunday
+	}
+	return uint(n), nil
+}
+
+// Next returns the next scheduled time after the given time, the zero time if not found in 5 years.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	origLoc := t.Location()
+	loc := s.location
+	if loc == nil {
+		loc = origLoc
+	}
+	t = t.In(loc)
+
+	// start at the next whole minute
+	t = t.Add(time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	added := false
+	yearLimit := t.Year() + 5
+
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+	for 1<<uint(t.Month())&s.month == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto WRAP
+		}
+	}
+	for !s.dayMatches(t) {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 0, 1)
+		// the midnight may be skipped by the daylight saving time
+		if t.Hour() != 0 {
+			if t.Hour() > 12 {
+				t = t.Add(time.Duration(24-t.Hour()) * time.Hour)
+			} else {
+				t = t.Add(time.Duration(-t.Hour()) * time.Hour)
+			}
+		}
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+	for 1<<uint(t.Hour())&s.hour == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		}
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto WRAP
+		}
+	}
+	for 1<<uint(t.Minute())&s.minute == 0 {
+		added = true
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+	return t.In(origLoc)
+}
+
+// dayMatches returns whether the day of month and the day of week match the schedule, if both of them
+// are restricted, either of them matches is enough.
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	domMatch := 1<<uint(t.Day())&s.dom > 0
+	dowMatch := 1<<uint(t.Weekday())&s.dow > 0
+	if s.dom&starBit > 0 || s.dow&starBit > 0 {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// MostRecentScheduleTime returns the latest scheduled time in (earliest, now], nil if there is none.
+// It checks 100 missed scheduled times at most, as the CronJob controller does, and returns an error if more are missed.
+func MostRecentScheduleTime(sched *CronSchedule, earliest, now time.Time) (*time.Time, error) {
+	var recent *time.Time
+	missed := 0
+	for t := sched.Next(earliest); !t.IsZero() && !t.After(now); t = sched.Next(t) {
+		if missed++; missed > maxMissedScheduleTimes {
+			return nil, fmt.Errorf("too many missed start times (> %d)", maxMissedScheduleTimes)
+		}
+		scheduled := t
+		recent = &scheduled
+	}
+	return recent, nil
+}
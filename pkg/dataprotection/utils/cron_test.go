@@ -0,0 +1,87 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	tests := []struct {
+		spec string
+		from string
+		want string
+	}{
+		{spec: "*/15 * * * *", from: "2024-01-01T00:07:30Z", want: "2024-01-01T00:15:00Z"},
+		{spec: "0 18 * * *", from: "2024-01-01T18:00:00Z", want: "2024-01-02T18:00:00Z"},
+		{spec: "30 2 1 * *", from: "2024-01-31T00:00:00Z", want: "2024-02-01T02:30:00Z"},
+		{spec: "0 0 * * MON-FRI", from: "2024-01-05T12:00:00Z", want: "2024-01-08T00:00:00Z"},
+		{spec: "0 0 * * 7", from: "2024-01-01T00:00:00Z", want: "2024-01-07T00:00:00Z"},
+		// either the day of month or the day of week matches
+		{spec: "0 0 13 * 5", from: "2024-01-01T00:00:00Z", want: "2024-01-05T00:00:00Z"},
+		{spec: "0 0 29 2 *", from: "2024-03-01T00:00:00Z", want: "2028-02-29T00:00:00Z"},
+		{spec: "@monthly", from: "2024-01-15T00:00:00Z", want: "2024-02-01T00:00:00Z"},
+		{spec: "CRON_TZ=Asia/Shanghai 0 2 * * *", from: "2024-01-01T00:00:00Z", want: "2024-01-01T18:00:00Z"},
+		{spec: "TZ=Asia/Shanghai 0 2 * * *", from: "2024-01-01T18:00:00Z", want: "2024-01-02T18:00:00Z"},
+	}
+	for _, tt := range tests {
+		sched, err := ParseCronSchedule(tt.spec)
+		if err != nil {
+			t.Fatalf("ParseCronSchedule(%q) error = %v", tt.spec, err)
+		}
+		from, _ := time.Parse(time.RFC3339, tt.from)
+		want, _ := time.Parse(time.RFC3339, tt.want)
+		if got := sched.Next(from); !got.Equal(want) {
+			t.Errorf("Next(%q, %s) = %s, want %s", tt.spec, tt.from, got.In(shanghai), want.In(shanghai))
+		}
+	}
+}
+
+func TestParseCronScheduleError(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "@every 1h", "CRON_TZ=Mars/Base * * * * *", "a * * * *"} {
+		if _, err := ParseCronSchedule(spec); err == nil {
+			t.Errorf("ParseCronSchedule(%q) expects an error", spec)
+		}
+	}
+}
+
+func TestMostRecentScheduleTime(t *testing.T) {
+	sched, err := ParseCronSchedule("0 * * * *")
+	if err != nil {
+		t.Fatalf("ParseCronSchedule() error = %v", err)
+	}
+	earliest := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	recent, err := MostRecentScheduleTime(sched, earliest, earliest.Add(30*time.Minute))
+	if err != nil || recent != nil {
+		t.Fatalf("MostRecentScheduleTime() = %v, %v, want none", recent, err)
+	}
+	recent, err = MostRecentScheduleTime(sched, earliest, earliest.Add(5*time.Hour+time.Minute))
+	if err != nil || recent == nil || !recent.Equal(earliest.Add(5*time.Hour)) {
+		t.Fatalf("MostRecentScheduleTime() = %v, %v, want %s", recent, err, earliest.Add(5*time.Hour))
+	}
+	_, err = MostRecentScheduleTime(sched, earliest, earliest.Add(101*time.Hour))
+	if err == nil || !strings.Contains(err.Error(), "too many missed start times") {
+		t.Fatalf("MostRecentScheduleTime() error = %v, want too many missed start times", err)
+	}
+}
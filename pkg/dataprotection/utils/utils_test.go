@@ -32,6 +32,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
@@ -253,6 +254,30 @@ func TestDatasafedInjectionHelpers(t *testing.T) {
 	assert.True(t, strings.Contains(podSpec.InitContainers[0].Command[2], "/bin/datasafed"))
 }
 
+func TestBandwidthAnnotations(t *testing.T) {
+	assert.Nil(t, MergeThrottlePolicies(nil, nil))
+
+	upload, download := resource.MustParse("50Mi"), resource.MustParse("100Mi")
+	smallerUpload := resource.MustParse("10Mi")
+	throttle := MergeThrottlePolicies(
+		&dpv1alpha1.ThrottlePolicy{UploadBandwidth: &upload, DownloadBandwidth: &download, MaxConcurrentBackups: pointer.Int32(3)},
+		nil,
+		&dpv1alpha1.ThrottlePolicy{UploadBandwidth: &smallerUpload, MaxConcurrentBackups: pointer.Int32(5), MaxConcurrentBackupsPerNode: pointer.Int32(1)},
+	)
+	assert.Equal(t, "10Mi", throttle.UploadBandwidth.String())
+	assert.Equal(t, "100Mi", throttle.DownloadBandwidth.String())
+	assert.Equal(t, int32(3), *throttle.MaxConcurrentBackups)
+	assert.Equal(t, int32(1), *throttle.MaxConcurrentBackupsPerNode)
+	assert.Nil(t, throttle.MaxConcurrentBackupsPerNamespace)
+
+	objMeta := &metav1.ObjectMeta{}
+	SetBandwidthAnnotations(objMeta, nil)
+	assert.Empty(t, objMeta.Annotations)
+	SetBandwidthAnnotations(objMeta, throttle)
+	assert.Equal(t, "83886080", objMeta.Annotations[dptypes.PodEgressBandwidthAnnotationKey])
+	assert.Equal(t, "838860800", objMeta.Annotations[dptypes.PodIngressBandwidthAnnotationKey])
+}
+
 func TestValidationAndComparisonHelpers(t *testing.T) {
 	schema := &apiextensionsv1.JSONSchemaProps{
 		Type: "object",
@@ -38,6 +38,22 @@ type Client interface {
 	Action(ctx context.Context, req proto.ActionRequest) (proto.ActionResponse, error)
 }
 
+// EventClient is a Client which can subscribe to the probe and task events of the kb-agent.
+// The Watch methods call the handler for each event received, and return when the ctx is done,
+// the stream is broken, or the handler returns an error.
+type EventClient interface {
+	Client
+	WatchProbeEvents(ctx context.Context, req proto.ProbeEventsRequest, handler func(proto.ProbeEvent) error) error
+	WatchTaskEvents(ctx context.Context, req proto.TaskEventsRequest, handler func(proto.TaskEvent) error) error
+}
+
+// TaskClient is a Client which can submit tasks to the kb-agent to run in the background, and query the
+// latest events of them. The output of the response is the latest TaskEvent of the task.
+type TaskClient interface {
+	Client
+	Task(ctx context.Context, req proto.TaskRequest) (proto.ActionResponse, error)
+}
+
 // HACK: for unit test only.
 var mockClient Client
 var mockClientError error
@@ -57,6 +73,12 @@ func GetMockClient() Client {
 }
 
 func NewClient(endpoint func() (string, int32, error)) (Client, error) {
+	return NewClientWithCredentials(endpoint, nil, "")
+}
+
+// NewClientWithCredentials returns a HTTP client which presents the credentials to the kb-agent,
+// the serverName is used to verify the certificate of the kb-agent with the mTLS auth mode.
+func NewClientWithCredentials(endpoint func() (string, int32, error), creds *proto.Credentials, serverName string) (Client, error) {
 	if mockClient != nil || mockClientError != nil {
 		return mockClient, mockClientError
 	}
@@ -69,6 +91,11 @@ func NewClient(endpoint func() (string, int32, error)) (Client, error) {
 		return nil, nil
 	}
 
+	tlsConfig, err := creds.ClientTLSConfig(serverName)
+	if err != nil {
+		return nil, err
+	}
+
 	// don't use default http-client
 	dialer := &net.Dialer{
 		Timeout: defaultConnectTimeout,
@@ -76,15 +103,22 @@ func NewClient(endpoint func() (string, int32, error)) (Client, error) {
 	transport := &http.Transport{
 		Dial:                dialer.Dial,
 		TLSHandshakeTimeout: defaultConnectTimeout,
+		TLSClientConfig:     tlsConfig,
 	}
 	cli := &http.Client{
 		// don't set timeout at client level
 		// Timeout:   time.Second * 30,
 		Transport: transport,
 	}
+	scheme := "http"
+	if tlsConfig != nil {
+		scheme = "https"
+	}
 	return &httpClient{
-		host:   host,
-		port:   port,
-		client: cli,
+		scheme:        scheme,
+		host:          host,
+		port:          port,
+		authorization: creds.Authorization(),
+		client:        cli,
 	}, nil
 }
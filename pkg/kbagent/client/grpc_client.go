@@ -0,0 +1,152 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+	kbagentv1 "github.com/apecloud/kubeblocks/pkg/kbagent/proto/v1"
+)
+
+type grpcClient struct {
+	conn   *grpc.ClientConn
+	client kbagentv1.KBAgentClient
+}
+
+var _ EventClient = &grpcClient{}
+
+// NewGRPCClient returns a client which calls the kb-agent through the gRPC transport, and presents the credentials
+// to the kb-agent. The serverName is used to verify the certificate of the kb-agent with the mTLS auth mode.
+func NewGRPCClient(endpoint func() (string, int32, error), creds *proto.Credentials, serverName string) (EventClient, error) {
+	host, port, err := endpoint()
+	if err != nil {
+		return nil, err
+	}
+	if host == "" && port == 0 {
+		return nil, nil
+	}
+
+	tlsConfig, err := creds.ClientTLSConfig(serverName)
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           backoff.DefaultConfig,
+			MinConnectTimeout: defaultConnectTimeout,
+		}),
+	}
+	if tlsConfig != nil {
+		opts[0] = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+	if authorization := creds.Authorization(); len(authorization) > 0 {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{authorization: authorization, secure: tlsConfig != nil}))
+	}
+	conn, err := grpc.NewClient(net.JoinHostPort(host, strconv.Itoa(int(port))), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcClient{
+		conn:   conn,
+		client: kbagentv1.NewKBAgentClient(conn),
+	}, nil
+}
+
+func (c *grpcClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *grpcClient) Action(ctx context.Context, req proto.ActionRequest) (proto.ActionResponse, error) {
+	dryRun, ok := ctx.Value(constant.DryRunContextKey).(bool)
+	if ok && dryRun {
+		return proto.ActionResponse{}, nil
+	}
+
+	rsp, err := c.client.Action(ctx, proto.ActionRequestToPB(&req))
+	if err != nil {
+		return proto.ActionResponse{}, err
+	}
+	return proto.ActionResponseFromPB(rsp), nil
+}
+
+func (c *grpcClient) WatchProbeEvents(ctx context.Context, req proto.ProbeEventsRequest, handler func(proto.ProbeEvent) error) error {
+	stream, err := c.client.WatchProbeEvents(ctx, proto.ProbeEventsRequestToPB(&req))
+	if err != nil {
+		return err
+	}
+	return recvEvents(ctx, stream, func(event *kbagentv1.ProbeEvent) error {
+		return handler(proto.ProbeEventFromPB(event))
+	})
+}
+
+func (c *grpcClient) WatchTaskEvents(ctx context.Context, req proto.TaskEventsRequest, handler func(proto.TaskEvent) error) error {
+	stream, err := c.client.WatchTaskEvents(ctx, proto.TaskEventsRequestToPB(&req))
+	if err != nil {
+		return err
+	}
+	return recvEvents(ctx, stream, func(event *kbagentv1.TaskEvent) error {
+		return handler(proto.TaskEventFromPB(event))
+	})
+}
+
+// tokenCredentials presents the bearer token to the kb-agent in the metadata of each call.
+type tokenCredentials struct {
+	authorization string
+	secure        bool
+}
+
+func (c tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{strings.ToLower(proto.AuthorizationHeader): c.authorization}, nil
+}
+
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+func recvEvents[T any](ctx context.Context, stream grpc.ServerStreamingClient[T], handler func(*T) error) error {
+	for {
+		event, err := stream.Recv()
+		switch {
+		case errors.Is(err, io.EOF):
+			return errors.New("the event stream is closed by the kb-agent")
+		case err != nil:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if err = handler(event); err != nil {
+			return err
+		}
+	}
+}
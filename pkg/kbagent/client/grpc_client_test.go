@@ -0,0 +1,153 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+	kbagentv1 "github.com/apecloud/kubeblocks/pkg/kbagent/proto/v1"
+)
+
+type fakeKBAgentServer struct {
+	kbagentv1.UnimplementedKBAgentServer
+	probeEvents []proto.ProbeEvent
+	taskEvents  []proto.TaskEvent
+}
+
+func (s *fakeKBAgentServer) Action(_ context.Context, req *kbagentv1.ActionRequest) (*kbagentv1.ActionResponse, error) {
+	return &kbagentv1.ActionResponse{Output: []byte(req.GetAction())}, nil
+}
+
+func (s *fakeKBAgentServer) WatchProbeEvents(_ *kbagentv1.ProbeEventsRequest, stream grpc.ServerStreamingServer[kbagentv1.ProbeEvent]) error {
+	for i := range s.probeEvents {
+		if err := stream.Send(proto.ProbeEventToPB(&s.probeEvents[i])); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func (s *fakeKBAgentServer) WatchTaskEvents(_ *kbagentv1.TaskEventsRequest, stream grpc.ServerStreamingServer[kbagentv1.TaskEvent]) error {
+	for i := range s.taskEvents {
+		if err := stream.Send(proto.TaskEventToPB(&s.taskEvents[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newGRPCClientForTest(t *testing.T, srv kbagentv1.KBAgentServer) EventClient {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := grpc.NewServer()
+	kbagentv1.RegisterKBAgentServer(s, srv)
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	t.Cleanup(s.Stop)
+
+	addr := listener.Addr().(*net.TCPAddr)
+	cli, err := NewGRPCClient(func() (string, int32, error) {
+		return addr.IP.String(), int32(addr.Port), nil
+	}, nil, "")
+	if err != nil {
+		t.Fatalf("NewGRPCClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = cli.Close() })
+	return cli
+}
+
+func TestNewGRPCClientEndpoint(t *testing.T) {
+	endpointErr := errors.New("endpoint")
+	if _, err := NewGRPCClient(func() (string, int32, error) { return "", 0, endpointErr }, nil, ""); !errors.Is(err, endpointErr) {
+		t.Fatalf("NewGRPCClient() error = %v, want %v", err, endpointErr)
+	}
+	cli, err := NewGRPCClient(func() (string, int32, error) { return "", 0, nil }, nil, "")
+	if cli != nil || err != nil {
+		t.Fatalf("NewGRPCClient() = %v, %v, want nil client", cli, err)
+	}
+}
+
+func TestGRPCClientAction(t *testing.T) {
+	cli := newGRPCClientForTest(t, &fakeKBAgentServer{})
+
+	rsp, err := cli.Action(context.Background(), proto.ActionRequest{Action: "roleProbe"})
+	if err != nil || string(rsp.Output) != "roleProbe" {
+		t.Fatalf("Action() = %#v, %v", rsp, err)
+	}
+
+	ctx := context.WithValue(context.Background(), constant.DryRunContextKey, true)
+	rsp, err = cli.Action(ctx, proto.ActionRequest{Action: "roleProbe"})
+	if err != nil || len(rsp.Output) != 0 {
+		t.Fatalf("Action() with dry-run = %#v, %v", rsp, err)
+	}
+}
+
+func TestGRPCClientWatchEvents(t *testing.T) {
+	cli := newGRPCClientForTest(t, &fakeKBAgentServer{
+		probeEvents: []proto.ProbeEvent{{Probe: "roleProbe"}, {Probe: "availableProbe"}},
+		taskEvents:  []proto.TaskEvent{{UID: "1"}},
+	})
+
+	// the handler stops the watching
+	stop := errors.New("stop")
+	var probes []string
+	err := cli.WatchProbeEvents(context.Background(), proto.ProbeEventsRequest{}, func(event proto.ProbeEvent) error {
+		probes = append(probes, event.Probe)
+		if len(probes) == 2 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || len(probes) != 2 {
+		t.Fatalf("WatchProbeEvents() = %v, %v", probes, err)
+	}
+
+	// the ctx stops the watching
+	ctx, cancel := context.WithCancel(context.Background())
+	err = cli.WatchProbeEvents(ctx, proto.ProbeEventsRequest{}, func(proto.ProbeEvent) error {
+		cancel()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WatchProbeEvents() with ctx canceled error = %v", err)
+	}
+
+	// the stream is closed by the server
+	var uids []string
+	err = cli.WatchTaskEvents(context.Background(), proto.TaskEventsRequest{}, func(event proto.TaskEvent) error {
+		uids = append(uids, event.UID)
+		return nil
+	})
+	if err == nil || len(uids) != 1 {
+		t.Fatalf("WatchTaskEvents() = %v, %v", uids, err)
+	}
+}
@@ -32,16 +32,18 @@ import (
 )
 
 const (
-	urlTemplate = "http://%s:%d%s"
+	urlTemplate = "%s://%s:%d%s"
 )
 
 type httpClient struct {
-	host   string
-	port   int32
-	client *http.Client
+	scheme        string
+	host          string
+	port          int32
+	authorization string
+	client        *http.Client
 }
 
-var _ Client = &httpClient{}
+var _ TaskClient = &httpClient{}
 
 func (c *httpClient) Close() error {
 	c.client.CloseIdleConnections()
@@ -56,12 +58,22 @@ func (c *httpClient) Action(ctx context.Context, req proto.ActionRequest) (proto
 		return rsp, nil
 	}
 
+	return c.post(ctx, proto.ServiceAction.URI, req)
+}
+
+func (c *httpClient) Task(ctx context.Context, req proto.TaskRequest) (proto.ActionResponse, error) {
+	return c.post(ctx, proto.ServiceTask.URI, req)
+}
+
+func (c *httpClient) post(ctx context.Context, uri string, req any) (proto.ActionResponse, error) {
+	rsp := proto.ActionResponse{}
+
 	data, err := json.Marshal(req)
 	if err != nil {
 		return rsp, err
 	}
 
-	url := fmt.Sprintf(urlTemplate, c.host, c.port, proto.ServiceAction.URI)
+	url := fmt.Sprintf(urlTemplate, c.scheme, c.host, c.port, uri)
 	payload, err := c.request(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return rsp, err
@@ -76,6 +88,9 @@ func (c *httpClient) request(ctx context.Context, method, url string, body io.Re
 	if err != nil {
 		return nil, err
 	}
+	if len(c.authorization) > 0 {
+		req.Header.Set(proto.AuthorizationHeader, c.authorization)
+	}
 
 	rsp, err := c.client.Do(req)
 	if err != nil {
@@ -83,7 +98,7 @@ func (c *httpClient) request(ctx context.Context, method, url string, body io.Re
 	}
 
 	switch rsp.StatusCode {
-	case http.StatusOK, http.StatusInternalServerError:
+	case http.StatusOK, http.StatusInternalServerError, http.StatusUnauthorized:
 		return rsp.Body, nil
 	default:
 		return nil, fmt.Errorf("unexpected http status code: %s", rsp.Status)
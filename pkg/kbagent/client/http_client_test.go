@@ -57,7 +57,7 @@ func newHTTPClientForTest(t *testing.T, handler http.HandlerFunc) (*httpClient,
 	if _, err := fmt.Sscan(portString, &port); err != nil {
 		t.Fatalf("parse port: %v", err)
 	}
-	return &httpClient{host: host, port: port, client: server.Client()}, server.Close
+	return &httpClient{scheme: "http", host: host, port: port, client: server.Client()}, server.Close
 }
 
 func TestHTTPClientAction(t *testing.T) {
@@ -91,6 +91,59 @@ func TestHTTPClientAction(t *testing.T) {
 	}
 }
 
+func TestHTTPClientTask(t *testing.T) {
+	cli, closeServer := newHTTPClientForTest(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != proto.ServiceTask.URI || r.Method != http.MethodPost {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		request := &proto.TaskRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			t.Fatalf("decode Task request: %v", err)
+		}
+		if request.UID != "u1" || request.Task == nil || request.Task.NewReplica == nil {
+			t.Fatalf("unexpected Task request: %#v", request)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"output":"eyJVSUQiOiJ1MSJ9"}`))
+	})
+	defer closeServer()
+
+	resp, err := cli.Task(context.Background(), proto.TaskRequest{
+		UID:  "u1",
+		Task: &proto.Task{UID: "u1", NewReplica: &proto.NewReplicaTask{}},
+	})
+	if err != nil {
+		t.Fatalf("Task() error = %v", err)
+	}
+	if string(resp.Output) != `{"UID":"u1"}` {
+		t.Fatalf("unexpected response: %#v", resp)
+	}
+}
+
+func TestHTTPClientAuthorization(t *testing.T) {
+	cli, closeServer := newHTTPClientForTest(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(proto.AuthorizationHeader) != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"message":"done"}`))
+	})
+	defer closeServer()
+
+	resp, err := cli.Action(context.Background(), proto.ActionRequest{Action: "backup"})
+	if err != nil || !errors.Is(proto.Type2Error(resp.Error), proto.ErrUnauthorized) {
+		t.Fatalf("Action() without token = %#v, %v", resp, err)
+	}
+
+	cli.authorization = (&proto.Credentials{Mode: proto.AuthModeToken, Token: "secret"}).Authorization()
+	resp, err = cli.Action(context.Background(), proto.ActionRequest{Action: "backup"})
+	if err != nil || resp.Message != "done" {
+		t.Fatalf("Action() with token = %#v, %v", resp, err)
+	}
+}
+
 func TestHTTPClientRequestAndDecodeErrors(t *testing.T) {
 	cli, closeServer := newHTTPClientForTest(t, func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Query().Get("case") {
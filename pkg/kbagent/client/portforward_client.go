@@ -39,10 +39,12 @@ import (
 )
 
 type portForwardClient struct {
-	pod    *corev1.Pod
-	port   string
-	config *rest.Config
-	logger logr.Logger
+	pod        *corev1.Pod
+	port       string
+	config     *rest.Config
+	logger     logr.Logger
+	creds      *proto.Credentials
+	serverName string
 }
 
 var _ Client = &portForwardClient{}
@@ -106,7 +108,7 @@ func (pf *portForwardClient) Action(ctx context.Context, req proto.ActionRequest
 	endpoint := func() (string, int32, error) {
 		return "localhost", int32(ports[0].Local), nil
 	}
-	client, err := NewClient(endpoint)
+	client, err := NewClientWithCredentials(endpoint, pf.creds, pf.serverName)
 	if err != nil {
 		return emptyResp, err
 	}
@@ -150,6 +152,13 @@ func (pf *portForwardClient) newPortForwarder(readyCh, stopCh chan struct{}, out
 }
 
 func NewPortForwardClient(pod *corev1.Pod, endpoint func() (string, int32, error)) (Client, error) {
+	return NewPortForwardClientWithCredentials(pod, endpoint, nil, "")
+}
+
+// NewPortForwardClientWithCredentials returns a port-forward client which presents the credentials to the kb-agent,
+// the serverName is used to verify the certificate of the kb-agent with the mTLS auth mode.
+func NewPortForwardClientWithCredentials(pod *corev1.Pod, endpoint func() (string, int32, error),
+	creds *proto.Credentials, serverName string) (Client, error) {
 	if mockClient != nil || mockClientError != nil {
 		return mockClient, mockClientError
 	}
@@ -161,9 +170,11 @@ func NewPortForwardClient(pod *corev1.Pod, endpoint func() (string, int32, error
 
 	config := ctrl.GetConfigOrDie()
 	return &portForwardClient{
-		pod:    pod,
-		port:   fmt.Sprint(port),
-		config: config,
-		logger: ctrl.Log.WithName("portforward"),
+		pod:        pod,
+		port:       fmt.Sprint(port),
+		config:     config,
+		logger:     ctrl.Log.WithName("portforward"),
+		creds:      creds,
+		serverName: serverName,
 	}, nil
 }
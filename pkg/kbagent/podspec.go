@@ -21,8 +21,14 @@ package kbagent
 
 import (
 	"slices"
+	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/server"
 )
 
 const (
@@ -31,6 +37,15 @@ const (
 	SharedMountPath  = "/kubeblocks"
 	SharedBinaryPath = SharedMountPath + "/kbagent"
 	SharedVolumeName = "kubeblocks"
+
+	AuthVolumeName = "kbagent-auth"
+	AuthMountPath  = "/etc/kbagent/auth"
+
+	authModeArg = "--auth-mode"
+	authDirArg  = "--auth-dir"
+
+	GRPCPortArg   = "--grpc-port"
+	transportsArg = "--transports"
 )
 
 // InitCommand returns the current init-kbagent copy command.
@@ -75,3 +90,60 @@ func UsesSharedBinary(containers []corev1.Container) bool {
 func SharedVolumeMount() corev1.VolumeMount {
 	return corev1.VolumeMount{Name: SharedVolumeName, MountPath: SharedMountPath}
 }
+
+// AuthSecretName returns the name of the secret holding the credentials of the kbagent of a component.
+func AuthSecretName(clusterName, compName string) string {
+	return clusterName + "-" + compName + "-kbagent-auth"
+}
+
+// AuthArgs returns the args to run the kbagent with the auth mode and the mounted credentials.
+func AuthArgs(mode proto.AuthMode) []string {
+	return []string{authModeArg, string(mode), authDirArg, AuthMountPath}
+}
+
+// GRPCArgs returns the args to serve the gRPC transport on the port, alongside the HTTP transport.
+func GRPCArgs(port int) []string {
+	return []string{GRPCPortArg, strconv.Itoa(port), transportsArg, server.TransportHTTP + "," + server.TransportGRPC}
+}
+
+// AuthVolume returns the volume of the secret holding the kbagent credentials.
+func AuthVolume(secretName string) corev1.Volume {
+	return corev1.Volume{
+		Name: AuthVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  secretName,
+				Optional:    ptr.To(false),
+				DefaultMode: ptr.To(int32(0400)),
+			},
+		},
+	}
+}
+
+// AuthVolumeMount returns the volume mount of the kbagent credentials.
+func AuthVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{Name: AuthVolumeName, MountPath: AuthMountPath, ReadOnly: true}
+}
+
+// AuthModeOf returns the auth mode the kbagent server container of the pod spec runs with.
+func AuthModeOf(podSpec *corev1.PodSpec) proto.AuthMode {
+	if podSpec == nil {
+		return proto.AuthModeNone
+	}
+	index := slices.IndexFunc(podSpec.Containers, func(container corev1.Container) bool {
+		return container.Name == ContainerName
+	})
+	if index < 0 {
+		return proto.AuthModeNone
+	}
+	args := podSpec.Containers[index].Args
+	for i, arg := range args {
+		if arg == authModeArg && i+1 < len(args) {
+			return proto.AuthMode(args[i+1])
+		}
+		if mode, ok := strings.CutPrefix(arg, authModeArg+"="); ok {
+			return proto.AuthMode(mode)
+		}
+	}
+	return proto.AuthModeNone
+}
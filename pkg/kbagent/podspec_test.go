@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
 )
 
 func TestInitCopyCommandContract(t *testing.T) {
@@ -67,3 +69,28 @@ func TestPodSpecPredicates(t *testing.T) {
 		t.Fatalf("unexpected shared volume mount: %#v", mount)
 	}
 }
+
+func TestAuthModeOf(t *testing.T) {
+	podSpec := &corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Name: ContainerName,
+			Args: append([]string{"--port", "3501"}, AuthArgs(proto.AuthModeMTLS)...),
+		}},
+	}
+	if mode := AuthModeOf(podSpec); mode != proto.AuthModeMTLS {
+		t.Fatalf("AuthModeOf() = %s, want %s", mode, proto.AuthModeMTLS)
+	}
+
+	podSpec.Containers[0].Args = []string{"--auth-mode=token"}
+	if mode := AuthModeOf(podSpec); mode != proto.AuthModeToken {
+		t.Fatalf("AuthModeOf() = %s, want %s", mode, proto.AuthModeToken)
+	}
+
+	podSpec.Containers[0].Args = nil
+	if mode := AuthModeOf(podSpec); mode != proto.AuthModeNone {
+		t.Fatalf("AuthModeOf() without args = %s, want %s", mode, proto.AuthModeNone)
+	}
+	if mode := AuthModeOf(nil); mode != proto.AuthModeNone {
+		t.Fatalf("AuthModeOf(nil) = %s, want %s", mode, proto.AuthModeNone)
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package proto
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AuthMode is the mode the kb-agent authenticates the clients of the action and streaming services.
+type AuthMode string
+
+const (
+	AuthModeNone  AuthMode = "none"
+	AuthModeToken AuthMode = "token"
+	AuthModeMTLS  AuthMode = "mtls"
+)
+
+// The keys of the credential files, which are the keys of the secret mounted to the kb-agent as well.
+const (
+	AuthTokenKey = "token"
+	AuthCAKey    = "ca.crt"
+	AuthCertKey  = "tls.crt"
+	AuthKeyKey   = "tls.key"
+)
+
+const (
+	AuthorizationHeader = "Authorization"
+	BearerTokenPrefix   = "Bearer "
+)
+
+// Credentials are used by the kb-agent to authenticate the clients, and presented by the clients to the kb-agent.
+// With the mTLS mode, the server and the clients present the certificates signed by the same CA to each other.
+type Credentials struct {
+	Mode  AuthMode
+	Token string
+	CA    []byte
+	Cert  []byte
+	Key   []byte
+}
+
+// NewCredentials builds the credentials of the mode from the data keyed by the Auth*Key.
+func NewCredentials(mode AuthMode, data map[string][]byte) (*Credentials, error) {
+	creds := &Credentials{Mode: mode}
+	switch mode {
+	case "", AuthModeNone:
+		creds.Mode = AuthModeNone
+	case AuthModeToken:
+		creds.Token = strings.TrimSpace(string(data[AuthTokenKey]))
+		if len(creds.Token) == 0 {
+			return nil, fmt.Errorf("the %s is required for the %s auth mode", AuthTokenKey, mode)
+		}
+	case AuthModeMTLS:
+		creds.CA, creds.Cert, creds.Key = data[AuthCAKey], data[AuthCertKey], data[AuthKeyKey]
+		if len(creds.CA) == 0 || len(creds.Cert) == 0 || len(creds.Key) == 0 {
+			return nil, fmt.Errorf("the %s, %s and %s are required for the %s auth mode", AuthCAKey, AuthCertKey, AuthKeyKey, mode)
+		}
+	default:
+		return nil, fmt.Errorf("unknown auth mode %s", mode)
+	}
+	return creds, nil
+}
+
+// LoadCredentials loads the credentials of the mode from the files in the dir.
+func LoadCredentials(mode AuthMode, dir string) (*Credentials, error) {
+	data := make(map[string][]byte)
+	if mode != "" && mode != AuthModeNone {
+		for _, key := range []string{AuthTokenKey, AuthCAKey, AuthCertKey, AuthKeyKey} {
+			content, err := os.ReadFile(filepath.Join(dir, key))
+			if err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+			data[key] = content
+		}
+	}
+	return NewCredentials(mode, data)
+}
+
+// Enabled returns whether the clients are required to be authenticated.
+func (c *Credentials) Enabled() bool {
+	return c != nil && c.Mode != "" && c.Mode != AuthModeNone
+}
+
+// VerifyToken verifies the bearer token presented by a client, it always succeeds if the mode is not token.
+func (c *Credentials) VerifyToken(authorization string) error {
+	if !c.Enabled() || c.Mode != AuthModeToken {
+		return nil
+	}
+	token, ok := strings.CutPrefix(authorization, BearerTokenPrefix)
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(c.Token)) != 1 {
+		return fmt.Errorf("%w: invalid or missing bearer token", ErrUnauthorized)
+	}
+	return nil
+}
+
+// Authorization returns the value of the authorization header presented by a client, empty if the mode is not token.
+func (c *Credentials) Authorization() string {
+	if !c.Enabled() || c.Mode != AuthModeToken {
+		return ""
+	}
+	return BearerTokenPrefix + c.Token
+}
+
+// ServerTLSConfig returns the TLS config which requires and verifies the client certificates,
+// it returns nil if the mode is not mTLS.
+func (c *Credentials) ServerTLSConfig() (*tls.Config, error) {
+	if !c.Enabled() || c.Mode != AuthModeMTLS {
+		return nil, nil
+	}
+	cert, pool, err := c.certAndPool()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ClientTLSConfig returns the TLS config which presents the client certificate and verifies the server
+// with the server name, it returns nil if the mode is not mTLS.
+func (c *Credentials) ClientTLSConfig(serverName string) (*tls.Config, error) {
+	if !c.Enabled() || c.Mode != AuthModeMTLS {
+		return nil, nil
+	}
+	cert, pool, err := c.certAndPool()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   serverName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (c *Credentials) certAndPool() (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.X509KeyPair(c.Cert, c.Key)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(c.CA) {
+		return tls.Certificate{}, nil, fmt.Errorf("no valid CA certificate found")
+	}
+	return cert, pool, nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package proto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewCredentials(t *testing.T) {
+	creds, err := NewCredentials("", nil)
+	if err != nil || creds.Enabled() {
+		t.Fatalf("NewCredentials(none) = %#v, %v", creds, err)
+	}
+	if _, err = NewCredentials(AuthModeToken, map[string][]byte{}); err == nil {
+		t.Fatalf("expected missing token error")
+	}
+	if _, err = NewCredentials(AuthModeMTLS, map[string][]byte{AuthCAKey: []byte("ca")}); err == nil {
+		t.Fatalf("expected missing certificate error")
+	}
+	if _, err = NewCredentials("basic", nil); err == nil {
+		t.Fatalf("expected unknown auth mode error")
+	}
+}
+
+func TestCredentialsToken(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, AuthTokenKey), []byte("secret\n"), 0600); err != nil {
+		t.Fatalf("write token: %v", err)
+	}
+	creds, err := LoadCredentials(AuthModeToken, dir)
+	if err != nil {
+		t.Fatalf("LoadCredentials() error = %v", err)
+	}
+	if creds.Authorization() != "Bearer secret" {
+		t.Fatalf("Authorization() = %q", creds.Authorization())
+	}
+	if err = creds.VerifyToken(creds.Authorization()); err != nil {
+		t.Fatalf("VerifyToken() error = %v", err)
+	}
+	for _, authorization := range []string{"", "secret", "Bearer other"} {
+		if err = creds.VerifyToken(authorization); !errors.Is(err, ErrUnauthorized) {
+			t.Fatalf("VerifyToken(%q) error = %v, want unauthorized", authorization, err)
+		}
+	}
+
+	var none *Credentials
+	if none.Enabled() || none.VerifyToken("") != nil || none.Authorization() != "" {
+		t.Fatalf("nil credentials should not authenticate")
+	}
+	if config, err := creds.ServerTLSConfig(); config != nil || err != nil {
+		t.Fatalf("ServerTLSConfig() with token = %v, %v", config, err)
+	}
+}
+
+func TestCredentialsMTLS(t *testing.T) {
+	creds, err := NewCredentials(AuthModeMTLS, testCertificates(t))
+	if err != nil {
+		t.Fatalf("NewCredentials() error = %v", err)
+	}
+	if err = creds.VerifyToken(""); err != nil {
+		t.Fatalf("VerifyToken() with mTLS error = %v", err)
+	}
+
+	serverConfig, err := creds.ServerTLSConfig()
+	if err != nil {
+		t.Fatalf("ServerTLSConfig() error = %v", err)
+	}
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	clientConfig, err := creds.ClientTLSConfig("localhost")
+	if err != nil {
+		t.Fatalf("ClientTLSConfig() error = %v", err)
+	}
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+	if err != nil {
+		t.Fatalf("dial with the client certificate: %v", err)
+	}
+	_ = conn.Close()
+
+	// the server rejects the clients without a certificate
+	conn, err = tls.Dial("tcp", listener.Addr().String(), &tls.Config{RootCAs: clientConfig.RootCAs, ServerName: "localhost"})
+	if err == nil {
+		_, err = conn.Read(make([]byte, 1))
+		_ = conn.Close()
+	}
+	if err == nil {
+		t.Fatalf("expected the connection without a client certificate to be rejected")
+	}
+}
+
+// testCertificates generates a CA and a certificate signed by it for localhost.
+func testCertificates(t *testing.T) map[string][]byte {
+	t.Helper()
+	newKey := func() *ecdsa.PrivateKey {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("generate key: %v", err)
+		}
+		return key
+	}
+	encode := func(blockType string, der []byte) []byte {
+		return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	}
+
+	caKey := newKey()
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("create CA: %v", err)
+	}
+
+	key := newKey()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test peer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	return map[string][]byte{
+		AuthCAKey:   encode("CERTIFICATE", caDER),
+		AuthCertKey: encode("CERTIFICATE", der),
+		AuthKeyKey:  encode("EC PRIVATE KEY", keyDER),
+	}
+}
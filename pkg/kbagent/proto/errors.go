@@ -33,6 +33,7 @@ var (
 	ErrTimedOut           = errors.New("timedOut")
 	ErrFailed             = errors.New("failed")
 	ErrInternalError      = errors.New("internalError")
+	ErrUnauthorized       = errors.New("unauthorized")
 	ErrUnknown            = errors.New("unknown")
 )
 
@@ -58,6 +59,8 @@ func Error2Type(err error) string {
 		return "failed"
 	case errors.Is(err, ErrInternalError):
 		return "internalError"
+	case errors.Is(err, ErrUnauthorized):
+		return "unauthorized"
 	default:
 		return "unknown"
 	}
@@ -85,6 +88,8 @@ func Type2Error(errType string) error {
 		return ErrFailed
 	case "internalError":
 		return ErrInternalError
+	case "unauthorized":
+		return ErrUnauthorized
 	default:
 		return ErrUnknown
 	}
@@ -40,6 +40,7 @@ func TestError2Type(t *testing.T) {
 		{name: "timed out", err: ErrTimedOut, want: "timedOut"},
 		{name: "failed", err: ErrFailed, want: "failed"},
 		{name: "internal error", err: ErrInternalError, want: "internalError"},
+		{name: "unauthorized", err: ErrUnauthorized, want: "unauthorized"},
 		{name: "wrapped", err: errors.Join(ErrBusy), want: "busy"},
 		{name: "unknown", err: errors.New("other"), want: "unknown"},
 	}
@@ -67,6 +68,7 @@ func TestType2Error(t *testing.T) {
 		{errType: "timedOut", want: ErrTimedOut},
 		{errType: "failed", want: ErrFailed},
 		{errType: "internalError", want: ErrInternalError},
+		{errType: "unauthorized", want: ErrUnauthorized},
 		{errType: "unknown-type", want: ErrUnknown},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,167 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package proto
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	kbagentv1 "github.com/apecloud/kubeblocks/pkg/kbagent/proto/v1"
+)
+
+// The gRPC API of kb-agent is defined in v1/kbagent.proto, the functions below convert the messages
+// between the gRPC API and the types shared by the HTTP API and the services.
+
+func ActionRequestToPB(req *ActionRequest) *kbagentv1.ActionRequest {
+	pb := &kbagentv1.ActionRequest{
+		Action:         req.Action,
+		Parameters:     req.Parameters,
+		TimeoutSeconds: req.TimeoutSeconds,
+		Rerun:          req.Rerun,
+	}
+	for _, args := range req.Arguments {
+		pb.Arguments = append(pb.Arguments, &kbagentv1.Arguments{Values: args})
+	}
+	if req.RetryPolicy != nil {
+		pb.RetryPolicy = &kbagentv1.RetryPolicy{
+			MaxRetries:    int32(req.RetryPolicy.MaxRetries),
+			RetryInterval: durationpb.New(req.RetryPolicy.RetryInterval),
+		}
+	}
+	return pb
+}
+
+func ActionRequestFromPB(pb *kbagentv1.ActionRequest) ActionRequest {
+	req := ActionRequest{
+		Action:         pb.GetAction(),
+		Parameters:     pb.GetParameters(),
+		TimeoutSeconds: pb.TimeoutSeconds,
+		Rerun:          pb.GetRerun(),
+	}
+	for _, args := range pb.GetArguments() {
+		req.Arguments = append(req.Arguments, args.GetValues())
+	}
+	if pb.GetRetryPolicy() != nil {
+		req.RetryPolicy = &RetryPolicy{
+			MaxRetries:    int(pb.GetRetryPolicy().GetMaxRetries()),
+			RetryInterval: pb.GetRetryPolicy().GetRetryInterval().AsDuration(),
+		}
+	}
+	return req
+}
+
+func ActionResponseToPB(rsp *ActionResponse) *kbagentv1.ActionResponse {
+	return &kbagentv1.ActionResponse{
+		Error:   rsp.Error,
+		Message: rsp.Message,
+		Output:  rsp.Output,
+	}
+}
+
+func ActionResponseFromPB(pb *kbagentv1.ActionResponse) ActionResponse {
+	return ActionResponse{
+		Error:   pb.GetError(),
+		Message: pb.GetMessage(),
+		Output:  pb.GetOutput(),
+	}
+}
+
+func ProbeEventsRequestToPB(req *ProbeEventsRequest) *kbagentv1.ProbeEventsRequest {
+	return &kbagentv1.ProbeEventsRequest{Probes: req.Probes}
+}
+
+func ProbeEventsRequestFromPB(pb *kbagentv1.ProbeEventsRequest) ProbeEventsRequest {
+	return ProbeEventsRequest{Probes: pb.GetProbes()}
+}
+
+func ProbeEventToPB(event *ProbeEvent) *kbagentv1.ProbeEvent {
+	return &kbagentv1.ProbeEvent{
+		Instance: event.Instance,
+		Probe:    event.Probe,
+		Code:     event.Code,
+		Output:   event.Output,
+		Message:  event.Message,
+	}
+}
+
+func ProbeEventFromPB(pb *kbagentv1.ProbeEvent) ProbeEvent {
+	return ProbeEvent{
+		Instance: pb.GetInstance(),
+		Probe:    pb.GetProbe(),
+		Code:     pb.GetCode(),
+		Output:   pb.GetOutput(),
+		Message:  pb.GetMessage(),
+	}
+}
+
+func TaskEventsRequestToPB(req *TaskEventsRequest) *kbagentv1.TaskEventsRequest {
+	return &kbagentv1.TaskEventsRequest{Tasks: req.Tasks}
+}
+
+func TaskEventsRequestFromPB(pb *kbagentv1.TaskEventsRequest) TaskEventsRequest {
+	return TaskEventsRequest{Tasks: pb.GetTasks()}
+}
+
+func TaskEventToPB(event *TaskEvent) *kbagentv1.TaskEvent {
+	return &kbagentv1.TaskEvent{
+		Instance:  event.Instance,
+		Task:      event.Task,
+		Uid:       event.UID,
+		Replica:   event.Replica,
+		StartTime: timestampToPB(event.StartTime),
+		EndTime:   timestampToPB(event.EndTime),
+		Code:      event.Code,
+		Output:    event.Output,
+		Message:   event.Message,
+		Progress:  event.Progress,
+	}
+}
+
+func TaskEventFromPB(pb *kbagentv1.TaskEvent) TaskEvent {
+	return TaskEvent{
+		Instance:  pb.GetInstance(),
+		Task:      pb.GetTask(),
+		UID:       pb.GetUid(),
+		Replica:   pb.GetReplica(),
+		StartTime: timestampFromPB(pb.GetStartTime()),
+		EndTime:   timestampFromPB(pb.GetEndTime()),
+		Code:      pb.GetCode(),
+		Output:    pb.GetOutput(),
+		Message:   pb.GetMessage(),
+		Progress:  pb.GetProgress(),
+	}
+}
+
+// timestampToPB leaves the zero time unset, e.g. the end time of a running task.
+func timestampToPB(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timestampFromPB(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
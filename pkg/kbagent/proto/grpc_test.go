@@ -0,0 +1,68 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package proto
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestActionRequestPB(t *testing.T) {
+	req := ActionRequest{
+		Action:         "reconfigure",
+		Parameters:     map[string]string{"a": "1"},
+		Arguments:      [][]string{{"x", "y"}, {"z"}},
+		TimeoutSeconds: ptr.To[int32](10),
+		RetryPolicy:    &RetryPolicy{MaxRetries: 3, RetryInterval: 5 * time.Second},
+		Rerun:          true,
+	}
+	if got := ActionRequestFromPB(ActionRequestToPB(&req)); !reflect.DeepEqual(got, req) {
+		t.Fatalf("ActionRequestFromPB() = %#v, want %#v", got, req)
+	}
+
+	req = ActionRequest{Action: "roleProbe"}
+	if got := ActionRequestFromPB(ActionRequestToPB(&req)); !reflect.DeepEqual(got, req) {
+		t.Fatalf("ActionRequestFromPB() = %#v, want %#v", got, req)
+	}
+}
+
+func TestTaskEventPB(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	event := TaskEvent{
+		Instance:  "mysql-0",
+		Task:      "newReplica",
+		UID:       "1",
+		Replica:   "mysql-1",
+		StartTime: start,
+		Code:      0,
+		Output:    []byte("ok"),
+		Progress:  "10%",
+	}
+	pb := TaskEventToPB(&event)
+	if pb.GetEndTime() != nil {
+		t.Fatalf("TaskEventToPB() end time = %v, want unset", pb.GetEndTime())
+	}
+	if got := TaskEventFromPB(pb); !reflect.DeepEqual(got, event) {
+		t.Fatalf("TaskEventFromPB() = %#v, want %#v", got, event)
+	}
+}
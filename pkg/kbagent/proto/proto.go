@@ -24,13 +24,14 @@ import (
 )
 
 type Action struct {
-	Name           string       `json:"name"`
-	Exec           *ExecAction  `json:"exec,omitempty"`
-	HTTP           *HTTPAction  `json:"http,omitempty"`
-	GRPC           *GRPCAction  `json:"grpc,omitempty"`
-	NonBlocking    bool         `json:"nonBlocking,omitempty"`
-	TimeoutSeconds int32        `json:"timeoutSeconds,omitempty"`
-	RetryPolicy    *RetryPolicy `json:"retryPolicy,omitempty"`
+	Name           string             `json:"name"`
+	Exec           *ExecAction        `json:"exec,omitempty"`
+	HTTP           *HTTPAction        `json:"http,omitempty"`
+	GRPC           *GRPCAction        `json:"grpc,omitempty"`
+	VolumeStats    *VolumeStatsAction `json:"volumeStats,omitempty"`
+	NonBlocking    bool               `json:"nonBlocking,omitempty"`
+	TimeoutSeconds int32              `json:"timeoutSeconds,omitempty"`
+	RetryPolicy    *RetryPolicy       `json:"retryPolicy,omitempty"`
 }
 
 type ExecAction struct {
@@ -66,6 +67,19 @@ type GRPCResponse struct {
 	Message string `json:"message,omitempty"`
 }
 
+// VolumeStatsAction is a built-in action that reports the filesystem stats of the volumes mounted.
+type VolumeStatsAction struct {
+	Volumes map[string]string `json:"volumes"` // volume name -> mount path
+}
+
+// VolumeStats is the output of the VolumeStatsAction for a volume.
+// The utilization is reported in whole percentages to keep the output stable between probes.
+type VolumeStats struct {
+	Name          string `json:"name"`
+	CapacityBytes uint64 `json:"capacityBytes"`
+	Utilization   int32  `json:"utilization"` // percentage of the space used, rounded up
+}
+
 type RetryPolicy struct {
 	MaxRetries    int           `json:"maxRetries,omitempty"`
 	RetryInterval time.Duration `json:"retryInterval,omitempty"`
@@ -82,6 +96,13 @@ type ActionRequest struct {
 	Rerun bool `json:"rerun,omitempty"`
 }
 
+// StreamingHandshake is the first packet sent by the client of a streaming connection.
+type StreamingHandshake struct {
+	ActionRequest
+	// Authorization is the bearer token presented by the client if the kb-agent enables the token auth mode.
+	Authorization string `json:"authorization,omitempty"`
+}
+
 type ActionResponse struct {
 	Error   string `json:"error,omitempty"`
 	Message string `json:"message,omitempty"`
@@ -118,6 +139,11 @@ type ProbeEvent struct {
 	Message  string `json:"message,omitempty"` // message of the probe on failure
 }
 
+// ProbeEventsRequest subscribes to the probe events of an agent.
+type ProbeEventsRequest struct {
+	Probes []string `json:"probes,omitempty"` // the probes to watch, all probes if empty
+}
+
 type Task struct {
 	Instance            string          `json:"instance"`
 	Task                string          `json:"task"`
@@ -136,8 +162,21 @@ type TaskEvent struct {
 	StartTime time.Time `json:"startTime"`
 	EndTime   time.Time `json:"endTime"`
 	Code      int32     `json:"code"`
-	Output    []byte    `json:"output,omitempty"`  // output of the task on success
-	Message   string    `json:"message,omitempty"` // message of the task on failure
+	Output    []byte    `json:"output,omitempty"`   // output of the task on success
+	Message   string    `json:"message,omitempty"`  // message of the task on failure
+	Progress  string    `json:"progress,omitempty"` // progress of the task before it is finished, e.g. the amount of data loaded
+}
+
+// TaskEventsRequest subscribes to the task events of an agent.
+type TaskEventsRequest struct {
+	Tasks []string `json:"tasks,omitempty"` // the UIDs of the tasks to watch, all tasks if empty
+}
+
+// TaskRequest submits a task to run in the background, or queries the latest event of a task submitted before.
+// The response is an ActionResponse, whose output is the latest TaskEvent of the task.
+type TaskRequest struct {
+	UID  string `json:"UID"`
+	Task *Task  `json:"task,omitempty"` // the task to submit, query the task of the UID only if it is nil
 }
 
 type NewReplicaTask struct {
@@ -145,5 +184,6 @@ type NewReplicaTask struct {
 	Port           int32             `json:"port"`
 	Replicas       string            `json:"replicas"`                 // replicas to load the data
 	Parameters     map[string]string `json:"parameters,omitempty"`     // parameters for data dump and load
+	Authorization  string            `json:"authorization,omitempty"`  // the authorization presented to the remote, use the local credentials if empty
 	TimeoutSeconds *int32            `json:"timeoutSeconds,omitempty"` // TODO: not implemented
 }
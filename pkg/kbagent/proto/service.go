@@ -41,4 +41,9 @@ var (
 		Version: "v1.0",
 		URI:     "/v1.0/streaming",
 	}
+	ServiceTask = &Service{
+		Kind:    "Task",
+		Version: "v1.0",
+		URI:     "/v1.0/task",
+	}
 )
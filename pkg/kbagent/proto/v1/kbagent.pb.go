@@ -0,0 +1,701 @@
+//
+//Copyright (C) 2022-2026 ApeCloud Co., Ltd
+//
+//This file is part of KubeBlocks project
+//
+//This program is free software: you can redistribute it and/or modify
+//it under the terms of the GNU Affero General Public License as published by
+//the Free Software Foundation, either version 3 of the License, or
+//(at your option) any later version.
+//
+//This program is distributed in the hope that it will be useful
+//but WITHOUT ANY WARRANTY; without even the implied warranty of
+//MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+//GNU Affero General Public License for more details.
+//
+//You should have received a copy of the GNU Affero General Public License
+//along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: kbagent.proto
+
+package kbagentv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RetryPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxRetries    int32                  `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	RetryInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=retry_interval,json=retryInterval,proto3" json:"retry_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_kbagent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_kbagent_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_kbagent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RetryPolicy) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RetryInterval
+	}
+	return nil
+}
+
+type Arguments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Arguments) Reset() {
+	*x = Arguments{}
+	mi := &file_kbagent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Arguments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Arguments) ProtoMessage() {}
+
+func (x *Arguments) ProtoReflect() protoreflect.Message {
+	mi := &file_kbagent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Arguments.ProtoReflect.Descriptor instead.
+func (*Arguments) Descriptor() ([]byte, []int) {
+	return file_kbagent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Arguments) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ActionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Action         string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Parameters     map[string]string      `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Arguments      []*Arguments           `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	TimeoutSeconds *int32                 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	RetryPolicy    *RetryPolicy           `protobuf:"bytes,5,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// rerun requests a new run instead of returning the previous terminal result.
+	Rerun         bool `protobuf:"varint,6,opt,name=rerun,proto3" json:"rerun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionRequest) Reset() {
+	*x = ActionRequest{}
+	mi := &file_kbagent_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionRequest) ProtoMessage() {}
+
+func (x *ActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kbagent_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionRequest.ProtoReflect.Descriptor instead.
+func (*ActionRequest) Descriptor() ([]byte, []int) {
+	return file_kbagent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActionRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ActionRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ActionRequest) GetArguments() []*Arguments {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *ActionRequest) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ActionRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *ActionRequest) GetRerun() bool {
+	if x != nil {
+		return x.Rerun
+	}
+	return false
+}
+
+type ActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Output        []byte                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionResponse) Reset() {
+	*x = ActionResponse{}
+	mi := &file_kbagent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionResponse) ProtoMessage() {}
+
+func (x *ActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kbagent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionResponse.ProtoReflect.Descriptor instead.
+func (*ActionResponse) Descriptor() ([]byte, []int) {
+	return file_kbagent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ActionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ActionResponse) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// ProbeEventsRequest subscribes to the probe events of an agent.
+type ProbeEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the probes to watch, all probes if empty
+	Probes        []string `protobuf:"bytes,1,rep,name=probes,proto3" json:"probes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProbeEventsRequest) Reset() {
+	*x = ProbeEventsRequest{}
+	mi := &file_kbagent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeEventsRequest) ProtoMessage() {}
+
+func (x *ProbeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kbagent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeEventsRequest.ProtoReflect.Descriptor instead.
+func (*ProbeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_kbagent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProbeEventsRequest) GetProbes() []string {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+type ProbeEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Instance string                 `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Probe    string                 `protobuf:"bytes,2,opt,name=probe,proto3" json:"probe,omitempty"`
+	Code     int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	// output of the probe on success, or latest succeed output on failure
+	Output []byte `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	// message of the probe on failure
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProbeEvent) Reset() {
+	*x = ProbeEvent{}
+	mi := &file_kbagent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeEvent) ProtoMessage() {}
+
+func (x *ProbeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kbagent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeEvent.ProtoReflect.Descriptor instead.
+func (*ProbeEvent) Descriptor() ([]byte, []int) {
+	return file_kbagent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProbeEvent) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *ProbeEvent) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
+func (x *ProbeEvent) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ProbeEvent) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *ProbeEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TaskEventsRequest subscribes to the task events of an agent.
+type TaskEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the UIDs of the tasks to watch, all tasks if empty
+	Tasks         []string `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEventsRequest) Reset() {
+	*x = TaskEventsRequest{}
+	mi := &file_kbagent_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEventsRequest) ProtoMessage() {}
+
+func (x *TaskEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kbagent_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEventsRequest.ProtoReflect.Descriptor instead.
+func (*TaskEventsRequest) Descriptor() ([]byte, []int) {
+	return file_kbagent_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TaskEventsRequest) GetTasks() []string {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type TaskEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Instance  string                 `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Task      string                 `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Uid       string                 `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	Replica   string                 `protobuf:"bytes,4,opt,name=replica,proto3" json:"replica,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Code      int32                  `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	// output of the task on success
+	Output []byte `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	// message of the task on failure
+	Message string `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	// progress of the task before it is finished, e.g. the amount of data loaded
+	Progress      string `protobuf:"bytes,10,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_kbagent_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEvent) ProtoMessage() {}
+
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kbagent_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_kbagent_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TaskEvent) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetReplica() string {
+	if x != nil {
+		return x.Replica
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetProgress() string {
+	if x != nil {
+		return x.Progress
+	}
+	return ""
+}
+
+var File_kbagent_proto protoreflect.FileDescriptor
+
+const file_kbagent_proto_rawDesc = "" +
+	"\n" +
+	"\rkbagent.proto\x12\n" +
+	"kbagent.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"p\n" +
+	"\vRetryPolicy\x12\x1f\n" +
+	"\vmax_retries\x18\x01 \x01(\x05R\n" +
+	"maxRetries\x12@\n" +
+	"\x0eretry_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rretryInterval\"#\n" +
+	"\tArguments\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xfa\x02\n" +
+	"\rActionRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12I\n" +
+	"\n" +
+	"parameters\x18\x02 \x03(\v2).kbagent.v1.ActionRequest.ParametersEntryR\n" +
+	"parameters\x123\n" +
+	"\targuments\x18\x03 \x03(\v2\x15.kbagent.v1.ArgumentsR\targuments\x12,\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05H\x00R\x0etimeoutSeconds\x88\x01\x01\x12:\n" +
+	"\fretry_policy\x18\x05 \x01(\v2\x17.kbagent.v1.RetryPolicyR\vretryPolicy\x12\x14\n" +
+	"\x05rerun\x18\x06 \x01(\bR\x05rerun\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x12\n" +
+	"\x10_timeout_seconds\"X\n" +
+	"\x0eActionResponse\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06output\x18\x03 \x01(\fR\x06output\",\n" +
+	"\x12ProbeEventsRequest\x12\x16\n" +
+	"\x06probes\x18\x01 \x03(\tR\x06probes\"\x84\x01\n" +
+	"\n" +
+	"ProbeEvent\x12\x1a\n" +
+	"\binstance\x18\x01 \x01(\tR\binstance\x12\x14\n" +
+	"\x05probe\x18\x02 \x01(\tR\x05probe\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x16\n" +
+	"\x06output\x18\x04 \x01(\fR\x06output\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\")\n" +
+	"\x11TaskEventsRequest\x12\x14\n" +
+	"\x05tasks\x18\x01 \x03(\tR\x05tasks\"\xbb\x02\n" +
+	"\tTaskEvent\x12\x1a\n" +
+	"\binstance\x18\x01 \x01(\tR\binstance\x12\x12\n" +
+	"\x04task\x18\x02 \x01(\tR\x04task\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\tR\x03uid\x12\x18\n" +
+	"\areplica\x18\x04 \x01(\tR\areplica\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x12\n" +
+	"\x04code\x18\a \x01(\x05R\x04code\x12\x16\n" +
+	"\x06output\x18\b \x01(\fR\x06output\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x12\x1a\n" +
+	"\bprogress\x18\n" +
+	" \x01(\tR\bprogress2\xe3\x01\n" +
+	"\aKBAgent\x12?\n" +
+	"\x06Action\x12\x19.kbagent.v1.ActionRequest\x1a\x1a.kbagent.v1.ActionResponse\x12L\n" +
+	"\x10WatchProbeEvents\x12\x1e.kbagent.v1.ProbeEventsRequest\x1a\x16.kbagent.v1.ProbeEvent0\x01\x12I\n" +
+	"\x0fWatchTaskEvents\x12\x1d.kbagent.v1.TaskEventsRequest\x1a\x15.kbagent.v1.TaskEvent0\x01B?Z=github.com/apecloud/kubeblocks/pkg/kbagent/proto/v1;kbagentv1b\x06proto3"
+
+var (
+	file_kbagent_proto_rawDescOnce sync.Once
+	file_kbagent_proto_rawDescData []byte
+)
+
+func file_kbagent_proto_rawDescGZIP() []byte {
+	file_kbagent_proto_rawDescOnce.Do(func() {
+		file_kbagent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_kbagent_proto_rawDesc), len(file_kbagent_proto_rawDesc)))
+	})
+	return file_kbagent_proto_rawDescData
+}
+
+var file_kbagent_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_kbagent_proto_goTypes = []any{
+	(*RetryPolicy)(nil),           // 0: kbagent.v1.RetryPolicy
+	(*Arguments)(nil),             // 1: kbagent.v1.Arguments
+	(*ActionRequest)(nil),         // 2: kbagent.v1.ActionRequest
+	(*ActionResponse)(nil),        // 3: kbagent.v1.ActionResponse
+	(*ProbeEventsRequest)(nil),    // 4: kbagent.v1.ProbeEventsRequest
+	(*ProbeEvent)(nil),            // 5: kbagent.v1.ProbeEvent
+	(*TaskEventsRequest)(nil),     // 6: kbagent.v1.TaskEventsRequest
+	(*TaskEvent)(nil),             // 7: kbagent.v1.TaskEvent
+	nil,                           // 8: kbagent.v1.ActionRequest.ParametersEntry
+	(*durationpb.Duration)(nil),   // 9: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+}
+var file_kbagent_proto_depIdxs = []int32{
+	9,  // 0: kbagent.v1.RetryPolicy.retry_interval:type_name -> google.protobuf.Duration
+	8,  // 1: kbagent.v1.ActionRequest.parameters:type_name -> kbagent.v1.ActionRequest.ParametersEntry
+	1,  // 2: kbagent.v1.ActionRequest.arguments:type_name -> kbagent.v1.Arguments
+	0,  // 3: kbagent.v1.ActionRequest.retry_policy:type_name -> kbagent.v1.RetryPolicy
+	10, // 4: kbagent.v1.TaskEvent.start_time:type_name -> google.protobuf.Timestamp
+	10, // 5: kbagent.v1.TaskEvent.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 6: kbagent.v1.KBAgent.Action:input_type -> kbagent.v1.ActionRequest
+	4,  // 7: kbagent.v1.KBAgent.WatchProbeEvents:input_type -> kbagent.v1.ProbeEventsRequest
+	6,  // 8: kbagent.v1.KBAgent.WatchTaskEvents:input_type -> kbagent.v1.TaskEventsRequest
+	3,  // 9: kbagent.v1.KBAgent.Action:output_type -> kbagent.v1.ActionResponse
+	5,  // 10: kbagent.v1.KBAgent.WatchProbeEvents:output_type -> kbagent.v1.ProbeEvent
+	7,  // 11: kbagent.v1.KBAgent.WatchTaskEvents:output_type -> kbagent.v1.TaskEvent
+	9,  // [9:12] is the sub-list for method output_type
+	6,  // [6:9] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_kbagent_proto_init() }
+func file_kbagent_proto_init() {
+	if File_kbagent_proto != nil {
+		return
+	}
+	file_kbagent_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kbagent_proto_rawDesc), len(file_kbagent_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kbagent_proto_goTypes,
+		DependencyIndexes: file_kbagent_proto_depIdxs,
+		MessageInfos:      file_kbagent_proto_msgTypes,
+	}.Build()
+	File_kbagent_proto = out.File
+	file_kbagent_proto_goTypes = nil
+	file_kbagent_proto_depIdxs = nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+	kbagentv1 "github.com/apecloud/kubeblocks/pkg/kbagent/proto/v1"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/service"
+)
+
+type grpcServer struct {
+	kbagentv1.UnimplementedKBAgentServer
+
+	logger   logr.Logger
+	config   Config
+	services []service.Service
+	server   *grpc.Server
+
+	subscribeProbeEvents func(ctx context.Context) <-chan proto.ProbeEvent
+	subscribeTaskEvents  func(ctx context.Context) <-chan proto.TaskEvent
+}
+
+var _ Server = &grpcServer{}
+var _ kbagentv1.KBAgentServer = &grpcServer{}
+
+// StartNonBlocking starts a new server in a goroutine.
+func (s *grpcServer) StartNonBlocking() error {
+	s.logger.Info("starting the gRPC server")
+
+	var (
+		listener net.Listener
+		err      error
+	)
+	if s.config.UnixDomainSocket != "" {
+		listener, err = net.Listen("unix", fmt.Sprintf("%s/kbagent-grpc.socket", s.config.UnixDomainSocket))
+	} else {
+		listener, err = net.Listen("tcp", fmt.Sprintf("%s:%v", s.config.Address, s.config.GRPCPort))
+	}
+	if err != nil {
+		s.logger.Error(err, "listen gRPC server error", "address", s.config.Address, "port", s.config.GRPCPort)
+		return err
+	}
+
+	tlsConfig, err := s.config.Credentials.ServerTLSConfig()
+	if err != nil {
+		return err
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.logging, s.authenticateUnary),
+		grpc.ChainStreamInterceptor(s.authenticateStream),
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	if s.config.Concurrency > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(uint32(s.config.Concurrency)))
+	}
+	s.server = grpc.NewServer(opts...)
+	kbagentv1.RegisterKBAgentServer(s.server, s)
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			panic(err)
+		}
+	}()
+	return nil
+}
+
+func (s *grpcServer) Close() error {
+	if s.server != nil {
+		s.server.GracefulStop()
+	}
+	return nil
+}
+
+func (s *grpcServer) Action(ctx context.Context, req *kbagentv1.ActionRequest) (*kbagentv1.ActionResponse, error) {
+	svc := s.service(proto.ServiceAction.Kind)
+	if svc == nil {
+		return nil, status.Error(codes.Unimplemented, "has no action service defined")
+	}
+	payload, err := json.Marshal(proto.ActionRequestFromPB(req))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	output, err := svc.HandleRequest(ctx, payload)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	rsp := &proto.ActionResponse{}
+	if err = json.Unmarshal(output, rsp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return proto.ActionResponseToPB(rsp), nil
+}
+
+func (s *grpcServer) WatchProbeEvents(req *kbagentv1.ProbeEventsRequest, stream grpc.ServerStreamingServer[kbagentv1.ProbeEvent]) error {
+	events := s.subscribeProbeEvents(stream.Context())
+	for event := range events {
+		if len(req.GetProbes()) > 0 && !slices.Contains(req.GetProbes(), event.Probe) {
+			continue
+		}
+		if err := stream.Send(proto.ProbeEventToPB(&event)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *grpcServer) WatchTaskEvents(req *kbagentv1.TaskEventsRequest, stream grpc.ServerStreamingServer[kbagentv1.TaskEvent]) error {
+	events := s.subscribeTaskEvents(stream.Context())
+	for event := range events {
+		if len(req.GetTasks()) > 0 && !slices.Contains(req.GetTasks(), event.UID) {
+			continue
+		}
+		if err := stream.Send(proto.TaskEventToPB(&event)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *grpcServer) service(kind string) service.Service {
+	for i := range s.services {
+		if s.services[i].Kind() == kind {
+			return s.services[i]
+		}
+	}
+	return nil
+}
+
+func (s *grpcServer) authenticate(ctx context.Context) error {
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(proto.AuthorizationHeader); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+	if err := s.config.Credentials.VerifyToken(authorization); err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return nil
+}
+
+func (s *grpcServer) authenticateUnary(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *grpcServer) authenticateStream(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.authenticate(stream.Context()); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+func (s *grpcServer) logging(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	rsp, err := handler(ctx, req)
+	if s.config.Logging {
+		s.logger.Info("gRPC API Called",
+			"method", info.FullMethod,
+			"status code", status.Code(err).String(),
+			"cost", time.Since(start).Milliseconds(),
+		)
+	}
+	return rsp, err
+}
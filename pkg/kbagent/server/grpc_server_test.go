@@ -0,0 +1,157 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"k8s.io/klog/v2/ktesting"
+
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+	kbagentv1 "github.com/apecloud/kubeblocks/pkg/kbagent/proto/v1"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/service"
+)
+
+func newGRPCClientForTest(t *testing.T, srv *grpcServer) kbagentv1.KBAgentClient {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	kbagentv1.RegisterKBAgentServer(s, srv)
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return kbagentv1.NewKBAgentClient(conn)
+}
+
+func TestNewGRPCServer(t *testing.T) {
+	logger := ktesting.NewLogger(t, ktesting.NewConfig())
+	srv := NewGRPCServer(logger, Config{GRPCPort: 3503}, nil)
+	if _, ok := srv.(*grpcServer); !ok {
+		t.Fatalf("NewGRPCServer() returned %T, want *grpcServer", srv)
+	}
+}
+
+func TestGRPCServerStartNonBlockingAndClose(t *testing.T) {
+	logger := ktesting.NewLogger(t, ktesting.NewConfig())
+	srv := &grpcServer{
+		logger: logger,
+		config: Config{Address: "256.256.256.256", GRPCPort: 3503},
+	}
+	if err := srv.StartNonBlocking(); err == nil {
+		t.Fatalf("expected listen error")
+	}
+
+	srv = &grpcServer{
+		logger: logger,
+		config: Config{Address: "127.0.0.1", GRPCPort: 0, Concurrency: 8},
+	}
+	if err := srv.StartNonBlocking(); err != nil {
+		t.Fatalf("StartNonBlocking() error = %v", err)
+	}
+	if err := srv.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+}
+
+func TestGRPCServerAction(t *testing.T) {
+	logger := ktesting.NewLogger(t, ktesting.NewConfig())
+	cli := newGRPCClientForTest(t, &grpcServer{
+		logger: logger,
+		config: Config{Logging: true},
+		services: []service.Service{&serverFakeService{
+			kind:   proto.ServiceAction.Kind,
+			uri:    proto.ServiceAction.URI,
+			output: []byte(`{"error":"failed","message":"exit code 1","output":"b2s="}`),
+		}},
+	})
+
+	rsp, err := cli.Action(context.Background(), &kbagentv1.ActionRequest{Action: "roleProbe"})
+	if err != nil {
+		t.Fatalf("Action() error = %v", err)
+	}
+	if rsp.Error != "failed" || rsp.Message != "exit code 1" || string(rsp.Output) != "ok" {
+		t.Fatalf("Action() response = %#v", rsp)
+	}
+
+	cli = newGRPCClientForTest(t, &grpcServer{logger: logger})
+	if _, err = cli.Action(context.Background(), &kbagentv1.ActionRequest{Action: "roleProbe"}); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("Action() without action service error = %v", err)
+	}
+}
+
+func TestGRPCServerWatchEvents(t *testing.T) {
+	logger := ktesting.NewLogger(t, ktesting.NewConfig())
+	probeEvents := make(chan proto.ProbeEvent, 2)
+	probeEvents <- proto.ProbeEvent{Probe: "availableProbe"}
+	probeEvents <- proto.ProbeEvent{Probe: "roleProbe", Output: []byte("primary")}
+	close(probeEvents)
+	taskEvents := make(chan proto.TaskEvent, 2)
+	taskEvents <- proto.TaskEvent{Task: "newReplica", UID: "1"}
+	taskEvents <- proto.TaskEvent{Task: "newReplica", UID: "2"}
+	close(taskEvents)
+
+	cli := newGRPCClientForTest(t, &grpcServer{
+		logger: logger,
+		subscribeProbeEvents: func(context.Context) <-chan proto.ProbeEvent {
+			return probeEvents
+		},
+		subscribeTaskEvents: func(context.Context) <-chan proto.TaskEvent {
+			return taskEvents
+		},
+	})
+
+	probeStream, err := cli.WatchProbeEvents(context.Background(), &kbagentv1.ProbeEventsRequest{Probes: []string{"roleProbe"}})
+	if err != nil {
+		t.Fatalf("WatchProbeEvents() error = %v", err)
+	}
+	probeEvent, err := probeStream.Recv()
+	if err != nil || probeEvent.Probe != "roleProbe" || string(probeEvent.Output) != "primary" {
+		t.Fatalf("WatchProbeEvents() event = %#v, error = %v", probeEvent, err)
+	}
+
+	taskStream, err := cli.WatchTaskEvents(context.Background(), &kbagentv1.TaskEventsRequest{})
+	if err != nil {
+		t.Fatalf("WatchTaskEvents() error = %v", err)
+	}
+	for _, uid := range []string{"1", "2"} {
+		taskEvent, err := taskStream.Recv()
+		if err != nil || taskEvent.Uid != uid {
+			t.Fatalf("WatchTaskEvents() event = %#v, error = %v", taskEvent, err)
+		}
+	}
+}
@@ -21,6 +21,8 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -30,6 +32,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/valyala/fasthttp"
 
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
 	"github.com/apecloud/kubeblocks/pkg/kbagent/service"
 )
 
@@ -62,10 +65,17 @@ func (s *httpServer) StartNonBlocking() error {
 		}
 		listeners = append(listeners, l)
 	} else {
+		tlsConfig, err := s.config.Credentials.ServerTLSConfig()
+		if err != nil {
+			return err
+		}
 		l, err := net.Listen("tcp", fmt.Sprintf("%s:%v", s.config.Address, s.config.Port))
 		if err != nil {
 			s.logger.Error(err, "listen HTTP server error", "address", s.config.Address, "port", s.config.Port)
 		} else {
+			if tlsConfig != nil {
+				l = tls.NewListener(l, tlsConfig)
+			}
 			listeners = append(listeners, l)
 		}
 	}
@@ -127,13 +137,21 @@ func (s *httpServer) registerService(router *fasthttprouter.Router, svc service.
 
 func (s *httpServer) dispatcher(svc service.Service) func(*fasthttp.RequestCtx) {
 	return func(reqCtx *fasthttp.RequestCtx) {
-		ctx := context.Background()
+		ctx := service.WithCredentials(context.Background(), s.config.Credentials)
 		body := reqCtx.PostBody()
 
-		output, err := svc.HandleRequest(ctx, body)
+		var output []byte
 		statusCode := fasthttp.StatusOK
+		err := s.config.Credentials.VerifyToken(string(reqCtx.Request.Header.Peek(proto.AuthorizationHeader)))
 		if err != nil {
-			statusCode = fasthttp.StatusInternalServerError
+			statusCode = fasthttp.StatusUnauthorized
+			output, _ = json.Marshal(&proto.ActionResponse{Error: proto.Error2Type(err), Message: err.Error()})
+			err = nil
+		} else {
+			output, err = svc.HandleRequest(ctx, body)
+			if err != nil {
+				statusCode = fasthttp.StatusInternalServerError
+			}
 		}
 		httpRespond(reqCtx, statusCode, output, err)
 		if s.config.Logging {
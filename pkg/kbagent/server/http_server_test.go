@@ -21,6 +21,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net"
 	"testing"
@@ -157,9 +158,44 @@ func TestHTTPServerRouterDispatcherAndRespond(t *testing.T) {
 	}
 }
 
-func runFastHTTP(handler fasthttp.RequestHandler, method, uri, body string) *fasthttp.RequestCtx {
+func TestHTTPServerRouterUnauthorized(t *testing.T) {
+	logger := ktesting.NewLogger(t, ktesting.NewConfig())
+	svc := &serverFakeService{
+		kind:   proto.ServiceAction.Kind,
+		uri:    proto.ServiceAction.URI,
+		output: []byte(`{"ok":true}`),
+	}
+	srv := &httpServer{
+		logger:   logger,
+		config:   Config{Credentials: &proto.Credentials{Mode: proto.AuthModeToken, Token: "secret"}},
+		services: []service.Service{svc},
+	}
+	handler := srv.router()
+
+	ctx := runFastHTTP(handler, fasthttp.MethodPost, proto.ServiceAction.URI, "ok")
+	if ctx.Response.StatusCode() != fasthttp.StatusUnauthorized {
+		t.Fatalf("status = %d, want 401", ctx.Response.StatusCode())
+	}
+	rsp := &proto.ActionResponse{}
+	if err := json.Unmarshal(ctx.Response.Body(), rsp); err != nil {
+		t.Fatalf("decode unauthorized response: %v", err)
+	}
+	if !errors.Is(proto.Type2Error(rsp.Error), proto.ErrUnauthorized) {
+		t.Fatalf("error = %q, want unauthorized", rsp.Error)
+	}
+
+	ctx = runFastHTTP(handler, fasthttp.MethodPost, proto.ServiceAction.URI, "ok", proto.BearerTokenPrefix+"secret")
+	if ctx.Response.StatusCode() != fasthttp.StatusOK {
+		t.Fatalf("status with token = %d, want 200", ctx.Response.StatusCode())
+	}
+}
+
+func runFastHTTP(handler fasthttp.RequestHandler, method, uri, body string, authorization ...string) *fasthttp.RequestCtx {
 	var req fasthttp.Request
 	req.Header.SetMethod(method)
+	if len(authorization) > 0 {
+		req.Header.Set(proto.AuthorizationHeader, authorization[0])
+	}
 	req.SetRequestURI(uri)
 	req.SetBodyString(body)
 
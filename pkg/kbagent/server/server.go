@@ -21,9 +21,11 @@ package server
 
 import (
 	"io"
+	"slices"
 
 	"github.com/go-logr/logr"
 
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
 	"github.com/apecloud/kubeblocks/pkg/kbagent/service"
 )
 
@@ -33,14 +35,35 @@ type Server interface {
 	StartNonBlocking() error
 }
 
+const (
+	TransportHTTP = "http"
+	TransportGRPC = "grpc"
+)
+
 type Config struct {
 	Server           bool
 	Address          string
 	UnixDomainSocket string
 	Port             int
 	StreamingPort    int
+	GRPCPort         int
+	Transports       []string
 	Concurrency      int
 	Logging          bool
+	AuthMode         string
+	AuthDir          string
+
+	// Credentials are loaded from the AuthDir with the AuthMode, to authenticate the clients.
+	Credentials *proto.Credentials
+}
+
+// TransportEnabled returns whether the transport is enabled to serve the services,
+// only the HTTP transport is enabled if no transport is specified.
+func (c Config) TransportEnabled(transport string) bool {
+	if len(c.Transports) == 0 {
+		return transport == TransportHTTP
+	}
+	return slices.Contains(c.Transports, transport)
 }
 
 // NewHTTPServer returns a new HTTP server.
@@ -52,6 +75,17 @@ func NewHTTPServer(logger logr.Logger, config Config, services []service.Service
 	}
 }
 
+// NewGRPCServer returns a new gRPC server.
+func NewGRPCServer(logger logr.Logger, config Config, services []service.Service) Server {
+	return &grpcServer{
+		logger:               logger,
+		config:               config,
+		services:             services,
+		subscribeProbeEvents: service.SubscribeProbeEvents,
+		subscribeTaskEvents:  service.SubscribeTaskEvents,
+	}
+}
+
 // NewStreamingServer returns a new Streaming server.
 func NewStreamingServer(logger logr.Logger, config Config, service service.Service) Server {
 	return &streamingServer{
@@ -21,6 +21,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -50,12 +51,18 @@ func (s *streamingServer) StartNonBlocking() error {
 		return nil
 	}
 
-	var err1 error
+	tlsConfig, err1 := s.config.Credentials.ServerTLSConfig()
+	if err1 != nil {
+		return err1
+	}
 	s.listener, err1 = net.Listen("tcp", fmt.Sprintf("%s:%v", s.config.Address, s.config.StreamingPort))
 	if err1 != nil {
 		s.logger.Error(err1, "listen failed", "listen address", s.config.Address, "port", s.config.StreamingPort)
 		return err1
 	}
+	if tlsConfig != nil {
+		s.listener = tls.NewListener(s.listener, tlsConfig)
+	}
 
 	go func() {
 		const (
@@ -109,7 +116,7 @@ func (s *streamingServer) handleConn(conn net.Conn) {
 	logger.Info("accepted a new streaming connection")
 
 	now := time.Now()
-	err := s.service.HandleConn(context.Background(), conn)
+	err := s.service.HandleConn(service.WithCredentials(context.Background(), s.config.Credentials), conn)
 	if err != nil {
 		logger.Error(err, "handle streaming connection error")
 	} else {
@@ -117,7 +117,7 @@ func (s *actionService) handleRequest(ctx context.Context, req *proto.ActionRequ
 	if !ok {
 		return nil, errors.Wrapf(proto.ErrNotDefined, "%s is not defined", req.Action)
 	}
-	if action.Exec == nil && action.HTTP == nil && action.GRPC == nil {
+	if action.Exec == nil && action.HTTP == nil && action.GRPC == nil && action.VolumeStats == nil {
 		return nil, errors.Wrapf(proto.ErrBadRequest, "%s is invalid", req.Action)
 	}
 	// HACK: pre-check for the reconfigure action
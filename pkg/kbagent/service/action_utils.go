@@ -190,6 +190,12 @@ func nonBlockingCallActionXWithTimeoutCap(ctx context.Context, action *kbaproto.
 			return nil, errors.Wrapf(kbaproto.ErrBadRequest, "runtime arguments are only supported for exec actions")
 		}
 		err = grpcActionCallX(ctx, cancel, action.GRPC, parameters, errChan, stdinReader, stdoutWriter, stderrWriter)
+	case action.VolumeStats != nil:
+		if len(arguments) > 0 {
+			cancel()
+			return nil, errors.Wrapf(kbaproto.ErrBadRequest, "runtime arguments are only supported for exec actions")
+		}
+		err = volumeStatsActionCallX(ctx, cancel, action.VolumeStats, errChan, stdoutWriter)
 	default:
 		cancel() // cancel the context to release the resources
 		err = errors.Wrapf(kbaproto.ErrBadRequest, "invalid action type")
@@ -0,0 +1,103 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+const eventSubscriberBufferSize = 64
+
+var (
+	probeEventHub = newEventHub(func(e proto.ProbeEvent) string { return e.Probe })
+	taskEventHub  = newEventHub[proto.TaskEvent](nil)
+)
+
+// SubscribeProbeEvents returns the channel of the probe events published by the probes of the agent,
+// the latest event of each probe is delivered first. The channel is closed when the ctx is done.
+func SubscribeProbeEvents(ctx context.Context) <-chan proto.ProbeEvent {
+	return probeEventHub.subscribe(ctx)
+}
+
+// SubscribeTaskEvents returns the channel of the task events published by the tasks run in the agent.
+// The channel is closed when the ctx is done.
+func SubscribeTaskEvents(ctx context.Context) <-chan proto.TaskEvent {
+	return taskEventHub.subscribe(ctx)
+}
+
+// eventHub broadcasts the events to the subscribers in the process. A slow subscriber misses the events
+// once its buffer is full, rather than blocking the publisher.
+type eventHub[T any] struct {
+	mu          sync.Mutex
+	subscribers map[chan T]struct{}
+
+	// latest keeps the latest event of each key if the key func is set, to replay to the new subscribers.
+	key    func(T) string
+	keys   []string
+	latest map[string]T
+}
+
+func newEventHub[T any](key func(T) string) *eventHub[T] {
+	return &eventHub[T]{
+		subscribers: make(map[chan T]struct{}),
+		key:         key,
+		latest:      make(map[string]T),
+	}
+}
+
+func (h *eventHub[T]) publish(event T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.key != nil {
+		k := h.key(event)
+		if _, ok := h.latest[k]; !ok {
+			h.keys = append(h.keys, k)
+		}
+		h.latest[k] = event
+	}
+	for ch := range h.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+func (h *eventHub[T]) subscribe(ctx context.Context) <-chan T {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ch := make(chan T, max(eventSubscriberBufferSize, len(h.keys)))
+	for _, k := range h.keys {
+		ch <- h.latest[k]
+	}
+	h.subscribers[ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subscribers, ch)
+		close(ch)
+	}()
+	return ch
+}
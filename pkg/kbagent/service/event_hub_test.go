@@ -0,0 +1,80 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package service
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+var _ = Describe("event hub", func() {
+	It("broadcasts the events to all subscribers", func() {
+		hub := newEventHub[proto.TaskEvent](nil)
+		ctx1, cancel1 := context.WithCancel(context.Background())
+		defer cancel1()
+		ctx2, cancel2 := context.WithCancel(context.Background())
+		defer cancel2()
+		ch1, ch2 := hub.subscribe(ctx1), hub.subscribe(ctx2)
+
+		hub.publish(proto.TaskEvent{Task: "newReplica", UID: "1"})
+		Expect((<-ch1).UID).Should(Equal("1"))
+		Expect((<-ch2).UID).Should(Equal("1"))
+	})
+
+	It("replays the latest event of each key to a new subscriber", func() {
+		hub := newEventHub(func(e proto.ProbeEvent) string { return e.Probe })
+		hub.publish(proto.ProbeEvent{Probe: "roleProbe", Output: []byte("primary")})
+		hub.publish(proto.ProbeEvent{Probe: "availableProbe"})
+		hub.publish(proto.ProbeEvent{Probe: "roleProbe", Output: []byte("secondary")})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ch := hub.subscribe(ctx)
+		event := <-ch
+		Expect(event.Probe).Should(Equal("roleProbe"))
+		Expect(event.Output).Should(Equal([]byte("secondary")))
+		Expect((<-ch).Probe).Should(Equal("availableProbe"))
+		Consistently(ch).ShouldNot(Receive())
+	})
+
+	It("does not block the publisher on a slow subscriber", func() {
+		hub := newEventHub[proto.TaskEvent](nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ch := hub.subscribe(ctx)
+		for i := 0; i < eventSubscriberBufferSize*2; i++ {
+			hub.publish(proto.TaskEvent{Task: "newReplica"})
+		}
+		Expect(ch).Should(HaveLen(eventSubscriberBufferSize))
+	})
+
+	It("closes the channel when the subscriber is done", func() {
+		hub := newEventHub[proto.TaskEvent](nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := hub.subscribe(ctx)
+		cancel()
+		Eventually(ch).Should(BeClosed())
+		hub.publish(proto.TaskEvent{Task: "newReplica"})
+	})
+})
@@ -193,6 +193,7 @@ func (r *probeRunner) report(probe *proto.Probe, output []byte, err error, force
 	}
 
 	if latestEvent != nil {
+		probeEventHub.publish(*latestEvent)
 		select {
 		case r.latestEvent <- *latestEvent:
 		default:
@@ -52,14 +52,16 @@ func New(logger logr.Logger, actions []proto.Action, probes []proto.Probe, strea
 	if err != nil {
 		return nil, err
 	}
-	return []Service{sa, sp, ss}, nil
+	st := newTaskService(logger, sa)
+	return []Service{sa, sp, ss, st}, nil
 }
 
-func RunTasks(logger logr.Logger, service Service, tasks []proto.Task) error {
+// RunTasks runs the tasks, the credentials are presented to the remote kb-agents the tasks connect to.
+func RunTasks(logger logr.Logger, service Service, tasks []proto.Task, creds *proto.Credentials) error {
 	st := &taskService{
 		logger:        logger,
 		actionService: service.(*actionService),
 		tasks:         tasks,
 	}
-	return st.runTasks(context.Background())
+	return st.runTasks(WithCredentials(context.Background(), creds))
 }
@@ -33,10 +33,11 @@ var _ = Describe("service", func() {
 		It("empty", func() {
 			services, err := New(logr.New(nil), nil, nil, nil)
 			Expect(err).Should(BeNil())
-			Expect(services).Should(HaveLen(3))
+			Expect(services).Should(HaveLen(4))
 			Expect(services[0]).ShouldNot(BeNil())
 			Expect(services[1]).ShouldNot(BeNil())
 			Expect(services[2]).ShouldNot(BeNil())
+			Expect(services[3]).ShouldNot(BeNil())
 		})
 
 		It("action", func() {
@@ -47,10 +48,11 @@ var _ = Describe("service", func() {
 			}
 			services, err := New(logr.New(nil), actions, nil, nil)
 			Expect(err).Should(BeNil())
-			Expect(services).Should(HaveLen(3))
+			Expect(services).Should(HaveLen(4))
 			Expect(services[0]).ShouldNot(BeNil())
 			Expect(services[1]).ShouldNot(BeNil())
 			Expect(services[2]).ShouldNot(BeNil())
+			Expect(services[3]).ShouldNot(BeNil())
 		})
 
 		It("probe", func() {
@@ -66,10 +68,11 @@ var _ = Describe("service", func() {
 			}
 			services, err := New(logr.New(nil), actions, probes, nil)
 			Expect(err).Should(BeNil())
-			Expect(services).Should(HaveLen(3))
+			Expect(services).Should(HaveLen(4))
 			Expect(services[0]).ShouldNot(BeNil())
 			Expect(services[1]).ShouldNot(BeNil())
 			Expect(services[2]).ShouldNot(BeNil())
+			Expect(services[3]).ShouldNot(BeNil())
 		})
 
 		It("streaming", func() {
@@ -83,10 +86,11 @@ var _ = Describe("service", func() {
 			}
 			services, err := New(logr.New(nil), actions, nil, streamingActions)
 			Expect(err).Should(BeNil())
-			Expect(services).Should(HaveLen(3))
+			Expect(services).Should(HaveLen(4))
 			Expect(services[0]).ShouldNot(BeNil())
 			Expect(services[1]).ShouldNot(BeNil())
 			Expect(services[2]).ShouldNot(BeNil())
+			Expect(services[3]).ShouldNot(BeNil())
 		})
 
 		It("probe which has no action", func() {
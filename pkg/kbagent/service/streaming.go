@@ -91,13 +91,27 @@ func (s *streamingService) HandleRequest(ctx context.Context, payload []byte) ([
 }
 
 func (s *streamingService) handshake(ctx context.Context, conn net.Conn) (*proto.ActionRequest, error) {
-	// reuse the action request as the handshake packet, define a new one when needed
-	req := &proto.ActionRequest{}
+	req := &proto.StreamingHandshake{}
 	decoder := json.NewDecoder(conn)
 	if err := decoder.Decode(req); err != nil {
 		return nil, errors.Wrapf(proto.ErrBadRequest, "read and unmarshal action request error: %s", err.Error())
 	}
-	return req, nil
+	if err := credentials(ctx).VerifyToken(req.Authorization); err != nil {
+		return nil, err
+	}
+	return &req.ActionRequest, nil
+}
+
+type credentialsKey struct{}
+
+// WithCredentials returns a copy of the ctx carrying the credentials to authenticate the streaming clients.
+func WithCredentials(ctx context.Context, creds *proto.Credentials) context.Context {
+	return context.WithValue(ctx, credentialsKey{}, creds)
+}
+
+func credentials(ctx context.Context) *proto.Credentials {
+	creds, _ := ctx.Value(credentialsKey{}).(*proto.Credentials)
+	return creds
 }
 
 func (s *streamingService) streaming(ctx context.Context, conn net.Conn, action *proto.Action, req *proto.ActionRequest) error {
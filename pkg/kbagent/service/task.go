@@ -21,10 +21,13 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
 	"reflect"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -34,10 +37,118 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/kbagent/util"
 )
 
+// finishedTaskEventTTL is how long the event of a finished task is kept after the task is finished,
+// the callers should query the result of the task within it.
+const finishedTaskEventTTL = time.Hour
+
+func newTaskService(logger logr.Logger, actionService *actionService) *taskService {
+	st := &taskService{
+		logger:        logger,
+		actionService: actionService,
+		events:        map[string]*proto.TaskEvent{},
+	}
+	logger.Info(fmt.Sprintf("create service %s", st.Kind()))
+	return st
+}
+
 type taskService struct {
 	logger        logr.Logger
 	actionService *actionService
 	tasks         []proto.Task
+
+	mutex sync.Mutex
+	// the latest events of the tasks submitted to the server, keyed by the task UID
+	events map[string]*proto.TaskEvent
+}
+
+var _ Service = &taskService{}
+
+func (s *taskService) Kind() string {
+	return proto.ServiceTask.Kind
+}
+
+func (s *taskService) URI() string {
+	return proto.ServiceTask.URI
+}
+
+func (s *taskService) Start() error {
+	return nil
+}
+
+func (s *taskService) HandleConn(ctx context.Context, conn net.Conn) error {
+	return errors.Wrapf(proto.ErrNotImplemented, "service %s does not support stream processing", s.Kind())
+}
+
+// HandleRequest submits the task to run in the background if it has not been submitted before,
+// and returns the latest event of the task.
+func (s *taskService) HandleRequest(ctx context.Context, payload []byte) ([]byte, error) {
+	req := &proto.TaskRequest{}
+	if err := json.Unmarshal(payload, req); err != nil {
+		return s.actionService.encode(nil, errors.Wrapf(proto.ErrBadRequest, "unmarshal task request error: %s", err.Error())), nil
+	}
+	event, err := s.submit(ctx, req)
+	if err != nil {
+		return s.actionService.encode(nil, err), nil
+	}
+	out, err := json.Marshal(event)
+	return s.actionService.encode(out, err), nil
+}
+
+func (s *taskService) submit(ctx context.Context, req *proto.TaskRequest) (*proto.TaskEvent, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.evictFinishedEvents(time.Now())
+	if event, ok := s.events[req.UID]; ok {
+		eventCopy := *event
+		return &eventCopy, nil
+	}
+	if req.Task == nil {
+		return nil, errors.Wrapf(proto.ErrNotDefined, "task %s not found", req.UID)
+	}
+	if req.Task.UID != req.UID {
+		return nil, errors.Wrapf(proto.ErrBadRequest, "the UID of the task %s mismatches the request %s", req.Task.UID, req.UID)
+	}
+	if s.newTask(*req.Task) == nil {
+		return nil, errors.Wrapf(proto.ErrBadRequest, "task %s is not supported", req.Task.Task)
+	}
+
+	event := &proto.TaskEvent{
+		Instance:  req.Task.Instance,
+		Task:      req.Task.Task,
+		UID:       req.Task.UID,
+		Replica:   util.PodName(),
+		StartTime: time.Now(),
+	}
+	s.events[req.UID] = event
+	eventCopy := *event
+
+	// the task outlives the request, only the credentials are inherited
+	taskCtx := WithCredentials(context.Background(), credentials(ctx))
+	go func(task proto.Task) {
+		if err := s.runTask(taskCtx, task); err != nil {
+			s.logger.Error(err, fmt.Sprintf("failed to run task: %s", task.UID))
+		}
+	}(*req.Task)
+	return &eventCopy, nil
+}
+
+// evictFinishedEvents removes the events of the tasks which have been finished for longer than the TTL.
+func (s *taskService) evictFinishedEvents(now time.Time) {
+	for uid, event := range s.events {
+		if !event.EndTime.IsZero() && now.Sub(event.EndTime) > finishedTaskEventTTL {
+			delete(s.events, uid)
+		}
+	}
+}
+
+// record records the latest event of the task submitted to the server.
+func (s *taskService) record(event proto.TaskEvent) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.events[event.UID]; ok {
+		s.events[event.UID] = &event
+	}
 }
 
 type task interface {
@@ -79,14 +190,15 @@ func (s *taskService) runTask(ctx context.Context, task proto.Task) error {
 			close(exit)
 			<-exited
 		}
+		event.EndTime = time.Now()
+		if err == nil {
+			event.Code = 0
+		} else {
+			event.Code = -1
+			event.Message = err.Error()
+		}
+		s.record(event)
 		if task.NotifyAtFinish {
-			event.EndTime = time.Now()
-			if err == nil {
-				event.Code = 0
-			} else {
-				event.Code = -1
-				event.Message = err.Error()
-			}
 			err1 := s.notify(task, event, true)
 			if err == nil { // the run error takes precedence
 				err = err1
@@ -131,6 +243,7 @@ func (s *taskService) report(ctx context.Context, task proto.Task, t task, event
 					eventCopy := event
 					t.status(ctx, &event)
 					if !reflect.DeepEqual(event, eventCopy) {
+						s.record(event)
 						_ = s.notify(task, event, false)
 					}
 				}
@@ -153,6 +266,7 @@ func (s *taskService) wait(ch chan error) error {
 }
 
 func (s *taskService) notify(task proto.Task, event proto.TaskEvent, sync bool) error {
+	taskEventHub.publish(event)
 	msg, err := marshalEventWithSizeLimit(&event, &event.Message, &event.Output)
 	if err == nil {
 		return util.SendEventWithMessage(&s.logger, "task", string(msg), sync)
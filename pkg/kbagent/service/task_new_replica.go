@@ -21,10 +21,13 @@ package service
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"strconv"
+	"sync/atomic"
 
 	"github.com/go-logr/logr"
 
@@ -43,6 +46,8 @@ type newReplicaTask struct {
 	logger        logr.Logger
 	actionService *actionService
 	task          *proto.NewReplicaTask
+	loaded        atomic.Int64 // bytes of the data received from the remote
+	connected     atomic.Bool
 }
 
 var _ task = &newReplicaTask{}
@@ -58,14 +63,17 @@ func (s *newReplicaTask) run(ctx context.Context) (chan error, error) {
 		return nil, err
 	}
 
-	return nonBlockingCallActionX(ctx, action, s.task.Parameters, nil, &action.TimeoutSeconds, conn, nil, nil)
+	s.connected.Store(true)
+	return nonBlockingCallActionX(ctx, action, s.task.Parameters, nil, &action.TimeoutSeconds, &countingReader{r: conn, n: &s.loaded}, nil, nil)
 }
 
 func (s *newReplicaTask) status(ctx context.Context, event *proto.TaskEvent) {
-	// TODO: query the progress
 	event.Code = 0
 	event.Output = nil
 	event.Message = ""
+	if s.connected.Load() {
+		event.Progress = fmt.Sprintf("%d bytes loaded", s.loaded.Load())
+	}
 }
 
 func (s *newReplicaTask) handshake(ctx context.Context) (net.Conn, error) {
@@ -75,9 +83,15 @@ func (s *newReplicaTask) handshake(ctx context.Context) (net.Conn, error) {
 	}
 
 	// reuse the action request as the handshake packet, define a new one when needed
-	req := proto.ActionRequest{
-		Action:     newReplicaDataDump,
-		Parameters: s.task.Parameters,
+	req := proto.StreamingHandshake{
+		ActionRequest: proto.ActionRequest{
+			Action:     newReplicaDataDump,
+			Parameters: s.task.Parameters,
+		},
+		Authorization: s.task.Authorization,
+	}
+	if len(req.Authorization) == 0 {
+		req.Authorization = credentials(ctx).Authorization()
 	}
 	if req.Parameters == nil {
 		req.Parameters = make(map[string]string)
@@ -112,5 +126,25 @@ func (s *newReplicaTask) connectToRemote(ctx context.Context) (net.Conn, error)
 	dialer := &net.Dialer{
 		Timeout: defaultConnectTimeout,
 	}
-	return dialer.Dial("tcp", net.JoinHostPort(s.task.Remote, strconv.Itoa(int(s.task.Port))))
+	address := net.JoinHostPort(s.task.Remote, strconv.Itoa(int(s.task.Port)))
+	tlsConfig, err := credentials(ctx).ClientTLSConfig(s.task.Remote)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		return tls.DialWithDialer(dialer, "tcp", address, tlsConfig)
+	}
+	return dialer.Dial("tcp", address)
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n *atomic.Int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n.Add(int64(n))
+	return n, err
 }
@@ -55,7 +55,7 @@ var _ = Describe("task", func() {
 			GinkgoT().Setenv("KB_AGENT_POD_NAME", "pod-0")
 			actionSvc, err := newActionService(logr.New(nil), nil)
 			Expect(err).Should(BeNil())
-			Expect(RunTasks(logr.New(nil), actionSvc, []proto.Task{{Replicas: "pod-1"}})).Should(Succeed())
+			Expect(RunTasks(logr.New(nil), actionSvc, []proto.Task{{Replicas: "pod-1"}}, nil)).Should(Succeed())
 		})
 
 		It("handles wait channel states", func() {
@@ -116,6 +116,19 @@ var _ = Describe("task", func() {
 			Expect(svc.runTasks(ctx)).Should(MatchError("remote server is required"))
 		})
 
+		It("evicts the events of the finished tasks after the TTL", func() {
+			now := time.Now()
+			svc := &taskService{events: map[string]*proto.TaskEvent{
+				"running":  {UID: "running", StartTime: now.Add(-2 * finishedTaskEventTTL)},
+				"finished": {UID: "finished", EndTime: now.Add(-time.Minute)},
+				"expired":  {UID: "expired", EndTime: now.Add(-finishedTaskEventTTL - time.Minute)},
+			}}
+			svc.evictFinishedEvents(now)
+			Expect(svc.events).Should(HaveKey("running"))
+			Expect(svc.events).Should(HaveKey("finished"))
+			Expect(svc.events).ShouldNot(HaveKey("expired"))
+		})
+
 		It("reports task status until stopped", func() {
 			svc := &taskService{logger: logr.New(nil)}
 			fake := fakeTask{statusCalled: make(chan struct{}, 1)}
@@ -163,14 +176,16 @@ var _ = Describe("task", func() {
 			defer listener.Close()
 
 			accepted := make(chan proto.ActionRequest, 1)
+			authorization := make(chan string, 1)
 			go func() {
 				defer GinkgoRecover()
 				conn, err := listener.Accept()
 				Expect(err).Should(BeNil())
 				defer conn.Close()
-				req := proto.ActionRequest{}
+				req := proto.StreamingHandshake{}
 				Expect(json.NewDecoder(conn).Decode(&req)).Should(Succeed())
-				accepted <- req
+				accepted <- req.ActionRequest
+				authorization <- req.Authorization
 			}()
 
 			_, port, err := net.SplitHostPort(listener.Addr().String())
@@ -181,9 +196,10 @@ var _ = Describe("task", func() {
 
 			task := &newReplicaTask{
 				task: &proto.NewReplicaTask{
-					Remote:     "127.0.0.1",
-					Port:       int32(portNumber),
-					Parameters: map[string]string{"foo": "bar"},
+					Remote:        "127.0.0.1",
+					Port:          int32(portNumber),
+					Parameters:    map[string]string{"foo": "bar"},
+					Authorization: "Bearer remote",
 				},
 			}
 			conn, err := task.handshake(ctx)
@@ -192,6 +208,7 @@ var _ = Describe("task", func() {
 
 			req := <-accepted
 			Expect(req.Action).Should(Equal(newReplicaDataDump))
+			Expect(<-authorization).Should(Equal("Bearer remote"))
 			Expect(req.Parameters).Should(HaveKeyWithValue("foo", "bar"))
 			Expect(req.Parameters).Should(HaveKeyWithValue(targetPodNameEnv, "pod-0"))
 
@@ -202,6 +219,56 @@ var _ = Describe("task", func() {
 			Expect(event.Output).Should(BeNil())
 		})
 
+		It("submits tasks in the background and queries the latest events", func() {
+			GinkgoT().Setenv("KB_AGENT_POD_NAME", "pod-0")
+			actionSvc, err := newActionService(logr.New(nil), []proto.Action{{
+				Name: newReplicaDataLoad,
+				Exec: &proto.ExecAction{Commands: []string{"/bin/bash", "-c", "cat"}},
+			}})
+			Expect(err).Should(BeNil())
+			svc := newTaskService(logr.New(nil), actionSvc)
+
+			query := func(req proto.TaskRequest) (proto.ActionResponse, *proto.TaskEvent) {
+				payload, err := json.Marshal(req)
+				Expect(err).Should(BeNil())
+				out, err := svc.HandleRequest(ctx, payload)
+				Expect(err).Should(BeNil())
+				rsp := proto.ActionResponse{}
+				Expect(json.Unmarshal(out, &rsp)).Should(Succeed())
+				if len(rsp.Error) > 0 {
+					return rsp, nil
+				}
+				event := &proto.TaskEvent{}
+				Expect(json.Unmarshal(rsp.Output, event)).Should(Succeed())
+				return rsp, event
+			}
+
+			rsp, _ := query(proto.TaskRequest{UID: "u1"})
+			Expect(rsp.Error).Should(Equal(proto.Error2Type(proto.ErrNotDefined)))
+
+			rsp, _ = query(proto.TaskRequest{UID: "u1", Task: &proto.Task{UID: "u1"}})
+			Expect(rsp.Error).Should(Equal(proto.Error2Type(proto.ErrBadRequest)))
+
+			task := &proto.Task{
+				Instance:   "inst",
+				Task:       "dataLoad",
+				UID:        "u1",
+				NewReplica: &proto.NewReplicaTask{Port: 3502},
+			}
+			_, event := query(proto.TaskRequest{UID: "u1", Task: task})
+			Expect(event).ShouldNot(BeNil())
+			Expect(event.UID).Should(Equal("u1"))
+			Expect(event.Replica).Should(Equal("pod-0"))
+
+			Eventually(func(g Gomega) {
+				_, event := query(proto.TaskRequest{UID: "u1", Task: task})
+				g.Expect(event).ShouldNot(BeNil())
+				g.Expect(event.EndTime.IsZero()).Should(BeFalse())
+				g.Expect(event.Code).Should(Equal(int32(-1)))
+				g.Expect(event.Message).Should(Equal("remote server is required"))
+			}).Should(Succeed())
+		})
+
 		It("validates remote connection settings", func() {
 			task := &newReplicaTask{task: &proto.NewReplicaTask{Remote: "127.0.0.1"}}
 			conn, err := task.connectToRemote(ctx)
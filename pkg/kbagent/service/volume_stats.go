@@ -0,0 +1,82 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	kbaproto "github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+var statfs = syscall.Statfs
+
+func volumeStatsActionCallX(_ context.Context, cancel context.CancelFunc,
+	action *kbaproto.VolumeStatsAction, errChan chan error, stdoutWriter io.Writer) error {
+	go func() {
+		defer cancel()
+		defer close(errChan)
+
+		stats, err := volumeStats(action)
+		if err == nil {
+			var out []byte
+			if out, err = json.Marshal(stats); err == nil {
+				_, err = stdoutWriter.Write(out)
+			}
+		}
+		errChan <- err
+	}()
+	return nil
+}
+
+func volumeStats(action *kbaproto.VolumeStatsAction) ([]kbaproto.VolumeStats, error) {
+	names := make([]string, 0, len(action.Volumes))
+	for name := range action.Volumes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	stats := make([]kbaproto.VolumeStats, 0, len(names))
+	for _, name := range names {
+		fs := &syscall.Statfs_t{}
+		if err := statfs(action.Volumes[name], fs); err != nil {
+			return nil, errors.Wrapf(kbaproto.ErrFailed, "failed to stat the volume %s: %v", name, err)
+		}
+		blockSize := uint64(fs.Bsize)
+		used := (fs.Blocks - fs.Bfree) * blockSize
+		// the same as df, the space reserved for the root user is excluded
+		total := used + fs.Bavail*blockSize
+		utilization := int32(0)
+		if total > 0 {
+			utilization = int32((used*100 + total - 1) / total)
+		}
+		stats = append(stats, kbaproto.VolumeStats{
+			Name:          name,
+			CapacityBytes: fs.Blocks * blockSize,
+			Utilization:   utilization,
+		})
+	}
+	return stats, nil
+}
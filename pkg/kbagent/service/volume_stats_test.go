@@ -0,0 +1,105 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package service
+
+import (
+	"encoding/json"
+	"syscall"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+var _ = Describe("volume stats", func() {
+	var (
+		originStatfs = statfs
+	)
+
+	AfterEach(func() {
+		statfs = originStatfs
+	})
+
+	It("reports the filesystem stats of the volumes", func() {
+		statfs = func(path string, fs *syscall.Statfs_t) error {
+			fs.Bsize = 1024
+			fs.Blocks = 100
+			fs.Bfree = 20
+			fs.Bavail = 10
+			if path == "/kubeblocks/volumes/log" {
+				fs.Bfree = 90
+			}
+			return nil
+		}
+		svc, err := newActionService(logr.New(nil), []proto.Action{
+			{
+				Name: "volumeStats",
+				VolumeStats: &proto.VolumeStatsAction{
+					Volumes: map[string]string{
+						"log":  "/kubeblocks/volumes/log",
+						"data": "/kubeblocks/volumes/data",
+					},
+				},
+			},
+		})
+		Expect(err).Should(BeNil())
+
+		output, err := svc.handleRequest(ctx, &proto.ActionRequest{Action: "volumeStats"})
+		Expect(err).Should(BeNil())
+		stats := make([]proto.VolumeStats, 0)
+		Expect(json.Unmarshal(output, &stats)).Should(Succeed())
+		Expect(stats).Should(Equal([]proto.VolumeStats{
+			{Name: "data", CapacityBytes: 102400, Utilization: 89},
+			{Name: "log", CapacityBytes: 102400, Utilization: 50},
+		}))
+	})
+
+	It("fails if the volume is not mounted", func() {
+		statfs = func(string, *syscall.Statfs_t) error {
+			return syscall.ENOENT
+		}
+		svc, err := newActionService(logr.New(nil), []proto.Action{
+			{
+				Name:        "volumeStats",
+				VolumeStats: &proto.VolumeStatsAction{Volumes: map[string]string{"data": "/kubeblocks/volumes/data"}},
+			},
+		})
+		Expect(err).Should(BeNil())
+
+		_, err = svc.handleRequest(ctx, &proto.ActionRequest{Action: "volumeStats"})
+		Expect(errors.Is(err, proto.ErrFailed)).Should(BeTrue())
+	})
+
+	It("rejects the runtime arguments", func() {
+		svc, err := newActionService(logr.New(nil), []proto.Action{
+			{
+				Name:        "volumeStats",
+				VolumeStats: &proto.VolumeStatsAction{Volumes: map[string]string{"data": "/kubeblocks/volumes/data"}},
+			},
+		})
+		Expect(err).Should(BeNil())
+
+		_, err = svc.handleRequest(ctx, &proto.ActionRequest{Action: "volumeStats", Arguments: [][]string{{"x"}}})
+		Expect(errors.Is(err, proto.ErrBadRequest)).Should(BeTrue())
+	})
+})
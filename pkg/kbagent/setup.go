@@ -42,9 +42,11 @@ const (
 
 	DefaultHTTPPortName      = "http"
 	DefaultStreamingPortName = "streaming"
+	DefaultGRPCPortName      = "grpc"
 
 	DefaultHTTPPort      = 3501
 	DefaultStreamingPort = 3502
+	DefaultGRPCPort      = 3503
 
 	actionEnvName    = "KB_AGENT_ACTION"
 	probeEnvName     = "KB_AGENT_PROBE"
@@ -134,10 +136,14 @@ func Launch(logger logr.Logger, config server.Config) (bool, error) {
 	if err != nil {
 		return false, errors.Wrap(err, "init action handlers failed")
 	}
+	config.Credentials, err = proto.LoadCredentials(proto.AuthMode(config.AuthMode), config.AuthDir)
+	if err != nil {
+		return false, errors.Wrap(err, "load credentials failed")
+	}
 	if config.Server {
 		return true, runAsServer(logger, config, services)
 	}
-	return false, runAsWorker(logger, services, envVars)
+	return false, runAsWorker(logger, services, envVars, config.Credentials)
 }
 
 func initialize(logger logr.Logger, envVars map[string]string) ([]service.Service, error) {
@@ -201,8 +207,8 @@ func deserializeActionNProbe(da, dp string) ([]proto.Action, []proto.Probe, erro
 }
 
 func runAsServer(logger logr.Logger, config server.Config, services []service.Service) error {
-	if config.Port == config.StreamingPort {
-		return errors.New("HTTP port and streaming port are the same")
+	if err := validateServerConfig(config); err != nil {
+		return err
 	}
 
 	// start all services first
@@ -215,22 +221,49 @@ func runAsServer(logger logr.Logger, config server.Config, services []service.Se
 	}
 
 	// start the HTTP server
-	httpServer := server.NewHTTPServer(logger, config, services)
-	err := httpServer.StartNonBlocking()
-	if err != nil {
-		return errors.Wrap(err, "failed to start the HTTP server")
+	if config.TransportEnabled(server.TransportHTTP) {
+		httpServer := server.NewHTTPServer(logger, config, services)
+		err := httpServer.StartNonBlocking()
+		if err != nil {
+			return errors.Wrap(err, "failed to start the HTTP server")
+		}
+	}
+
+	// start the gRPC server
+	if config.TransportEnabled(server.TransportGRPC) {
+		grpcServer := server.NewGRPCServer(logger, config, services)
+		err := grpcServer.StartNonBlocking()
+		if err != nil {
+			return errors.Wrap(err, "failed to start the gRPC server")
+		}
 	}
 
 	// start the streaming server
 	streamingServer := server.NewStreamingServer(logger, config, streamingService(services))
-	err = streamingServer.StartNonBlocking()
+	err := streamingServer.StartNonBlocking()
 	if err != nil {
 		return errors.Wrap(err, "failed to start the streaming server")
 	}
 	return nil
 }
 
-func runAsWorker(logger logr.Logger, services []service.Service, envVars map[string]string) error {
+func validateServerConfig(config server.Config) error {
+	for _, transport := range config.Transports {
+		if transport != server.TransportHTTP && transport != server.TransportGRPC {
+			return fmt.Errorf("unknown transport %s", transport)
+		}
+	}
+	if config.Port == config.StreamingPort {
+		return errors.New("HTTP port and streaming port are the same")
+	}
+	if config.TransportEnabled(server.TransportGRPC) &&
+		(config.GRPCPort == config.StreamingPort || config.TransportEnabled(server.TransportHTTP) && config.GRPCPort == config.Port) {
+		return errors.New("gRPC port conflicts with the HTTP port or the streaming port")
+	}
+	return nil
+}
+
+func runAsWorker(logger logr.Logger, services []service.Service, envVars map[string]string, creds *proto.Credentials) error {
 	dt, ok := envVars[taskEnvName]
 	if !ok || len(dt) == 0 {
 		return nil // has no task
@@ -243,7 +276,7 @@ func runAsWorker(logger logr.Logger, services []service.Service, envVars map[str
 		return err
 	}
 
-	if err := service.RunTasks(logger, actionService(services), tasks); err != nil {
+	if err := service.RunTasks(logger, actionService(services), tasks, creds); err != nil {
 		return errors.Wrap(err, "failed to run as worker")
 	}
 	return nil
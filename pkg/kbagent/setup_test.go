@@ -198,6 +198,29 @@ func TestRunAsServerStableErrors(t *testing.T) {
 	}
 }
 
+func TestRunAsServerTransportErrors(t *testing.T) {
+	logger := ktesting.NewLogger(t, ktesting.NewConfig())
+	if err := runAsServer(logger, server.Config{Port: 3501, StreamingPort: 3502, Transports: []string{"ws"}}, nil); err == nil {
+		t.Fatalf("expected unknown transport error")
+	}
+	if err := runAsServer(logger, server.Config{Port: 3501, StreamingPort: 3502, GRPCPort: 3501,
+		Transports: []string{server.TransportHTTP, server.TransportGRPC}}, nil); err == nil {
+		t.Fatalf("expected same port error")
+	}
+}
+
+func TestRunAsServerStartsGRPCOnly(t *testing.T) {
+	logger := ktesting.NewLogger(t, ktesting.NewConfig())
+	// the HTTP port is not used if only the gRPC transport is enabled
+	err := runAsServer(logger, server.Config{Address: "127.0.0.1", Port: 0, StreamingPort: 3502, GRPCPort: 0,
+		Transports: []string{server.TransportGRPC}}, []service.Service{
+		setupFakeService{kind: proto.ServiceAction.Kind, uri: proto.ServiceAction.URI},
+	})
+	if err != nil {
+		t.Fatalf("runAsServer() error = %v", err)
+	}
+}
+
 func TestRunAsServerStartsHTTPWithNoStreamingService(t *testing.T) {
 	logger := ktesting.NewLogger(t, ktesting.NewConfig())
 	err := runAsServer(logger, server.Config{Address: "127.0.0.1", Port: 0, StreamingPort: 3502}, []service.Service{
@@ -210,10 +233,10 @@ func TestRunAsServerStartsHTTPWithNoStreamingService(t *testing.T) {
 
 func TestRunAsWorkerStableBranches(t *testing.T) {
 	logger := ktesting.NewLogger(t, ktesting.NewConfig())
-	if err := runAsWorker(logger, nil, nil); err != nil {
+	if err := runAsWorker(logger, nil, nil, nil); err != nil {
 		t.Fatalf("runAsWorker(nil env) error = %v", err)
 	}
-	if err := runAsWorker(logger, nil, map[string]string{taskEnvName: "{"}); err == nil {
+	if err := runAsWorker(logger, nil, map[string]string{taskEnvName: "{"}, nil); err == nil {
 		t.Fatalf("expected invalid task error")
 	}
 }
@@ -84,6 +84,9 @@ func (c CustomOpsHandler) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli cli
 		compFailedCount      int
 		compCompleteCount    int
 	)
+	if len(customSpec.Steps) > 0 {
+		return c.reconcileSteps(reqCtx, cli, opsRes)
+	}
 	// TODO: support Parallelism
 	for _, v := range customSpec.CustomOpsComponents {
 		// 1. init component action progress and preCheck if the conditions for executing ops are met.
@@ -123,6 +126,49 @@ func (c CustomOpsHandler) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli cli
 	return opsv1alpha1.OpsFailedPhase, 0, nil
 }
 
+// reconcileSteps runs the steps of the custom ops in the order of their dependencies.
+func (c CustomOpsHandler) reconcileSteps(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (opsv1alpha1.OpsPhase, time.Duration, error) {
+	var (
+		oldOpsRequest   = opsRes.OpsRequest.DeepCopy()
+		opsRequestPhase = opsRes.OpsRequest.Status.Phase
+		customSpec      = opsRes.OpsRequest.Spec.CustomOps
+		preCheckFailed  bool
+	)
+	// 1. init step progress and preCheck if the conditions for executing ops are met.
+	for _, v := range customSpec.CustomOpsComponents {
+		requeueAfter, passed := c.initCompActionStatusAndPreCheck(reqCtx, cli, opsRes, v)
+		if requeueAfter != 0 {
+			return opsRequestPhase, requeueAfter, nil
+		}
+		if !passed {
+			preCheckFailed = true
+		}
+	}
+	if preCheckFailed {
+		if err := syncProgressToOpsRequest(reqCtx, cli, opsRes, oldOpsRequest, 0, len(customSpec.Steps)); err != nil {
+			return opsRequestPhase, 0, err
+		}
+		return opsv1alpha1.OpsFailedPhase, 0, nil
+	}
+	// 2. do workflow
+	workflowStatus, requeueAfter, err := NewWorkflowContext(reqCtx, cli, opsRes).RunSteps(customSpec.Steps)
+	if err != nil {
+		return opsRequestPhase, 0, err
+	}
+	// sync progress
+	if err = syncProgressToOpsRequest(reqCtx, cli, opsRes, oldOpsRequest, workflowStatus.CompletedCount, len(customSpec.Steps)); err != nil {
+		return opsRequestPhase, 0, err
+	}
+	// check if the ops has been finished.
+	if !workflowStatus.IsCompleted {
+		return opsRequestPhase, requeueAfter, nil
+	}
+	if workflowStatus.ExistFailure {
+		return opsv1alpha1.OpsFailedPhase, 0, nil
+	}
+	return opsv1alpha1.OpsSucceedPhase, 0, nil
+}
+
 // SaveLastConfiguration records last configuration to the OpsRequest.status.lastConfiguration
 func (c CustomOpsHandler) SaveLastConfiguration(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
 	return nil
@@ -226,12 +272,16 @@ func (c CustomOpsHandler) initCompActionStatusAndPreCheck(reqCtx intctrlutil.Req
 				compStatus.PreCheckResult = &opsv1alpha1.PreCheckResult{Pass: true}
 			}
 		}
-		// 2. init action progress details
-		for i := range opsRes.OpsDef.Spec.Actions {
-			compStatus.ProgressDetails = append(compStatus.ProgressDetails, opsv1alpha1.ProgressStatusDetail{
-				Status:     opsv1alpha1.PendingProgressStatus,
-				ActionName: opsRes.OpsDef.Spec.Actions[i].Name,
-			})
+		// 2. init action progress details, the progress details are initialized by steps if the workflow is specified.
+		if steps := opsRes.OpsRequest.Spec.CustomOps.Steps; len(steps) > 0 {
+			compStatus.ProgressDetails = initStepProgressDetails(steps, compCustomItem.ComponentName)
+		} else {
+			for i := range opsRes.OpsDef.Spec.Actions {
+				compStatus.ProgressDetails = append(compStatus.ProgressDetails, opsv1alpha1.ProgressStatusDetail{
+					Status:     opsv1alpha1.PendingProgressStatus,
+					ActionName: opsRes.OpsDef.Spec.Actions[i].Name,
+				})
+			}
 		}
 		opsRes.OpsRequest.Status.Components[compCustomItem.ComponentName] = compStatus
 	}
@@ -368,5 +418,9 @@ func initOpsDefAndValidate(reqCtx intctrlutil.RequestCtx,
 			}
 		}
 	}
+	// 3. validate the steps
+	if err := validateCustomOpsSteps(opsDef, customSpec); err != nil {
+		return intctrlutil.NewFatalError(err.Error())
+	}
 	return nil
 }
@@ -0,0 +1,85 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package custom
+
+import (
+	"encoding/json"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+// CollectOutputs collects the outputs of the succeeded action tasks from the termination messages of their containers.
+// A termination message is expected to be a JSON object with string values, other messages are ignored.
+func CollectOutputs(actionCtx ActionContext, tasks []opsv1alpha1.ActionTask) (map[string]string, error) {
+	outputs := map[string]string{}
+	for _, task := range tasks {
+		if task.Status != opsv1alpha1.SucceedActionTaskStatus {
+			continue
+		}
+		pods, err := actionCtx.listTaskPods(task)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods {
+			if pod.Status.Phase != corev1.PodSucceeded {
+				continue
+			}
+			for _, status := range pod.Status.ContainerStatuses {
+				if status.State.Terminated == nil || len(status.State.Terminated.Message) == 0 {
+					continue
+				}
+				values := map[string]string{}
+				if err = json.Unmarshal([]byte(status.State.Terminated.Message), &values); err != nil {
+					continue
+				}
+				for k, v := range values {
+					outputs[k] = v
+				}
+			}
+		}
+	}
+	return outputs, nil
+}
+
+func (actionCtx ActionContext) listTaskPods(task opsv1alpha1.ActionTask) ([]corev1.Pod, error) {
+	name := getNameFromObjectKey(task.ObjectKey)
+	switch getKindFromObjectKey(task.ObjectKey) {
+	case constant.PodKind:
+		pod := &corev1.Pod{}
+		if err := actionCtx.Client.Get(actionCtx.ReqCtx.Ctx, client.ObjectKey{Name: name, Namespace: task.Namespace}, pod); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return []corev1.Pod{*pod}, nil
+	case constant.JobKind:
+		podList := &corev1.PodList{}
+		if err := actionCtx.Client.List(actionCtx.ReqCtx.Ctx, podList, client.InNamespace(task.Namespace),
+			client.MatchingLabels{batchv1.JobNameLabel: name}); err != nil {
+			return nil, err
+		}
+		return podList.Items, nil
+	default:
+		return nil, nil
+	}
+}
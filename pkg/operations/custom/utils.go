@@ -425,6 +425,14 @@ func getNameFromObjectKey(objectKey string) string {
 	return objectKey
 }
 
+func getKindFromObjectKey(objectKey string) string {
+	strs := strings.Split(objectKey, "/")
+	if len(strs) == 2 {
+		return strs[0]
+	}
+	return ""
+}
+
 func getTolerations(cluster *appsv1.Cluster, compSpec *appsv1.ClusterComponentSpec) []corev1.Toleration {
 	schedulePolicy := scheduling.BuildSchedulingPolicy(cluster, compSpec)
 	if schedulePolicy == nil {
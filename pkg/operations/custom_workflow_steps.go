@@ -0,0 +1,545 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/operations/custom"
+)
+
+const (
+	stepProgressKind     = "Step"
+	stepRollbackGroup    = "rollback"
+	defaultStepBackoff   = 10 * time.Second
+	stepConditionVarName = "steps"
+)
+
+// RunSteps runs the steps of the workflow in the order of their dependencies, it returns the workflow status
+// and the duration to wait for the next retry of the failed steps.
+func (w *WorkflowContext) RunSteps(steps []opsv1alpha1.CustomOpsStep) (*WorkflowStatus, time.Duration, error) {
+	sortedSteps, err := sortCustomOpsSteps(steps)
+	if err != nil {
+		return nil, 0, intctrlutil.NewFatalError(err.Error())
+	}
+	var (
+		workflowStatus = &WorkflowStatus{}
+		requeueAfter   time.Duration
+		aborted        = w.stepsAborted(sortedSteps)
+		running        bool
+	)
+	for i := range sortedSteps {
+		step := &sortedSteps[i]
+		progress, err := w.runStep(step, aborted, &requeueAfter)
+		if err != nil {
+			return nil, 0, err
+		}
+		switch progress.Status {
+		case opsv1alpha1.ProcessingProgressStatus:
+			running = true
+		case opsv1alpha1.FailedProgressStatus:
+			if w.stepFailurePolicy(step) == opsv1alpha1.FailurePolicyFail {
+				aborted = true
+				done, err := w.rollbackStep(step)
+				if err != nil {
+					return nil, 0, err
+				}
+				running = running || !done
+			}
+			workflowStatus.CompletedCount += 1
+		case opsv1alpha1.SucceedProgressStatus, opsv1alpha1.SkippedProgressStatus:
+			workflowStatus.CompletedCount += 1
+		}
+	}
+	workflowStatus.ExistFailure = aborted
+	workflowStatus.IsCompleted = !running && (aborted || workflowStatus.CompletedCount == len(sortedSteps))
+	return workflowStatus, requeueAfter, nil
+}
+
+// runStep moves the step forward and returns its progress, the step is not started if the workflow is aborted.
+func (w *WorkflowContext) runStep(step *opsv1alpha1.CustomOpsStep, aborted bool, requeueAfter *time.Duration) (*opsv1alpha1.ProgressStatusDetail, error) {
+	compStatus := w.OpsRes.OpsRequest.Status.Components[step.ComponentName]
+	defer func() {
+		w.OpsRes.OpsRequest.Status.Components[step.ComponentName] = compStatus
+	}()
+	progress := findStatusProgressDetail(compStatus.ProgressDetails, stepProgressObjectKey(step.Name))
+	if progress == nil {
+		return nil, intctrlutil.NewFatalError("can not find the progress for step " + step.Name)
+	}
+	newProgress := *progress
+	switch progress.Status {
+	case opsv1alpha1.PendingProgressStatus:
+		if aborted || !w.stepDependenciesCompleted(step) {
+			return progress, nil
+		}
+		if wait := stepBackoff(step, progress); wait > 0 {
+			setRequeueAfter(requeueAfter, wait)
+			return progress, nil
+		}
+		run, err := w.evaluateStepCondition(step)
+		if err != nil {
+			newProgress.SetStatusAndMessage(opsv1alpha1.FailedProgressStatus,
+				fmt.Sprintf(`failed to evaluate the condition of the step "%s": %s`, step.Name, err.Error()))
+			break
+		}
+		if !run {
+			newProgress.SetStatusAndMessage(opsv1alpha1.SkippedProgressStatus,
+				fmt.Sprintf(`the step "%s" is skipped as the condition is not met`, step.Name))
+			break
+		}
+		actionStatus, err := w.executeStepAction(step, step.ActionName, stepAttemptName(step.Name, progress.Retries), progress)
+		if err != nil {
+			if !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+				return nil, err
+			}
+			newProgress.SetStatusAndMessage(opsv1alpha1.FailedProgressStatus, err.Error())
+			break
+		}
+		newProgress.ActionTasks = actionStatus.ActionTasks
+		newProgress.EndTime = metav1.Time{}
+		newProgress.SetStatusAndMessage(opsv1alpha1.ProcessingProgressStatus,
+			fmt.Sprintf(`Start to processing the step "%s" with action "%s" of the component %s`, step.Name, step.ActionName, step.ComponentName))
+	case opsv1alpha1.ProcessingProgressStatus:
+		actionStatus, err := w.checkStepAction(step, step.ActionName, stepAttemptName(step.Name, progress.Retries), progress)
+		if err != nil {
+			if !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+				return nil, err
+			}
+			newProgress.SetStatusAndMessage(opsv1alpha1.FailedProgressStatus, err.Error())
+			break
+		}
+		newProgress.ActionTasks = actionStatus.ActionTasks
+		if !actionStatus.IsCompleted {
+			break
+		}
+		if actionStatus.ExistFailure {
+			if step.RetryPolicy != nil && progress.Retries < step.RetryPolicy.MaxRetries {
+				newProgress.Retries += 1
+				newProgress.EndTime = metav1.Now()
+				newProgress.SetStatusAndMessage(opsv1alpha1.PendingProgressStatus,
+					fmt.Sprintf(`the step "%s" is failed, retry it %d/%d`, step.Name, newProgress.Retries, step.RetryPolicy.MaxRetries))
+				setRequeueAfter(requeueAfter, stepBackoff(step, &newProgress))
+				break
+			}
+			newProgress.SetStatusAndMessage(opsv1alpha1.FailedProgressStatus,
+				fmt.Sprintf(`the step "%s" of the component "%s" is Failed`, step.Name, step.ComponentName))
+			break
+		}
+		outputs, err := custom.CollectOutputs(w.actionContext(nil), actionStatus.ActionTasks)
+		if err != nil {
+			return nil, err
+		}
+		newProgress.Outputs = outputs
+		newProgress.SetStatusAndMessage(opsv1alpha1.SucceedProgressStatus,
+			fmt.Sprintf(`the step "%s" of the component "%s" is Succeed`, step.Name, step.ComponentName))
+	default:
+		return progress, nil
+	}
+	setComponentStatusProgressDetail(w.reqCtx.Recorder, w.OpsRes.OpsRequest, &compStatus.ProgressDetails, newProgress)
+	progress = findStatusProgressDetail(compStatus.ProgressDetails, stepProgressObjectKey(step.Name))
+	if !isCompletedProgressStatus(progress.Status) {
+		// the end time of the pending step records when the last attempt failed, which is used to compute the backoff.
+		progress.EndTime = newProgress.EndTime
+	}
+	return progress, nil
+}
+
+// rollbackStep runs the rollback actions of the failed step sequentially, it returns true if all of them are completed.
+func (w *WorkflowContext) rollbackStep(step *opsv1alpha1.CustomOpsStep) (bool, error) {
+	compStatus := w.OpsRes.OpsRequest.Status.Components[step.ComponentName]
+	defer func() {
+		w.OpsRes.OpsRequest.Status.Components[step.ComponentName] = compStatus
+	}()
+	for i, actionName := range step.RollbackActions {
+		objectKey := stepRollbackObjectKey(step.Name, actionName)
+		progress := findStatusProgressDetail(compStatus.ProgressDetails, objectKey)
+		if progress == nil {
+			progress = &opsv1alpha1.ProgressStatusDetail{
+				Group:      stepRollbackGroup,
+				ObjectKey:  objectKey,
+				ActionName: actionName,
+				Status:     opsv1alpha1.PendingProgressStatus,
+			}
+		}
+		newProgress := *progress
+		attemptName := fmt.Sprintf("%s-rb%d", step.Name, i)
+		switch progress.Status {
+		case opsv1alpha1.PendingProgressStatus:
+			actionStatus, err := w.executeStepAction(step, actionName, attemptName, progress)
+			if err != nil {
+				if !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+					return false, err
+				}
+				newProgress.SetStatusAndMessage(opsv1alpha1.FailedProgressStatus, err.Error())
+				break
+			}
+			newProgress.ActionTasks = actionStatus.ActionTasks
+			newProgress.SetStatusAndMessage(opsv1alpha1.ProcessingProgressStatus,
+				fmt.Sprintf(`Start to roll back the step "%s" with action "%s"`, step.Name, actionName))
+		case opsv1alpha1.ProcessingProgressStatus:
+			actionStatus, err := w.checkStepAction(step, actionName, attemptName, progress)
+			if err != nil {
+				if !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+					return false, err
+				}
+				newProgress.SetStatusAndMessage(opsv1alpha1.FailedProgressStatus, err.Error())
+				break
+			}
+			newProgress.ActionTasks = actionStatus.ActionTasks
+			if actionStatus.IsCompleted {
+				status := opsv1alpha1.SucceedProgressStatus
+				if actionStatus.ExistFailure {
+					status = opsv1alpha1.FailedProgressStatus
+				}
+				newProgress.SetStatusAndMessage(status,
+					fmt.Sprintf(`the rollback action "%s" of the step "%s" is %s`, actionName, step.Name, status))
+			}
+		default:
+			// the rollback action is completed, continue to the next one even if it is failed.
+			continue
+		}
+		setComponentStatusProgressDetail(w.reqCtx.Recorder, w.OpsRes.OpsRequest, &compStatus.ProgressDetails, newProgress)
+		if !isCompletedProgressStatus(newProgress.Status) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (w *WorkflowContext) executeStepAction(step *opsv1alpha1.CustomOpsStep, actionName, attemptName string,
+	progress *opsv1alpha1.ProgressStatusDetail) (*custom.ActionStatus, error) {
+	ac, action, err := w.stepAction(step, actionName, attemptName, progress)
+	if err != nil {
+		return nil, err
+	}
+	compSpec := getComponentSpecOrShardingTemplate(w.OpsRes.Cluster, step.ComponentName)
+	actionCtx := w.actionContext(action)
+	actionCtx.Images = w.getImages(compSpec)
+	return ac.Execute(actionCtx)
+}
+
+func (w *WorkflowContext) checkStepAction(step *opsv1alpha1.CustomOpsStep, actionName, attemptName string,
+	progress *opsv1alpha1.ProgressStatusDetail) (*custom.ActionStatus, error) {
+	ac, action, err := w.stepAction(step, actionName, attemptName, progress)
+	if err != nil {
+		return nil, err
+	}
+	return ac.CheckStatus(w.actionContext(action))
+}
+
+// stepAction builds the action of the step, the action is renamed after the attempt to separate the workloads
+// of the steps running the same action and the retries of a step.
+func (w *WorkflowContext) stepAction(step *opsv1alpha1.CustomOpsStep, actionName, attemptName string,
+	progress *opsv1alpha1.ProgressStatusDetail) (custom.OpsAction, *opsv1alpha1.OpsAction, error) {
+	index := slices.IndexFunc(w.OpsRes.OpsDef.Spec.Actions, func(action opsv1alpha1.OpsAction) bool {
+		return action.Name == actionName
+	})
+	if index < 0 {
+		return nil, nil, intctrlutil.NewFatalError(fmt.Sprintf(`the action "%s" is not defined in the OpsDefinition`, actionName))
+	}
+	action := w.OpsRes.OpsDef.Spec.Actions[index].DeepCopy()
+	action.Name = attemptName
+
+	compCustomSpec, err := w.stepComponentSpec(step)
+	if err != nil {
+		return nil, nil, err
+	}
+	compSpec := getComponentSpecOrShardingTemplate(w.OpsRes.Cluster, step.ComponentName)
+	ac := w.getAction(*action, compCustomSpec, compSpec, *progress)
+	if ac == nil {
+		return nil, nil, intctrlutil.NewFatalError("the action type is not implement for action " + actionName)
+	}
+	return ac, action, nil
+}
+
+// stepComponentSpec returns the component spec with the parameters of the step merged.
+func (w *WorkflowContext) stepComponentSpec(step *opsv1alpha1.CustomOpsStep) (*opsv1alpha1.CustomOpsComponent, error) {
+	customSpec := w.OpsRes.OpsRequest.Spec.CustomOps
+	index := slices.IndexFunc(customSpec.CustomOpsComponents, func(comp opsv1alpha1.CustomOpsComponent) bool {
+		return comp.ComponentName == step.ComponentName
+	})
+	if index < 0 {
+		return nil, intctrlutil.NewFatalError(fmt.Sprintf(`the component "%s" of the step "%s" is not found`, step.ComponentName, step.Name))
+	}
+	compCustomSpec := customSpec.CustomOpsComponents[index].DeepCopy()
+	for _, param := range step.Parameters {
+		progress := w.stepProgress(param.StepName)
+		if progress == nil {
+			return nil, intctrlutil.NewFatalError(fmt.Sprintf(`the step "%s" is not found`, param.StepName))
+		}
+		value, ok := progress.Outputs[param.OutputKey]
+		if !ok {
+			return nil, intctrlutil.NewFatalError(fmt.Sprintf(`the output "%s" of the step "%s" is not found`, param.OutputKey, param.StepName))
+		}
+		compCustomSpec.Parameters = slices.DeleteFunc(compCustomSpec.Parameters, func(p opsv1alpha1.Parameter) bool {
+			return p.Name == param.Name
+		})
+		compCustomSpec.Parameters = append(compCustomSpec.Parameters, opsv1alpha1.Parameter{Name: param.Name, Value: value})
+	}
+	return compCustomSpec, nil
+}
+
+func (w *WorkflowContext) actionContext(action *opsv1alpha1.OpsAction) custom.ActionContext {
+	return custom.ActionContext{ReqCtx: w.reqCtx, Client: w.Cli, Action: action}
+}
+
+// stepProgress returns the progress of the step.
+func (w *WorkflowContext) stepProgress(stepName string) *opsv1alpha1.ProgressStatusDetail {
+	for _, step := range w.OpsRes.OpsRequest.Spec.CustomOps.Steps {
+		if step.Name == stepName {
+			compStatus := w.OpsRes.OpsRequest.Status.Components[step.ComponentName]
+			return findStatusProgressDetail(compStatus.ProgressDetails, stepProgressObjectKey(stepName))
+		}
+	}
+	return nil
+}
+
+// stepsAborted checks whether any of the steps has failed with the `Fail` failure policy, the pending steps
+// must not be started once the workflow is aborted, regardless of their order.
+func (w *WorkflowContext) stepsAborted(steps []opsv1alpha1.CustomOpsStep) bool {
+	for i := range steps {
+		progress := w.stepProgress(steps[i].Name)
+		if progress != nil && progress.Status == opsv1alpha1.FailedProgressStatus &&
+			w.stepFailurePolicy(&steps[i]) == opsv1alpha1.FailurePolicyFail {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *WorkflowContext) stepFailurePolicy(step *opsv1alpha1.CustomOpsStep) opsv1alpha1.FailurePolicyType {
+	for _, action := range w.OpsRes.OpsDef.Spec.Actions {
+		if action.Name == step.ActionName {
+			return action.FailurePolicy
+		}
+	}
+	return opsv1alpha1.FailurePolicyFail
+}
+
+// stepDependenciesCompleted checks whether all the dependencies of the step are completed,
+// a failed dependency is completed only if it fails with the `Ignore` failure policy.
+func (w *WorkflowContext) stepDependenciesCompleted(step *opsv1alpha1.CustomOpsStep) bool {
+	steps := w.OpsRes.OpsRequest.Spec.CustomOps.Steps
+	for _, dep := range step.DependsOn {
+		progress := w.stepProgress(dep)
+		if progress == nil || !isCompletedProgressStatus(progress.Status) {
+			return false
+		}
+		if progress.Status == opsv1alpha1.FailedProgressStatus {
+			index := slices.IndexFunc(steps, func(s opsv1alpha1.CustomOpsStep) bool {
+				return s.Name == dep
+			})
+			if index < 0 || w.stepFailurePolicy(&steps[index]) != opsv1alpha1.FailurePolicyIgnore {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// evaluateStepCondition evaluates the CEL expression of the step over the completed steps.
+func (w *WorkflowContext) evaluateStepCondition(step *opsv1alpha1.CustomOpsStep) (bool, error) {
+	if len(step.When) == 0 {
+		return true, nil
+	}
+	steps := map[string]any{}
+	for _, s := range w.OpsRes.OpsRequest.Spec.CustomOps.Steps {
+		progress := w.stepProgress(s.Name)
+		if progress == nil || !isCompletedProgressStatus(progress.Status) {
+			continue
+		}
+		outputs := map[string]any{}
+		for k, v := range progress.Outputs {
+			outputs[k] = v
+		}
+		steps[s.Name] = map[string]any{
+			"status":  string(progress.Status),
+			"outputs": outputs,
+		}
+	}
+	return evaluateCustomOpsStepCondition(step.When, steps)
+}
+
+func compileCustomOpsStepCondition(expression string) (cel.Program, error) {
+	env, err := cel.NewEnv(cel.Variable(stepConditionVarName, cel.MapType(cel.StringType, cel.DynType)))
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("the expression %q must return a bool", expression)
+	}
+	return env.Program(ast)
+}
+
+func evaluateCustomOpsStepCondition(expression string, steps map[string]any) (bool, error) {
+	prg, err := compileCustomOpsStepCondition(expression)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := prg.Eval(map[string]any{stepConditionVarName: steps})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("the expression %q returns a non-bool value", expression)
+	}
+	return result, nil
+}
+
+// validateCustomOpsSteps validates the steps of the workflow against the components and the OpsDefinition.
+func validateCustomOpsSteps(opsDef *opsv1alpha1.OpsDefinition, customSpec *opsv1alpha1.CustomOps) error {
+	actionDefined := func(name string) bool {
+		return slices.ContainsFunc(opsDef.Spec.Actions, func(action opsv1alpha1.OpsAction) bool {
+			return action.Name == name
+		})
+	}
+	for _, step := range customSpec.Steps {
+		if !slices.ContainsFunc(customSpec.CustomOpsComponents, func(comp opsv1alpha1.CustomOpsComponent) bool {
+			return comp.ComponentName == step.ComponentName
+		}) {
+			return fmt.Errorf(`the component "%s" of the step "%s" is not one of the components`, step.ComponentName, step.Name)
+		}
+		for _, actionName := range append([]string{step.ActionName}, step.RollbackActions...) {
+			if !actionDefined(actionName) {
+				return fmt.Errorf(`the action "%s" of the step "%s" is not defined in the OpsDefinition "%s"`, actionName, step.Name, opsDef.Name)
+			}
+		}
+		for _, param := range step.Parameters {
+			if !slices.Contains(step.DependsOn, param.StepName) {
+				return fmt.Errorf(`the parameter "%s" of the step "%s" refers to the step "%s" which is not a dependency`,
+					param.Name, step.Name, param.StepName)
+			}
+		}
+		if len(step.When) > 0 {
+			if _, err := compileCustomOpsStepCondition(step.When); err != nil {
+				return fmt.Errorf(`invalid condition of the step "%s": %s`, step.Name, err.Error())
+			}
+		}
+	}
+	_, err := sortCustomOpsSteps(customSpec.Steps)
+	return err
+}
+
+// sortCustomOpsSteps sorts the steps in the topological order of their dependencies,
+// the steps without dependencies between them keep their order in the spec.
+func sortCustomOpsSteps(steps []opsv1alpha1.CustomOpsStep) ([]opsv1alpha1.CustomOpsStep, error) {
+	indexes := map[string]int{}
+	for i, step := range steps {
+		if _, ok := indexes[step.Name]; ok {
+			return nil, fmt.Errorf(`duplicate step "%s"`, step.Name)
+		}
+		indexes[step.Name] = i
+	}
+	inDegrees := make([]int, len(steps))
+	dependents := make([][]int, len(steps))
+	for i, step := range steps {
+		for _, dep := range step.DependsOn {
+			j, ok := indexes[dep]
+			if !ok {
+				return nil, fmt.Errorf(`the dependency "%s" of the step "%s" is not found`, dep, step.Name)
+			}
+			inDegrees[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+	sorted := make([]opsv1alpha1.CustomOpsStep, 0, len(steps))
+	visited := make([]bool, len(steps))
+	for len(sorted) < len(steps) {
+		next := -1
+		for i := range steps {
+			if !visited[i] && inDegrees[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil, fmt.Errorf("cycle found in the dependencies of the steps")
+		}
+		visited[next] = true
+		sorted = append(sorted, steps[next])
+		for _, d := range dependents[next] {
+			inDegrees[d]--
+		}
+	}
+	return sorted, nil
+}
+
+// stepBackoff returns the duration to wait before retrying the step.
+func stepBackoff(step *opsv1alpha1.CustomOpsStep, progress *opsv1alpha1.ProgressStatusDetail) time.Duration {
+	if progress.Retries == 0 || progress.EndTime.IsZero() {
+		return 0
+	}
+	backoff := defaultStepBackoff
+	if step.RetryPolicy != nil && step.RetryPolicy.BackoffSeconds > 0 {
+		backoff = time.Duration(step.RetryPolicy.BackoffSeconds) * time.Second
+	}
+	backoff <<= progress.Retries - 1
+	return time.Until(progress.EndTime.Add(backoff))
+}
+
+func setRequeueAfter(requeueAfter *time.Duration, wait time.Duration) {
+	if wait > 0 && (*requeueAfter == 0 || wait < *requeueAfter) {
+		*requeueAfter = wait
+	}
+}
+
+func stepAttemptName(stepName string, retries int32) string {
+	if retries == 0 {
+		return stepName
+	}
+	return fmt.Sprintf("%s-%d", stepName, retries)
+}
+
+func stepProgressObjectKey(stepName string) string {
+	return getProgressObjectKey(stepProgressKind, stepName)
+}
+
+func stepRollbackObjectKey(stepName, actionName string) string {
+	return fmt.Sprintf("%s/%s/%s", stepProgressObjectKey(stepName), stepRollbackGroup, actionName)
+}
+
+// initStepProgressDetails returns the progress details of the steps running on the component.
+func initStepProgressDetails(steps []opsv1alpha1.CustomOpsStep, componentName string) []opsv1alpha1.ProgressStatusDetail {
+	var details []opsv1alpha1.ProgressStatusDetail
+	for _, step := range steps {
+		if step.ComponentName != componentName {
+			continue
+		}
+		details = append(details, opsv1alpha1.ProgressStatusDetail{
+			Group:      step.Name,
+			ObjectKey:  stepProgressObjectKey(step.Name),
+			ActionName: step.ActionName,
+			Status:     opsv1alpha1.PendingProgressStatus,
+		})
+	}
+	return details
+}
@@ -0,0 +1,219 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+)
+
+func TestSortCustomOpsSteps(t *testing.T) {
+	steps := []opsv1alpha1.CustomOpsStep{
+		{Name: "verify", DependsOn: []string{"backup", "restore"}},
+		{Name: "restore", DependsOn: []string{"backup"}},
+		{Name: "backup"},
+		{Name: "notify"},
+	}
+	sorted, err := sortCustomOpsSteps(steps)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for _, step := range sorted {
+		names = append(names, step.Name)
+	}
+	if got, want := strings.Join(names, ","), "backup,restore,verify,notify"; got != want {
+		t.Fatalf("sorted steps = %s, want %s", got, want)
+	}
+
+	steps[2].DependsOn = []string{"verify"}
+	if _, err = sortCustomOpsSteps(steps); err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Fatalf("expected a cycle error, got %v", err)
+	}
+
+	steps[2].DependsOn = []string{"unknown"}
+	if _, err = sortCustomOpsSteps(steps); err == nil || !strings.Contains(err.Error(), "unknown") {
+		t.Fatalf("expected an unknown dependency error, got %v", err)
+	}
+}
+
+func TestValidateCustomOpsSteps(t *testing.T) {
+	opsDef := &opsv1alpha1.OpsDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "ops-def"},
+		Spec: opsv1alpha1.OpsDefinitionSpec{
+			Actions: []opsv1alpha1.OpsAction{{Name: "backup"}, {Name: "restore"}, {Name: "cleanup"}},
+		},
+	}
+	newCustomOps := func() *opsv1alpha1.CustomOps {
+		return &opsv1alpha1.CustomOps{
+			OpsDefinitionName:   opsDef.Name,
+			CustomOpsComponents: []opsv1alpha1.CustomOpsComponent{{ComponentOps: opsv1alpha1.ComponentOps{ComponentName: "mysql"}}},
+			Steps: []opsv1alpha1.CustomOpsStep{
+				{Name: "backup", ComponentName: "mysql", ActionName: "backup"},
+				{
+					Name:            "restore",
+					ComponentName:   "mysql",
+					ActionName:      "restore",
+					DependsOn:       []string{"backup"},
+					When:            `steps.backup.status == "Succeed"`,
+					Parameters:      []opsv1alpha1.CustomOpsStepParameter{{Name: "backupName", StepName: "backup", OutputKey: "name"}},
+					RollbackActions: []string{"cleanup"},
+				},
+			},
+		}
+	}
+	if err := validateCustomOpsSteps(opsDef, newCustomOps()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, mutate := range map[string]func(*opsv1alpha1.CustomOps){
+		"unknown component":   func(c *opsv1alpha1.CustomOps) { c.Steps[0].ComponentName = "redis" },
+		"unknown action":      func(c *opsv1alpha1.CustomOps) { c.Steps[0].ActionName = "unknown" },
+		"unknown rollback":    func(c *opsv1alpha1.CustomOps) { c.Steps[1].RollbackActions = []string{"unknown"} },
+		"parameter not a dep": func(c *opsv1alpha1.CustomOps) { c.Steps[1].DependsOn = nil },
+		"invalid condition":   func(c *opsv1alpha1.CustomOps) { c.Steps[1].When = "steps.backup.status ==" },
+		"non-bool condition":  func(c *opsv1alpha1.CustomOps) { c.Steps[1].When = `"backup"` },
+		"cycle": func(c *opsv1alpha1.CustomOps) {
+			c.Steps[0].DependsOn = []string{"restore"}
+		},
+	} {
+		customOps := newCustomOps()
+		mutate(customOps)
+		if err := validateCustomOpsSteps(opsDef, customOps); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestEvaluateCustomOpsStepCondition(t *testing.T) {
+	steps := map[string]any{
+		"backup": map[string]any{
+			"status":  "Succeed",
+			"outputs": map[string]any{"size": "10"},
+		},
+	}
+	for expression, want := range map[string]bool{
+		`steps.backup.status == "Succeed"`:                       true,
+		`int(steps.backup.outputs.size) > 100`:                   false,
+		`"restore" in steps && steps.restore.status == "Failed"`: false,
+		`has(steps.backup.outputs.size)`:                         true,
+	} {
+		got, err := evaluateCustomOpsStepCondition(expression, steps)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", expression, err)
+		}
+		if got != want {
+			t.Fatalf("%s = %v, want %v", expression, got, want)
+		}
+	}
+	if _, err := evaluateCustomOpsStepCondition(`steps.restore.status == "Succeed"`, steps); err == nil {
+		t.Fatalf("expected an error when referring to a step that is not completed")
+	}
+}
+
+func TestStepBackoff(t *testing.T) {
+	step := &opsv1alpha1.CustomOpsStep{
+		RetryPolicy: &opsv1alpha1.CustomOpsStepRetryPolicy{MaxRetries: 3, BackoffSeconds: 60},
+	}
+	progress := &opsv1alpha1.ProgressStatusDetail{}
+	if wait := stepBackoff(step, progress); wait != 0 {
+		t.Fatalf("backoff of the first attempt = %v, want 0", wait)
+	}
+	progress.Retries = 2
+	progress.EndTime = metav1.Now()
+	if wait := stepBackoff(step, progress); wait <= time.Minute || wait > 2*time.Minute {
+		t.Fatalf("backoff of the second retry = %v, want (1m, 2m]", wait)
+	}
+	progress.EndTime = metav1.NewTime(time.Now().Add(-3 * time.Minute))
+	if wait := stepBackoff(step, progress); wait > 0 {
+		t.Fatalf("backoff of the elapsed retry = %v, want <= 0", wait)
+	}
+	if got := stepAttemptName("backup", 2); got != "backup-2" {
+		t.Fatalf("attempt name = %s, want backup-2", got)
+	}
+}
+
+func TestRunStepsAfterFailure(t *testing.T) {
+	newWorkflow := func(failurePolicy opsv1alpha1.FailurePolicyType) *WorkflowContext {
+		steps := []opsv1alpha1.CustomOpsStep{
+			{Name: "notify", ComponentName: "mysql", ActionName: "notify"},
+			{Name: "backup", ComponentName: "mysql", ActionName: "backup"},
+			{Name: "restore", ComponentName: "mysql", ActionName: "notify", DependsOn: []string{"backup"}},
+		}
+		details := initStepProgressDetails(steps, "mysql")
+		details[1].Status = opsv1alpha1.FailedProgressStatus
+		details[2].Status = opsv1alpha1.SucceedProgressStatus
+		return &WorkflowContext{
+			OpsRes: &OpsResource{
+				OpsDef: &opsv1alpha1.OpsDefinition{
+					Spec: opsv1alpha1.OpsDefinitionSpec{
+						Actions: []opsv1alpha1.OpsAction{
+							{Name: "notify", FailurePolicy: opsv1alpha1.FailurePolicyFail},
+							{Name: "backup", FailurePolicy: failurePolicy},
+						},
+					},
+				},
+				OpsRequest: &opsv1alpha1.OpsRequest{
+					Spec: opsv1alpha1.OpsRequestSpec{
+						SpecificOpsRequest: opsv1alpha1.SpecificOpsRequest{
+							CustomOps: &opsv1alpha1.CustomOps{Steps: steps},
+						},
+					},
+					Status: opsv1alpha1.OpsRequestStatus{
+						Components: map[string]opsv1alpha1.OpsRequestComponentStatus{
+							"mysql": {ProgressDetails: details},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// the step "notify" precedes the failed step, but must not be started once the workflow is aborted
+	w := newWorkflow(opsv1alpha1.FailurePolicyFail)
+	status, _, err := w.RunSteps(w.OpsRes.OpsRequest.Spec.CustomOps.Steps)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !status.IsCompleted || !status.ExistFailure {
+		t.Fatalf("workflow status = %+v, want completed with failure", status)
+	}
+	if progress := w.stepProgress("notify"); progress.Status != opsv1alpha1.PendingProgressStatus {
+		t.Fatalf("status of the step notify = %s, want Pending", progress.Status)
+	}
+
+	// only a dependency failed with the Ignore failure policy is completed
+	restore := &w.OpsRes.OpsRequest.Spec.CustomOps.Steps[2]
+	if w.stepDependenciesCompleted(restore) {
+		t.Fatalf("the dependency failed with the Fail policy should not be completed")
+	}
+	w = newWorkflow(opsv1alpha1.FailurePolicyIgnore)
+	if !w.stepDependenciesCompleted(&w.OpsRes.OpsRequest.Spec.CustomOps.Steps[2]) {
+		t.Fatalf("the dependency failed with the Ignore policy should be completed")
+	}
+	if w.stepsAborted(w.OpsRes.OpsRequest.Spec.CustomOps.Steps) {
+		t.Fatalf("the workflow should not be aborted by a step failed with the Ignore policy")
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+type DemoteOpsHandler struct{}
+
+var _ OpsHandler = DemoteOpsHandler{}
+
+func init() {
+	// ToClusterPhase is not defined, because the demotion is carried out by lifecycle actions on the running pods.
+	demoteBehaviour := OpsBehaviour{
+		FromClusterPhases: append(appsv1.GetClusterUpRunningPhases(), appsv1.UpdatingClusterPhase),
+		QueueByCluster:    true,
+		OpsHandler:        DemoteOpsHandler{},
+	}
+
+	opsMgr := GetOpsManager()
+	opsMgr.RegisterOps(opsv1alpha1.DemoteType, demoteBehaviour)
+}
+
+// ActionStartedCondition the started condition when handling the demote request.
+func (d DemoteOpsHandler) ActionStartedCondition(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (*metav1.Condition, error) {
+	return opsv1alpha1.NewDemoteCondition(opsRes.OpsRequest), nil
+}
+
+// Action makes the cluster a standby of the new primary, the fencing is lifted as the standby is kept
+// read-only by the replication. The cluster is marked as bootstrapped, as the data is already in place.
+func (d DemoteOpsHandler) Action(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	cluster := opsRes.Cluster
+	patch := client.MergeFrom(cluster.DeepCopy())
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[constant.StandbyBootstrappedAnnotationKey] = "true"
+	cluster.Spec.Standby = &appsv1.ClusterStandby{
+		PrimaryCluster: opsRes.OpsRequest.Spec.Demote.PrimaryCluster,
+		Namespace:      d.primaryNamespace(opsRes),
+	}
+	cluster.Spec.Fenced = false
+	return cli.Patch(reqCtx.Ctx, cluster, patch)
+}
+
+// ReconcileAction waits for all the components to replicate from the new primary.
+func (d DemoteOpsHandler) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (opsv1alpha1.OpsPhase, time.Duration, error) {
+	primary := strings.Join([]string{d.primaryNamespace(opsRes), opsRes.OpsRequest.Spec.Demote.PrimaryCluster}, "/")
+	demoted, err := replicationSettled(reqCtx, cli, opsRes.Cluster, func(status *appsv1.ComponentReplicationStatus) bool {
+		return status != nil && status.Role == appsv1.StandbyReplicationRole && strings.HasPrefix(status.Source, primary+"/")
+	})
+	if err != nil || !demoted {
+		return opsv1alpha1.OpsRunningPhase, 5 * time.Second, err
+	}
+	return opsv1alpha1.OpsSucceedPhase, 0, nil
+}
+
+// SaveLastConfiguration records last configuration to the OpsRequest.status.lastConfiguration
+func (d DemoteOpsHandler) SaveLastConfiguration(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	return nil
+}
+
+func (d DemoteOpsHandler) primaryNamespace(opsRes *OpsResource) string {
+	if len(opsRes.OpsRequest.Spec.Demote.Namespace) > 0 {
+		return opsRes.OpsRequest.Spec.Demote.Namespace
+	}
+	return opsRes.OpsRequest.Namespace
+}
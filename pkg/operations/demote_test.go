@@ -0,0 +1,96 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+func TestDemote(t *testing.T) {
+	cluster := &appsv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "old-primary", Generation: 1},
+		Spec:       appsv1.ClusterSpec{Fenced: true},
+		Status:     appsv1.ClusterStatus{ObservedGeneration: 1},
+	}
+	comp := newReplicationTestComp("old-primary", "mysql", &appsv1.ComponentReplicationStatus{Role: appsv1.PrimaryReplicationRole})
+	cli := newReplicationTestClient(t, cluster, comp)
+	reqCtx := intctrlutil.RequestCtx{Ctx: context.Background()}
+	opsRes := &OpsResource{
+		Cluster: cluster,
+		OpsRequest: &opsv1alpha1.OpsRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "demote"},
+			Spec: opsv1alpha1.OpsRequestSpec{
+				SpecificOpsRequest: opsv1alpha1.SpecificOpsRequest{
+					Demote: &opsv1alpha1.Demote{PrimaryCluster: "new-primary", Namespace: "dr"},
+				},
+			},
+		},
+	}
+	handler := DemoteOpsHandler{}
+
+	if err := handler.Action(reqCtx, cli, opsRes); err != nil {
+		t.Fatalf("action: %v", err)
+	}
+	if cluster.Spec.Standby == nil || cluster.Spec.Standby.PrimaryCluster != "new-primary" || cluster.Spec.Standby.Namespace != "dr" {
+		t.Fatalf("unexpected standby: %+v", cluster.Spec.Standby)
+	}
+	if cluster.Spec.Fenced {
+		t.Fatal("expected the fencing to be lifted")
+	}
+	if _, ok := cluster.Annotations[constant.StandbyBootstrappedAnnotationKey]; !ok {
+		t.Fatal("expected the demoted cluster to be marked as bootstrapped")
+	}
+
+	phase, _, err := handler.ReconcileAction(reqCtx, cli, opsRes)
+	if err != nil || phase != opsv1alpha1.OpsRunningPhase {
+		t.Fatalf("expected running, got %s, err: %v", phase, err)
+	}
+
+	// the replication status reported before the latest spec is observed is not taken into account
+	comp.Generation = 3
+	if err = cli.Update(reqCtx.Ctx, comp); err != nil {
+		t.Fatalf("update component: %v", err)
+	}
+	comp.Status.Replication = &appsv1.ComponentReplicationStatus{Role: appsv1.StandbyReplicationRole, Source: "dr/new-primary/mysql"}
+	if err = cli.Status().Update(reqCtx.Ctx, comp); err != nil {
+		t.Fatalf("update component: %v", err)
+	}
+	phase, _, err = handler.ReconcileAction(reqCtx, cli, opsRes)
+	if err != nil || phase != opsv1alpha1.OpsRunningPhase {
+		t.Fatalf("expected running, got %s, err: %v", phase, err)
+	}
+
+	comp.Status.ObservedGeneration = 3
+	if err = cli.Status().Update(reqCtx.Ctx, comp); err != nil {
+		t.Fatalf("update component: %v", err)
+	}
+	phase, _, err = handler.ReconcileAction(reqCtx, cli, opsRes)
+	if err != nil || phase != opsv1alpha1.OpsSucceedPhase {
+		t.Fatalf("expected succeed, got %s, err: %v", phase, err)
+	}
+}
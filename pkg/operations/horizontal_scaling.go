@@ -42,6 +42,8 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/controller/model"
 	"github.com/apecloud/kubeblocks/pkg/controller/plan"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dprestore "github.com/apecloud/kubeblocks/pkg/dataprotection/restore"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 )
 
 type horizontalScalingOpsHandler struct{}
@@ -137,7 +139,16 @@ func (hs horizontalScalingOpsHandler) Action(reqCtx intctrlutil.RequestCtx, cli
 				horizontalScaling.ComponentName)
 			return intctrlutil.NewFatalError(errMsg)
 		}
+		if err = validateScalingParameterConstraints(reqCtx, cli, opsRes.Cluster, obj.GetComponentName(), func(comp *appsv1.Component) {
+			comp.Spec.Replicas = replicas
+		}); err != nil {
+			return err
+		}
 		if horizontalScaling.ScaleOut != nil && horizontalScaling.ScaleOut.FromBackup != nil {
+			// reject the backup and the restore time which can not be restored up front.
+			if _, _, err = hs.getBackupObj(reqCtx, cli, opsRes, *horizontalScaling.ScaleOut.FromBackup); err != nil {
+				return err
+			}
 			// Wait for the persistent volume to be restored from backup before proceeding.
 			return nil
 		}
@@ -187,7 +198,7 @@ func (hs horizontalScalingOpsHandler) ReconcileAction(reqCtx intctrlutil.Request
 func (hs horizontalScalingOpsHandler) getBackupObj(reqCtx intctrlutil.RequestCtx,
 	cli client.Client,
 	opsRes *OpsResource,
-	fromBackup opsv1alpha1.FromBackup) (*dpv1alpha1.Backup, error) {
+	fromBackup opsv1alpha1.FromBackup) (*dpv1alpha1.Backup, string, error) {
 	backupNamespace := opsRes.Cluster.Namespace
 	if fromBackup.Namespace != "" {
 		backupNamespace = fromBackup.Namespace
@@ -195,14 +206,19 @@ func (hs horizontalScalingOpsHandler) getBackupObj(reqCtx intctrlutil.RequestCtx
 	backupObj := &dpv1alpha1.Backup{}
 	if err := cli.Get(reqCtx.Ctx, client.ObjectKey{Namespace: backupNamespace, Name: fromBackup.Name}, backupObj); err != nil {
 		if apierrors.IsNotFound(err) {
-			return nil, intctrlutil.NewFatalError(fmt.Sprintf("backup %s not found", fromBackup.Name))
+			return nil, "", intctrlutil.NewFatalError(fmt.Sprintf("backup %s not found", fromBackup.Name))
 		}
-		return nil, err
+		return nil, "", err
+	}
+	if backupObj.Status.Phase != dpv1alpha1.BackupPhaseCompleted &&
+		backupObj.Labels[dptypes.BackupTypeLabelKey] != string(dpv1alpha1.BackupTypeContinuous) {
+		return nil, "", intctrlutil.NewFatalError(fmt.Sprintf("backup %s phase is not completed", fromBackup.Name))
 	}
-	if backupObj.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
-		return nil, intctrlutil.NewFatalError(fmt.Sprintf("backup %s phase is not completed", fromBackup.Name))
+	restoreTime, err := dprestore.ValidateRestorePointInTime(reqCtx.Ctx, cli, fromBackup.RestorePointInTime, backupObj)
+	if err != nil {
+		return nil, "", err
 	}
-	return backupObj, nil
+	return backupObj, restoreTime, nil
 }
 
 func (hs horizontalScalingOpsHandler) createRestore(reqCtx intctrlutil.RequestCtx,
@@ -263,7 +279,7 @@ func (hs horizontalScalingOpsHandler) restoreDataFromBackup(reqCtx intctrlutil.R
 	}
 	// get and check backup
 	fromBackup := horizontalScaling.ScaleOut.FromBackup
-	backupObj, err := hs.getBackupObj(reqCtx, cli, opsRes, *fromBackup)
+	backupObj, restoreTime, err := hs.getBackupObj(reqCtx, cli, opsRes, *fromBackup)
 	if err != nil {
 		return err
 	}
@@ -297,7 +313,7 @@ func (hs horizontalScalingOpsHandler) restoreDataFromBackup(reqCtx intctrlutil.R
 			constant.AppInstanceLabelKey:    opsRes.Cluster.Name,
 			constant.KBAppComponentLabelKey: pgRes.compOps.GetComponentName(),
 		}, 1, int32(podIndexInt))
-		restoreMGR.RestoreTime = fromBackup.RestorePointInTime
+		restoreMGR.RestoreTime = restoreTime
 		restoreMGR.RestoreNamePrefix = string(opsRes.OpsRequest.UID[:8])
 		// check restore status
 		restoreMeta := restoreMGR.GetRestoreObjectMeta(synthesizedComponent, dpv1alpha1.PrepareData, templateName)
@@ -0,0 +1,373 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/lifecycle"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+const (
+	// the variables to pass the filter of the data to the dataDump and dataLoad actions
+	logicalImportDatabasesVar = "KB_DATA_DATABASES"
+	logicalImportTablesVar    = "KB_DATA_TABLES"
+
+	// the output of the progress detail to record the time since when the task is not found from the kb-agent
+	logicalImportTaskLostSinceKey = "taskLostSince"
+	// the task is considered lost if it is not found from the kb-agent for the period,
+	// it tolerates the kb-agent being unreachable or the pod being recreated for a while
+	logicalImportTaskLostGracePeriod = 5 * time.Minute
+)
+
+type logicalImportOpsHandler struct{}
+
+var _ OpsHandler = logicalImportOpsHandler{}
+
+func init() {
+	// ToClusterPhase is not defined, because the data is imported by lifecycle actions on the running pods.
+	logicalImportBehaviour := OpsBehaviour{
+		FromClusterPhases: appsv1.GetClusterUpRunningPhases(),
+		QueueByCluster:    true,
+		OpsHandler:        logicalImportOpsHandler{},
+	}
+
+	opsMgr := GetOpsManager()
+	opsMgr.RegisterOps(opsv1alpha1.LogicalImportType, logicalImportBehaviour)
+}
+
+// ActionStartedCondition the started condition when handling the logical import request.
+func (l logicalImportOpsHandler) ActionStartedCondition(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (*metav1.Condition, error) {
+	return opsv1alpha1.NewLogicalImportCondition(opsRes.OpsRequest), nil
+}
+
+// Action submits the task to the target pods, which streams the data dumped by the source pod and loads it.
+// The task is identified by the UID of the OpsRequest, so it is submitted only once if the action is retried.
+func (l logicalImportOpsHandler) Action(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	opsRequest := opsRes.OpsRequest
+	logicalImport := opsRequest.Spec.LogicalImport
+	source, err := l.dataSource(reqCtx, cli, opsRequest)
+	if err != nil {
+		return err
+	}
+
+	synthesizedComp, err := l.buildSynthesizedComp(reqCtx, cli, opsRes.Cluster.Namespace, opsRes.Cluster.Name, logicalImport.ComponentName)
+	if err != nil {
+		return err
+	}
+	if synthesizedComp.LifecycleActions.ComponentLifecycleActions == nil || !synthesizedComp.LifecycleActions.DataLoad.Defined() {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`the component "%s" does not define the dataLoad lifecycle action`, logicalImport.ComponentName))
+	}
+	pods, err := component.ListOwnedPods(reqCtx.Ctx, cli, synthesizedComp.Namespace, synthesizedComp.ClusterName, synthesizedComp.Name)
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return intctrlutil.NewErrorf(intctrlutil.ErrorTypeNeedWaiting, "waiting for the pods of component %s", logicalImport.ComponentName)
+	}
+
+	// load the data into a writable replica by default, and keep the target deterministic to submit the task only once
+	actions := synthesizedComp.LifecycleActions.ComponentLifecycleActions.DeepCopy()
+	if selector, _ := targetPodSelector(actions.DataLoad); len(selector) == 0 || selector == appsv1.AnyReplica {
+		actions.DataLoad.TargetPodSelector = ""
+		if actions.DataLoad.Exec != nil {
+			actions.DataLoad.Exec.TargetPodSelector = ""
+		}
+	}
+	lfa, err := lifecycle.New(synthesizedComp.Namespace, synthesizedComp.ClusterName, synthesizedComp.Name,
+		actions, synthesizedComp.TemplateVars, preferredDataLoadPod(synthesizedComp.Roles, pods), pods)
+	if err != nil {
+		return err
+	}
+	events, err := lfa.DataLoad(reqCtx.Ctx, cli, nil, string(opsRequest.UID), *source, l.parameters(logicalImport))
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return intctrlutil.NewFatalError(fmt.Sprintf("no pod of component %s accepts the data load task", logicalImport.ComponentName))
+	}
+
+	if opsRequest.Status.Components == nil {
+		opsRequest.Status.Components = make(map[string]opsv1alpha1.OpsRequestComponentStatus)
+	}
+	compStatus := opsv1alpha1.OpsRequestComponentStatus{Phase: appsv1.UpdatingComponentPhase}
+	for _, event := range events {
+		compStatus.ProgressDetails = append(compStatus.ProgressDetails, opsv1alpha1.ProgressStatusDetail{
+			ObjectKey: getProgressObjectKey(constant.PodKind, event.Replica),
+			Status:    opsv1alpha1.ProcessingProgressStatus,
+			Message:   fmt.Sprintf("start to load the data dumped from pod %s/%s", source.Namespace, source.Pod.Name),
+			StartTime: metav1.NewTime(event.StartTime),
+		})
+	}
+	opsRequest.Status.Components[logicalImport.ComponentName] = compStatus
+	opsRequest.Status.Progress = fmt.Sprintf("0/%d", len(events))
+	return nil
+}
+
+// ReconcileAction polls the latest events of the task from the target pods, and reflects them to the progress details.
+func (l logicalImportOpsHandler) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (opsv1alpha1.OpsPhase, time.Duration, error) {
+	opsRequest := opsRes.OpsRequest
+	compName := opsRequest.Spec.LogicalImport.ComponentName
+	compStatus, ok := opsRequest.Status.Components[compName]
+	if !ok || len(compStatus.ProgressDetails) == 0 {
+		return opsv1alpha1.OpsFailedPhase, 0, intctrlutil.NewFatalError(fmt.Sprintf("the progress of component %s is not found", compName))
+	}
+
+	events, err := l.taskEvents(reqCtx, cli, opsRes.Cluster, compName, string(opsRequest.UID), compStatus.ProgressDetails)
+	if err != nil {
+		return opsv1alpha1.OpsRunningPhase, 0, err
+	}
+
+	patch := client.MergeFrom(opsRequest.DeepCopy())
+	var completedCount, failedCount int
+	progressDetails := compStatus.ProgressDetails
+	for _, detail := range compStatus.ProgressDetails {
+		newDetail := logicalImportProgressDetail(detail, events, time.Now())
+		setComponentStatusProgressDetail(opsRes.Recorder, opsRequest, &progressDetails, newDetail)
+		if isCompletedProgressStatus(newDetail.Status) {
+			completedCount++
+		}
+		if newDetail.Status == opsv1alpha1.FailedProgressStatus {
+			failedCount++
+		}
+	}
+	compStatus.ProgressDetails = progressDetails
+	if completedCount == len(progressDetails) {
+		compStatus.Phase = appsv1.RunningComponentPhase
+		if failedCount > 0 {
+			compStatus.Phase = appsv1.FailedComponentPhase
+		}
+	}
+	opsRequest.Status.Components[compName] = compStatus
+	opsRequest.Status.Progress = fmt.Sprintf("%d/%d", completedCount, len(progressDetails))
+	if err = cli.Status().Patch(reqCtx.Ctx, opsRequest, patch); err != nil {
+		return opsv1alpha1.OpsRunningPhase, 0, err
+	}
+
+	switch {
+	case completedCount < len(progressDetails):
+		return opsv1alpha1.OpsRunningPhase, 10 * time.Second, nil
+	case failedCount > 0:
+		return opsv1alpha1.OpsFailedPhase, 0, nil
+	default:
+		return opsv1alpha1.OpsSucceedPhase, 0, nil
+	}
+}
+
+// SaveLastConfiguration records last configuration to the OpsRequest.status.lastConfiguration
+func (l logicalImportOpsHandler) SaveLastConfiguration(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	return nil
+}
+
+// dataSource resolves the source pod to dump the data from.
+func (l logicalImportOpsHandler) dataSource(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRequest *opsv1alpha1.OpsRequest) (*lifecycle.DataSource, error) {
+	source := opsRequest.Spec.LogicalImport.Source
+	namespace := source.Namespace
+	if len(namespace) == 0 {
+		namespace = opsRequest.Namespace
+	}
+	synthesizedComp, err := l.buildSynthesizedComp(reqCtx, cli, namespace, source.ClusterName, source.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	if synthesizedComp.LifecycleActions.ComponentLifecycleActions == nil || !synthesizedComp.LifecycleActions.DataDump.Defined() {
+		return nil, intctrlutil.NewFatalError(fmt.Sprintf(`the source component "%s" does not define the dataDump lifecycle action`, source.ComponentName))
+	}
+	pods, err := component.ListOwnedPods(reqCtx.Ctx, cli, namespace, source.ClusterName, source.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pod *corev1.Pod
+	if len(source.InstanceName) > 0 {
+		idx := slices.IndexFunc(pods, func(p *corev1.Pod) bool { return p.Name == source.InstanceName })
+		if idx < 0 {
+			return nil, intctrlutil.NewFatalError(fmt.Sprintf(`the source instance "%s" not found`, source.InstanceName))
+		}
+		pod = pods[idx]
+	} else if len(pods) > 0 {
+		dataDump := synthesizedComp.LifecycleActions.DataDump.DeepCopy()
+		if selector, _ := targetPodSelector(dataDump); len(selector) == 0 {
+			dataDump.TargetPodSelector = appsv1.AnyReplica
+		}
+		selected, err := lifecycle.SelectTargetPods(pods, nil, dataDump)
+		if err != nil {
+			return nil, err
+		}
+		if len(selected) > 0 {
+			pod = selected[0]
+		}
+	}
+	if pod == nil {
+		return nil, intctrlutil.NewErrorf(intctrlutil.ErrorTypeNeedWaiting, "waiting for the pods of source component %s to dump data", source.ComponentName)
+	}
+	return &lifecycle.DataSource{
+		Namespace:   namespace,
+		ClusterName: source.ClusterName,
+		CompName:    source.ComponentName,
+		Pod:         pod,
+	}, nil
+}
+
+func (l logicalImportOpsHandler) buildSynthesizedComp(reqCtx intctrlutil.RequestCtx, cli client.Client,
+	namespace, clusterName, compName string) (*component.SynthesizedComponent, error) {
+	compObj, compDefObj, err := component.GetCompNCompDefByName(reqCtx.Ctx, cli, namespace, constant.GenerateClusterComponentName(clusterName, compName))
+	if err != nil {
+		return nil, err
+	}
+	return component.BuildSynthesizedComponent(reqCtx.Ctx, cli, compDefObj, compObj)
+}
+
+func (l logicalImportOpsHandler) parameters(logicalImport *opsv1alpha1.LogicalImport) map[string]string {
+	parameters := map[string]string{}
+	for k, v := range logicalImport.Parameters {
+		parameters[k] = v
+	}
+	if len(logicalImport.Databases) > 0 {
+		parameters[logicalImportDatabasesVar] = strings.Join(logicalImport.Databases, ",")
+	}
+	if len(logicalImport.Tables) > 0 {
+		parameters[logicalImportTablesVar] = strings.Join(logicalImport.Tables, ",")
+	}
+	return parameters
+}
+
+// taskEvents queries the latest events of the task from the target pods recorded in the progress details.
+func (l logicalImportOpsHandler) taskEvents(reqCtx intctrlutil.RequestCtx, cli client.Client, cluster *appsv1.Cluster,
+	compName, uid string, progressDetails []opsv1alpha1.ProgressStatusDetail) (map[string]proto.TaskEvent, error) {
+	pods, err := component.ListOwnedPods(reqCtx.Ctx, cli, cluster.Namespace, cluster.Name, compName)
+	if err != nil {
+		return nil, err
+	}
+	pods = slices.DeleteFunc(pods, func(pod *corev1.Pod) bool {
+		detail := findStatusProgressDetail(progressDetails, getProgressObjectKey(constant.PodKind, pod.Name))
+		return detail == nil || isCompletedProgressStatus(detail.Status)
+	})
+	events := map[string]proto.TaskEvent{}
+	if len(pods) == 0 {
+		return events, nil
+	}
+	lfa, err := lifecycle.New(cluster.Namespace, cluster.Name, compName, nil, nil, nil, pods)
+	if err != nil {
+		return nil, err
+	}
+	taskEvents, err := lfa.DataLoadStatus(reqCtx.Ctx, cli, uid)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range taskEvents {
+		events[event.Replica] = event
+	}
+	return events, nil
+}
+
+// targetPodSelector returns the selector of the action, backing off to the one of the exec action.
+func targetPodSelector(action *appsv1.Action) (appsv1.TargetPodSelector, string) {
+	if len(action.TargetPodSelector) == 0 && action.Exec != nil {
+		return action.Exec.TargetPodSelector, action.Exec.MatchingKey
+	}
+	return action.TargetPodSelector, action.MatchingKey
+}
+
+// preferredDataLoadPod returns the pod with the role of the highest update priority, which is usually the writable one.
+func preferredDataLoadPod(roles []appsv1.ReplicaRole, pods []*corev1.Pod) *corev1.Pod {
+	var (
+		target   = pods[0]
+		priority = -1
+	)
+	for _, pod := range pods {
+		role := pod.Labels[constant.RoleLabelKey]
+		for _, r := range roles {
+			if r.Name == role && r.UpdatePriority > priority {
+				target, priority = pod, r.UpdatePriority
+			}
+		}
+	}
+	return target
+}
+
+// logicalImportProgressDetail builds the progress detail of a target pod from the latest event of the task,
+// the task not found is tolerated for a grace period before the progress is marked as failed.
+func logicalImportProgressDetail(detail opsv1alpha1.ProgressStatusDetail, events map[string]proto.TaskEvent, now time.Time) opsv1alpha1.ProgressStatusDetail {
+	if isCompletedProgressStatus(detail.Status) {
+		return detail
+	}
+	_, podName, _ := strings.Cut(detail.ObjectKey, "/")
+	event, ok := events[podName]
+	_, wasLost := detail.Outputs[logicalImportTaskLostSinceKey]
+	detail.Outputs = maps.Clone(detail.Outputs)
+	if ok {
+		delete(detail.Outputs, logicalImportTaskLostSinceKey)
+	}
+	switch {
+	case !ok:
+		lostSince := now
+		if since, err := time.Parse(time.RFC3339, detail.Outputs[logicalImportTaskLostSinceKey]); err == nil {
+			lostSince = since
+		}
+		if now.Sub(lostSince) < logicalImportTaskLostGracePeriod {
+			if detail.Outputs == nil {
+				detail.Outputs = map[string]string{}
+			}
+			detail.Outputs[logicalImportTaskLostSinceKey] = lostSince.UTC().Format(time.RFC3339)
+			detail.Status = opsv1alpha1.ProcessingProgressStatus
+			detail.Message = fmt.Sprintf("the data load task is not found since %s, waiting for the kb-agent to report it",
+				detail.Outputs[logicalImportTaskLostSinceKey])
+		} else {
+			detail.Status = opsv1alpha1.FailedProgressStatus
+			detail.Message = fmt.Sprintf("the data load task is lost for more than %s, the kb-agent may have been restarted",
+				logicalImportTaskLostGracePeriod)
+		}
+	case event.EndTime.IsZero():
+		detail.Status = opsv1alpha1.ProcessingProgressStatus
+		if len(event.Progress) > 0 {
+			detail.Message = fmt.Sprintf("loading data: %s", event.Progress)
+		} else if wasLost {
+			detail.Message = "loading data"
+		}
+	case event.Code == 0:
+		detail.Status = opsv1alpha1.SucceedProgressStatus
+		detail.Message = "the data is loaded successfully"
+	default:
+		detail.Status = opsv1alpha1.FailedProgressStatus
+		detail.Message = fmt.Sprintf("failed to load the data: %s", event.Message)
+	}
+	if isCompletedProgressStatus(detail.Status) {
+		detail.EndTime = metav1.NewTime(event.EndTime)
+		if detail.EndTime.IsZero() {
+			detail.EndTime = metav1.Now()
+		}
+	}
+	return detail
+}
@@ -0,0 +1,127 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/kbagent/proto"
+)
+
+func TestPreferredDataLoadPod(t *testing.T) {
+	newPod := func(name, role string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{constant.RoleLabelKey: role}}}
+	}
+	roles := []appsv1.ReplicaRole{
+		{Name: "secondary", UpdatePriority: 1},
+		{Name: "primary", UpdatePriority: 2},
+	}
+	pods := []*corev1.Pod{newPod("pod-0", "secondary"), newPod("pod-1", "primary"), newPod("pod-2", "secondary")}
+	if pod := preferredDataLoadPod(roles, pods); pod.Name != "pod-1" {
+		t.Fatalf("preferred pod = %s, want pod-1", pod.Name)
+	}
+	if pod := preferredDataLoadPod(nil, pods); pod.Name != "pod-0" {
+		t.Fatalf("preferred pod without roles = %s, want pod-0", pod.Name)
+	}
+}
+
+func TestLogicalImportProgressDetail(t *testing.T) {
+	detail := opsv1alpha1.ProgressStatusDetail{
+		ObjectKey: getProgressObjectKey(constant.PodKind, "pod-0"),
+		Status:    opsv1alpha1.ProcessingProgressStatus,
+	}
+	now := time.Now()
+	lostDetail := func(since time.Time) opsv1alpha1.ProgressStatusDetail {
+		d := detail
+		d.Outputs = map[string]string{logicalImportTaskLostSinceKey: since.UTC().Format(time.RFC3339)}
+		return d
+	}
+	tests := []struct {
+		name   string
+		detail opsv1alpha1.ProgressStatusDetail
+		events map[string]proto.TaskEvent
+		want   opsv1alpha1.ProgressStatus
+		lost   bool
+	}{
+		{
+			name:   "not found",
+			detail: detail,
+			want:   opsv1alpha1.ProcessingProgressStatus,
+			lost:   true,
+		},
+		{
+			name:   "not found within the grace period",
+			detail: lostDetail(now.Add(-time.Minute)),
+			want:   opsv1alpha1.ProcessingProgressStatus,
+			lost:   true,
+		},
+		{
+			name:   "lost",
+			detail: lostDetail(now.Add(-logicalImportTaskLostGracePeriod - time.Minute)),
+			want:   opsv1alpha1.FailedProgressStatus,
+			lost:   true,
+		},
+		{
+			name:   "found again",
+			detail: lostDetail(now.Add(-time.Minute)),
+			events: map[string]proto.TaskEvent{"pod-0": {Replica: "pod-0", StartTime: now}},
+			want:   opsv1alpha1.ProcessingProgressStatus,
+		},
+		{
+			name:   "running",
+			detail: detail,
+			events: map[string]proto.TaskEvent{"pod-0": {Replica: "pod-0", StartTime: now, Progress: "1024 bytes loaded"}},
+			want:   opsv1alpha1.ProcessingProgressStatus,
+		},
+		{
+			name:   "succeed",
+			detail: detail,
+			events: map[string]proto.TaskEvent{"pod-0": {Replica: "pod-0", StartTime: now, EndTime: now}},
+			want:   opsv1alpha1.SucceedProgressStatus,
+		},
+		{
+			name:   "failed",
+			detail: detail,
+			events: map[string]proto.TaskEvent{"pod-0": {Replica: "pod-0", StartTime: now, EndTime: now, Code: -1, Message: "boom"}},
+			want:   opsv1alpha1.FailedProgressStatus,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := logicalImportProgressDetail(tt.detail, tt.events, now)
+			if got.Status != tt.want {
+				t.Fatalf("status = %s, want %s: %s", got.Status, tt.want, got.Message)
+			}
+			if isCompletedProgressStatus(got.Status) == got.EndTime.IsZero() {
+				t.Fatalf("unexpected end time %v for status %s", got.EndTime, got.Status)
+			}
+			if _, lost := got.Outputs[logicalImportTaskLostSinceKey]; lost != tt.lost {
+				t.Fatalf("unexpected outputs %v", got.Outputs)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+func TestValidateOpsMaintenanceWindow(t *testing.T) {
+	now := time.Now()
+	newOpsRes := func(opsType opsv1alpha1.OpsType, force bool) *OpsResource {
+		cluster := &appsv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "mycluster", Namespace: "default"},
+			Spec: appsv1.ClusterSpec{
+				MaintenanceWindowPolicy: &appsv1.MaintenanceWindowPolicy{
+					BlackoutPeriods: []appsv1.BlackoutPeriod{
+						{Start: metav1.NewTime(now.Add(-time.Hour)), End: metav1.NewTime(now.Add(time.Hour))},
+					},
+				},
+			},
+		}
+		ops := &opsv1alpha1.OpsRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "ops", Namespace: "default"},
+			Spec: opsv1alpha1.OpsRequestSpec{
+				ClusterName: cluster.Name,
+				Type:        opsType,
+				Force:       force,
+			},
+			Status: opsv1alpha1.OpsRequestStatus{Phase: opsv1alpha1.OpsPendingPhase},
+		}
+		return &OpsResource{OpsRequest: ops, Cluster: cluster, Recorder: record.NewFakeRecorder(10)}
+	}
+	scheme := runtime.NewScheme()
+	if err := opsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("disruptive ops waits for the window", func(t *testing.T) {
+		opsRes := newOpsRes(opsv1alpha1.RestartType, false)
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(opsRes.OpsRequest).
+			WithStatusSubresource(opsRes.OpsRequest).Build()
+		err := validateOpsMaintenanceWindow(context.Background(), cli, opsRes, OpsBehaviour{})
+		var waitErr *WaitForMaintenanceWindowErr
+		if !errors.As(err, &waitErr) {
+			t.Fatalf("expected WaitForMaintenanceWindowErr, got %v", err)
+		}
+		if !waitErr.nextTime.Equal(now.Add(time.Hour)) {
+			t.Fatalf("next time = %v, want %v", waitErr.nextTime, now.Add(time.Hour))
+		}
+		if !meta.IsStatusConditionTrue(opsRes.OpsRequest.Status.Conditions, opsv1alpha1.ConditionTypeMaintenanceWindow) {
+			t.Fatalf("expected condition %s to be true", opsv1alpha1.ConditionTypeMaintenanceWindow)
+		}
+		if opsRes.OpsRequest.Status.Phase != opsv1alpha1.OpsPendingPhase {
+			t.Fatalf("phase = %s, want %s", opsRes.OpsRequest.Status.Phase, opsv1alpha1.OpsPendingPhase)
+		}
+	})
+
+	t.Run("non-disruptive ops is not blocked", func(t *testing.T) {
+		opsRes := newOpsRes(opsv1alpha1.HorizontalScalingType, false)
+		if err := validateOpsMaintenanceWindow(context.Background(), nil, opsRes, OpsBehaviour{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("force ops bypasses the window", func(t *testing.T) {
+		opsRes := newOpsRes(opsv1alpha1.RestartType, true)
+		if err := validateOpsMaintenanceWindow(context.Background(), nil, opsRes, OpsBehaviour{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("invalid time zone is fatal", func(t *testing.T) {
+		opsRes := newOpsRes(opsv1alpha1.RestartType, false)
+		opsRes.Cluster.Spec.MaintenanceWindowPolicy.TimeZone = ptr.To("Nowhere/Land")
+		err := validateOpsMaintenanceWindow(context.Background(), nil, opsRes, OpsBehaviour{})
+		if !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+			t.Fatalf("expected a fatal error, got %v", err)
+		}
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -84,6 +85,10 @@ func (opsMgr *OpsManager) Do(reqCtx intctrlutil.RequestCtx, cli client.Client, o
 			if _, ok := err.(*WaitForClusterPhaseErr); ok {
 				return intctrlutil.ResultToP(intctrlutil.RequeueAfter(time.Second, reqCtx.Log, "wait cluster to a right phase"))
 			}
+			if e, ok := err.(*WaitForMaintenanceWindowErr); ok {
+				// recheck periodically, the maintenance window policy of the cluster may be changed in the meantime
+				return intctrlutil.ResultToP(intctrlutil.RequeueAfter(min(time.Until(e.nextTime), maintenanceWindowRecheckInterval), reqCtx.Log, e.Error()))
+			}
 			return nil, err
 		}
 		return intctrlutil.ResultToP(intctrlutil.Reconciled())
@@ -109,6 +114,10 @@ func (opsMgr *OpsManager) doPreConditionAndTransPhaseToCreating(reqCtx intctrlut
 	cli client.Client,
 	opsRes *OpsResource,
 	opsBehaviour OpsBehaviour) error {
+	// disruptive operations are only carried out within the maintenance windows of the cluster
+	if err := validateOpsMaintenanceWindow(reqCtx.Ctx, cli, opsRes, opsBehaviour); err != nil {
+		return err
+	}
 	if opsBehaviour.QueueByCluster || opsBehaviour.QueueBySelf {
 		// if ToClusterPhase is not empty, enqueue OpsRequest to the cluster Annotation.
 		opsRecorde, err := enqueueOpsRequestToClusterAnnotation(reqCtx.Ctx, cli, opsRes, opsBehaviour)
@@ -145,6 +154,9 @@ func (opsMgr *OpsManager) doPreConditionAndTransPhaseToCreating(reqCtx intctrlut
 		}
 	}
 	opsDeepCopy := opsRes.OpsRequest.DeepCopy()
+	if meta.IsStatusConditionTrue(opsRes.OpsRequest.Status.Conditions, opsv1alpha1.ConditionTypeMaintenanceWindow) {
+		opsRes.OpsRequest.SetStatusCondition(*opsv1alpha1.NewMaintenanceWindowOpenCondition(opsRes.OpsRequest))
+	}
 	// save last configuration into status.lastConfiguration
 	if err = opsBehaviour.OpsHandler.SaveLastConfiguration(reqCtx, cli, opsRes); err != nil {
 		return err
@@ -42,10 +42,10 @@ func getProgressObjectKey(kind, name string) string {
 	return fmt.Sprintf("%s/%s", kind, name)
 }
 
-// isCompletedProgressStatus checks the progress detail with final state, either Failed or Succeed.
+// isCompletedProgressStatus checks the progress detail with final state, either Failed, Succeed or Skipped.
 func isCompletedProgressStatus(status opsv1alpha1.ProgressStatus) bool {
 	return slices.Contains([]opsv1alpha1.ProgressStatus{opsv1alpha1.SucceedProgressStatus,
-		opsv1alpha1.FailedProgressStatus}, status)
+		opsv1alpha1.FailedProgressStatus, opsv1alpha1.SkippedProgressStatus}, status)
 }
 
 // setComponentStatusProgressDetail sets the corresponding progressDetail in progressDetails to newProgressDetail.
@@ -84,6 +84,8 @@ func setComponentStatusProgressDetail(
 	existingProgressDetail.Status = newProgressDetail.Status
 	existingProgressDetail.Message = newProgressDetail.Message
 	existingProgressDetail.ActionTasks = newProgressDetail.ActionTasks
+	existingProgressDetail.Retries = newProgressDetail.Retries
+	existingProgressDetail.Outputs = newProgressDetail.Outputs
 	updateProgressDetailTime(existingProgressDetail)
 	sendProgressDetailEvent(recorder, opsRequest, newProgressDetail)
 }
@@ -125,6 +127,8 @@ func getProgressDetailEventReason(status opsv1alpha1.ProgressStatus) string {
 		return "Processing"
 	case opsv1alpha1.FailedProgressStatus:
 		return "Failed"
+	case opsv1alpha1.SkippedProgressStatus:
+		return "Skipped"
 	}
 	return ""
 }
@@ -27,6 +27,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -49,6 +50,20 @@ func (e *WaitForClusterPhaseErr) Error() string {
 	return fmt.Sprintf("wait for cluster %s to reach phase %v, current status is :%s", e.clusterName, e.expectedPhase, e.currentPhase)
 }
 
+// maintenanceWindowRecheckInterval is the max interval to recheck the maintenance window of an OpsRequest.
+const maintenanceWindowRecheckInterval = 5 * time.Minute
+
+var _ error = &WaitForMaintenanceWindowErr{}
+
+type WaitForMaintenanceWindowErr struct {
+	clusterName string
+	nextTime    time.Time
+}
+
+func (e *WaitForMaintenanceWindowErr) Error() string {
+	return fmt.Sprintf("wait for the maintenance window of cluster %s, which opens at %s", e.clusterName, e.nextTime.Format(time.RFC3339))
+}
+
 type handleStatusProgressWithComponent func(reqCtx intctrlutil.RequestCtx,
 	cli client.Client,
 	opsRes *OpsResource,
@@ -211,6 +226,33 @@ func validateOpsNeedWaitingClusterPhase(cluster *appsv1.Cluster, ops *opsv1alpha
 	}
 }
 
+// validateOpsMaintenanceWindow checks whether the disruptive OpsRequest is allowed to run under the maintenance window policy
+// of the cluster. If not, the OpsRequest keeps Pending with a WaitForMaintenanceWindow condition until the next window opens.
+func validateOpsMaintenanceWindow(ctx context.Context, cli client.Client, opsRes *OpsResource, opsBehaviour OpsBehaviour) error {
+	ops := opsRes.OpsRequest
+	if ops.Force() || opsBehaviour.IsClusterCreation || opsRes.Cluster == nil {
+		return nil
+	}
+	allowed, next, err := opsRes.Cluster.Spec.MaintenanceWindowPolicy.Allows(string(ops.Spec.Type), time.Now())
+	if err != nil {
+		return intctrlutil.NewFatalError(err.Error())
+	}
+	if allowed {
+		return nil
+	}
+	condition := opsv1alpha1.NewWaitForMaintenanceWindowCondition(ops, next)
+	if existing := meta.FindStatusCondition(ops.Status.Conditions, condition.Type); existing == nil ||
+		existing.Status != condition.Status || existing.Message != condition.Message {
+		if err = PatchOpsStatus(ctx, cli, opsRes, opsv1alpha1.OpsPendingPhase, condition); err != nil {
+			return err
+		}
+	}
+	return &WaitForMaintenanceWindowErr{
+		clusterName: opsRes.Cluster.Name,
+		nextTime:    next,
+	}
+}
+
 func preConditionDeadlineSecondsIsSet(ops *opsv1alpha1.OpsRequest) bool {
 	return ops.Spec.PreConditionDeadlineSeconds != nil && *ops.Spec.PreConditionDeadlineSeconds != 0
 }
@@ -0,0 +1,171 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+// defaultFencingTimeoutSeconds is the default duration to wait for the old primary Cluster to be fenced.
+const defaultFencingTimeoutSeconds = 300
+
+type PromoteOpsHandler struct{}
+
+var _ OpsHandler = PromoteOpsHandler{}
+
+func init() {
+	// ToClusterPhase is not defined, because the promotion is carried out by lifecycle actions on the running pods.
+	promoteBehaviour := OpsBehaviour{
+		FromClusterPhases: append(appsv1.GetClusterUpRunningPhases(), appsv1.UpdatingClusterPhase),
+		QueueByCluster:    true,
+		OpsHandler:        PromoteOpsHandler{},
+	}
+
+	opsMgr := GetOpsManager()
+	opsMgr.RegisterOps(opsv1alpha1.PromoteType, promoteBehaviour)
+}
+
+// ActionStartedCondition the started condition when handling the promote request.
+func (p PromoteOpsHandler) ActionStartedCondition(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (*metav1.Condition, error) {
+	return opsv1alpha1.NewPromoteCondition(opsRes.OpsRequest), nil
+}
+
+// Action fences the old primary cluster first, to keep it from accepting writes after the standby is promoted.
+func (p PromoteOpsHandler) Action(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	if p.skipFencing(opsRes) || opsRes.Cluster.Spec.Standby == nil {
+		return nil
+	}
+	primary := &appsv1.Cluster{}
+	if err := cli.Get(reqCtx.Ctx, component.StandbyPrimaryKey(opsRes.Cluster), primary); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if primary.Spec.Fenced {
+		return nil
+	}
+	patch := client.MergeFrom(primary.DeepCopy())
+	primary.Spec.Fenced = true
+	return cli.Patch(reqCtx.Ctx, primary, patch)
+}
+
+// ReconcileAction promotes the standby cluster after the old primary has been fenced, and waits for
+// all the components to be promoted.
+func (p PromoteOpsHandler) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (opsv1alpha1.OpsPhase, time.Duration, error) {
+	cluster := opsRes.Cluster
+	if cluster.Spec.Standby != nil {
+		if !p.skipFencing(opsRes) {
+			fenced, err := p.primaryFenced(reqCtx, cli, cluster)
+			if err != nil {
+				return opsv1alpha1.OpsRunningPhase, 5 * time.Second, err
+			}
+			if !fenced {
+				if timeout := p.fencingTimeout(opsRes); p.fencingTimedOut(opsRes, timeout) {
+					return opsv1alpha1.OpsFailedPhase, 0, intctrlutil.NewFatalError(fmt.Sprintf(
+						"the primary cluster %s has not been fenced in %s, retry with skipFencing if it is unreachable",
+						component.StandbyPrimaryKey(cluster), timeout))
+				}
+				return opsv1alpha1.OpsRunningPhase, 5 * time.Second, nil
+			}
+		}
+		patch := client.MergeFrom(cluster.DeepCopy())
+		cluster.Spec.Standby = nil
+		if err := cli.Patch(reqCtx.Ctx, cluster, patch); err != nil {
+			return opsv1alpha1.OpsRunningPhase, 0, err
+		}
+		return opsv1alpha1.OpsRunningPhase, 5 * time.Second, nil
+	}
+
+	promoted, err := replicationSettled(reqCtx, cli, cluster, func(status *appsv1.ComponentReplicationStatus) bool {
+		return status == nil || status.Role != appsv1.StandbyReplicationRole
+	})
+	if err != nil || !promoted {
+		return opsv1alpha1.OpsRunningPhase, 5 * time.Second, err
+	}
+	return opsv1alpha1.OpsSucceedPhase, 0, nil
+}
+
+// SaveLastConfiguration records last configuration to the OpsRequest.status.lastConfiguration
+func (p PromoteOpsHandler) SaveLastConfiguration(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	return nil
+}
+
+func (p PromoteOpsHandler) skipFencing(opsRes *OpsResource) bool {
+	return opsRes.OpsRequest.Spec.Promote != nil && opsRes.OpsRequest.Spec.Promote.SkipFencing
+}
+
+func (p PromoteOpsHandler) fencingTimeout(opsRes *OpsResource) time.Duration {
+	promote := opsRes.OpsRequest.Spec.Promote
+	if promote != nil && promote.FencingTimeoutSeconds != nil {
+		return time.Duration(*promote.FencingTimeoutSeconds) * time.Second
+	}
+	return defaultFencingTimeoutSeconds * time.Second
+}
+
+// fencingTimedOut checks whether the old primary has not been fenced in time since the OpsRequest started.
+func (p PromoteOpsHandler) fencingTimedOut(opsRes *OpsResource, timeout time.Duration) bool {
+	startTime := opsRes.OpsRequest.Status.StartTimestamp
+	return !startTime.IsZero() && time.Since(startTime.Time) > timeout
+}
+
+// primaryFenced checks whether the old primary has been fenced, a deleted primary is taken as fenced.
+func (p PromoteOpsHandler) primaryFenced(reqCtx intctrlutil.RequestCtx, cli client.Client, cluster *appsv1.Cluster) (bool, error) {
+	primary := &appsv1.Cluster{}
+	if err := cli.Get(reqCtx.Ctx, component.StandbyPrimaryKey(cluster), primary); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return primary.Status.Replication != nil && primary.Status.Replication.Fenced, nil
+}
+
+// replicationSettled checks whether the replication status of all the components of the cluster meets the expectation,
+// the status is taken into account only after the latest spec of the cluster and components has been observed.
+func replicationSettled(reqCtx intctrlutil.RequestCtx, cli client.Client, cluster *appsv1.Cluster,
+	settled func(status *appsv1.ComponentReplicationStatus) bool) (bool, error) {
+	if cluster.Status.ObservedGeneration < cluster.Generation {
+		return false, nil
+	}
+	compList := &appsv1.ComponentList{}
+	if err := cli.List(reqCtx.Ctx, compList, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels(constant.GetClusterLabels(cluster.Name))); err != nil {
+		return false, err
+	}
+	for i := range compList.Items {
+		comp := &compList.Items[i]
+		if _, ok := comp.Labels[constant.KBAppShardingNameLabelKey]; ok {
+			continue // the shards do not replicate from the primary
+		}
+		if comp.Status.ObservedGeneration < comp.Generation || !settled(comp.Status.Replication) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
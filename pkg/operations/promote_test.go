@@ -0,0 +1,215 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+func newReplicationTestClient(t *testing.T, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add apps scheme: %v", err)
+	}
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&appsv1.Cluster{}, &appsv1.Component{}).
+		WithObjects(objs...).
+		Build()
+}
+
+func newReplicationTestComp(clusterName, compName string, replication *appsv1.ComponentReplicationStatus) *appsv1.Component {
+	return &appsv1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       constant.GenerateClusterComponentName(clusterName, compName),
+			Labels:     constant.GetCompLabels(clusterName, compName),
+			Generation: 2,
+		},
+		Status: appsv1.ComponentStatus{
+			ObservedGeneration: 2,
+			Replication:        replication,
+		},
+	}
+}
+
+func TestPromoteFencesThePrimaryFirst(t *testing.T) {
+	primary := &appsv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "primary"}}
+	standby := &appsv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "standby", Generation: 1},
+		Spec: appsv1.ClusterSpec{
+			Standby: &appsv1.ClusterStandby{PrimaryCluster: "primary"},
+		},
+		Status: appsv1.ClusterStatus{ObservedGeneration: 1},
+	}
+	comp := newReplicationTestComp("standby", "mysql", &appsv1.ComponentReplicationStatus{Role: appsv1.StandbyReplicationRole})
+	cli := newReplicationTestClient(t, primary, standby, comp)
+	reqCtx := intctrlutil.RequestCtx{Ctx: context.Background()}
+	opsRes := &OpsResource{
+		Cluster:    standby,
+		OpsRequest: &opsv1alpha1.OpsRequest{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "promote"}},
+	}
+	handler := PromoteOpsHandler{}
+
+	if err := handler.Action(reqCtx, cli, opsRes); err != nil {
+		t.Fatalf("action: %v", err)
+	}
+	if err := cli.Get(reqCtx.Ctx, client.ObjectKeyFromObject(primary), primary); err != nil {
+		t.Fatalf("get primary: %v", err)
+	}
+	if !primary.Spec.Fenced {
+		t.Fatal("expected the primary to be fenced")
+	}
+
+	// the fencing has not taken effect yet
+	phase, _, err := handler.ReconcileAction(reqCtx, cli, opsRes)
+	if err != nil || phase != opsv1alpha1.OpsRunningPhase {
+		t.Fatalf("expected running, got %s, err: %v", phase, err)
+	}
+	if standby.Spec.Standby == nil {
+		t.Fatal("expected the standby not to be promoted before the primary is fenced")
+	}
+
+	primary.Status.Replication = &appsv1.ClusterReplicationStatus{Fenced: true}
+	if err = cli.Status().Update(reqCtx.Ctx, primary); err != nil {
+		t.Fatalf("update primary: %v", err)
+	}
+	phase, _, err = handler.ReconcileAction(reqCtx, cli, opsRes)
+	if err != nil || phase != opsv1alpha1.OpsRunningPhase {
+		t.Fatalf("expected running, got %s, err: %v", phase, err)
+	}
+	if standby.Spec.Standby != nil {
+		t.Fatal("expected the standby to be promoted")
+	}
+
+	// the new spec has not been observed yet
+	standby.Generation = 2
+	phase, _, err = handler.ReconcileAction(reqCtx, cli, opsRes)
+	if err != nil || phase != opsv1alpha1.OpsRunningPhase {
+		t.Fatalf("expected running, got %s, err: %v", phase, err)
+	}
+
+	standby.Status.ObservedGeneration = 2
+	phase, _, err = handler.ReconcileAction(reqCtx, cli, opsRes)
+	if err != nil || phase != opsv1alpha1.OpsRunningPhase {
+		t.Fatalf("expected running before the component is promoted, got %s, err: %v", phase, err)
+	}
+
+	comp.Status.Replication = &appsv1.ComponentReplicationStatus{Role: appsv1.PrimaryReplicationRole}
+	if err = cli.Status().Update(reqCtx.Ctx, comp); err != nil {
+		t.Fatalf("update component: %v", err)
+	}
+	phase, _, err = handler.ReconcileAction(reqCtx, cli, opsRes)
+	if err != nil || phase != opsv1alpha1.OpsSucceedPhase {
+		t.Fatalf("expected succeed, got %s, err: %v", phase, err)
+	}
+}
+
+func TestPromoteSkipFencing(t *testing.T) {
+	primary := &appsv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "primary"}}
+	standby := &appsv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "standby"},
+		Spec: appsv1.ClusterSpec{
+			Standby: &appsv1.ClusterStandby{PrimaryCluster: "primary"},
+		},
+	}
+	cli := newReplicationTestClient(t, primary, standby)
+	reqCtx := intctrlutil.RequestCtx{Ctx: context.Background()}
+	opsRes := &OpsResource{
+		Cluster: standby,
+		OpsRequest: &opsv1alpha1.OpsRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "promote"},
+			Spec: opsv1alpha1.OpsRequestSpec{
+				SpecificOpsRequest: opsv1alpha1.SpecificOpsRequest{Promote: &opsv1alpha1.Promote{SkipFencing: true}},
+			},
+		},
+	}
+	handler := PromoteOpsHandler{}
+
+	if err := handler.Action(reqCtx, cli, opsRes); err != nil {
+		t.Fatalf("action: %v", err)
+	}
+	if err := cli.Get(reqCtx.Ctx, client.ObjectKeyFromObject(primary), primary); err != nil {
+		t.Fatalf("get primary: %v", err)
+	}
+	if primary.Spec.Fenced {
+		t.Fatal("expected the primary not to be fenced")
+	}
+	if _, _, err := handler.ReconcileAction(reqCtx, cli, opsRes); err != nil {
+		t.Fatalf("reconcile action: %v", err)
+	}
+	if standby.Spec.Standby != nil {
+		t.Fatal("expected the standby to be promoted without fencing")
+	}
+}
+
+func TestPromoteFencingTimeout(t *testing.T) {
+	primary := &appsv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "primary"},
+		Spec:       appsv1.ClusterSpec{Fenced: true},
+	}
+	standby := &appsv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "standby"},
+		Spec: appsv1.ClusterSpec{
+			Standby: &appsv1.ClusterStandby{PrimaryCluster: "primary"},
+		},
+	}
+	cli := newReplicationTestClient(t, primary, standby)
+	reqCtx := intctrlutil.RequestCtx{Ctx: context.Background()}
+	opsRes := &OpsResource{
+		Cluster: standby,
+		OpsRequest: &opsv1alpha1.OpsRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "promote"},
+			Spec: opsv1alpha1.OpsRequestSpec{
+				SpecificOpsRequest: opsv1alpha1.SpecificOpsRequest{Promote: &opsv1alpha1.Promote{FencingTimeoutSeconds: pointer.Int32(60)}},
+			},
+			Status: opsv1alpha1.OpsRequestStatus{StartTimestamp: metav1.NewTime(time.Now().Add(-30 * time.Second))},
+		},
+	}
+	handler := PromoteOpsHandler{}
+
+	// the pods of the primary are down, the fencing never takes effect
+	phase, _, err := handler.ReconcileAction(reqCtx, cli, opsRes)
+	if err != nil || phase != opsv1alpha1.OpsRunningPhase {
+		t.Fatalf("expected running within the timeout, got %s, err: %v", phase, err)
+	}
+
+	opsRes.OpsRequest.Status.StartTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	phase, _, err = handler.ReconcileAction(reqCtx, cli, opsRes)
+	if phase != opsv1alpha1.OpsFailedPhase || !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+		t.Fatalf("expected failed with a fatal error, got %s, err: %v", phase, err)
+	}
+	if standby.Spec.Standby == nil {
+		t.Fatal("expected the standby not to be promoted")
+	}
+}
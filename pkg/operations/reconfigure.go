@@ -22,17 +22,23 @@ package operations
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
 	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
 	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
 	"github.com/apecloud/kubeblocks/pkg/controller/component"
 	"github.com/apecloud/kubeblocks/pkg/controller/sharding"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/parameters"
 	parameterscore "github.com/apecloud/kubeblocks/pkg/parameters/core"
 )
 
@@ -84,7 +90,7 @@ func (r *reconfigureAction) Action(reqCtx intctrlutil.RequestCtx, cli client.Cli
 		return intctrlutil.NewErrorf(intctrlutil.ErrorTypeFatal, `invalid reconfigure request: %s`, resource.OpsRequest.GetName())
 	}
 	for _, reconfigure := range resource.OpsRequest.Spec.Reconfigures {
-		if len(reconfigure.Parameters) == 0 {
+		if len(reconfigure.Parameters) == 0 && len(reconfigure.RollbackToRevision) == 0 {
 			return intctrlutil.NewErrorf(intctrlutil.ErrorTypeFatal, "invalid reconfigure request for component %s: no parameters", reconfigure.ComponentName)
 		}
 		compNames, err := r.resolveReconfigureComponents(reqCtx.Ctx, cli, resource.Cluster, reconfigure.ComponentName)
@@ -92,7 +98,16 @@ func (r *reconfigureAction) Action(reqCtx intctrlutil.RequestCtx, cli client.Cli
 			return err
 		}
 		for _, compName := range compNames {
-			if err := r.applyReconfigureToParameters(reqCtx, cli, resource.Cluster, compName, reconfigure); err != nil {
+			compReconfigure := reconfigure
+			if len(reconfigure.RollbackToRevision) != 0 {
+				if compReconfigure.Parameters, err = r.resolveRollbackParameters(reqCtx, cli, resource.Cluster, compName, reconfigure.RollbackToRevision); err != nil {
+					return err
+				}
+			}
+			if err := r.validateParameterConstraints(reqCtx, cli, resource.Cluster, compName, compReconfigure.Parameters); err != nil {
+				return err
+			}
+			if err := r.applyReconfigureToParameters(reqCtx, cli, resource.Cluster, compName, compReconfigure); err != nil {
 				return err
 			}
 		}
@@ -100,6 +115,192 @@ func (r *reconfigureAction) Action(reqCtx intctrlutil.RequestCtx, cli client.Cli
 	return nil
 }
 
+// resolveRollbackParameters computes the parameters to restore all the config templates of the component to the revision.
+// The rollback is applied as a forward change of these parameters, so the same reload or restart policy is selected.
+func (r *reconfigureAction) resolveRollbackParameters(reqCtx intctrlutil.RequestCtx, cli client.Client,
+	cluster *appsv1.Cluster, compName, revision string) ([]opsv1alpha1.ParameterPair, error) {
+	_, compDef, err := component.GetCompNCompDefByName(reqCtx.Ctx, cli, cluster.Namespace, constant.GenerateClusterComponentName(cluster.Name, compName))
+	if err != nil {
+		return nil, err
+	}
+	configDescs, _, err := parameters.ResolveCmpdParametersDefs(reqCtx.Ctx, cli, compDef)
+	if err != nil {
+		return nil, err
+	}
+	configMaps, err := listComponentConfigMaps(reqCtx.Ctx, cli, cluster, compName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []opsv1alpha1.ParameterPair
+	for i := range configMaps.Items {
+		configMap := &configMaps.Items[i]
+		templateName := configMap.Labels[constant.CMConfigurationSpecProviderLabelKey]
+		templateConfigDescs := parameters.GetComponentConfigDescriptions(configDescs, templateName)
+		if len(templateConfigDescs) == 0 {
+			continue
+		}
+		snapshot, err := parameters.GetRevisionSnapshot(configMap, revision)
+		if err != nil {
+			return nil, err
+		}
+		var params parametersv1alpha1.ComponentParameters
+		if snapshot != nil {
+			if params, err = parameters.RollbackParameters(configMap.Data, snapshot, templateConfigDescs); err != nil {
+				return nil, intctrlutil.NewErrorf(intctrlutil.ErrorTypeFatal, "failed to roll back config template %s in component %s: %s", templateName, compName, err.Error())
+			}
+		} else {
+			// the snapshot is dropped to keep the size of the ConfigMap bounded, rebuild from the recorded parameters
+			var found bool
+			if params, found, err = parameters.RollbackRecordedParameters(configMap, revision); err != nil {
+				return nil, err
+			}
+			if !found {
+				return nil, intctrlutil.NewErrorf(intctrlutil.ErrorTypeFatal,
+					"the revision %s of config template %s in component %s is not found, it may have not been applied or been garbage-collected", revision, templateName, compName)
+			}
+		}
+		for key, value := range params {
+			pairs = append(pairs, opsv1alpha1.ParameterPair{Key: key, Value: value})
+		}
+	}
+	slices.SortFunc(pairs, func(a, b opsv1alpha1.ParameterPair) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return pairs, nil
+}
+
+// validateParameterConstraints validates the parameters to update against the constraints between the parameters
+// defined in the ParametersDefinitions, with the current configuration of the component.
+func (r *reconfigureAction) validateParameterConstraints(reqCtx intctrlutil.RequestCtx, cli client.Client,
+	cluster *appsv1.Cluster, compName string, params []opsv1alpha1.ParameterPair) error {
+	if len(params) == 0 {
+		return nil
+	}
+	comp, compDef, err := component.GetCompNCompDefByName(reqCtx.Ctx, cli, cluster.Namespace, constant.GenerateClusterComponentName(cluster.Name, compName))
+	if err != nil {
+		return err
+	}
+	configDescs, paramsDefs, err := parameters.ResolveCmpdParametersDefs(reqCtx.Ctx, cli, compDef)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(paramsDefs, func(paramsDef *parametersv1alpha1.ParametersDefinition) bool {
+		return paramsDef != nil && len(paramsDef.Spec.Constraints) > 0
+	}) {
+		return nil
+	}
+
+	configMaps, err := listComponentConfigMaps(reqCtx.Ctx, cli, cluster, compName)
+	if err != nil {
+		return err
+	}
+	templates := make(map[string]*corev1.ConfigMap, len(configMaps.Items))
+	for i := range configMaps.Items {
+		templates[configMaps.Items[i].Labels[constant.CMConfigurationSpecProviderLabelKey]] = &configMaps.Items[i]
+	}
+	assignments := make(parametersv1alpha1.ComponentParameters, len(params))
+	for _, param := range params {
+		assignments[param.Key] = param.Value
+	}
+	classifiedParams, err := parameters.ClassifyComponentParameters(assignments, paramsDefs, compDef.Spec.Configs, templates, configDescs)
+	if err != nil {
+		return intctrlutil.NewFatalError(err.Error())
+	}
+	var violations []string
+	for _, templateName := range slices.Sorted(maps.Keys(classifiedParams)) {
+		configMap, ok := templates[templateName]
+		if !ok {
+			continue
+		}
+		templateConfigDescs := parameters.GetComponentConfigDescriptions(configDescs, templateName)
+		merged, err := parameters.DoMerge(configMap.Data, parameters.DerefMapValues(classifiedParams[templateName]), paramsDefs, templateConfigDescs)
+		if err != nil {
+			return intctrlutil.NewFatalError(err.Error())
+		}
+		if err = parameters.ValidateParameterConstraints(merged, paramsDefs, templateConfigDescs, comp); err != nil {
+			violations = append(violations, err.Error())
+		}
+	}
+	if len(violations) > 0 {
+		return intctrlutil.NewErrorf(intctrlutil.ErrorTypeFatal, "invalid parameters for component %s: %s", compName, strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// validateScalingParameterConstraints validates that the component scaled does not violate the constraints between
+// the component and the parameters assigned explicitly. Only the violations introduced by the scaling are reported,
+// and the parameters not assigned explicitly are not checked, since they are rendered with the scaled component again.
+func validateScalingParameterConstraints(reqCtx intctrlutil.RequestCtx, cli client.Client,
+	cluster *appsv1.Cluster, compName string, scale func(comp *appsv1.Component)) error {
+	comp, compDef, err := component.GetCompNCompDefByName(reqCtx.Ctx, cli, cluster.Namespace, constant.GenerateClusterComponentName(cluster.Name, compName))
+	if err != nil {
+		// the shardings and the components not created yet are not checked.
+		return client.IgnoreNotFound(err)
+	}
+	configDescs, paramsDefs, err := parameters.ResolveCmpdParametersDefs(reqCtx.Ctx, cli, compDef)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(paramsDefs, func(paramsDef *parametersv1alpha1.ParametersDefinition) bool {
+		return paramsDef != nil && len(paramsDef.Spec.Constraints) > 0
+	}) {
+		return nil
+	}
+	compParam := &parametersv1alpha1.ComponentParameter{}
+	if err = cli.Get(reqCtx.Ctx, client.ObjectKey{Namespace: cluster.Namespace,
+		Name: parameterscore.GenerateComponentConfigurationName(cluster.Name, compName)}, compParam); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	configMaps, err := listComponentConfigMaps(reqCtx.Ctx, cli, cluster, compName)
+	if err != nil {
+		return err
+	}
+
+	scaled := comp.DeepCopy()
+	scale(scaled)
+	var violations []string
+	for _, configMap := range configMaps.Items {
+		templateName := configMap.Labels[constant.CMConfigurationSpecProviderLabelKey]
+		item := parameters.GetConfigTemplateItem(&compParam.Spec, templateName)
+		if item == nil || len(item.ConfigFileParams) == 0 {
+			continue
+		}
+		templateConfigDescs := parameters.GetComponentConfigDescriptions(configDescs, templateName)
+		added, _, err := parameters.DiffConstraintViolations(
+			parameters.ValidateParameterConstraints(configMap.Data, paramsDefs, templateConfigDescs, scaled),
+			parameters.ValidateParameterConstraints(configMap.Data, paramsDefs, templateConfigDescs, comp))
+		if err != nil {
+			return intctrlutil.NewFatalError(err.Error())
+		}
+		for _, violation := range added {
+			if slices.ContainsFunc(violation.Parameters, func(path string) bool {
+				fileName, paramName, _ := strings.Cut(path, ":")
+				value, ok := item.ConfigFileParams[fileName].Parameters[paramName]
+				return ok && value != nil
+			}) {
+				violations = append(violations, violation.String())
+			}
+		}
+	}
+	if len(violations) > 0 {
+		return intctrlutil.NewErrorf(intctrlutil.ErrorTypeFatal, "the parameters of component %s are invalid after scaling: %s", compName, strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+func listComponentConfigMaps(ctx context.Context, cli client.Client, cluster *appsv1.Cluster, compName string) (*corev1.ConfigMapList, error) {
+	configMaps := &corev1.ConfigMapList{}
+	if err := cli.List(ctx, configMaps,
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels(constant.GetCompLabels(cluster.Name, compName)),
+		client.MatchingLabels{constant.CMConfigurationTypeLabelKey: constant.ConfigInstanceType},
+		client.HasLabels{constant.CMConfigurationSpecProviderLabelKey}); err != nil {
+		return nil, err
+	}
+	return configMaps, nil
+}
+
 func (r *reconfigureAction) syncReconfigureForOps(reqCtx intctrlutil.RequestCtx, cli client.Client, resource *OpsResource, opsDeepCopy *opsv1alpha1.OpsRequest, phase opsv1alpha1.OpsPhase) (opsv1alpha1.OpsPhase, time.Duration, error) {
 	if err := PatchOpsStatusWithOpsDeepCopy(reqCtx.Ctx, cli, resource, opsDeepCopy, phase); err != nil {
 		return "", noRequeueAfter, err
@@ -213,13 +213,11 @@ func (r RestoreOpsHandler) restoreClusterFromBackup(reqCtx intctrlutil.RequestCt
 	}
 
 	// format and validate the restore time
-	if backupType == string(dpv1alpha1.BackupTypeContinuous) {
-		restoreTimeStr, err := restore.FormatRestoreTimeAndValidate(restoreSpec.RestorePointInTime, backup)
-		if err != nil {
-			return nil, intctrlutil.NewFatalError(err.Error())
-		}
-		restoreSpec.RestorePointInTime = restoreTimeStr
+	restoreTimeStr, err := restore.ValidateRestorePointInTime(reqCtx.Ctx, cli, restoreSpec.RestorePointInTime, backup)
+	if err != nil {
+		return nil, err
 	}
+	restoreSpec.RestorePointInTime = restoreTimeStr
 
 	clusterObj, err := r.getClusterObjFromBackup(backup, opsRequest)
 	if err != nil {
@@ -220,7 +220,13 @@ var _ = Describe("Restore OpsRequest", func() {
 			Start: &metav1.Time{Time: time.Date(2026, 5, 4, 7, 0, 0, 0, time.UTC)},
 			End:   &metav1.Time{Time: time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)},
 		}
-		cli := newRestoreOpsFakeClient(opsRequest, backup)
+		fullBackup := newRestoreOpsBackup("full-backup", map[string]string{
+			dptypes.BackupTypeLabelKey:   string(dpv1alpha1.BackupTypeFull),
+			constant.AppInstanceLabelKey: restoreClusterName,
+		})
+		fullBackup.Status.Phase = dpv1alpha1.BackupPhaseCompleted
+		fullBackup.Status.CompletionTimestamp = &metav1.Time{Time: time.Date(2026, 5, 4, 7, 30, 0, 0, time.UTC)}
+		cli := newRestoreOpsFakeClient(opsRequest, backup, fullBackup)
 
 		Expect(restoreHandler.Action(reqCtx, cli, &OpsResource{OpsRequest: opsRequest})).Should(Succeed())
 
@@ -229,6 +235,30 @@ var _ = Describe("Restore OpsRequest", func() {
 		Expect(cluster.Spec.Restore.PITR).Should(Equal("2026-05-04T08:00:00Z"))
 	})
 
+	It("rejects the restore time which is not recoverable with the base backups", func() {
+		opsRequest := createRestoreOpsObj(restoreClusterName, restoreOpsName, backupName)
+		opsRequest.Spec.GetRestore().RestorePointInTime = "2026-05-04T07:15:00Z"
+		backup := newRestoreOpsBackup(backupName, map[string]string{
+			dptypes.BackupTypeLabelKey:   string(dpv1alpha1.BackupTypeContinuous),
+			constant.AppInstanceLabelKey: restoreClusterName,
+		})
+		backup.Status.TimeRange = &dpv1alpha1.BackupTimeRange{
+			Start: &metav1.Time{Time: time.Date(2026, 5, 4, 7, 0, 0, 0, time.UTC)},
+			End:   &metav1.Time{Time: time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)},
+		}
+		fullBackup := newRestoreOpsBackup("full-backup", map[string]string{
+			dptypes.BackupTypeLabelKey:   string(dpv1alpha1.BackupTypeFull),
+			constant.AppInstanceLabelKey: restoreClusterName,
+		})
+		fullBackup.Status.Phase = dpv1alpha1.BackupPhaseCompleted
+		fullBackup.Status.CompletionTimestamp = &metav1.Time{Time: time.Date(2026, 5, 4, 7, 30, 0, 0, time.UTC)}
+		cli := newRestoreOpsFakeClient(opsRequest, backup, fullBackup)
+
+		err := restoreHandler.Action(reqCtx, cli, &OpsResource{OpsRequest: opsRequest})
+		Expect(intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal)).Should(BeTrue())
+		Expect(err.Error()).Should(ContainSubstring(`the nearest recoverable point in time is "2026-05-04T07:30:00Z"`))
+	})
+
 	It("keeps running while restore condition is not completed", func() {
 		opsRequest := createRestoreOpsObj(restoreClusterName, restoreOpsName, backupName)
 		cluster := newRestoreTargetCluster(opsRequest.Namespace, restoreClusterName, appsv1.CreatingClusterPhase, metav1.ConditionUnknown)
@@ -62,6 +62,11 @@ func (vs verticalScalingHandler) Action(reqCtx intctrlutil.RequestCtx, cli clien
 	applyVerticalScaling := func(compSpec *appsv1.ClusterComponentSpec, obj ComponentOpsInterface) error {
 		verticalScaling := obj.(opsv1alpha1.VerticalScaling)
 		if vs.verticalScalingComp(verticalScaling) {
+			if err := validateScalingParameterConstraints(reqCtx, cli, opsRes.Cluster, obj.GetComponentName(), func(comp *appsv1.Component) {
+				comp.Spec.Resources = verticalScaling.ResourceRequirements
+			}); err != nil {
+				return err
+			}
 			compSpec.Resources = verticalScaling.ResourceRequirements
 		}
 		for _, v := range verticalScaling.Instances {
@@ -20,7 +20,9 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package operations
 
 import (
+	"context"
 	"fmt"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -30,14 +32,18 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
 	opsv1alpha1 "github.com/apecloud/kubeblocks/apis/operations/v1alpha1"
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	"github.com/apecloud/kubeblocks/pkg/generics"
 	opsutil "github.com/apecloud/kubeblocks/pkg/operations/util"
+	parameterscore "github.com/apecloud/kubeblocks/pkg/parameters/core"
 	testapps "github.com/apecloud/kubeblocks/pkg/testutil/apps"
 	testk8s "github.com/apecloud/kubeblocks/pkg/testutil/k8s"
 	testops "github.com/apecloud/kubeblocks/pkg/testutil/operations"
@@ -472,3 +478,90 @@ var _ = Describe("verticalScalingHandler resource match contract", func() {
 		Expect(vs.podApplyCompOps(ops, makeInstance(pod), makePgRes(target))).Should(BeFalse())
 	})
 })
+
+func TestValidateScalingParameterConstraints(t *testing.T) {
+	const (
+		clusterName  = "test-cluster"
+		compName     = "mysql"
+		compDefName  = "mysql-8.0"
+		templateName = "mysql-config"
+		namespace    = "default"
+	)
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		corev1.AddToScheme,
+		appsv1.AddToScheme,
+		parametersv1alpha1.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			t.Fatalf("add scheme: %v", err)
+		}
+	}
+	memory := func(quantity string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(quantity)}}
+	}
+	newClient := func(bufferPoolSize *string) client.Client {
+		comp := &appsv1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: constant.GenerateClusterComponentName(clusterName, compName), Namespace: namespace},
+			Spec:       appsv1.ComponentSpec{CompDef: compDefName, Resources: memory("4Gi")},
+		}
+		compDef := &appsv1.ComponentDefinition{ObjectMeta: metav1.ObjectMeta{Name: compDefName}}
+		paramsDef := &parametersv1alpha1.ParametersDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysql-pd"},
+			Spec: parametersv1alpha1.ParametersDefinitionSpec{
+				ComponentDef: compDefName,
+				TemplateName: templateName,
+				FileName:     "my.cnf",
+				FileFormatConfig: &parametersv1alpha1.FileFormatConfig{
+					Format: parametersv1alpha1.Ini,
+					FormatterAction: parametersv1alpha1.FormatterAction{
+						IniConfig: &parametersv1alpha1.IniConfig{SectionName: "mysqld"},
+					},
+				},
+				Constraints: []parametersv1alpha1.ParameterConstraint{{
+					Name: "buffer-pool-size",
+					Rule: "quantity(parameters.innodb_buffer_pool_size) < component.resources.limits.memory",
+				}},
+			},
+			Status: parametersv1alpha1.ParametersDefinitionStatus{Phase: parametersv1alpha1.PDAvailablePhase},
+		}
+		compParam := &parametersv1alpha1.ComponentParameter{
+			ObjectMeta: metav1.ObjectMeta{Name: parameterscore.GenerateComponentConfigurationName(clusterName, compName), Namespace: namespace},
+			Spec: parametersv1alpha1.ComponentParameterSpec{
+				ConfigItemDetails: []parametersv1alpha1.ConfigTemplateItemDetail{{
+					Name: templateName,
+					ConfigFileParams: map[string]parametersv1alpha1.ParametersInFile{
+						"my.cnf": {Parameters: map[string]*string{"innodb_buffer_pool_size": bufferPoolSize}},
+					},
+				}},
+			},
+		}
+		labels := constant.GetCompLabels(clusterName, compName)
+		labels[constant.CMConfigurationTypeLabelKey] = constant.ConfigInstanceType
+		labels[constant.CMConfigurationSpecProviderLabelKey] = templateName
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysql-config", Namespace: namespace, Labels: labels},
+			Data:       map[string]string{"my.cnf": "[mysqld]\ninnodb_buffer_pool_size=2Gi\n"},
+		}
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(comp, compDef, paramsDef, compParam, configMap).Build()
+	}
+	reqCtx := intctrlutil.RequestCtx{Ctx: context.Background()}
+	cluster := &appsv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}}
+	scaleDown := func(comp *appsv1.Component) {
+		comp.Spec.Resources = memory("1Gi")
+	}
+
+	err := validateScalingParameterConstraints(reqCtx, newClient(pointer.String("2Gi")), cluster, compName, scaleDown)
+	if err == nil || !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+		t.Fatalf("expected the scaling to be rejected by the assigned parameter, got %v", err)
+	}
+	// the parameter not assigned explicitly is rendered with the scaled component again
+	if err = validateScalingParameterConstraints(reqCtx, newClient(nil), cluster, compName, scaleDown); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = validateScalingParameterConstraints(reqCtx, newClient(pointer.String("2Gi")), cluster, compName, func(comp *appsv1.Component) {
+		comp.Spec.Resources = memory("8Gi")
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
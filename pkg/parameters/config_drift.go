@@ -0,0 +1,148 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package parameters
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/parameters/core"
+)
+
+// RenderedParameterValues returns the values of the parameters in the rendered configuration by file,
+// only the files described by a ParametersDefinition are included.
+func RenderedParameterValues(configData map[string]string,
+	configs []parametersv1alpha1.ComponentConfigDescription,
+	paramsDefs []*parametersv1alpha1.ParametersDefinition) (map[string]map[string]string, error) {
+	rendered := make(map[string]map[string]string, len(configData))
+	for file, content := range configData {
+		if resolveParametersDef(paramsDefs, file) == nil || core.ResolveConfigFormat(configs, file) == nil {
+			continue
+		}
+		values, err := core.TransformConfigFileToKeyValueMap(file, configs, []byte(content))
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != 0 {
+			rendered[file] = values
+		}
+	}
+	return rendered, nil
+}
+
+// DetectParameterDrift compares the live values of the parameters with the rendered ones, and returns the drifted parameters.
+// The parameters absent from the live values are ignored.
+func DetectParameterDrift(rendered map[string]map[string]string,
+	live map[string]string,
+	paramsDefs []*parametersv1alpha1.ParametersDefinition) []parametersv1alpha1.DriftedParameter {
+	var drifted []parametersv1alpha1.DriftedParameter
+	for file, values := range rendered {
+		paramsDef := resolveParametersDef(paramsDefs, file)
+		for name, expected := range values {
+			liveValue, ok := live[name]
+			if !ok || parameterValueEqual(expected, liveValue) {
+				continue
+			}
+			reason := parametersv1alpha1.ParameterManuallyChanged
+			if paramsDef != nil && !core.IsDynamicParameter(name, &paramsDef.Spec) {
+				reason = parametersv1alpha1.ParameterPendingRestart
+			}
+			drifted = append(drifted, parametersv1alpha1.DriftedParameter{
+				FileName:      file,
+				Name:          name,
+				ExpectedValue: expected,
+				LiveValue:     liveValue,
+				Reason:        reason,
+			})
+		}
+	}
+	slices.SortFunc(drifted, func(a, b parametersv1alpha1.DriftedParameter) int {
+		if c := strings.Compare(a.FileName, b.FileName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return drifted
+}
+
+// parameterValueEqual reports whether the live value is equivalent to the rendered one,
+// since the engines may report the values in a normalized form, e.g. "ON" for "1", or "1073741824" for "1G".
+func parameterValueEqual(expected, live string) bool {
+	expected, live = normalizeParameterValue(expected), normalizeParameterValue(live)
+	if strings.EqualFold(expected, live) {
+		return true
+	}
+	if b1, ok := parseBoolValue(expected); ok {
+		if b2, ok := parseBoolValue(live); ok {
+			return b1 == b2
+		}
+	}
+	for _, q1 := range parseQuantityValues(expected) {
+		for _, q2 := range parseQuantityValues(live) {
+			if q1.Cmp(q2) == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func normalizeParameterValue(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+	return value
+}
+
+func parseBoolValue(value string) (bool, bool) {
+	switch strings.ToLower(value) {
+	case "on", "yes", "true", "1":
+		return true, true
+	case "off", "no", "false", "0":
+		return false, true
+	}
+	return false, false
+}
+
+var binarySuffixes = map[string]string{"k": "Ki", "m": "Mi", "g": "Gi", "t": "Ti"}
+
+// parseQuantityValues parses the value as quantities, the single-letter suffixes such as "K", "M" and "G"
+// are also interpreted as binary units, as most of the engines do.
+func parseQuantityValues(value string) []resource.Quantity {
+	var quantities []resource.Quantity
+	if q, err := resource.ParseQuantity(value); err == nil {
+		quantities = append(quantities, q)
+	}
+	if n := len(value); n > 1 {
+		if _, err := strconv.ParseFloat(value[:n-1], 64); err == nil {
+			if suffix, ok := binarySuffixes[strings.ToLower(value[n-1:])]; ok {
+				if q, err := resource.ParseQuantity(value[:n-1] + suffix); err == nil {
+					quantities = append(quantities, q)
+				}
+			}
+		}
+	}
+	return quantities
+}
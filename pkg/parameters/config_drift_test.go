@@ -0,0 +1,112 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package parameters
+
+import (
+	"reflect"
+	"testing"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+func TestDetectParameterDrift(t *testing.T) {
+	configDescs := []parametersv1alpha1.ComponentConfigDescription{{
+		Name:         "my.cnf",
+		TemplateName: "mysql-config",
+		FileFormatConfig: &parametersv1alpha1.FileFormatConfig{
+			Format: parametersv1alpha1.Ini,
+			FormatterAction: parametersv1alpha1.FormatterAction{
+				IniConfig: &parametersv1alpha1.IniConfig{SectionName: "mysqld"},
+			},
+		},
+	}}
+	paramsDefs := []*parametersv1alpha1.ParametersDefinition{{
+		Spec: parametersv1alpha1.ParametersDefinitionSpec{
+			FileName:         "my.cnf",
+			StaticParameters: []string{"innodb_buffer_pool_size"},
+		},
+	}}
+	configData := map[string]string{
+		"my.cnf":  "[mysqld]\ninnodb_buffer_pool_size=1G\nmax_connections=100\nslow_query_log=ON\nlong_query_time=2\n",
+		"init.sh": "#!/bin/sh\n",
+	}
+
+	rendered, err := RenderedParameterValues(configData, configDescs, paramsDefs)
+	if err != nil {
+		t.Fatalf("failed to resolve rendered values: %v", err)
+	}
+	if _, ok := rendered["init.sh"]; ok {
+		t.Fatalf("unexpected values of the file without ParametersDefinition: %v", rendered)
+	}
+	if rendered["my.cnf"]["max_connections"] != "100" {
+		t.Fatalf("unexpected rendered values: %v", rendered)
+	}
+
+	live := map[string]string{
+		"innodb_buffer_pool_size": "134217728",
+		"max_connections":         "200",
+		"slow_query_log":          "1",
+		"long_query_time":         "2.000000",
+	}
+	expected := []parametersv1alpha1.DriftedParameter{{
+		FileName:      "my.cnf",
+		Name:          "innodb_buffer_pool_size",
+		ExpectedValue: "1G",
+		LiveValue:     "134217728",
+		Reason:        parametersv1alpha1.ParameterPendingRestart,
+	}, {
+		FileName:      "my.cnf",
+		Name:          "max_connections",
+		ExpectedValue: "100",
+		LiveValue:     "200",
+		Reason:        parametersv1alpha1.ParameterManuallyChanged,
+	}}
+	if drifted := DetectParameterDrift(rendered, live, paramsDefs); !reflect.DeepEqual(drifted, expected) {
+		t.Fatalf("unexpected drifted parameters: %+v", drifted)
+	}
+
+	live["innodb_buffer_pool_size"] = "1073741824"
+	live["max_connections"] = "100"
+	if drifted := DetectParameterDrift(rendered, live, paramsDefs); len(drifted) != 0 {
+		t.Fatalf("expected no drift, got %+v", drifted)
+	}
+}
+
+func TestParameterValueEqual(t *testing.T) {
+	tests := []struct {
+		expected string
+		live     string
+		equal    bool
+	}{
+		{"100", "100", true},
+		{"'utf8mb4'", "UTF8MB4", true},
+		{"ON", "1", true},
+		{"off", "ON", false},
+		{"128M", "134217728", true},
+		{"1Gi", "1073741824", true},
+		{"2", "2.000000", true},
+		{"ROW", "STATEMENT", false},
+	}
+	for _, tt := range tests {
+		if got := parameterValueEqual(tt.expected, tt.live); got != tt.equal {
+			t.Errorf("parameterValueEqual(%q, %q) = %v, want %v", tt.expected, tt.live, got, tt.equal)
+		}
+	}
+}
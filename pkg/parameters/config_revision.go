@@ -0,0 +1,271 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package parameters
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/parameters/core"
+)
+
+var (
+	snapshotReader *zstd.Decoder
+	snapshotWriter *zstd.Encoder
+)
+
+func init() {
+	var err error
+	snapshotReader, err = zstd.NewReader(nil)
+	utilruntime.Must(err)
+	snapshotWriter, err = zstd.NewWriter(nil)
+	utilruntime.Must(err)
+}
+
+// maxRevisionSnapshotsSize is the maximum total size of the snapshots kept in the annotations of a ConfigMap,
+// which keeps the annotations within the size limit along with the other annotations.
+const maxRevisionSnapshotsSize = 64 * 1024
+
+// SetRevisionSnapshot records the configuration data of the ConfigMap as the snapshot of the revision,
+// along with the parameters assigned explicitly in the revision.
+//
+// The snapshots are compressed, and the ones of the oldest revisions are dropped if the total size exceeds
+// maxRevisionSnapshotsSize, the snapshot is not kept at all if it exceeds the limit alone. The revisions
+// without snapshots are rolled back by the recorded parameters.
+func SetRevisionSnapshot(configMap *corev1.ConfigMap, revision string) error {
+	if configMap.Annotations == nil {
+		configMap.Annotations = map[string]string{}
+	}
+	if err := setRevisionParameters(configMap, revision); err != nil {
+		return err
+	}
+	b, err := json.Marshal(configMap.Data)
+	if err != nil {
+		return err
+	}
+	key := core.GenerateRevisionSnapshotKey(revision)
+	snapshot := base64.StdEncoding.EncodeToString(snapshotWriter.EncodeAll(b, nil))
+	if configMap.Annotations[key] == snapshot {
+		return nil
+	}
+	delete(configMap.Annotations, key)
+	if len(snapshot) > maxRevisionSnapshotsSize {
+		return nil
+	}
+	size := len(snapshot)
+	revisions := snapshotRevisions(configMap.Annotations)
+	for _, r := range revisions {
+		size += len(configMap.Annotations[core.GenerateRevisionSnapshotKey(r)])
+	}
+	for i := 0; size > maxRevisionSnapshotsSize && i < len(revisions); i++ {
+		oldKey := core.GenerateRevisionSnapshotKey(revisions[i])
+		size -= len(configMap.Annotations[oldKey])
+		delete(configMap.Annotations, oldKey)
+	}
+	configMap.Annotations[key] = snapshot
+	return nil
+}
+
+// snapshotRevisions returns the revisions with snapshots, from the oldest to the latest.
+func snapshotRevisions(annotations map[string]string) []string {
+	prefix := constant.ConfigurationRevisionSnapshot + "-"
+	var revisions []string
+	for key := range annotations {
+		if strings.HasPrefix(key, prefix) {
+			revisions = append(revisions, strings.TrimPrefix(key, prefix))
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		ri, _ := strconv.ParseInt(revisions[i], 10, 64)
+		rj, _ := strconv.ParseInt(revisions[j], 10, 64)
+		return ri < rj
+	})
+	return revisions
+}
+
+// setRevisionParameters records the parameters assigned explicitly in the revision, which are much smaller
+// than the snapshot and are always kept.
+func setRevisionParameters(configMap *corev1.ConfigMap, revision string) error {
+	params, err := appliedParameters(configMap)
+	if err != nil || params == nil {
+		return err
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	configMap.Annotations[core.GenerateRevisionParametersKey(revision)] = string(b)
+	return nil
+}
+
+// appliedParameters returns the parameters assigned explicitly in the config template item applied to the ConfigMap,
+// nil if the applied item is not recorded.
+func appliedParameters(configMap *corev1.ConfigMap) (map[string]*string, error) {
+	applied, ok := configMap.GetAnnotations()[constant.ConfigAppliedVersionAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	item := parametersv1alpha1.ConfigTemplateItemDetail{}
+	if err := json.Unmarshal([]byte(applied), &item); err != nil {
+		return nil, err
+	}
+	params := map[string]*string{}
+	for _, file := range item.ConfigFileParams {
+		for key, value := range file.Parameters {
+			params[key] = value
+		}
+	}
+	return params, nil
+}
+
+// GetRevisionSnapshot returns the configuration data recorded for the revision, nil if the snapshot is not found.
+func GetRevisionSnapshot(configMap *corev1.ConfigMap, revision string) (map[string]string, error) {
+	snapshot, ok := configMap.GetAnnotations()[core.GenerateRevisionSnapshotKey(revision)]
+	if !ok {
+		return nil, nil
+	}
+	compressed, err := base64.StdEncoding.DecodeString(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	b, err := snapshotReader.DecodeAll(compressed, nil)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]string{}
+	if err = json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// RollbackRecordedParameters computes the parameters to restore the parameters assigned explicitly in the revision,
+// the ones assigned since the revision are reset to the defaults. It is used to roll back to a revision whose snapshot
+// is not kept, so the changes of the config template itself are not restored. It returns false if the parameters of
+// the revision are not recorded.
+func RollbackRecordedParameters(configMap *corev1.ConfigMap, revision string) (parametersv1alpha1.ComponentParameters, bool, error) {
+	recorded, ok := configMap.GetAnnotations()[core.GenerateRevisionParametersKey(revision)]
+	if !ok {
+		return nil, false, nil
+	}
+	target := map[string]*string{}
+	if err := json.Unmarshal([]byte(recorded), &target); err != nil {
+		return nil, false, err
+	}
+	current, err := appliedParameters(configMap)
+	if err != nil {
+		return nil, false, err
+	}
+	params := parametersv1alpha1.ComponentParameters{}
+	for key, value := range current {
+		if _, ok := target[key]; !ok && value != nil {
+			params[key] = nil
+		}
+	}
+	for key, value := range target {
+		if !ptr.Equal(current[key], value) {
+			params[key] = value
+		}
+	}
+	return params, true, nil
+}
+
+// RollbackParameters computes the reverse diff from the current configuration data to the target one,
+// and returns the parameters to be assigned to restore the target, a nil value means the parameter is removed.
+func RollbackParameters(current, target map[string]string, configDescs []parametersv1alpha1.ComponentConfigDescription) (parametersv1alpha1.ComponentParameters, error) {
+	patch, _, err := core.CreateConfigPatch(current, target, configDescs, false)
+	if err != nil {
+		return nil, err
+	}
+	if !patch.IsModify {
+		return nil, nil
+	}
+	for _, files := range []map[string]interface{}{patch.AddConfig, patch.DeleteConfig} {
+		if len(files) != 0 {
+			return nil, fmt.Errorf("the config files %v are added or removed since the revision, which can not be rolled back by parameters", sortedKeys(files))
+		}
+	}
+
+	params := parametersv1alpha1.ComponentParameters{}
+	for _, file := range sortedKeys(patch.UpdateConfig) {
+		var diff map[string]interface{}
+		if err = json.Unmarshal(patch.UpdateConfig[file], &diff); err != nil {
+			return nil, err
+		}
+		// parameters in the default section of ini files are assigned without the section name
+		if section := core.NestedPrefixField(core.ResolveConfigFormat(configDescs, file)); section != "" {
+			if nested, ok := diff[section].(map[string]interface{}); ok {
+				delete(diff, section)
+				if err = flattenParameterPatch("", nested, params); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err = flattenParameterPatch("", diff, params); err != nil {
+			return nil, err
+		}
+	}
+	return params, nil
+}
+
+func flattenParameterPatch(prefix string, diff map[string]interface{}, params parametersv1alpha1.ComponentParameters) error {
+	for key, value := range diff {
+		if prefix != "" {
+			key = strings.Join([]string{prefix, key}, ".")
+		}
+		switch v := value.(type) {
+		case nil:
+			params[key] = nil
+		case string:
+			params[key] = &v
+		case map[string]interface{}:
+			if err := flattenParameterPatch(key, v, params); err != nil {
+				return err
+			}
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			params[key] = ptr.To(string(b))
+		}
+	}
+	return nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,185 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package parameters
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/parameters/core"
+)
+
+func TestRevisionSnapshot(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql-config"},
+		Data:       map[string]string{"my.cnf": "[mysqld]\nmax_connections=100\n"},
+	}
+	if err := SetRevisionSnapshot(cm, "2"); err != nil {
+		t.Fatalf("failed to set snapshot: %v", err)
+	}
+	cm.Data["my.cnf"] = "[mysqld]\nmax_connections=200\n"
+
+	snapshot, err := GetRevisionSnapshot(cm, "2")
+	if err != nil {
+		t.Fatalf("failed to get snapshot: %v", err)
+	}
+	if snapshot["my.cnf"] != "[mysqld]\nmax_connections=100\n" {
+		t.Fatalf("unexpected snapshot: %v", snapshot)
+	}
+	if snapshot, err = GetRevisionSnapshot(cm, "1"); err != nil || snapshot != nil {
+		t.Fatalf("expected no snapshot for unknown revision, got %v, %v", snapshot, err)
+	}
+}
+
+func TestRollbackParameters(t *testing.T) {
+	configDescs := []parametersv1alpha1.ComponentConfigDescription{{
+		Name:         "my.cnf",
+		TemplateName: "mysql-config",
+		FileFormatConfig: &parametersv1alpha1.FileFormatConfig{
+			Format: parametersv1alpha1.Ini,
+			FormatterAction: parametersv1alpha1.FormatterAction{
+				IniConfig: &parametersv1alpha1.IniConfig{SectionName: "mysqld"},
+			},
+		},
+	}}
+	current := map[string]string{
+		"my.cnf": "[mysqld]\nmax_connections=200\ninnodb_buffer_pool_size=1G\n[client]\nport=3307\n",
+	}
+	target := map[string]string{
+		"my.cnf": "[mysqld]\nmax_connections=100\n[client]\nport=3306\n",
+	}
+
+	params, err := RollbackParameters(current, target, configDescs)
+	if err != nil {
+		t.Fatalf("failed to compute rollback parameters: %v", err)
+	}
+	expected := parametersv1alpha1.ComponentParameters{
+		"max_connections":         ptr.To("100"),
+		"innodb_buffer_pool_size": nil,
+		"client.port":             ptr.To("3306"),
+	}
+	if len(params) != len(expected) {
+		t.Fatalf("unexpected rollback parameters: %v", params)
+	}
+	for key, value := range expected {
+		got, ok := params[key]
+		if !ok || !ptr.Equal(got, value) {
+			t.Fatalf("unexpected value of parameter %s: %v", key, got)
+		}
+	}
+
+	if params, err = RollbackParameters(target, target, configDescs); err != nil || len(params) != 0 {
+		t.Fatalf("expected no parameters for the same revision, got %v, %v", params, err)
+	}
+}
+
+func randomConfig(t *testing.T, size int) string {
+	b := make([]byte, size/2)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatal(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func TestRevisionSnapshotBounded(t *testing.T) {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "mysql-config"}}
+	// the random data is not compressible
+	for _, revision := range []string{"9", "10", "11"} {
+		cm.Data = map[string]string{"my.cnf": randomConfig(t, 16*1024)}
+		if err := SetRevisionSnapshot(cm, revision); err != nil {
+			t.Fatalf("failed to set snapshot: %v", err)
+		}
+	}
+	// the snapshot of the oldest revision is dropped
+	if _, ok := cm.Annotations[core.GenerateRevisionSnapshotKey("9")]; ok {
+		t.Fatal("expected the snapshot of the oldest revision to be dropped")
+	}
+	for _, revision := range []string{"10", "11"} {
+		if _, ok := cm.Annotations[core.GenerateRevisionSnapshotKey(revision)]; !ok {
+			t.Fatalf("expected the snapshot of revision %s to be kept", revision)
+		}
+	}
+
+	// the snapshot exceeding the limit alone is not kept
+	cm.Data = map[string]string{"my.cnf": randomConfig(t, 128*1024)}
+	if err := SetRevisionSnapshot(cm, "12"); err != nil {
+		t.Fatalf("failed to set snapshot: %v", err)
+	}
+	if snapshot, err := GetRevisionSnapshot(cm, "12"); err != nil || snapshot != nil {
+		t.Fatalf("expected no snapshot for the oversized revision, got %v, %v", snapshot, err)
+	}
+	if _, ok := cm.Annotations[core.GenerateRevisionSnapshotKey("11")]; !ok {
+		t.Fatal("expected the snapshots of the other revisions to be kept")
+	}
+}
+
+func TestRollbackRecordedParameters(t *testing.T) {
+	setApplied := func(cm *corev1.ConfigMap, params map[string]*string) {
+		b, err := json.Marshal(parametersv1alpha1.ConfigTemplateItemDetail{
+			Name:             "mysql-config",
+			ConfigFileParams: map[string]parametersv1alpha1.ParametersInFile{"my.cnf": {Parameters: params}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		cm.Annotations[constant.ConfigAppliedVersionAnnotationKey] = string(b)
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql-config", Annotations: map[string]string{}},
+		Data:       map[string]string{"my.cnf": "[mysqld]\nmax_connections=100\n"},
+	}
+	setApplied(cm, map[string]*string{"max_connections": ptr.To("100")})
+	if err := SetRevisionSnapshot(cm, "2"); err != nil {
+		t.Fatalf("failed to set snapshot: %v", err)
+	}
+	// the snapshot is dropped
+	delete(cm.Annotations, core.GenerateRevisionSnapshotKey("2"))
+	setApplied(cm, map[string]*string{"max_connections": ptr.To("200"), "innodb_buffer_pool_size": ptr.To("1G")})
+
+	params, found, err := RollbackRecordedParameters(cm, "2")
+	if err != nil || !found {
+		t.Fatalf("expected the parameters of the revision to be found, got %v, %v", found, err)
+	}
+	expected := parametersv1alpha1.ComponentParameters{
+		"max_connections":         ptr.To("100"),
+		"innodb_buffer_pool_size": nil,
+	}
+	if len(params) != len(expected) {
+		t.Fatalf("unexpected rollback parameters: %v", params)
+	}
+	for key, value := range expected {
+		got, ok := params[key]
+		if !ok || !ptr.Equal(got, value) {
+			t.Fatalf("unexpected value of parameter %s: %v", key, got)
+		}
+	}
+
+	if _, found, err = RollbackRecordedParameters(cm, "1"); err != nil || found {
+		t.Fatalf("expected no parameters for unknown revision, got %v, %v", found, err)
+	}
+}
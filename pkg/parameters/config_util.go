@@ -54,6 +54,8 @@ type Result struct {
 	Retry   bool   `json:"retry"`
 	Failed  bool   `json:"failed"`
 	Message string `json:"message"`
+
+	Rollout *parametersv1alpha1.ParameterRolloutStatus `json:"rollout,omitempty"`
 }
 
 // MergeAndValidateConfigs merges and validates configuration files
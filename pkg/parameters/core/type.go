@@ -190,3 +190,11 @@ func GenerateEnvFromName(originName string) string {
 func GenerateRevisionPhaseKey(revision string) string {
 	return strings.Join([]string{constant.LastConfigurationRevisionPhase, revision}, "-")
 }
+
+func GenerateRevisionSnapshotKey(revision string) string {
+	return strings.Join([]string{constant.ConfigurationRevisionSnapshot, revision}, "-")
+}
+
+func GenerateRevisionParametersKey(revision string) string {
+	return strings.Join([]string{constant.ConfigurationRevisionParameters, revision}, "-")
+}
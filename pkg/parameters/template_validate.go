@@ -20,6 +20,10 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package parameters
 
 import (
+	"errors"
+	"slices"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
 	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/parameters/core"
 	"github.com/apecloud/kubeblocks/pkg/parameters/validate"
@@ -64,3 +68,69 @@ func validateConfigContent(renderedData string, paramsDef *parametersv1alpha1.Pa
 	}
 	return nil
 }
+
+// ValidateParameterConstraints validates the config files against the constraints between the parameters defined in
+// the ParametersDefinitions, and reports the violations of all the files.
+func ValidateParameterConstraints(configData map[string]string,
+	paramsDefs []*parametersv1alpha1.ParametersDefinition,
+	configs []parametersv1alpha1.ComponentConfigDescription,
+	comp *appsv1.Component) error {
+	var constraintComp validate.ConstraintComponent
+	if comp != nil {
+		constraintComp = validate.ConstraintComponent{
+			Replicas:       comp.Spec.Replicas,
+			ServiceVersion: comp.Spec.ServiceVersion,
+			Resources:      comp.Spec.Resources,
+		}
+	}
+
+	var violations validate.ConstraintViolations
+	for _, paramsDef := range paramsDefs {
+		if paramsDef == nil || len(paramsDef.Spec.Constraints) == 0 {
+			continue
+		}
+		content, ok := configData[paramsDef.Spec.FileName]
+		if !ok {
+			continue
+		}
+		fileConfig := resolveFileFormatConfig(configs, paramsDef.Spec.FileName)
+		if fileConfig == nil {
+			continue
+		}
+		err := validate.ValidateParameterConstraints(&paramsDef.Spec, fileConfig, content, constraintComp)
+		var fileViolations validate.ConstraintViolations
+		switch {
+		case errors.As(err, &fileViolations):
+			violations = append(violations, fileViolations...)
+		case err != nil:
+			return err
+		}
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// DiffConstraintViolations splits the violations of the parameter constraints reported by err into the ones which are
+// not reported by base, and the ones reported by both. The errors other than the violations are returned as is.
+func DiffConstraintViolations(err, base error) (added, existing validate.ConstraintViolations, _ error) {
+	if err == nil {
+		return nil, nil, nil
+	}
+	var violations, baseViolations validate.ConstraintViolations
+	if !errors.As(err, &violations) {
+		return nil, nil, err
+	}
+	errors.As(base, &baseViolations)
+	for _, violation := range violations {
+		if slices.ContainsFunc(baseViolations, func(v validate.ConstraintViolation) bool {
+			return v.Constraint == violation.Constraint && slices.Equal(v.Parameters, violation.Parameters)
+		}) {
+			existing = append(existing, violation)
+		} else {
+			added = append(added, violation)
+		}
+	}
+	return added, existing, nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package parameters
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/controller/render"
+	configcore "github.com/apecloud/kubeblocks/pkg/parameters/core"
+)
+
+// RenderTuningProfile evaluates the formulas of the tuning profile for the files of the config template,
+// and returns the derived parameter values by file.
+func RenderTuningProfile(reconcileCtx *render.ReconcileCtx,
+	item parametersv1alpha1.ConfigTemplateItemDetail,
+	profile string,
+	configs []parametersv1alpha1.ComponentConfigDescription) (map[string]map[string]string, error) {
+	formulas, err := resolveTuningFormulas(item, profile, configs)
+	if err != nil {
+		return nil, err
+	}
+
+	templateRender := render.NewTemplateBuilder(reconcileCtx)
+	tuned := make(map[string]map[string]string, len(formulas))
+	for file, fileFormulas := range formulas {
+		values, err := templateRender.RenderExpressions(fmt.Sprintf("%s/%s", profile, file), fileFormulas)
+		if err != nil {
+			return nil, err
+		}
+		for param, value := range values {
+			if value == "" {
+				delete(values, param)
+			}
+		}
+		if len(values) != 0 {
+			tuned[file] = values
+		}
+	}
+	return tuned, nil
+}
+
+// resolveTuningFormulas returns the formulas of the tuning profile by file,
+// the parameters set explicitly by the user are excluded.
+func resolveTuningFormulas(item parametersv1alpha1.ConfigTemplateItemDetail,
+	profile string,
+	configs []parametersv1alpha1.ComponentConfigDescription) (map[string]map[string]string, error) {
+	found := false
+	formulas := make(map[string]map[string]string)
+	for _, config := range GetComponentConfigDescriptions(configs, item.Name) {
+		index := slices.IndexFunc(config.TuningProfiles, func(p parametersv1alpha1.TuningProfile) bool {
+			return p.Name == profile
+		})
+		if index < 0 {
+			continue
+		}
+		found = true
+		userParams := item.ConfigFileParams[config.Name].Parameters
+		fileFormulas := make(map[string]string, len(config.TuningProfiles[index].Parameters))
+		for _, param := range config.TuningProfiles[index].Parameters {
+			if _, ok := userParams[param.Name]; ok {
+				continue
+			}
+			fileFormulas[param.Name] = param.Formula
+		}
+		if len(fileFormulas) != 0 {
+			formulas[config.Name] = fileFormulas
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("tuning profile %s is not found for config template %s", profile, item.Name)
+	}
+	return formulas, nil
+}
+
+// ApplyTuningProfile merges the parameter values derived by the tuning profile into the base config,
+// and returns the tuning status that compares the derived values with the running config.
+// The base config is returned as is if the tuning is a dry run.
+func ApplyTuningProfile(tuning *appsv1.ConfigTuning,
+	tuned map[string]map[string]string,
+	baseConfig, running *corev1.ConfigMap,
+	configs []parametersv1alpha1.ComponentConfigDescription,
+	paramsDefs []*parametersv1alpha1.ParametersDefinition) (*corev1.ConfigMap, *parametersv1alpha1.ParameterTuningStatus, error) {
+	status, err := buildTuningStatus(tuning, tuned, running, configs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tuning.DryRun || len(tuned) == 0 {
+		return baseConfig, status, nil
+	}
+
+	patch := make(map[string]parametersv1alpha1.ParametersInFile, len(tuned))
+	for file, values := range tuned {
+		params := make(map[string]*string, len(values))
+		for param, value := range values {
+			params[param] = ptr.To(value)
+		}
+		patch[file] = parametersv1alpha1.ParametersInFile{Parameters: params}
+	}
+	newData, err := DoMerge(baseConfig.Data, patch, paramsDefs, configs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to apply tuning profile %s: %v", tuning.Profile, err)
+	}
+	expected := baseConfig.DeepCopy()
+	expected.Data = newData
+	return expected, status, nil
+}
+
+func buildTuningStatus(tuning *appsv1.ConfigTuning,
+	tuned map[string]map[string]string,
+	running *corev1.ConfigMap,
+	configs []parametersv1alpha1.ComponentConfigDescription) (*parametersv1alpha1.ParameterTuningStatus, error) {
+	status := &parametersv1alpha1.ParameterTuningStatus{
+		Profile: tuning.Profile,
+		DryRun:  tuning.DryRun,
+	}
+	for file, values := range tuned {
+		var current map[string]string
+		if running != nil {
+			if content, ok := running.Data[file]; ok {
+				var err error
+				if current, err = configcore.TransformConfigFileToKeyValueMap(file, configs, []byte(content)); err != nil {
+					return nil, err
+				}
+			}
+		}
+		for param, value := range values {
+			tunedParam := parametersv1alpha1.TunedParameter{
+				FileName: file,
+				Name:     param,
+				Value:    value,
+			}
+			if currentValue, ok := current[param]; ok {
+				tunedParam.CurrentValue = ptr.To(currentValue)
+			}
+			status.Parameters = append(status.Parameters, tunedParam)
+		}
+	}
+	slices.SortFunc(status.Parameters, func(a, b parametersv1alpha1.TunedParameter) int {
+		if c := strings.Compare(a.FileName, b.FileName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return status, nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package parameters
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	appsv1 "github.com/apecloud/kubeblocks/apis/apps/v1"
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+func TestApplyTuningProfile(t *testing.T) {
+	configDescs := []parametersv1alpha1.ComponentConfigDescription{{
+		Name:         "my.cnf",
+		TemplateName: "mysql-config",
+		FileFormatConfig: &parametersv1alpha1.FileFormatConfig{
+			Format: parametersv1alpha1.Ini,
+			FormatterAction: parametersv1alpha1.FormatterAction{
+				IniConfig: &parametersv1alpha1.IniConfig{SectionName: "mysqld"},
+			},
+		},
+		TuningProfiles: []parametersv1alpha1.TuningProfile{{
+			Name: "oltp",
+			Parameters: []parametersv1alpha1.TuningParameter{
+				{Name: "innodb_buffer_pool_size", Formula: "{{ div $.memory 2 }}"},
+				{Name: "max_connections", Formula: "{{ mul $.replicas 100 }}"},
+			},
+		}},
+	}}
+	item := parametersv1alpha1.ConfigTemplateItemDetail{
+		Name: "mysql-config",
+		ConfigFileParams: map[string]parametersv1alpha1.ParametersInFile{
+			"my.cnf": {Parameters: map[string]*string{"max_connections": ptr.To("500")}},
+		},
+	}
+
+	formulas, err := resolveTuningFormulas(item, "oltp", configDescs)
+	if err != nil {
+		t.Fatalf("failed to resolve formulas: %v", err)
+	}
+	expectedFormulas := map[string]map[string]string{
+		"my.cnf": {"innodb_buffer_pool_size": "{{ div $.memory 2 }}"},
+	}
+	if !reflect.DeepEqual(formulas, expectedFormulas) {
+		t.Fatalf("unexpected formulas: %v", formulas)
+	}
+	if _, err = resolveTuningFormulas(item, "analytics", configDescs); err == nil {
+		t.Fatalf("expected error for unknown profile")
+	}
+
+	base := &corev1.ConfigMap{Data: map[string]string{"my.cnf": "[mysqld]\ninnodb_buffer_pool_size=128M\n"}}
+	running := &corev1.ConfigMap{Data: map[string]string{"my.cnf": "[mysqld]\ninnodb_buffer_pool_size=256M\n"}}
+	tuned := map[string]map[string]string{"my.cnf": {"innodb_buffer_pool_size": "512M"}}
+	expectedStatus := &parametersv1alpha1.ParameterTuningStatus{
+		Profile: "oltp",
+		DryRun:  true,
+		Parameters: []parametersv1alpha1.TunedParameter{{
+			FileName:     "my.cnf",
+			Name:         "innodb_buffer_pool_size",
+			Value:        "512M",
+			CurrentValue: ptr.To("256M"),
+		}},
+	}
+
+	// dry run only reports the changes
+	updated, status, err := ApplyTuningProfile(&appsv1.ConfigTuning{Profile: "oltp", DryRun: true}, tuned, base, running, configDescs, nil)
+	if err != nil {
+		t.Fatalf("failed to apply tuning profile: %v", err)
+	}
+	if updated != base {
+		t.Fatalf("expected base config to be untouched in dry run")
+	}
+	if !reflect.DeepEqual(status, expectedStatus) {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+
+	updated, status, err = ApplyTuningProfile(&appsv1.ConfigTuning{Profile: "oltp"}, tuned, base, running, configDescs, nil)
+	if err != nil {
+		t.Fatalf("failed to apply tuning profile: %v", err)
+	}
+	if !strings.Contains(updated.Data["my.cnf"], "innodb_buffer_pool_size=512M") {
+		t.Fatalf("unexpected config: %s", updated.Data["my.cnf"])
+	}
+	if base.Data["my.cnf"] != "[mysqld]\ninnodb_buffer_pool_size=128M\n" {
+		t.Fatalf("base config should not be modified: %s", base.Data["my.cnf"])
+	}
+	expectedStatus.DryRun = false
+	if !reflect.DeepEqual(status, expectedStatus) {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+}
@@ -0,0 +1,335 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package validate
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/parameters/core"
+	"github.com/apecloud/kubeblocks/pkg/parameters/openapi"
+)
+
+const (
+	constraintParametersVarName = "parameters"
+	constraintComponentVarName  = "component"
+
+	// constraintCostLimit limits the cost of evaluating a rule, the same as the per call limit of the CEL
+	// validation rules of the CRDs, to keep the expensive rules from blocking the controllers.
+	constraintCostLimit uint64 = 1000000
+)
+
+// constraintEnv is the CEL environment shared by all the constraints, it is safe for concurrent use.
+var constraintEnv = sync.OnceValues(newConstraintEnv)
+
+// ConstraintComponent is the component that the parameter constraints are evaluated against.
+type ConstraintComponent struct {
+	Replicas       int32
+	ServiceVersion string
+	Resources      corev1.ResourceRequirements
+}
+
+// ConstraintViolation represents a parameter constraint that is violated.
+type ConstraintViolation struct {
+	Constraint string
+	// Parameters are the paths of the parameters referred by the constraint, in the form of <file>:<parameter>.
+	Parameters []string
+	Message    string
+}
+
+func (v ConstraintViolation) String() string {
+	return fmt.Sprintf("constraint %q on parameters [%s] is violated: %s", v.Constraint, strings.Join(v.Parameters, ", "), v.Message)
+}
+
+// ConstraintViolations is the error of all the violated parameter constraints.
+type ConstraintViolations []ConstraintViolation
+
+func (v ConstraintViolations) Error() string {
+	messages := make([]string, 0, len(v))
+	for _, violation := range v {
+		messages = append(messages, violation.String())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// CompileParameterConstraints checks whether the rules of the constraints are valid CEL expressions.
+func CompileParameterConstraints(constraints []parametersv1alpha1.ParameterConstraint) error {
+	env, err := constraintEnv()
+	if err != nil {
+		return err
+	}
+	var errs []string
+	for _, constraint := range constraints {
+		if _, err = compileConstraint(env, constraint); err != nil {
+			errs = append(errs, fmt.Sprintf("constraint %q: %s", constraint.Name, err.Error()))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid parameter constraints: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// ValidateParameterConstraints evaluates the constraints of the ParametersDefinition against the content of the config file,
+// and returns ConstraintViolations with all the violated constraints.
+func ValidateParameterConstraints(paramsDef *parametersv1alpha1.ParametersDefinitionSpec,
+	fileFormat *parametersv1alpha1.FileFormatConfig, content string, comp ConstraintComponent) error {
+	if paramsDef == nil || len(paramsDef.Constraints) == 0 || fileFormat == nil {
+		return nil
+	}
+	params, err := LoadConfigObjectFromContent(fileFormat.Format, content)
+	if err != nil {
+		return err
+	}
+	vars := map[string]any{
+		constraintParametersVarName: typedConstraintParameters(flattenConstraintParameters(params, core.NestedPrefixField(fileFormat)), paramsDef.ParametersSchema),
+		constraintComponentVarName:  constraintComponentObject(comp),
+	}
+
+	env, err := constraintEnv()
+	if err != nil {
+		return err
+	}
+	var violations ConstraintViolations
+	for _, constraint := range paramsDef.Constraints {
+		ast, err := compileConstraint(env, constraint)
+		if err != nil {
+			return fmt.Errorf("invalid parameter constraint %q: %s", constraint.Name, err.Error())
+		}
+		violation := ConstraintViolation{
+			Constraint: constraint.Name,
+			Parameters: constraintParameterPaths(ast, paramsDef.FileName),
+			Message:    constraint.Message,
+		}
+		if violation.Message == "" {
+			violation.Message = fmt.Sprintf("failed rule: %s", constraint.Rule)
+		}
+		prg, err := env.Program(ast, cel.CostLimit(constraintCostLimit))
+		if err != nil {
+			return err
+		}
+		out, _, err := prg.Eval(vars)
+		switch {
+		case err != nil:
+			violation.Message = fmt.Sprintf("%s, failed to evaluate: %s", violation.Message, err.Error())
+			violations = append(violations, violation)
+		case out.Value() != true:
+			violations = append(violations, violation)
+		}
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+func newConstraintEnv() (*cel.Env, error) {
+	quantity := func(value ref.Val) ref.Val {
+		switch v := value.(type) {
+		case types.Int:
+			return v
+		case types.String:
+			q, err := resource.ParseQuantity(string(v))
+			if err != nil {
+				return types.NewErr("invalid quantity %q: %s", string(v), err.Error())
+			}
+			return types.Int(q.Value())
+		default:
+			return types.NewErr("invalid quantity: %v", value)
+		}
+	}
+	return cel.NewEnv(
+		cel.Variable(constraintParametersVarName, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(constraintComponentVarName, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Function("quantity",
+			cel.Overload("quantity_string", []*cel.Type{cel.StringType}, cel.IntType, cel.UnaryBinding(quantity)),
+			cel.Overload("quantity_int", []*cel.Type{cel.IntType}, cel.IntType, cel.UnaryBinding(quantity)),
+		),
+	)
+}
+
+func compileConstraint(env *cel.Env, constraint parametersv1alpha1.ParameterConstraint) (*cel.Ast, error) {
+	ast, issues := env.Compile(constraint.Rule)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("the rule %q must return a bool", constraint.Rule)
+	}
+	return ast, nil
+}
+
+// constraintParameterPaths returns the paths of the parameters referred by the rule.
+func constraintParameterPaths(ast *cel.Ast, fileName string) []string {
+	var (
+		names []string
+		walk  func(expr *exprpb.Expr)
+	)
+	isParametersVar := func(expr *exprpb.Expr) bool {
+		return expr.GetIdentExpr() != nil && expr.GetIdentExpr().GetName() == constraintParametersVarName
+	}
+	walk = func(expr *exprpb.Expr) {
+		if expr == nil {
+			return
+		}
+		switch kind := expr.GetExprKind().(type) {
+		case *exprpb.Expr_SelectExpr:
+			if isParametersVar(kind.SelectExpr.GetOperand()) {
+				names = append(names, kind.SelectExpr.GetField())
+				return
+			}
+			walk(kind.SelectExpr.GetOperand())
+		case *exprpb.Expr_CallExpr:
+			args := kind.CallExpr.GetArgs()
+			if kind.CallExpr.GetFunction() == "_[_]" && len(args) == 2 && isParametersVar(args[0]) && args[1].GetConstExpr() != nil {
+				names = append(names, args[1].GetConstExpr().GetStringValue())
+				return
+			}
+			walk(kind.CallExpr.GetTarget())
+			for _, arg := range args {
+				walk(arg)
+			}
+		case *exprpb.Expr_ListExpr:
+			for _, elem := range kind.ListExpr.GetElements() {
+				walk(elem)
+			}
+		case *exprpb.Expr_StructExpr:
+			for _, entry := range kind.StructExpr.GetEntries() {
+				walk(entry.GetMapKey())
+				walk(entry.GetValue())
+			}
+		case *exprpb.Expr_ComprehensionExpr:
+			walk(kind.ComprehensionExpr.GetIterRange())
+			walk(kind.ComprehensionExpr.GetAccuInit())
+			walk(kind.ComprehensionExpr.GetLoopCondition())
+			walk(kind.ComprehensionExpr.GetLoopStep())
+			walk(kind.ComprehensionExpr.GetResult())
+		}
+	}
+	walk(ast.Expr())
+
+	slices.Sort(names)
+	paths := make([]string, 0, len(names))
+	for _, name := range slices.Compact(names) {
+		paths = append(paths, fmt.Sprintf("%s:%s", fileName, name))
+	}
+	return paths
+}
+
+// flattenConstraintParameters flattens the nested parameters with dot-separated names,
+// the parameters in the default section of ini files are named without the section name.
+func flattenConstraintParameters(params map[string]interface{}, defaultSection string) map[string]any {
+	flattened := make(map[string]any)
+	var flatten func(prefix string, m map[string]interface{})
+	flatten = func(prefix string, m map[string]interface{}) {
+		for key, value := range m {
+			name := key
+			if prefix != "" {
+				name = prefix + "." + key
+			}
+			if nested, ok := value.(map[string]interface{}); ok {
+				if prefix == "" && key == defaultSection {
+					flatten("", nested)
+				} else {
+					flatten(name, nested)
+				}
+				continue
+			}
+			flattened[name] = value
+		}
+	}
+	flatten("", params)
+	return flattened
+}
+
+// typedConstraintParameters converts the string values of the parameters to the types defined in the schema.
+func typedConstraintParameters(params map[string]any, schema *parametersv1alpha1.ParametersSchema) map[string]any {
+	if schema == nil || schema.SchemaInJSON == nil {
+		return params
+	}
+	specSchema, ok := schema.SchemaInJSON.Properties[openapi.DefaultSchemaName]
+	if !ok {
+		return params
+	}
+	properties := openapi.FlattenSchema(specSchema).Properties
+	for name, value := range params {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if typed, ok := convertConstraintValue(properties[name], str); ok {
+			params[name] = typed
+		}
+	}
+	return params
+}
+
+func convertConstraintValue(schema apiext.JSONSchemaProps, value string) (any, bool) {
+	value = strings.Trim(value, `"'`)
+	switch schema.Type {
+	case "integer":
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return v, true
+		}
+	case "number":
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			return v, true
+		}
+	case "boolean":
+		if v, err := strconv.ParseBool(value); err == nil {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func constraintComponentObject(comp ConstraintComponent) map[string]any {
+	resourceList := func(list corev1.ResourceList) map[string]any {
+		m := make(map[string]any, len(list))
+		for name, q := range list {
+			if name == corev1.ResourceCPU {
+				m[string(name)] = q.MilliValue()
+			} else {
+				m[string(name)] = q.Value()
+			}
+		}
+		return m
+	}
+	return map[string]any{
+		"replicas":       int64(comp.Replicas),
+		"serviceVersion": comp.ServiceVersion,
+		"resources": map[string]any{
+			"limits":   resourceList(comp.Resources.Limits),
+			"requests": resourceList(comp.Resources.Requests),
+		},
+	}
+}
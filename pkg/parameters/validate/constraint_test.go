@@ -0,0 +1,139 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/parameters/openapi"
+)
+
+func TestCompileParameterConstraints(t *testing.T) {
+	require.NoError(t, CompileParameterConstraints([]parametersv1alpha1.ParameterConstraint{{
+		Name: "valid",
+		Rule: "quantity(parameters.innodb_buffer_pool_size) < component.resources.limits.memory",
+	}}))
+
+	err := CompileParameterConstraints([]parametersv1alpha1.ParameterConstraint{{
+		Name: "syntax",
+		Rule: "parameters.a <",
+	}, {
+		Name: "non-bool",
+		Rule: "1 + 1",
+	}})
+	require.ErrorContains(t, err, `constraint "syntax"`)
+	require.ErrorContains(t, err, `constraint "non-bool"`)
+}
+
+func TestValidateParameterConstraints(t *testing.T) {
+	paramsDef := &parametersv1alpha1.ParametersDefinitionSpec{
+		FileName: "my.cnf",
+		ParametersSchema: &parametersv1alpha1.ParametersSchema{
+			SchemaInJSON: &apiext.JSONSchemaProps{
+				Properties: map[string]apiext.JSONSchemaProps{
+					openapi.DefaultSchemaName: {
+						Type: "object",
+						Properties: map[string]apiext.JSONSchemaProps{
+							"max_connections": {Type: "integer"},
+						},
+					},
+				},
+			},
+		},
+		Constraints: []parametersv1alpha1.ParameterConstraint{{
+			Name:    "buffer-pool-size",
+			Rule:    "quantity(parameters.innodb_buffer_pool_size) * 5 < component.resources.limits.memory * 4",
+			Message: "innodb_buffer_pool_size must be below 80% of the memory limit",
+		}, {
+			Name:    "connections",
+			Rule:    "parameters.max_connections <= 1000 * component.replicas",
+			Message: "too many connections",
+		}, {
+			Name: "parallel-workers",
+			Rule: "!has(parameters.slave_parallel_workers) || parameters['slave_parallel_type'] == 'LOGICAL_CLOCK'",
+		}},
+	}
+	fileFormat := &parametersv1alpha1.FileFormatConfig{
+		Format: parametersv1alpha1.Ini,
+		FormatterAction: parametersv1alpha1.FormatterAction{
+			IniConfig: &parametersv1alpha1.IniConfig{SectionName: "mysqld"},
+		},
+	}
+	comp := ConstraintComponent{
+		Replicas: 1,
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+		},
+	}
+
+	valid := "[mysqld]\ninnodb_buffer_pool_size=512Mi\nmax_connections=500\n"
+	require.NoError(t, ValidateParameterConstraints(paramsDef, fileFormat, valid, comp))
+
+	invalid := "[mysqld]\ninnodb_buffer_pool_size=1Gi\nmax_connections=2000\nslave_parallel_workers=4\nslave_parallel_type=DATABASE\n"
+	err := ValidateParameterConstraints(paramsDef, fileFormat, invalid, comp)
+	var violations ConstraintViolations
+	require.True(t, errors.As(err, &violations))
+	require.Len(t, violations, 3)
+	require.Equal(t, []string{"my.cnf:innodb_buffer_pool_size"}, violations[0].Parameters)
+	require.Equal(t, "innodb_buffer_pool_size must be below 80% of the memory limit", violations[0].Message)
+	require.Equal(t, []string{"my.cnf:max_connections"}, violations[1].Parameters)
+	require.Equal(t, []string{"my.cnf:slave_parallel_type", "my.cnf:slave_parallel_workers"}, violations[2].Parameters)
+
+	comp.Replicas = 2
+	err = ValidateParameterConstraints(paramsDef, fileFormat, invalid, comp)
+	require.True(t, errors.As(err, &violations))
+	require.Len(t, violations, 2)
+}
+
+func TestValidateParameterConstraintsCostLimit(t *testing.T) {
+	paramsDef := &parametersv1alpha1.ParametersDefinitionSpec{
+		FileName: "my.cnf",
+		Constraints: []parametersv1alpha1.ParameterConstraint{{
+			Name: "expensive",
+			Rule: "parameters.all(a, parameters.all(b, a == b || a != b))",
+		}},
+	}
+	fileFormat := &parametersv1alpha1.FileFormatConfig{
+		Format: parametersv1alpha1.Ini,
+		FormatterAction: parametersv1alpha1.FormatterAction{
+			IniConfig: &parametersv1alpha1.IniConfig{SectionName: "mysqld"},
+		},
+	}
+	var content strings.Builder
+	content.WriteString("[mysqld]\n")
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(&content, "param_%d=%d\n", i, i)
+	}
+
+	err := ValidateParameterConstraints(paramsDef, fileFormat, content.String(), ConstraintComponent{})
+	var violations ConstraintViolations
+	require.True(t, errors.As(err, &violations))
+	require.Len(t, violations, 1)
+	require.Contains(t, violations[0].Message, "cost limit exceeded")
+}
@@ -0,0 +1,147 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"sort"
+	"strings"
+)
+
+// blockNode is a statement of a brace-block configuration file (nginx, hocon).
+// It records the offsets of the statement in the source, so that an update only splices the changed text
+// and the comments and the layout of the rest of the file are kept.
+type blockNode struct {
+	// key is the path of the node relative to the enclosing block.
+	key []string
+
+	// value is the decoded value of a non-block node.
+	value interface{}
+
+	// block is set for the nodes which enclose other statements in braces.
+	block    bool
+	children []*blockNode
+
+	// start and end are the offsets of the whole statement, keyEnd is the end offset of the key.
+	start, keyEnd, end int
+
+	// valueStart and valueEnd are the offsets of the raw value.
+	valueStart, valueEnd int
+
+	// bodyStart and bodyEnd are the offsets of the content inside the braces.
+	bodyStart, bodyEnd int
+}
+
+// blockDocument is the source text and the parsed statements of a brace-block configuration file,
+// it is shared by a ConfigObject and its SubConfig views.
+type blockDocument struct {
+	text string
+	root *blockNode
+}
+
+// textEdit replaces the text between start and end with the replacement.
+type textEdit struct {
+	start, end  int
+	replacement string
+}
+
+const defaultBlockIndent = "    "
+
+func applyTextEdits(text string, edits []textEdit) string {
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].start > edits[j].start
+	})
+	for _, e := range edits {
+		text = text[:e.start] + e.replacement + text[e.end:]
+	}
+	return text
+}
+
+// lineIndent returns the leading whitespace of the line containing pos.
+func lineIndent(text string, pos int) string {
+	start := strings.LastIndexByte(text[:pos], '\n') + 1
+	end := start
+	for end < len(text) && (text[end] == ' ' || text[end] == '\t') {
+		end++
+	}
+	return text[start:end]
+}
+
+// onlySpaceBefore reports whether there is only whitespace between the line start and pos.
+func onlySpaceBefore(text string, pos int) bool {
+	start := strings.LastIndexByte(text[:pos], '\n') + 1
+	return strings.TrimSpace(text[start:pos]) == ""
+}
+
+// restOfLineIsComment reports whether there is only whitespace or a comment between pos and the line end,
+// and returns the offset of the line end.
+func restOfLineIsComment(text string, pos int, isComment func(string) bool) (bool, int) {
+	eol := strings.IndexByte(text[pos:], '\n')
+	if eol < 0 {
+		eol = len(text)
+	} else {
+		eol += pos
+	}
+	rest := strings.TrimSpace(text[pos:eol])
+	return rest == "" || isComment(rest), eol
+}
+
+// insertStatementEdit returns the edit which adds the statement as the last statement of the block,
+// the following lines of a multi-line statement are indented like the first one.
+func insertStatementEdit(text string, block *blockNode, root bool, stmt string, isComment func(string) bool) textEdit {
+	if n := len(block.children); n != 0 {
+		last := block.children[n-1]
+		indent := lineIndent(text, last.start)
+		stmt = strings.ReplaceAll(stmt, "\n", "\n"+indent)
+		if ok, eol := restOfLineIsComment(text, last.end, isComment); ok {
+			return textEdit{start: eol, end: eol, replacement: "\n" + indent + stmt}
+		}
+		return textEdit{start: last.end, end: last.end, replacement: " " + stmt}
+	}
+
+	if root {
+		pos := block.bodyEnd
+		prefix := ""
+		if pos > 0 && text[pos-1] != '\n' {
+			prefix = "\n"
+		}
+		return textEdit{start: pos, end: pos, replacement: prefix + stmt + "\n"}
+	}
+	indent := lineIndent(text, block.start)
+	stmt = strings.ReplaceAll(stmt, "\n", "\n"+indent+defaultBlockIndent)
+	replacement := "\n" + indent + defaultBlockIndent + stmt
+	if !strings.Contains(text[block.bodyStart:block.bodyEnd], "\n") {
+		replacement += "\n" + indent
+	}
+	return textEdit{start: block.bodyStart, end: block.bodyStart, replacement: replacement}
+}
+
+// removeStatementEdit returns the edit which removes the statement, and the whole line if nothing else is on it.
+func removeStatementEdit(text string, node *blockNode, isComment func(string) bool) textEdit {
+	start, end := node.start, node.end
+	if ok, eol := restOfLineIsComment(text, end, isComment); ok && onlySpaceBefore(text, start) {
+		start = strings.LastIndexByte(text[:start], '\n') + 1
+		end = min(eol+1, len(text))
+		return textEdit{start: start, end: end}
+	}
+	for end < len(text) && (text[end] == ' ' || text[end] == '\t' || text[end] == ',') {
+		end++
+	}
+	return textEdit{start: start, end: end}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+func TestConfigObjectGoldenRoundTrip(t *testing.T) {
+	tests := []struct {
+		file    string
+		format  parametersv1alpha1.CfgFileFormat
+		updated map[string]interface{}
+		removed []string
+	}{{
+		file:   "my.cnf",
+		format: parametersv1alpha1.Ini,
+		updated: map[string]interface{}{
+			"mysqld.gtid_mode":                  "ON",
+			"mysqld.innodb-buffer-pool-size":    "1G",
+			"mysqld.max_connections":            1000,
+			"mysqld.binlog_expire_logs_seconds": "604800",
+			"mysqld_safe.nice":                  "0",
+		},
+		removed: []string{"mysqld.innodb_log_file_size"},
+	}, {
+		file:   "postgresql.conf",
+		format: parametersv1alpha1.Properties,
+		updated: map[string]interface{}{
+			"shared_buffers":                "'1GB'",
+			"auto_explain.log_min_duration": "'500ms'",
+			"max_connections":               "'200'",
+		},
+		removed: []string{"work_mem"},
+	}, {
+		file:   "pd.toml",
+		format: parametersv1alpha1.TOML,
+		updated: map[string]interface{}{
+			"lease":                             "5",
+			"log.level":                         "warn",
+			"schedule.max-snapshot-count":       128,
+			"schedule.enable-cross-table-merge": "false",
+			"schedule.leader-schedule-limit":    "4",
+			"pd-server.metric-storage":          "http://prometheus:9090",
+		},
+		removed: []string{"schedule.store-limit-mode"},
+	}, {
+		file:   "app.env",
+		format: parametersv1alpha1.Dotenv,
+		updated: map[string]interface{}{
+			"PROXY_PORT":       6034,
+			"proxy_admin_user": "root",
+			"PROXY_BANNER":     `hello "kubeblocks"`,
+			"PROXY_WORKERS":    "8",
+		},
+		removed: []string{"PROXY_LOG_LEVEL"},
+	}, {
+		file:   "nginx.conf",
+		format: parametersv1alpha1.Nginx,
+		updated: map[string]interface{}{
+			"worker_processes":                          4,
+			"events.worker_connections":                 "4096",
+			"events.multi_accept":                       "on",
+			"http.keepalive_timeout":                    "75s",
+			"http.upstream backend.server":              []interface{}{"127.0.0.1:8080 weight=5", "127.0.0.1:8082"},
+			"http.server.location /.proxy_read_timeout": "60s",
+		},
+		removed: []string{"http.sendfile"},
+	}, {
+		file:   "application.conf",
+		format: parametersv1alpha1.HOCON,
+		updated: map[string]interface{}{
+			"akka.loglevel":                      "WARNING",
+			"akka.actor.provider":                "local",
+			"akka.remote.artery.canonical.port":  25521,
+			"akka.cluster.seed-nodes":            []interface{}{"akka://app@seed-0:25520"},
+			"akka.coordinated-shutdown.exit-jvm": "on",
+			"app.http.port":                      "9090",
+		},
+		removed: []string{"akka.log-dead-letters"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			content, err := os.ReadFile(filepath.Join("testdata", tt.file))
+			require.Nil(t, err)
+			golden, err := os.ReadFile(filepath.Join("testdata", tt.file+".golden"))
+			require.Nil(t, err)
+
+			configObj, err := LoadConfig(tt.file, string(content), tt.format)
+			require.Nil(t, err)
+
+			// an untouched file is written back as is
+			output, err := configObj.Marshal()
+			assert.Nil(t, err)
+			assert.Equal(t, string(content), output)
+
+			for key, value := range tt.updated {
+				assert.Nil(t, configObj.Update(key, value))
+			}
+			for _, key := range tt.removed {
+				assert.Nil(t, configObj.RemoveKey(key))
+			}
+			output, err = configObj.Marshal()
+			assert.Nil(t, err)
+			assert.Equal(t, string(golden), output)
+
+			// the patched file reads back the updated parameters
+			newObj, err := LoadConfig(tt.file, output, tt.format)
+			require.Nil(t, err)
+			assert.Equal(t, configObj.GetAllParameters(), newObj.GetAllParameters())
+			for _, key := range tt.removed {
+				assert.Nil(t, newObj.Get(key))
+			}
+		})
+	}
+}
@@ -0,0 +1,612 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+// hoconConfig is a ConfigObject for HOCON (https://github.com/lightbend/config/blob/main/HOCON.md) files,
+// e.g. the application.conf of Java/Scala applications.
+//
+// A field is addressed by its dotted path, e.g. "akka.actor.provider", no matter it is defined with
+// a dotted key or with nested objects. A quoted segment of the path is a single element,
+// e.g. `"a.b".c` addresses the field c of the key "a.b". As in HOCON, the last definition of a field wins and the objects
+// with the same path are merged. Substitutions and value concatenations are kept as raw text,
+// and include statements are left untouched.
+type hoconConfig struct {
+	name string
+	doc  *blockDocument
+
+	// prefix is set for the ConfigObject returned by SubConfig, keys are resolved relative to it.
+	prefix []string
+}
+
+func init() {
+	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.HOCON, func(name string) ConfigObject {
+		return &hoconConfig{name: name, doc: &blockDocument{root: &blockNode{block: true}}}
+	})
+}
+
+// hoconField is a field definition with its absolute path.
+type hoconField struct {
+	path []string
+	node *blockNode
+}
+
+func (c *hoconConfig) Update(key string, value any) error {
+	path := c.fullPath(key)
+	var last *blockNode
+	for _, f := range c.fields() {
+		if equalPath(f.path, path) {
+			last = f.node
+		}
+	}
+	if last != nil {
+		if last.block {
+			return fmt.Errorf("the key [%s] refers to an object", key)
+		}
+		raw := c.doc.text[last.valueStart:last.valueEnd]
+		return c.apply([]textEdit{{start: last.valueStart, end: last.valueEnd, replacement: encodeHOCONValue(value, raw)}})
+	}
+
+	parent, parentPath := c.doc.root, []string(nil)
+	for _, f := range c.fields() {
+		if f.node.block && len(f.path) < len(path) && len(f.path) >= len(parentPath) && equalPath(f.path, path[:len(f.path)]) {
+			parent, parentPath = f.node, f.path
+		}
+	}
+	stmt := formatHOCONKey(path[len(parentPath):]) + c.separator(parent) + encodeHOCONValue(value, "")
+	edit := insertStatementEdit(c.doc.text, parent, parent == c.doc.root && c.doc.root.bodyStart == 0, stmt, isHOCONComment)
+	return c.apply([]textEdit{edit})
+}
+
+func (c *hoconConfig) RemoveKey(key string) error {
+	path := c.fullPath(key)
+	var edits []textEdit
+	for _, f := range c.fields() {
+		if equalPath(f.path, path) {
+			edits = append(edits, removeStatementEdit(c.doc.text, f.node, isHOCONComment))
+		}
+	}
+	return c.apply(edits)
+}
+
+func (c *hoconConfig) Get(key string) interface{} {
+	var value interface{} = c.GetAllParameters()
+	for _, k := range splitHOCONPath(key) {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if value, ok = m[k]; !ok {
+			return nil
+		}
+	}
+	return value
+}
+
+func (c *hoconConfig) GetString(key string) (string, error) {
+	return cast.ToStringE(c.Get(key))
+}
+
+func (c *hoconConfig) GetAllParameters() map[string]interface{} {
+	params := hoconObjectParameters(c.doc.root)
+	for _, k := range c.prefix {
+		sub, ok := params[k].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		params = sub
+	}
+	return params
+}
+
+func (c *hoconConfig) SubConfig(key string) ConfigObject {
+	if _, ok := c.Get(key).(map[string]interface{}); !ok {
+		return nil
+	}
+	return &hoconConfig{
+		name:   c.name,
+		doc:    c.doc,
+		prefix: c.fullPath(key),
+	}
+}
+
+func (c *hoconConfig) Marshal() (string, error) {
+	if len(c.prefix) == 0 {
+		return c.doc.text, nil
+	}
+	var last *blockNode
+	for _, f := range c.fields() {
+		if f.node.block && equalPath(f.path, c.prefix) {
+			last = f.node
+		}
+	}
+	if last == nil {
+		return "", nil
+	}
+	return c.doc.text[last.bodyStart:last.bodyEnd], nil
+}
+
+func (c *hoconConfig) Unmarshal(str string) error {
+	root, err := parseHOCONDocument(str)
+	if err != nil {
+		return err
+	}
+	c.doc = &blockDocument{text: str, root: root}
+	c.prefix = nil
+	return nil
+}
+
+func (c *hoconConfig) fullPath(key string) []string {
+	path := make([]string, 0, len(c.prefix)+1)
+	path = append(path, c.prefix...)
+	return append(path, splitHOCONPath(key)...)
+}
+
+// splitHOCONPath splits the dotted path as the keys of the document are parsed,
+// a quoted segment is a single path element, e.g. `"a.b".c` is ["a.b", "c"].
+func splitHOCONPath(key string) []string {
+	p := &hoconParser{text: key + "\n"}
+	path, err := p.parseKey()
+	if err != nil || p.pos != len(key) {
+		return strings.Split(key, DelimiterDot)
+	}
+	return path
+}
+
+func (c *hoconConfig) apply(edits []textEdit) error {
+	if len(edits) == 0 {
+		return nil
+	}
+	text := applyTextEdits(c.doc.text, edits)
+	root, err := parseHOCONDocument(text)
+	if err != nil {
+		return err
+	}
+	c.doc.text, c.doc.root = text, root
+	return nil
+}
+
+// fields returns all the field definitions in the document order.
+func (c *hoconConfig) fields() []hoconField {
+	var fields []hoconField
+	var walk func(block *blockNode, base []string)
+	walk = func(block *blockNode, base []string) {
+		for _, child := range block.children {
+			path := append(append([]string{}, base...), child.key...)
+			fields = append(fields, hoconField{path: path, node: child})
+			if child.block {
+				walk(child, path)
+			}
+		}
+	}
+	walk(c.doc.root, nil)
+	return fields
+}
+
+// separator returns the key-value separator used by the fields of the block.
+func (c *hoconConfig) separator(block *blockNode) string {
+	for i := len(block.children) - 1; i >= 0; i-- {
+		if child := block.children[i]; !child.block {
+			return c.doc.text[child.keyEnd:child.valueStart]
+		}
+	}
+	return " = "
+}
+
+func equalPath(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func hoconObjectParameters(block *blockNode) map[string]interface{} {
+	params := make(map[string]interface{})
+	for _, child := range block.children {
+		m := params
+		for _, k := range child.key[:len(child.key)-1] {
+			sub, ok := m[k].(map[string]interface{})
+			if !ok {
+				sub = make(map[string]interface{})
+				m[k] = sub
+			}
+			m = sub
+		}
+		k := child.key[len(child.key)-1]
+		if !child.block {
+			m[k] = child.value
+			continue
+		}
+		if sub, ok := m[k].(map[string]interface{}); ok {
+			mergeHOCONObject(sub, hoconObjectParameters(child))
+		} else {
+			m[k] = hoconObjectParameters(child)
+		}
+	}
+	return params
+}
+
+func mergeHOCONObject(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if sub, ok := v.(map[string]interface{}); ok {
+			if dstSub, ok := dst[k].(map[string]interface{}); ok {
+				mergeHOCONObject(dstSub, sub)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+func isHOCONComment(s string) bool {
+	return strings.HasPrefix(s, "#") || strings.HasPrefix(s, "//")
+}
+
+const hoconForbiddenChars = "$\"{}[]:=,+#`^?!@*&\\"
+
+func isHOCONUnquotedString(s string) bool {
+	return s != "" && !strings.ContainsAny(s, hoconForbiddenChars+" \t\r\n") && !strings.Contains(s, "//")
+}
+
+func formatHOCONKey(path []string) string {
+	parts := make([]string, len(path))
+	for i, k := range path {
+		if isHOCONUnquotedString(k) && !strings.Contains(k, DelimiterDot) {
+			parts[i] = k
+		} else {
+			parts[i] = strconv.Quote(k)
+		}
+	}
+	return strings.Join(parts, DelimiterDot)
+}
+
+// encodeHOCONValue formats the value, a string keeps the quotes of the raw value it replaces.
+func encodeHOCONValue(value any, raw string) string {
+	switch v := value.(type) {
+	case []interface{}:
+		elems := make([]string, len(v))
+		for i, e := range v {
+			elems[i] = encodeHOCONValue(e, "")
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case []string:
+		return encodeHOCONValue(cast.ToSlice(v), raw)
+	}
+	s := cast.ToString(value)
+	if strings.HasPrefix(raw, `"`) || !isHOCONUnquotedString(s) {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+type hoconParser struct {
+	text string
+	pos  int
+}
+
+// parseHOCONDocument parses the document, the bodyStart of the root is set if the root object is enclosed in braces.
+func parseHOCONDocument(text string) (*blockNode, error) {
+	p := &hoconParser{text: text}
+	root := &blockNode{block: true, bodyEnd: len(text)}
+	p.skipSpaces(true)
+	if p.pos < len(text) && text[p.pos] == '{' {
+		root.start = p.pos
+		p.pos++
+		root.bodyStart = p.pos
+		if err := p.parseObject(root, true); err != nil {
+			return nil, err
+		}
+		if p.skipSpaces(true); p.pos < len(text) {
+			return nil, p.errorf("unexpected content after the root object")
+		}
+		return root, nil
+	}
+	if err := p.parseObject(root, false); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func (p *hoconParser) errorf(format string, args ...interface{}) error {
+	line := strings.Count(p.text[:min(p.pos, len(p.text))], "\n") + 1
+	return fmt.Errorf("%s at line %d", fmt.Sprintf(format, args...), line)
+}
+
+func (p *hoconParser) peek(s string) bool {
+	return strings.HasPrefix(p.text[p.pos:], s)
+}
+
+// skipSpaces skips the whitespaces and comments, the newlines and the commas are skipped only if newline is set.
+func (p *hoconParser) skipSpaces(newline bool) {
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			p.pos++
+		case (c == '\n' || c == ',') && newline:
+			p.pos++
+		case c == '#' || p.peek("//"):
+			if !newline {
+				return
+			}
+			p.skipLine()
+		default:
+			return
+		}
+	}
+}
+
+func (p *hoconParser) skipLine() {
+	if eol := strings.IndexByte(p.text[p.pos:], '\n'); eol < 0 {
+		p.pos = len(p.text)
+	} else {
+		p.pos += eol
+	}
+}
+
+func (p *hoconParser) parseObject(block *blockNode, closing bool) error {
+	for {
+		p.skipSpaces(true)
+		switch {
+		case p.pos >= len(p.text):
+			if closing {
+				return p.errorf("unexpected end of file, expecting \"}\"")
+			}
+			return nil
+		case p.text[p.pos] == '}':
+			if !closing {
+				return p.errorf("unexpected \"}\"")
+			}
+			block.bodyEnd = p.pos
+			p.pos++
+			return nil
+		case p.isInclude():
+			p.skipLine()
+			continue
+		}
+		node, err := p.parseField()
+		if err != nil {
+			return err
+		}
+		block.children = append(block.children, node)
+	}
+}
+
+func (p *hoconParser) isInclude() bool {
+	if !p.peek("include") {
+		return false
+	}
+	rest := strings.TrimLeft(p.text[p.pos+len("include"):], " \t")
+	if len(rest) == len(p.text)-p.pos-len("include") {
+		return false
+	}
+	for _, prefix := range []string{`"`, "required(", "file(", "url(", "classpath("} {
+		if strings.HasPrefix(rest, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *hoconParser) parseField() (*blockNode, error) {
+	node := &blockNode{start: p.pos}
+	key, err := p.parseKey()
+	if err != nil {
+		return nil, err
+	}
+	node.key = key
+	node.keyEnd = p.pos
+	p.skipSpaces(false)
+	switch {
+	case p.peek("{"):
+	case p.peek("+="):
+		p.pos += 2
+	case p.peek("=") || p.peek(":"):
+		p.pos++
+	default:
+		return nil, p.errorf("key-value separator not found for the key [%s]", strings.Join(key, DelimiterDot))
+	}
+	p.skipSpaces(false)
+
+	node.valueStart = p.pos
+	if p.peek("{") {
+		node.block = true
+		p.pos++
+		node.bodyStart = p.pos
+		if err := p.parseObject(node, true); err != nil {
+			return nil, err
+		}
+		node.valueEnd, node.end = p.pos, p.pos
+		return node, nil
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	node.value = value
+	node.valueEnd, node.end = p.pos, p.pos
+	return node, nil
+}
+
+func (p *hoconParser) parseKey() ([]string, error) {
+	var key []string
+	var part strings.Builder
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		switch {
+		case c == '"':
+			s, err := p.parseQuotedString()
+			if err != nil {
+				return nil, err
+			}
+			part.WriteString(s)
+			continue
+		case c == '.':
+			key = append(key, part.String())
+			part.Reset()
+		case strings.IndexByte(" \t\r\n=:{+#", c) >= 0 || p.peek("//"):
+			if part.Len() == 0 && len(key) == 0 {
+				return nil, p.errorf("expecting a key")
+			}
+			return append(key, part.String()), nil
+		default:
+			part.WriteByte(c)
+		}
+		p.pos++
+	}
+	return nil, p.errorf("unexpected end of file after the key")
+}
+
+func (p *hoconParser) parseQuotedString() (string, error) {
+	if p.peek(`"""`) {
+		end := strings.Index(p.text[p.pos+3:], `"""`)
+		if end < 0 {
+			return "", p.errorf("unclosed quoted string")
+		}
+		end += p.pos + 3
+		// extra quotes before the closing ones belong to the string
+		for end+3 < len(p.text) && p.text[end+3] == '"' {
+			end++
+		}
+		s := p.text[p.pos+3 : end]
+		p.pos = end + 3
+		return s, nil
+	}
+	for i := p.pos + 1; i < len(p.text); i++ {
+		switch p.text[i] {
+		case '\\':
+			i++
+		case '\n':
+			return "", p.errorf("unclosed quoted string")
+		case '"':
+			s, err := strconv.Unquote(p.text[p.pos : i+1])
+			if err != nil {
+				return "", p.errorf("invalid quoted string: %v", err)
+			}
+			p.pos = i + 1
+			return s, nil
+		}
+	}
+	return "", p.errorf("unclosed quoted string")
+}
+
+// parseValue parses a value till the end of the field, a single string or array is decoded,
+// the other values, e.g. substitutions and concatenations, are kept as raw text.
+func (p *hoconParser) parseValue() (interface{}, error) {
+	start := p.pos
+	var elems []interface{}
+	end := p.pos
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		switch {
+		case c == '\n' || c == ',' || c == '}' || c == ']' || c == '#' || p.peek("//"):
+			if len(elems) == 0 {
+				return nil, p.errorf("expecting a value")
+			}
+			p.pos = end
+			return hoconValue(p.text[start:end], elems), nil
+		case c == ' ' || c == '\t' || c == '\r':
+			p.pos++
+			continue
+		case c == '"':
+			s, err := p.parseQuotedString()
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, s)
+		case c == '[':
+			arr, err := p.parseArray()
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, arr)
+		case c == '{':
+			obj := &blockNode{block: true}
+			p.pos++
+			if err := p.parseObject(obj, true); err != nil {
+				return nil, err
+			}
+			elems = append(elems, hoconObjectParameters(obj))
+		case p.peek("${"):
+			i := strings.IndexByte(p.text[p.pos:], '}')
+			if i < 0 {
+				return nil, p.errorf("unclosed substitution")
+			}
+			p.pos += i + 1
+			elems = append(elems, nil)
+		default:
+			s := p.pos
+			for p.pos < len(p.text) && !strings.ContainsRune(hoconForbiddenChars+" \t\r\n", rune(p.text[p.pos])) && !p.peek("//") {
+				p.pos++
+			}
+			if p.pos == s {
+				return nil, p.errorf("unexpected %q", c)
+			}
+			elems = append(elems, p.text[s:p.pos])
+		}
+		end = p.pos
+	}
+	p.pos = end
+	if len(elems) == 0 {
+		return nil, p.errorf("expecting a value")
+	}
+	return hoconValue(p.text[start:end], elems), nil
+}
+
+func hoconValue(raw string, elems []interface{}) interface{} {
+	if len(elems) == 1 && elems[0] != nil {
+		return elems[0]
+	}
+	return raw
+}
+
+func (p *hoconParser) parseArray() ([]interface{}, error) {
+	p.pos++
+	arr := make([]interface{}, 0)
+	for {
+		p.skipSpaces(true)
+		if p.pos >= len(p.text) {
+			return nil, p.errorf("unexpected end of file, expecting \"]\"")
+		}
+		if p.text[p.pos] == ']' {
+			p.pos++
+			return arr, nil
+		}
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, v)
+	}
+}
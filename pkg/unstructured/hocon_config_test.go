@@ -0,0 +1,119 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+func TestHOCONConfig(t *testing.T) {
+	const hoconContext = `# comment of the file
+include "reference.conf"
+
+akka {
+  loglevel = "INFO"
+  actor {
+    provider = cluster // cluster provider
+    default-dispatcher.throughput = 10
+  }
+  cluster.seed-nodes = ["akka://sys@host1:2552", "akka://sys@host2:2552"]
+}
+
+akka.actor.serializers: { java: "akka.serialization.JavaSerializer" }
+app.home = ${HOME}"/app"
+app.timeout = 30 seconds
+`
+
+	hoconConfigObj, err := LoadConfig("hocon_test", hoconContext, parametersv1alpha1.HOCON)
+	assert.Nil(t, err)
+
+	assert.EqualValues(t, "INFO", hoconConfigObj.Get("akka.loglevel"))
+	assert.EqualValues(t, "cluster", hoconConfigObj.Get("akka.actor.provider"))
+	assert.EqualValues(t, "10", hoconConfigObj.Get("akka.actor.default-dispatcher.throughput"))
+	assert.EqualValues(t, []interface{}{"akka://sys@host1:2552", "akka://sys@host2:2552"}, hoconConfigObj.Get("akka.cluster.seed-nodes"))
+	// the objects with the same path are merged
+	assert.EqualValues(t, "akka.serialization.JavaSerializer", hoconConfigObj.Get("akka.actor.serializers.java"))
+	assert.EqualValues(t, `${HOME}"/app"`, hoconConfigObj.Get("app.home"))
+	assert.EqualValues(t, "30 seconds", hoconConfigObj.Get("app.timeout"))
+	assert.Nil(t, hoconConfigObj.Get("akka.remote"))
+
+	subConfigObj := hoconConfigObj.SubConfig("akka.actor")
+	assert.NotNil(t, subConfigObj)
+	assert.Nil(t, hoconConfigObj.SubConfig("akka.loglevel"))
+	assert.Nil(t, subConfigObj.Update("provider", "local"))
+
+	assert.Nil(t, hoconConfigObj.Update("akka.loglevel", "DEBUG"))
+	assert.Nil(t, hoconConfigObj.Update("akka.actor.default-dispatcher.throughput", 20))
+	assert.Nil(t, hoconConfigObj.Update("akka.cluster.seed-nodes", []interface{}{"akka://sys@host3:2552"}))
+	assert.Nil(t, hoconConfigObj.Update("akka.actor.debug.receive", "on"))
+	assert.Nil(t, hoconConfigObj.Update("app.name", "demo app"))
+	assert.Nil(t, hoconConfigObj.RemoveKey("app.timeout"))
+	// an object can not be set as a value
+	assert.NotNil(t, hoconConfigObj.Update("akka.actor", "none"))
+
+	dumpContext, err := hoconConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, `# comment of the file
+include "reference.conf"
+
+akka {
+  loglevel = "DEBUG"
+  actor {
+    provider = local // cluster provider
+    default-dispatcher.throughput = 20
+    debug.receive = on
+  }
+  cluster.seed-nodes = ["akka://sys@host3:2552"]
+}
+
+akka.actor.serializers: { java: "akka.serialization.JavaSerializer" }
+app.home = ${HOME}"/app"
+app.name = "demo app"
+`, dumpContext)
+
+	_, err = LoadConfig("hocon_test", "akka {\n  loglevel = INFO\n", parametersv1alpha1.HOCON)
+	assert.NotNil(t, err)
+	_, err = LoadConfig("hocon_test", "akka.loglevel INFO\n", parametersv1alpha1.HOCON)
+	assert.NotNil(t, err)
+}
+
+func TestHOCONQuotedKey(t *testing.T) {
+	hoconConfigObj, err := LoadConfig("hocon_test", "\"a.b\" = 1\nc {\n  \"d.e\".f = on\n}\n", parametersv1alpha1.HOCON)
+	assert.Nil(t, err)
+
+	assert.EqualValues(t, "1", hoconConfigObj.Get(`"a.b"`))
+	assert.EqualValues(t, "on", hoconConfigObj.Get(`c."d.e".f`))
+	assert.Nil(t, hoconConfigObj.Get("a.b"))
+
+	// the quoted key is edited in place, no nested "a { b }" path is added
+	assert.Nil(t, hoconConfigObj.Update(`"a.b"`, 2))
+	assert.Nil(t, hoconConfigObj.Update(`c."d.e".f`, "off"))
+	assert.Nil(t, hoconConfigObj.Update(`"x.y"`, "z"))
+	dump, err := hoconConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.Equal(t, "\"a.b\" = 2\nc {\n  \"d.e\".f = off\n}\n\"x.y\" = z\n", dump)
+
+	assert.Nil(t, hoconConfigObj.RemoveKey(`"a.b"`))
+	assert.Nil(t, hoconConfigObj.Get(`"a.b"`))
+}
@@ -0,0 +1,372 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+// iniConfig is a ConfigObject for ini files (e.g. my.cnf) which keeps comments, blank lines,
+// key order and the original quoting of the values.
+//
+// Like the former viper based implementation, keys are addressed as "section.key", matched
+// case-insensitively, and the values keep their surrounding quotes.
+type iniConfig struct {
+	name string
+	doc  *textDocument
+
+	// section is set for the ConfigObject returned by SubConfig, keys are resolved relative to it.
+	section *string
+}
+
+const (
+	iniDefaultSection   = "default"
+	iniDefaultSeparator = "="
+)
+
+func init() {
+	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.Ini, func(name string) ConfigObject {
+		return &iniConfig{name: name, doc: &textDocument{}}
+	})
+}
+
+func (c *iniConfig) Update(key string, value any) error {
+	section, name, lines := c.resolve(key)
+	if len(lines) != 0 {
+		line := lines[len(lines)-1]
+		v := cast.ToString(value)
+		c.doc.setValue(line, v, v)
+		return nil
+	}
+	return c.addEntry(section, name, cast.ToString(value))
+}
+
+func (c *iniConfig) RemoveKey(key string) error {
+	_, _, lines := c.resolve(key)
+	for _, line := range lines {
+		c.doc.remove(line)
+	}
+	return nil
+}
+
+func (c *iniConfig) Get(key string) interface{} {
+	if _, _, lines := c.resolve(key); len(lines) != 0 {
+		return lines[len(lines)-1].entry.value
+	}
+	var v interface{} = c.GetAllParameters()
+	for _, k := range strings.Split(strings.ToLower(key), DelimiterDot) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if v, ok = m[k]; !ok {
+			return nil
+		}
+	}
+	return v
+}
+
+func (c *iniConfig) GetString(key string) (string, error) {
+	return cast.ToStringE(c.Get(key))
+}
+
+func (c *iniConfig) GetAllParameters() map[string]interface{} {
+	params := make(map[string]interface{})
+	for _, line := range c.doc.entries() {
+		if c.section != nil && !iniSectionEqual(iniSectionName(line.section), *c.section) {
+			continue
+		}
+		m := params
+		if c.section == nil {
+			m = nestedMap(params, iniSectionPath(iniSectionName(line.section)))
+		}
+		m[strings.ToLower(line.entry.key[0])] = line.entry.value
+	}
+	return params
+}
+
+func (c *iniConfig) SubConfig(key string) ConfigObject {
+	if c.section != nil || !c.hasSection(key) {
+		return nil
+	}
+	return &iniConfig{
+		name:    c.name,
+		doc:     c.doc,
+		section: &key,
+	}
+}
+
+func (c *iniConfig) Marshal() (string, error) {
+	if c.section == nil {
+		return c.doc.String(), nil
+	}
+	sub := &textDocument{trailingNewline: true}
+	for _, line := range c.doc.entries() {
+		if iniSectionEqual(iniSectionName(line.section), *c.section) {
+			sub.append(line)
+		}
+	}
+	return sub.String(), nil
+}
+
+func (c *iniConfig) Unmarshal(str string) (err error) {
+	c.section = nil
+	c.doc, err = parseIniDocument(str)
+	return err
+}
+
+// resolve returns the section and the key name of a "section.key" path, and the entry lines defining it.
+func (c *iniConfig) resolve(key string) (string, string, []*textLine) {
+	var lines []*textLine
+	if c.section != nil {
+		for _, line := range c.doc.entries() {
+			if iniSectionEqual(iniSectionName(line.section), *c.section) && strings.EqualFold(line.entry.key[0], key) {
+				lines = append(lines, line)
+			}
+		}
+		return *c.section, key, lines
+	}
+
+	section, name := c.splitKey(key)
+	for _, line := range c.doc.entries() {
+		if iniSectionEqual(iniSectionName(line.section), section) && strings.EqualFold(line.entry.key[0], name) {
+			lines = append(lines, line)
+		}
+	}
+	return section, name, lines
+}
+
+// splitKey splits the path into the section and the key, the longest existing section wins
+// so that keys containing the delimiter can still be addressed, e.g. "mysqld.loose.key".
+func (c *iniConfig) splitKey(key string) (string, string) {
+	section, name := "", key
+	for _, line := range c.doc.lines {
+		if line.kind != sectionLine {
+			continue
+		}
+		s := iniSectionName(line.section)
+		if len(s) > len(section) && len(key) > len(s) && strings.EqualFold(key[:len(s)], s) && key[len(s)] == '.' {
+			section, name = s, key[len(s)+1:]
+		}
+	}
+	if section != "" {
+		return section, name
+	}
+	if pos := strings.LastIndex(key, DelimiterDot); pos >= 0 {
+		return key[:pos], key[pos+1:]
+	}
+	return "", key
+}
+
+func (c *iniConfig) hasSection(section string) bool {
+	for _, line := range c.doc.lines {
+		if line.kind == sectionLine && iniSectionEqual(iniSectionName(line.section), section) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *iniConfig) addEntry(section, key, value string) error {
+	if strings.TrimSpace(key) == "" {
+		return fmt.Errorf("invalid ini key: [%s]", key)
+	}
+	if len(c.doc.lines) == 0 {
+		c.doc.trailingNewline = true
+	}
+
+	header := -1
+	for i, line := range c.doc.lines {
+		if line.kind == sectionLine && iniSectionEqual(iniSectionName(line.section), section) {
+			header = i
+		}
+	}
+	pos := len(c.doc.lines)
+	if header >= 0 || iniSectionKey(section) == nil {
+		pos = c.doc.sectionEnd(header)
+	}
+
+	newLine := &textLine{
+		kind:    entryLine,
+		section: iniSectionKey(section),
+		entry: &textEntry{
+			key:    []string{key},
+			value:  value,
+			prefix: key + c.doc.newSeparator(pos, iniDefaultSeparator),
+		},
+	}
+	newLine.text = newLine.entry.prefix + value
+	if header >= 0 || newLine.section == nil {
+		c.doc.insert(pos, newLine)
+		return nil
+	}
+
+	if n := len(c.doc.lines); n != 0 && c.doc.lines[n-1].kind != blankLine {
+		c.doc.append(&textLine{kind: blankLine})
+	}
+	c.doc.append(&textLine{kind: sectionLine, text: "[" + section + "]", section: newLine.section})
+	c.doc.append(newLine)
+	return nil
+}
+
+func parseIniDocument(str string) (*textDocument, error) {
+	lines, trailingNewline := splitDocumentLines(str)
+	doc := &textDocument{trailingNewline: trailingNewline}
+
+	var section []string
+	for i := 0; i < len(lines); i++ {
+		text := lines[i]
+		trimmed := strings.TrimSpace(text)
+		switch {
+		case trimmed == "":
+			doc.append(&textLine{kind: blankLine, text: text})
+		case trimmed[0] == '#' || trimmed[0] == ';':
+			doc.append(&textLine{kind: commentLine, text: text})
+		case trimmed[0] == '!':
+			doc.append(&textLine{kind: opaqueLine, text: text, section: section})
+		case trimmed[0] == '[':
+			end := strings.IndexByte(trimmed, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed section at line %d: %s", i+1, text)
+			}
+			section = iniSectionKey(strings.TrimSpace(trimmed[1:end]))
+			doc.append(&textLine{kind: sectionLine, text: text, section: section})
+		default:
+			// a trailing backslash continues the value on the next line
+			for strings.HasSuffix(strings.TrimSpace(text), `\`) && i+1 < len(lines) {
+				i++
+				text += "\n" + lines[i]
+			}
+			entry, err := parseIniEntry(text)
+			if err != nil {
+				return nil, fmt.Errorf("%v at line %d", err, i+1)
+			}
+			doc.append(&textLine{kind: entryLine, text: text, section: section, entry: entry})
+		}
+	}
+	return doc, nil
+}
+
+func parseIniEntry(text string) (*textEntry, error) {
+	delim := strings.IndexAny(text, "=:")
+	if delim < 0 {
+		return nil, fmt.Errorf("key-value delimiter not found: %s", text)
+	}
+	key := strings.TrimSpace(text[:delim])
+	if key == "" {
+		return nil, fmt.Errorf("empty key: %s", text)
+	}
+
+	start := delim + 1
+	for start < len(text) && (text[start] == ' ' || text[start] == '\t') {
+		start++
+	}
+	entry := &textEntry{key: []string{key}, prefix: text[:start]}
+	if strings.Contains(text[start:], "\n") {
+		entry.value = joinContinuationLines(text[start:])
+		return entry, nil
+	}
+	entry.value, entry.suffix = splitInlineComment(text[start:], "#;")
+	return entry, nil
+}
+
+// splitInlineComment splits the value from the trailing whitespace and the inline comment,
+// a comment starts with one of the markers preceded by whitespace and outside quotes.
+func splitInlineComment(s string, markers string) (string, string) {
+	var quote byte
+	end := len(s)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case strings.IndexByte(markers, c) >= 0 && i > 0 && (s[i-1] == ' ' || s[i-1] == '\t'):
+			end = i
+			i = len(s)
+		}
+	}
+	value := strings.TrimRight(s[:end], " \t\r")
+	return value, s[len(value):]
+}
+
+func joinContinuationLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		line := strings.TrimSpace(lines[i])
+		if i < len(lines)-1 {
+			line = strings.TrimSuffix(line, `\`)
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "")
+}
+
+func iniSectionKey(name string) []string {
+	if name == "" || strings.EqualFold(name, iniDefaultSection) {
+		return nil
+	}
+	return []string{name}
+}
+
+func iniSectionName(section []string) string {
+	if len(section) == 0 {
+		return ""
+	}
+	return section[0]
+}
+
+func iniSectionEqual(s1, s2 string) bool {
+	if strings.EqualFold(s1, iniDefaultSection) {
+		s1 = ""
+	}
+	if strings.EqualFold(s2, iniDefaultSection) {
+		s2 = ""
+	}
+	return strings.EqualFold(s1, s2)
+}
+
+func iniSectionPath(section string) []string {
+	if section == "" {
+		return []string{iniDefaultSection}
+	}
+	return strings.Split(strings.ToLower(section), DelimiterDot)
+}
+
+// nestedMap returns the map at the path, the intermediate maps are created if absent.
+func nestedMap(m map[string]interface{}, path []string) map[string]interface{} {
+	for _, k := range path {
+		next, ok := m[k].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			m[k] = next
+		}
+		m = next
+	}
+	return m
+}
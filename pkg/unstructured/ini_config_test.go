@@ -0,0 +1,99 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+func TestIniConfig(t *testing.T) {
+	const iniContext = `port=3306
+[mysqld]
+skip-name-resolve:1
+init_connect = SET NAMES utf8mb4 \
+  COLLATE utf8mb4_general_ci
+Log_Error = /data/mysql/log/mysqld.err # error log
+[mysqld.replica]
+relay_log = relay-bin`
+
+	iniConfigObj, err := LoadConfig("ini_test", iniContext, parametersv1alpha1.Ini)
+	assert.Nil(t, err)
+
+	assert.EqualValues(t, "3306", iniConfigObj.Get("port"))
+	assert.EqualValues(t, "3306", iniConfigObj.Get("default.port"))
+	assert.EqualValues(t, "1", iniConfigObj.Get("mysqld.skip-name-resolve"))
+	assert.EqualValues(t, "SET NAMES utf8mb4 COLLATE utf8mb4_general_ci", iniConfigObj.Get("mysqld.init_connect"))
+	assert.EqualValues(t, "/data/mysql/log/mysqld.err", iniConfigObj.Get("mysqld.log_error"))
+	assert.EqualValues(t, "relay-bin", iniConfigObj.Get("mysqld.replica.relay_log"))
+	assert.EqualValues(t, map[string]interface{}{
+		"default": map[string]interface{}{"port": "3306"},
+		"mysqld": map[string]interface{}{
+			"skip-name-resolve": "1",
+			"init_connect":      "SET NAMES utf8mb4 COLLATE utf8mb4_general_ci",
+			"log_error":         "/data/mysql/log/mysqld.err",
+			"replica":           map[string]interface{}{"relay_log": "relay-bin"},
+		},
+	}, iniConfigObj.GetAllParameters())
+
+	// the sub config shares the content with its parent
+	subConfigObj := iniConfigObj.SubConfig("mysqld")
+	assert.Nil(t, subConfigObj.Update("log_error", "/tmp/mysqld.err"))
+	assert.Nil(t, subConfigObj.Update("init_connect", "SET NAMES utf8mb4"))
+	assert.Nil(t, subConfigObj.Update("skip-name-resolve", "OFF"))
+	assert.Nil(t, subConfigObj.Update("max_connections", "100"))
+	assert.Nil(t, iniConfigObj.Update("mysqld.replica.relay_log", "relay"))
+	assert.Nil(t, iniConfigObj.RemoveKey("port"))
+	assert.Nil(t, iniConfigObj.SubConfig("not_exist"))
+
+	dumpContext, err := iniConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, `[mysqld]
+skip-name-resolve:OFF
+init_connect = SET NAMES utf8mb4
+Log_Error = /tmp/mysqld.err # error log
+max_connections = 100
+[mysqld.replica]
+relay_log = relay`, dumpContext)
+
+	subContext, err := subConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, "skip-name-resolve:OFF\ninit_connect = SET NAMES utf8mb4\nLog_Error = /tmp/mysqld.err # error log\nmax_connections = 100\n", subContext)
+}
+
+func TestIniConfigEmpty(t *testing.T) {
+	iniConfigObj, err := LoadConfig("ini_test", "", parametersv1alpha1.Ini)
+	assert.Nil(t, err)
+	assert.Nil(t, iniConfigObj.SubConfig("mysqld"))
+
+	assert.Nil(t, iniConfigObj.Update("mysqld.port", "3306"))
+	assert.Nil(t, iniConfigObj.Update("client.port", "3306"))
+	dumpContext, err := iniConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, "[mysqld]\nport=3306\n\n[client]\nport=3306\n", dumpContext)
+
+	_, err = LoadConfig("ini_test", "[mysqld\nport=3306", parametersv1alpha1.Ini)
+	assert.NotNil(t, err)
+	_, err = LoadConfig("ini_test", "[mysqld]\nskip-name-resolve", parametersv1alpha1.Ini)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,395 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cast"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+// keyValueConfig is a ConfigObject for flat "key = value" files, which keeps comments, blank lines,
+// key order and the original quoting of the values. The syntax of the file is defined by the dialect.
+//
+// Like the former viper based implementation, keys are matched case-insensitively and
+// reported in lower case by GetAllParameters.
+type keyValueConfig struct {
+	name    string
+	doc     *textDocument
+	dialect keyValueDialect
+}
+
+type keyValueDialect interface {
+	// isComment reports whether the trimmed line is a comment.
+	isComment(trimmed string) bool
+
+	// continued reports whether the logical line continues on the next physical line.
+	continued(text string) bool
+
+	// parseEntry parses a logical line into an entry.
+	parseEntry(text string) (*textEntry, error)
+
+	// encodeValue returns the raw text of the value, oldRaw is the raw text of the replaced value if any.
+	encodeValue(value string, oldRaw *string) string
+
+	defaultSeparator() string
+}
+
+func init() {
+	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.Properties, func(name string) ConfigObject {
+		return &keyValueConfig{name: name, doc: &textDocument{}, dialect: propertiesDialect{inlineComment: isPostgreSQLConfig(name)}}
+	})
+	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.Dotenv, func(name string) ConfigObject {
+		return &keyValueConfig{name: name, doc: &textDocument{}, dialect: dotenvDialect{}}
+	})
+}
+
+func (c *keyValueConfig) Update(key string, value any) error {
+	v := cast.ToString(value)
+	if lines := c.lookup(key); len(lines) != 0 {
+		line := lines[len(lines)-1]
+		oldRaw := line.text[len(line.entry.prefix) : len(line.text)-len(line.entry.suffix)]
+		if oldRaw == "" && !hasSeparator(line.entry.prefix) {
+			// a bare key, e.g. "a" of the properties, has no separator before the value
+			line.entry.prefix += c.dialect.defaultSeparator()
+		}
+		c.doc.setValue(line, c.dialect.encodeValue(v, &oldRaw), v)
+		return nil
+	}
+
+	if strings.TrimSpace(key) == "" || strings.ContainsFunc(key, unicode.IsSpace) {
+		return fmt.Errorf("invalid key: [%s]", key)
+	}
+	pos := c.doc.sectionEnd(-1)
+	entry := &textEntry{
+		key:    []string{key},
+		value:  v,
+		prefix: key + c.doc.newSeparator(pos, c.dialect.defaultSeparator()),
+	}
+	if len(c.doc.lines) == 0 {
+		c.doc.trailingNewline = true
+	}
+	c.doc.insert(pos, &textLine{
+		kind:  entryLine,
+		text:  entry.prefix + c.dialect.encodeValue(v, nil),
+		entry: entry,
+	})
+	return nil
+}
+
+func (c *keyValueConfig) RemoveKey(key string) error {
+	for _, line := range c.lookup(key) {
+		c.doc.remove(line)
+	}
+	return nil
+}
+
+func (c *keyValueConfig) Get(key string) interface{} {
+	if lines := c.lookup(key); len(lines) != 0 {
+		return lines[len(lines)-1].entry.value
+	}
+	return nil
+}
+
+func (c *keyValueConfig) GetString(key string) (string, error) {
+	return cast.ToStringE(c.Get(key))
+}
+
+func (c *keyValueConfig) GetAllParameters() map[string]interface{} {
+	params := make(map[string]interface{})
+	for _, line := range c.doc.entries() {
+		params[strings.ToLower(line.entry.key[0])] = line.entry.value
+	}
+	return params
+}
+
+func (c *keyValueConfig) SubConfig(key string) ConfigObject {
+	return nil
+}
+
+func (c *keyValueConfig) Marshal() (string, error) {
+	return c.doc.String(), nil
+}
+
+func (c *keyValueConfig) Unmarshal(str string) error {
+	lines, trailingNewline := splitDocumentLines(str)
+	doc := &textDocument{trailingNewline: trailingNewline}
+	for i := 0; i < len(lines); i++ {
+		text := lines[i]
+		trimmed := strings.TrimSpace(text)
+		switch {
+		case trimmed == "":
+			doc.append(&textLine{kind: blankLine, text: text})
+		case c.dialect.isComment(trimmed):
+			doc.append(&textLine{kind: commentLine, text: text})
+		default:
+			for c.dialect.continued(text) && i+1 < len(lines) {
+				i++
+				text += "\n" + lines[i]
+			}
+			entry, err := c.dialect.parseEntry(text)
+			if err != nil {
+				return fmt.Errorf("%v at line %d", err, i+1)
+			}
+			doc.append(&textLine{kind: entryLine, text: text, entry: entry})
+		}
+	}
+	c.doc = doc
+	return nil
+}
+
+func (c *keyValueConfig) lookup(key string) []*textLine {
+	var lines []*textLine
+	for _, line := range c.doc.entries() {
+		if strings.EqualFold(line.entry.key[0], key) {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// hasSeparator reports whether the prefix of an entry ends with a separator between the key and the value.
+func hasSeparator(prefix string) bool {
+	return prefix != "" && strings.IndexByte("=: \t\f", prefix[len(prefix)-1]) >= 0
+}
+
+// propertiesDialect follows the java properties syntax, e.g. postgresql.conf or the pulsar broker.conf.
+type propertiesDialect struct {
+	// inlineComment reports whether a '#' preceded by whitespace starts an inline comment, as postgresql.conf does.
+	// It is part of the value in the java properties, e.g. "url=jdbc:...#frag".
+	inlineComment bool
+}
+
+// isPostgreSQLConfig reports whether the file is a postgresql.conf or an included one, e.g. postgresql.auto.conf.
+func isPostgreSQLConfig(name string) bool {
+	base := path.Base(name)
+	return strings.HasPrefix(base, "postgresql") && strings.HasSuffix(base, ".conf")
+}
+
+func (propertiesDialect) isComment(trimmed string) bool {
+	return trimmed[0] == '#' || trimmed[0] == '!'
+}
+
+func (propertiesDialect) continued(text string) bool {
+	n := 0
+	for i := len(text) - 1; i >= 0 && text[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+func (d propertiesDialect) parseEntry(text string) (*textEntry, error) {
+	start := len(text) - len(strings.TrimLeft(text, " \t\f"))
+	end := start
+	for end < len(text) {
+		c := text[end]
+		if c == '\\' {
+			end += 2
+			continue
+		}
+		if c == '=' || c == ':' || c == ' ' || c == '\t' || c == '\f' {
+			break
+		}
+		end++
+	}
+	end = min(end, len(text))
+
+	pos := skipSpaces(text, end)
+	if pos < len(text) && (text[pos] == '=' || text[pos] == ':') {
+		pos = skipSpaces(text, pos+1)
+	}
+	raw := strings.TrimRight(text[pos:], " \t\r")
+	if d.inlineComment {
+		// the inline comments are kept in the suffix
+		raw, _ = splitInlineComment(text[pos:], "#")
+	}
+	return &textEntry{
+		key:    []string{unescapeProperties(text[start:end])},
+		value:  unescapeProperties(raw),
+		prefix: text[:pos],
+		suffix: text[pos+len(raw):],
+	}, nil
+}
+
+func (propertiesDialect) encodeValue(value string, _ *string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "\f", `\f`).Replace(value)
+}
+
+func (propertiesDialect) defaultSeparator() string {
+	return " = "
+}
+
+func skipSpaces(s string, pos int) int {
+	for pos < len(s) && (s[pos] == ' ' || s[pos] == '\t' || s[pos] == '\f') {
+		pos++
+	}
+	return pos
+}
+
+func unescapeProperties(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	buffer := &strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 >= len(s) {
+			buffer.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			buffer.WriteByte('\t')
+		case 'n':
+			buffer.WriteByte('\n')
+		case 'r':
+			buffer.WriteByte('\r')
+		case 'f':
+			buffer.WriteByte('\f')
+		case '\n':
+			// line continuation, the leading whitespace of the next line is ignored
+			i = skipSpaces(s, i+1) - 1
+		case 'u':
+			if i+4 < len(s) {
+				if r, err := strconv.ParseUint(s[i+1:i+5], 16, 32); err == nil {
+					buffer.WriteRune(rune(r))
+					i += 4
+					continue
+				}
+			}
+			buffer.WriteByte('u')
+		default:
+			buffer.WriteByte(s[i])
+		}
+	}
+	return buffer.String()
+}
+
+// dotenvDialect follows the .env syntax, variables are not expanded.
+type dotenvDialect struct{}
+
+func (dotenvDialect) isComment(trimmed string) bool {
+	return trimmed[0] == '#'
+}
+
+func (dotenvDialect) continued(text string) bool {
+	_, entry := splitDotenvKey(text)
+	if entry < 0 {
+		return false
+	}
+	value := strings.TrimLeft(text[entry:], " \t")
+	if value == "" || (value[0] != '"' && value[0] != '\'') {
+		return false
+	}
+	return findClosingQuote(value) < 0
+}
+
+func (d dotenvDialect) parseEntry(text string) (*textEntry, error) {
+	key, pos := splitDotenvKey(text)
+	if pos < 0 {
+		return nil, fmt.Errorf("invalid dotenv line: %s", text)
+	}
+	pos = skipSpaces(text, pos)
+	entry := &textEntry{key: []string{key}, prefix: text[:pos]}
+
+	rest := text[pos:]
+	var raw string
+	switch {
+	case rest != "" && (rest[0] == '"' || rest[0] == '\''):
+		end := findClosingQuote(rest)
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed quoted value: %s", text)
+		}
+		raw = rest[:end+1]
+		entry.value = unquoteDotenv(raw)
+	default:
+		raw = rest
+		if i := strings.IndexByte(raw, '#'); i >= 0 {
+			raw = raw[:i]
+		}
+		raw = strings.TrimRight(raw, " \t\r")
+		entry.value = raw
+	}
+	entry.suffix = rest[len(raw):]
+	return entry, nil
+}
+
+func (dotenvDialect) encodeValue(value string, oldRaw *string) string {
+	var quote byte
+	if oldRaw != nil && *oldRaw != "" {
+		quote = (*oldRaw)[0]
+	}
+	switch {
+	case quote == '\'' && !strings.ContainsAny(value, "'\n"):
+		return "'" + value + "'"
+	case quote == '"' || strings.ContainsAny(value, " \t\r\n#'\"\\"):
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+	default:
+		return value
+	}
+}
+
+func (dotenvDialect) defaultSeparator() string {
+	return "="
+}
+
+// splitDotenvKey returns the key and the position after the separator, or -1 if the line is not an entry.
+func splitDotenvKey(text string) (string, int) {
+	start := skipSpaces(text, 0)
+	if strings.HasPrefix(text[start:], "export ") {
+		start = skipSpaces(text, start+len("export "))
+	}
+	end := strings.IndexAny(text[start:], "=:")
+	if end <= 0 {
+		return "", -1
+	}
+	key := strings.TrimSpace(text[start : start+end])
+	if key == "" || strings.ContainsFunc(key, unicode.IsSpace) {
+		return "", -1
+	}
+	return key, start + end + 1
+}
+
+func findClosingQuote(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			i++
+		case s[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+func unquoteDotenv(raw string) string {
+	if raw[0] == '\'' {
+		return raw[1 : len(raw)-1]
+	}
+	return strings.NewReplacer(`\\`, `\`, `\"`, `"`, `\n`, "\n", `\r`, "\r", `\t`, "\t").Replace(raw[1 : len(raw)-1])
+}
@@ -0,0 +1,136 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+func TestPropertiesConfig(t *testing.T) {
+	const propsContext = `! java style comment
+brokerServicePort:6650
+bookkeeperMetadataServiceUri = zk+null://zookeeper:2181/ledgers\
+  /pulsar
+webServicePath  C:\\pulsar\tweb
+`
+
+	propsConfigObj, err := LoadConfig("props_test", propsContext, parametersv1alpha1.Properties)
+	assert.Nil(t, err)
+
+	assert.EqualValues(t, "6650", propsConfigObj.Get("brokerServicePort"))
+	assert.EqualValues(t, "zk+null://zookeeper:2181/ledgers/pulsar", propsConfigObj.Get("bookkeepermetadataserviceuri"))
+	assert.EqualValues(t, "C:\\pulsar\tweb", propsConfigObj.Get("webServicePath"))
+	assert.EqualValues(t, map[string]interface{}{
+		"brokerserviceport":            "6650",
+		"bookkeepermetadataserviceuri": "zk+null://zookeeper:2181/ledgers/pulsar",
+		"webservicepath":               "C:\\pulsar\tweb",
+	}, propsConfigObj.GetAllParameters())
+	assert.Nil(t, propsConfigObj.SubConfig("broker"))
+
+	assert.Nil(t, propsConfigObj.Update("bookkeeperMetadataServiceUri", "zk+null://zk:2181/ledgers"))
+	assert.Nil(t, propsConfigObj.Update("webServicePath", "C:\\web"))
+	assert.Nil(t, propsConfigObj.Update("numIOThreads", 8))
+	dumpContext, err := propsConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, `! java style comment
+brokerServicePort:6650
+bookkeeperMetadataServiceUri = zk+null://zk:2181/ledgers
+webServicePath  C:\\web
+numIOThreads = 8
+`, dumpContext)
+}
+
+func TestPropertiesInlineComment(t *testing.T) {
+	const pgContext = `shared_buffers = 128MB   # min 128kB
+archive_command = 'cp %p /arch/%f # not a comment'
+`
+	propsConfigObj, err := LoadConfig("postgresql.conf", pgContext, parametersv1alpha1.Properties)
+	assert.Nil(t, err)
+	assert.EqualValues(t, "128MB", propsConfigObj.Get("shared_buffers"))
+	assert.EqualValues(t, "'cp %p /arch/%f # not a comment'", propsConfigObj.Get("archive_command"))
+
+	assert.Nil(t, propsConfigObj.Update("shared_buffers", "256MB"))
+	dumpContext, err := propsConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, `shared_buffers = 256MB   # min 128kB
+archive_command = 'cp %p /arch/%f # not a comment'
+`, dumpContext)
+}
+
+func TestPropertiesHashInValue(t *testing.T) {
+	const propsContext = `url=jdbc:mysql://db:3306/app#frag
+msg=a #b
+`
+	propsConfigObj, err := LoadConfig("app.properties", propsContext, parametersv1alpha1.Properties)
+	assert.Nil(t, err)
+	assert.EqualValues(t, "jdbc:mysql://db:3306/app#frag", propsConfigObj.Get("url"))
+	assert.EqualValues(t, "a #b", propsConfigObj.Get("msg"))
+
+	assert.Nil(t, propsConfigObj.Update("msg", "c #d"))
+	dumpContext, err := propsConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, `url=jdbc:mysql://db:3306/app#frag
+msg=c #d
+`, dumpContext)
+}
+
+func TestPropertiesBareKey(t *testing.T) {
+	const propsContext = `a
+b
+`
+	propsConfigObj, err := LoadConfig("props_test", propsContext, parametersv1alpha1.Properties)
+	assert.Nil(t, err)
+	assert.EqualValues(t, "", propsConfigObj.Get("a"))
+
+	assert.Nil(t, propsConfigObj.Update("a", "x"))
+	dumpContext, err := propsConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, `a = x
+b
+`, dumpContext)
+}
+
+func TestDotenvConfig(t *testing.T) {
+	dotenvConfigObj, err := LoadConfig("dotenv_test", "", parametersv1alpha1.Dotenv)
+	assert.Nil(t, err)
+
+	assert.Nil(t, dotenvConfigObj.Update("MODE", "standalone"))
+	assert.Nil(t, dotenvConfigObj.Update("OPTIONS", "-a -b"))
+	assert.Nil(t, dotenvConfigObj.Update("EMPTY", ""))
+	dumpContext, err := dotenvConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, "MODE=standalone\nOPTIONS=\"-a -b\"\nEMPTY=\n", dumpContext)
+
+	const dotenvContext = `KEY1="line1
+line2"
+KEY2=value#comment
+`
+	dotenvConfigObj, err = LoadConfig("dotenv_test", dotenvContext, parametersv1alpha1.Dotenv)
+	assert.Nil(t, err)
+	assert.EqualValues(t, "line1\nline2", dotenvConfigObj.Get("KEY1"))
+	assert.EqualValues(t, "value", dotenvConfigObj.Get("KEY2"))
+
+	_, err = LoadConfig("dotenv_test", "INVALID LINE", parametersv1alpha1.Dotenv)
+	assert.NotNil(t, err)
+}
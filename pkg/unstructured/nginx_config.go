@@ -0,0 +1,439 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+// nginxConfig is a ConfigObject for nginx-style configuration files, which consist of
+// `name args...;` directives and `name args... { ... }` blocks, e.g. nginx.conf.
+//
+// A directive is addressed by the dotted path of its enclosing blocks and its name, e.g. "http.server.listen",
+// a block with arguments is addressed by its name and arguments, e.g. "http.upstream backend.keepalive".
+// The value of a directive is its raw arguments joined by a space, a repeated directive (e.g. listen)
+// is represented as a list of values. Only the changed directives are rewritten by Update and RemoveKey.
+type nginxConfig struct {
+	name string
+	doc  *blockDocument
+
+	// prefix is set for the ConfigObject returned by SubConfig, keys are resolved relative to it.
+	prefix string
+}
+
+func init() {
+	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.Nginx, func(name string) ConfigObject {
+		return &nginxConfig{name: name, doc: &blockDocument{root: &blockNode{block: true}}}
+	})
+}
+
+func (c *nginxConfig) Update(key string, value any) error {
+	values := nginxValues(value)
+	parent, nodes, rest, err := c.resolve(c.fullKey(key))
+	if err != nil {
+		return err
+	}
+
+	var edits []textEdit
+	for i, node := range nodes {
+		switch {
+		case node.block:
+			return fmt.Errorf("the key [%s] refers to a block", key)
+		case i < len(values):
+			edits = append(edits, c.setValueEdit(node, values[i]))
+		default:
+			edits = append(edits, removeStatementEdit(c.doc.text, node, isNginxComment))
+		}
+	}
+	if len(values) > len(nodes) {
+		stmts := make([]string, 0, len(values)-len(nodes))
+		for _, v := range values[len(nodes):] {
+			stmts = append(stmts, nginxDirective(rest[len(rest)-1], v))
+		}
+		edits = append(edits, c.insertEdit(parent, nodes, rest, stmts))
+	}
+	return c.apply(edits)
+}
+
+func (c *nginxConfig) RemoveKey(key string) error {
+	_, nodes, _, err := c.resolve(c.fullKey(key))
+	if err != nil {
+		return err
+	}
+	edits := make([]textEdit, 0, len(nodes))
+	for _, node := range nodes {
+		edits = append(edits, removeStatementEdit(c.doc.text, node, isNginxComment))
+	}
+	return c.apply(edits)
+}
+
+func (c *nginxConfig) Get(key string) interface{} {
+	_, nodes, _, err := c.resolve(c.fullKey(key))
+	if err != nil || len(nodes) == 0 {
+		return nil
+	}
+	return nginxNodesValue(nodes)
+}
+
+func (c *nginxConfig) GetString(key string) (string, error) {
+	return cast.ToStringE(c.Get(key))
+}
+
+func (c *nginxConfig) GetAllParameters() map[string]interface{} {
+	if c.prefix == "" {
+		return nginxBlockParameters(c.doc.root)
+	}
+	_, nodes, _, err := c.resolve(c.prefix)
+	if err != nil || len(nodes) != 1 || !nodes[0].block {
+		return nil
+	}
+	return nginxBlockParameters(nodes[0])
+}
+
+func (c *nginxConfig) SubConfig(key string) ConfigObject {
+	_, nodes, _, err := c.resolve(c.fullKey(key))
+	if err != nil || len(nodes) != 1 || !nodes[0].block {
+		return nil
+	}
+	return &nginxConfig{
+		name:   c.name,
+		doc:    c.doc,
+		prefix: c.fullKey(key),
+	}
+}
+
+func (c *nginxConfig) Marshal() (string, error) {
+	if c.prefix == "" {
+		return c.doc.text, nil
+	}
+	_, nodes, _, err := c.resolve(c.prefix)
+	if err != nil || len(nodes) != 1 {
+		return "", err
+	}
+	return c.doc.text[nodes[0].bodyStart:nodes[0].bodyEnd], nil
+}
+
+func (c *nginxConfig) Unmarshal(str string) error {
+	root, err := parseNginxDocument(str)
+	if err != nil {
+		return err
+	}
+	c.doc = &blockDocument{text: str, root: root}
+	c.prefix = ""
+	return nil
+}
+
+func (c *nginxConfig) fullKey(key string) string {
+	if c.prefix == "" {
+		return key
+	}
+	return c.prefix + DelimiterDot + key
+}
+
+func (c *nginxConfig) apply(edits []textEdit) error {
+	if len(edits) == 0 {
+		return nil
+	}
+	text := applyTextEdits(c.doc.text, edits)
+	root, err := parseNginxDocument(text)
+	if err != nil {
+		return err
+	}
+	c.doc.text, c.doc.root = text, root
+	return nil
+}
+
+// resolve walks down the blocks matching the key, and returns the deepest block found, the nodes
+// matching the whole key and the unresolved key parts.
+func (c *nginxConfig) resolve(key string) (*blockNode, []*blockNode, []string, error) {
+	block := c.doc.root
+	rest := key
+	for {
+		var matched []*blockNode
+		var descent []*blockNode
+		descentKey := ""
+		for _, child := range block.children {
+			childKey := strings.Join(child.key, " ")
+			switch {
+			case childKey == rest:
+				matched = append(matched, child)
+			case child.block && strings.HasPrefix(rest, childKey+DelimiterDot) && len(childKey) >= len(descentKey):
+				if len(childKey) > len(descentKey) {
+					descent = nil
+				}
+				descent = append(descent, child)
+				descentKey = childKey
+			}
+		}
+		switch {
+		case len(matched) != 0:
+			return block, matched, []string{rest}, nil
+		case len(descent) > 1:
+			return nil, nil, nil, fmt.Errorf("the key [%s] is ambiguous: block [%s] is repeated", key, descentKey)
+		case len(descent) == 1:
+			block = descent[0]
+			rest = rest[len(descentKey)+1:]
+		default:
+			return block, nil, strings.Split(rest, DelimiterDot), nil
+		}
+	}
+}
+
+func (c *nginxConfig) setValueEdit(node *blockNode, value string) textEdit {
+	if node.valueStart == node.valueEnd {
+		if value == "" {
+			return textEdit{start: node.valueStart, end: node.valueEnd}
+		}
+		return textEdit{start: node.valueStart, end: node.valueEnd, replacement: " " + value}
+	}
+	if value == "" {
+		return textEdit{start: node.keyEnd, end: node.valueEnd}
+	}
+	return textEdit{start: node.valueStart, end: node.valueEnd, replacement: value}
+}
+
+// insertEdit adds the directives after the existing ones, or into the deepest block, the missing blocks are created.
+func (c *nginxConfig) insertEdit(parent *blockNode, nodes []*blockNode, rest []string, stmts []string) textEdit {
+	if len(nodes) != 0 {
+		last := nodes[len(nodes)-1]
+		indent := lineIndent(c.doc.text, last.start)
+		if ok, eol := restOfLineIsComment(c.doc.text, last.end, isNginxComment); ok {
+			return textEdit{start: eol, end: eol, replacement: "\n" + indent + strings.Join(stmts, "\n"+indent)}
+		}
+		return textEdit{start: last.end, end: last.end, replacement: " " + strings.Join(stmts, " ")}
+	}
+
+	stmt := strings.Join(stmts, "\n")
+	for i := len(rest) - 2; i >= 0; i-- {
+		stmt = rest[i] + " {\n" + defaultBlockIndent + strings.ReplaceAll(stmt, "\n", "\n"+defaultBlockIndent) + "\n}"
+	}
+	return insertStatementEdit(c.doc.text, parent, parent == c.doc.root, stmt, isNginxComment)
+}
+
+func nginxDirective(name, value string) string {
+	if value == "" {
+		return name + ";"
+	}
+	return name + " " + value + ";"
+}
+
+func nginxValues(value any) []string {
+	switch v := value.(type) {
+	case []interface{}:
+		return cast.ToStringSlice(v)
+	case []string:
+		return v
+	default:
+		return []string{cast.ToString(value)}
+	}
+}
+
+func nginxNodesValue(nodes []*blockNode) interface{} {
+	values := make([]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		if node.block {
+			values = append(values, nginxBlockParameters(node))
+		} else {
+			values = append(values, node.value)
+		}
+	}
+	if len(values) == 1 {
+		return values[0]
+	}
+	return values
+}
+
+func nginxBlockParameters(block *blockNode) map[string]interface{} {
+	params := make(map[string]interface{})
+	keys := make([]string, 0, len(block.children))
+	groups := make(map[string][]*blockNode)
+	for _, child := range block.children {
+		key := strings.Join(child.key, " ")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], child)
+	}
+	for _, key := range keys {
+		params[key] = nginxNodesValue(groups[key])
+	}
+	return params
+}
+
+func isNginxComment(s string) bool {
+	return strings.HasPrefix(s, "#")
+}
+
+type nginxParser struct {
+	text string
+	pos  int
+}
+
+func parseNginxDocument(text string) (*blockNode, error) {
+	p := &nginxParser{text: text}
+	root := &blockNode{block: true, bodyEnd: len(text)}
+	if err := p.parseBlock(root, false); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func (p *nginxParser) errorf(format string, args ...interface{}) error {
+	line := strings.Count(p.text[:min(p.pos, len(p.text))], "\n") + 1
+	return fmt.Errorf("%s at line %d", fmt.Sprintf(format, args...), line)
+}
+
+func (p *nginxParser) skipSpacesAndComments() {
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			p.pos++
+		case c == '#':
+			eol := strings.IndexByte(p.text[p.pos:], '\n')
+			if eol < 0 {
+				p.pos = len(p.text)
+			} else {
+				p.pos += eol
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *nginxParser) parseBlock(block *blockNode, closing bool) error {
+	for {
+		p.skipSpacesAndComments()
+		if p.pos >= len(p.text) {
+			if closing {
+				return p.errorf("unexpected end of file, expecting \"}\"")
+			}
+			return nil
+		}
+		switch p.text[p.pos] {
+		case '}':
+			if !closing {
+				return p.errorf("unexpected \"}\"")
+			}
+			block.bodyEnd = p.pos
+			p.pos++
+			return nil
+		case ';':
+			p.pos++
+			continue
+		}
+		node, err := p.parseStatement()
+		if err != nil {
+			return err
+		}
+		block.children = append(block.children, node)
+	}
+}
+
+func (p *nginxParser) parseStatement() (*blockNode, error) {
+	node := &blockNode{start: p.pos}
+	var words []string
+	for {
+		p.skipSpacesAndComments()
+		if p.pos >= len(p.text) {
+			return nil, p.errorf("unexpected end of file, expecting \";\" or \"}\"")
+		}
+		switch p.text[p.pos] {
+		case ';':
+			p.pos++
+			node.end = p.pos
+			node.key = words[:1]
+			node.value = strings.Join(words[1:], " ")
+			if len(words) == 1 {
+				node.valueStart, node.valueEnd = node.keyEnd, node.keyEnd
+			}
+			return node, nil
+		case '{':
+			if len(words) == 0 {
+				return nil, p.errorf("unexpected \"{\"")
+			}
+			node.block = true
+			node.key = []string{strings.Join(words, " ")}
+			p.pos++
+			node.bodyStart = p.pos
+			if err := p.parseBlock(node, true); err != nil {
+				return nil, err
+			}
+			node.end = p.pos
+			return node, nil
+		case '}':
+			return nil, p.errorf("unexpected \"}\"")
+		}
+
+		start := p.pos
+		if err := p.scanWord(); err != nil {
+			return nil, err
+		}
+		if len(words) == 0 {
+			node.keyEnd = p.pos
+		} else {
+			if len(words) == 1 {
+				node.valueStart = start
+			}
+			node.valueEnd = p.pos
+		}
+		words = append(words, p.text[start:p.pos])
+	}
+}
+
+func (p *nginxParser) scanWord() error {
+	if c := p.text[p.pos]; c == '"' || c == '\'' {
+		for i := p.pos + 1; i < len(p.text); i++ {
+			switch p.text[i] {
+			case '\\':
+				i++
+			case c:
+				p.pos = i + 1
+				return nil
+			}
+		}
+		return p.errorf("unclosed quoted string")
+	}
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == ';' || c == '{' || c == '}':
+			return nil
+		case c == '\\':
+			p.pos += 2
+		case c == '$' && p.pos+1 < len(p.text) && p.text[p.pos+1] == '{':
+			end := strings.IndexByte(p.text[p.pos:], '}')
+			if end < 0 {
+				return p.errorf("unclosed variable")
+			}
+			p.pos += end + 1
+		default:
+			p.pos++
+		}
+	}
+	p.pos = min(p.pos, len(p.text))
+	return nil
+}
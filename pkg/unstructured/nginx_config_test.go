@@ -0,0 +1,129 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+func TestNginxConfig(t *testing.T) {
+	const nginxContext = `# main context
+worker_processes  auto; # one per core
+error_log /var/log/nginx/error.log warn;
+
+events {
+    worker_connections 1024;
+}
+
+http {
+    upstream backend {
+        server 10.0.0.1:8080;
+        server 10.0.0.2:8080;
+    }
+    server {
+        listen 80;
+        server_name "example.com";
+        location / { proxy_pass http://backend; }
+    }
+}
+`
+
+	nginxConfigObj, err := LoadConfig("nginx_test", nginxContext, parametersv1alpha1.Nginx)
+	assert.Nil(t, err)
+
+	assert.EqualValues(t, "auto", nginxConfigObj.Get("worker_processes"))
+	assert.EqualValues(t, "/var/log/nginx/error.log warn", nginxConfigObj.Get("error_log"))
+	assert.EqualValues(t, "1024", nginxConfigObj.Get("events.worker_connections"))
+	assert.EqualValues(t, []interface{}{"10.0.0.1:8080", "10.0.0.2:8080"}, nginxConfigObj.Get("http.upstream backend.server"))
+	assert.EqualValues(t, `"example.com"`, nginxConfigObj.Get("http.server.server_name"))
+	assert.EqualValues(t, "http://backend", nginxConfigObj.Get("http.server.location /.proxy_pass"))
+	assert.Nil(t, nginxConfigObj.Get("http.gzip"))
+	assert.EqualValues(t, map[string]interface{}{"worker_connections": "1024"}, nginxConfigObj.GetAllParameters()["events"])
+
+	subConfigObj := nginxConfigObj.SubConfig("http.server")
+	assert.NotNil(t, subConfigObj)
+	assert.Nil(t, nginxConfigObj.SubConfig("not_exist"))
+	assert.EqualValues(t, "80", subConfigObj.Get("listen"))
+	assert.Nil(t, subConfigObj.Update("server_name", `"kubeblocks.io"`))
+
+	assert.Nil(t, nginxConfigObj.Update("worker_processes", 4))
+	assert.Nil(t, nginxConfigObj.Update("events.worker_connections", "4096"))
+	assert.Nil(t, nginxConfigObj.Update("http.upstream backend.server", []interface{}{"10.0.0.3:8080"}))
+	assert.Nil(t, nginxConfigObj.Update("http.upstream backend.keepalive", "32"))
+	assert.Nil(t, nginxConfigObj.Update("http.server.location /.proxy_read_timeout", "60s"))
+	assert.Nil(t, nginxConfigObj.Update("http.gzip", "on"))
+	assert.Nil(t, nginxConfigObj.Update("stream.server.listen", "3306"))
+	assert.Nil(t, nginxConfigObj.RemoveKey("error_log"))
+	// a block can not be set as a directive
+	assert.NotNil(t, nginxConfigObj.Update("http.server", "on"))
+
+	dumpContext, err := nginxConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, `# main context
+worker_processes  4; # one per core
+
+events {
+    worker_connections 4096;
+}
+
+http {
+    upstream backend {
+        server 10.0.0.3:8080;
+        keepalive 32;
+    }
+    server {
+        listen 80;
+        server_name "kubeblocks.io";
+        location / { proxy_pass http://backend; proxy_read_timeout 60s; }
+    }
+    gzip on;
+}
+stream {
+    server {
+        listen 3306;
+    }
+}
+`, dumpContext)
+
+	_, err = LoadConfig("nginx_test", "http {\n    gzip on;\n", parametersv1alpha1.Nginx)
+	assert.NotNil(t, err)
+	_, err = LoadConfig("nginx_test", "gzip on\n", parametersv1alpha1.Nginx)
+	assert.NotNil(t, err)
+}
+
+func TestNginxConfigRepeatedBlocks(t *testing.T) {
+	const nginxContext = `http {
+    server { listen 80; }
+    server { listen 8080; }
+}
+`
+	nginxConfigObj, err := LoadConfig("nginx_test", nginxContext, parametersv1alpha1.Nginx)
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{
+		map[string]interface{}{"listen": "80"},
+		map[string]interface{}{"listen": "8080"},
+	}, nginxConfigObj.Get("http.server"))
+	assert.NotNil(t, nginxConfigObj.Update("http.server.listen", "443"))
+	assert.Nil(t, nginxConfigObj.SubConfig("http.server"))
+}
@@ -0,0 +1,202 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"strings"
+)
+
+// textDocument keeps the physical layout of a line-oriented configuration file
+// (comments, blank lines, key order and quoting), so that Marshal writes every
+// untouched line back byte for byte and an update only rewrites the lines it changes.
+type textDocument struct {
+	lines []*textLine
+
+	// trailingNewline records whether the source ends with a line break.
+	trailingNewline bool
+}
+
+type textLineKind int
+
+const (
+	blankLine textLineKind = iota
+	commentLine
+	sectionLine
+	entryLine
+	// opaqueLine is a line kept as is but not exposed as a parameter, e.g. the !include directive of my.cnf.
+	opaqueLine
+)
+
+type textLine struct {
+	kind textLineKind
+
+	// text is the physical text of the line, it may span several lines for continued or multi-line values.
+	text string
+
+	// section is the section (ini) or table (toml) the line belongs to, or the name of the section it declares.
+	section []string
+
+	// repeated is set for the lines of an element of an array of tables ([[name]] in toml).
+	repeated bool
+
+	// entry is set for entryLine only.
+	entry *textEntry
+}
+
+type textEntry struct {
+	// key is the parameter name relative to the section, split by the format specific key delimiter.
+	key []string
+
+	// value is the decoded value exposed by Get.
+	value string
+
+	// prefix and suffix surround the raw value on the physical line, they are kept when the value is replaced.
+	prefix string
+	suffix string
+}
+
+func splitDocumentLines(str string) ([]string, bool) {
+	if str == "" {
+		return nil, false
+	}
+	trailingNewline := strings.HasSuffix(str, "\n")
+	return strings.Split(strings.TrimSuffix(str, "\n"), "\n"), trailingNewline
+}
+
+func (d *textDocument) append(line *textLine) {
+	d.lines = append(d.lines, line)
+}
+
+func (d *textDocument) insert(pos int, lines ...*textLine) {
+	d.lines = append(d.lines[:pos], append(lines, d.lines[pos:]...)...)
+}
+
+func (d *textDocument) remove(line *textLine) {
+	for i, l := range d.lines {
+		if l == line {
+			d.lines = append(d.lines[:i], d.lines[i+1:]...)
+			return
+		}
+	}
+}
+
+// setValue replaces the raw value of the entry line, and keeps the key, separator and inline comment.
+func (d *textDocument) setValue(line *textLine, rawValue, value string) {
+	line.entry.value = value
+	line.text = line.entry.prefix + rawValue + line.entry.suffix
+}
+
+// entries returns the entry lines in document order.
+func (d *textDocument) entries() []*textLine {
+	r := make([]*textLine, 0, len(d.lines))
+	for _, l := range d.lines {
+		if l.kind == entryLine {
+			r = append(r, l)
+		}
+	}
+	return r
+}
+
+func (d *textDocument) String() string {
+	if len(d.lines) == 0 {
+		return ""
+	}
+	buffer := &strings.Builder{}
+	for i, l := range d.lines {
+		if i > 0 {
+			buffer.WriteByte('\n')
+		}
+		buffer.WriteString(l.text)
+	}
+	if d.trailingNewline {
+		buffer.WriteByte('\n')
+	}
+	return buffer.String()
+}
+
+// sectionEnd returns the position after the last entry of the section whose header is at index start,
+// a new entry inserted there stays in front of the blank lines and comments preceding the next section.
+func (d *textDocument) sectionEnd(start int) int {
+	pos := start + 1
+	for i := start + 1; i < len(d.lines); i++ {
+		switch d.lines[i].kind {
+		case sectionLine:
+			return pos
+		case entryLine:
+			pos = i + 1
+		}
+	}
+	return pos
+}
+
+// newSeparator returns the key/value separator used by the entry nearest to pos, so that a new entry
+// inserted at pos looks like its neighbours.
+func (d *textDocument) newSeparator(pos int, defaultSeparator string) string {
+	separator := func(l *textLine) string {
+		if l.kind != entryLine || !strings.HasPrefix(l.text, l.entry.prefix) {
+			return ""
+		}
+		prefix := strings.TrimLeft(l.entry.prefix, " \t")
+		i := strings.IndexAny(prefix, "=:")
+		if i <= 0 {
+			return ""
+		}
+		j := i + 1
+		for j < len(prefix) && (prefix[j] == ' ' || prefix[j] == '\t') {
+			j++
+		}
+		return prefix[i-countTrailingSpaces(prefix[:i]) : j]
+	}
+	for i := min(pos, len(d.lines)) - 1; i >= 0; i-- {
+		if sep := separator(d.lines[i]); sep != "" {
+			return sep
+		}
+	}
+	for i := max(pos, 0); i < len(d.lines); i++ {
+		if sep := separator(d.lines[i]); sep != "" {
+			return sep
+		}
+	}
+	return defaultSeparator
+}
+
+func countTrailingSpaces(s string) int {
+	n := 0
+	for i := len(s) - 1; i >= 0 && (s[i] == ' ' || s[i] == '\t'); i-- {
+		n++
+	}
+	return n
+}
+
+func equalKeys(k1, k2 []string) bool {
+	if len(k1) != len(k2) {
+		return false
+	}
+	for i := range k1 {
+		if !strings.EqualFold(k1[i], k2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasKeyPrefix(key, prefix []string) bool {
+	return len(key) >= len(prefix) && equalKeys(key[:len(prefix)], prefix)
+}
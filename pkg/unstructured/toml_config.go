@@ -0,0 +1,528 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/cast"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+// tomlConfig is a ConfigObject for toml files which keeps comments, blank lines, key order
+// and the original quoting of the values.
+//
+// Keys are addressed as dotted paths, e.g. "store.store-capacity", matched case-insensitively,
+// and reported in lower case by GetAllParameters like the former viper based implementation.
+// The keys inside an inline table or an array of tables are updated by marshaling the whole file again.
+type tomlConfig struct {
+	name string
+	doc  *textDocument
+
+	// table is set for the ConfigObject returned by SubConfig, keys are resolved relative to it.
+	table []string
+}
+
+var tomlBareKeyRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func init() {
+	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.TOML, func(name string) ConfigObject {
+		return &tomlConfig{name: name, doc: &textDocument{}}
+	})
+}
+
+func (c *tomlConfig) Update(key string, value any) error {
+	path := c.path(key)
+	if line := c.lookup(path); line != nil {
+		oldText, oldValue := line.text, line.entry.value
+		oldRaw := oldText[len(line.entry.prefix) : len(oldText)-len(line.entry.suffix)]
+		raw, err := encodeTOMLValue(value, &oldRaw)
+		if err != nil {
+			return err
+		}
+		c.doc.setValue(line, raw, raw)
+		if err = c.validate(); err != nil {
+			line.text, line.entry.value = oldText, oldValue
+			return err
+		}
+		return nil
+	}
+
+	raw, err := encodeTOMLValue(value, nil)
+	if err != nil {
+		return err
+	}
+	added := c.addEntry(path, raw)
+	if err = c.validate(); err != nil {
+		for _, line := range added {
+			c.doc.remove(line)
+		}
+		// the key is inside an inline table or an array of tables, which can't be edited in place
+		if err = c.marshalUpdate(path, value); err != nil {
+			return fmt.Errorf("failed to set [%s]: %v", key, err)
+		}
+	}
+	return nil
+}
+
+// marshalUpdate sets the key in the decoded document and marshals it again like the former viper based
+// implementation, the comments and the layout of the file are not kept.
+func (c *tomlConfig) marshalUpdate(path []string, value any) error {
+	params := make(map[string]interface{})
+	if err := toml.Unmarshal([]byte(c.doc.String()), &params); err != nil {
+		return err
+	}
+	m := params
+	for _, k := range path[:len(path)-1] {
+		sub, ok := m[k].(map[string]interface{})
+		if !ok {
+			sub = make(map[string]interface{})
+			m[k] = sub
+		}
+		m = sub
+	}
+	m[path[len(path)-1]] = value
+
+	b, err := toml.Marshal(params)
+	if err != nil {
+		return err
+	}
+	updated := &tomlConfig{}
+	if err = updated.Unmarshal(string(b)); err != nil {
+		return err
+	}
+	// the document is shared with the ConfigObjects returned by SubConfig
+	*c.doc = *updated.doc
+	return nil
+}
+
+func (c *tomlConfig) RemoveKey(key string) error {
+	if line := c.lookup(c.path(key)); line != nil {
+		c.doc.remove(line)
+	}
+	return nil
+}
+
+func (c *tomlConfig) Get(key string) interface{} {
+	var v interface{} = c.GetAllParameters()
+	for _, k := range strings.Split(strings.ToLower(key), DelimiterDot) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if v, ok = m[k]; !ok {
+			return nil
+		}
+	}
+	return v
+}
+
+func (c *tomlConfig) GetString(key string) (string, error) {
+	return cast.ToStringE(c.Get(key))
+}
+
+func (c *tomlConfig) GetAllParameters() map[string]interface{} {
+	params := make(map[string]interface{})
+	if err := toml.Unmarshal([]byte(c.doc.String()), &params); err != nil {
+		return nil
+	}
+	params = lowerCaseKeys(params).(map[string]interface{})
+	for _, k := range c.table {
+		sub, ok := params[strings.ToLower(k)].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		params = sub
+	}
+	return params
+}
+
+func (c *tomlConfig) SubConfig(key string) ConfigObject {
+	sub := &tomlConfig{
+		name:  c.name,
+		doc:   c.doc,
+		table: c.path(key),
+	}
+	if sub.GetAllParameters() == nil {
+		return nil
+	}
+	return sub
+}
+
+func (c *tomlConfig) Marshal() (string, error) {
+	if len(c.table) == 0 {
+		return c.doc.String(), nil
+	}
+	b, err := toml.Marshal(c.GetAllParameters())
+	return string(b), err
+}
+
+func (c *tomlConfig) Unmarshal(str string) error {
+	var params map[string]interface{}
+	if err := toml.Unmarshal([]byte(str), &params); err != nil {
+		return err
+	}
+
+	lines, trailingNewline := splitDocumentLines(str)
+	doc := &textDocument{trailingNewline: trailingNewline}
+	var table []string
+	var repeated bool
+	for i := 0; i < len(lines); i++ {
+		text := lines[i]
+		trimmed := strings.TrimSpace(text)
+		switch {
+		case trimmed == "":
+			doc.append(&textLine{kind: blankLine, text: text})
+		case trimmed[0] == '#':
+			doc.append(&textLine{kind: commentLine, text: text})
+		case trimmed[0] == '[':
+			repeated = strings.HasPrefix(trimmed, "[[")
+			if repeated {
+				table, _ = parseTOMLKey(trimmed[2:])
+			} else {
+				table, _ = parseTOMLKey(trimmed[1:])
+			}
+			doc.append(&textLine{kind: sectionLine, text: text, section: table, repeated: repeated})
+		default:
+			entry := parseTOMLEntry(text)
+			for entry == nil && i+1 < len(lines) {
+				i++
+				text += "\n" + lines[i]
+				entry = parseTOMLEntry(text)
+			}
+			if entry == nil {
+				return fmt.Errorf("failed to parse toml entry: %s", text)
+			}
+			doc.append(&textLine{kind: entryLine, text: text, section: table, repeated: repeated, entry: entry})
+		}
+	}
+	c.doc = doc
+	c.table = nil
+	return nil
+}
+
+func (c *tomlConfig) path(key string) []string {
+	path := make([]string, 0, len(c.table)+1)
+	path = append(path, c.table...)
+	return append(path, strings.Split(key, DelimiterDot)...)
+}
+
+func (c *tomlConfig) lookup(path []string) *textLine {
+	var found *textLine
+	for _, line := range c.doc.entries() {
+		if !line.repeated && hasKeyPrefix(path, line.section) && equalKeys(path[len(line.section):], line.entry.key) {
+			found = line
+		}
+	}
+	return found
+}
+
+func (c *tomlConfig) validate() error {
+	var params map[string]interface{}
+	return toml.Unmarshal([]byte(c.doc.String()), &params)
+}
+
+// addEntry adds the key into the deepest table containing it, a new table is appended if there is none.
+func (c *tomlConfig) addEntry(path []string, raw string) []*textLine {
+	header, table := -1, []string(nil)
+	for i, line := range c.doc.lines {
+		if line.kind == sectionLine && !line.repeated && len(line.section) < len(path) &&
+			len(line.section) > len(table) && hasKeyPrefix(path, line.section) {
+			header, table = i, line.section
+		}
+	}
+
+	newEntry := func(table, key []string) *textLine {
+		entry := &textEntry{key: key, value: raw, prefix: formatTOMLKey(key) + " = "}
+		return &textLine{kind: entryLine, text: entry.prefix + raw, section: table, entry: entry}
+	}
+	if len(c.doc.lines) == 0 {
+		c.doc.trailingNewline = true
+	}
+
+	if header < 0 && len(path) > 1 {
+		table = path[:len(path)-1]
+		lines := []*textLine{
+			{kind: sectionLine, text: "[" + formatTOMLKey(table) + "]", section: table},
+			newEntry(table, path[len(path)-1:]),
+		}
+		if n := len(c.doc.lines); n != 0 && c.doc.lines[n-1].kind != blankLine {
+			lines = append([]*textLine{{kind: blankLine}}, lines...)
+		}
+		for _, line := range lines {
+			c.doc.append(line)
+		}
+		return lines
+	}
+
+	line := newEntry(table, path[len(table):])
+	pos := c.doc.sectionEnd(header)
+	if header >= 0 || pos != 0 || len(c.doc.lines) == 0 {
+		c.doc.insert(pos, line)
+		return []*textLine{line}
+	}
+
+	// the root table has no entries, put the new entry in front of the first table and its comments
+	for pos < len(c.doc.lines) && c.doc.lines[pos].kind != sectionLine {
+		pos++
+	}
+	for pos > 0 && c.doc.lines[pos-1].kind == commentLine {
+		pos--
+	}
+	lines := []*textLine{line}
+	if pos < len(c.doc.lines) {
+		lines = append(lines, &textLine{kind: blankLine})
+	}
+	c.doc.insert(pos, lines...)
+	return lines
+}
+
+// parseTOMLEntry parses a "key = value" logical line, it returns nil if the value continues on the next line.
+func parseTOMLEntry(text string) *textEntry {
+	indent := len(text) - len(strings.TrimLeft(text, " \t"))
+	key, n := parseTOMLKey(text[indent:])
+	pos := skipSpaces(text, indent+n)
+	if pos >= len(text) || text[pos] != '=' {
+		return nil
+	}
+	pos = skipSpaces(text, pos+1)
+	size := scanTOMLValue(text[pos:])
+	if size < 0 {
+		return nil
+	}
+	return &textEntry{
+		key:    key,
+		value:  text[pos : pos+size],
+		prefix: text[:pos],
+		suffix: text[pos+size:],
+	}
+}
+
+// parseTOMLKey parses a dotted key, and returns the key parts and the length of the consumed text.
+func parseTOMLKey(s string) ([]string, int) {
+	var parts []string
+	pos := 0
+	for {
+		pos = skipSpaces(s, pos)
+		if pos >= len(s) {
+			return parts, pos
+		}
+		switch s[pos] {
+		case '"', '\'':
+			size := scanTOMLString(s[pos:])
+			if size < 0 {
+				return parts, pos
+			}
+			parts = append(parts, unquoteTOMLString(s[pos:pos+size]))
+			pos += size
+		default:
+			start := pos
+			for pos < len(s) && (isTOMLBareKeyChar(s[pos])) {
+				pos++
+			}
+			if start == pos {
+				return parts, pos
+			}
+			parts = append(parts, s[start:pos])
+		}
+		next := skipSpaces(s, pos)
+		if next >= len(s) || s[next] != '.' {
+			return parts, pos
+		}
+		pos = next + 1
+	}
+}
+
+func isTOMLBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+// scanTOMLValue returns the length of the value at the beginning of s, or -1 if the value is not complete.
+func scanTOMLValue(s string) int {
+	depth := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\'':
+			size := scanTOMLString(s[i:])
+			if size < 0 {
+				return -1
+			}
+			i += size
+			if depth == 0 {
+				return i
+			}
+			continue
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		case c == '#' && depth > 0:
+			eol := strings.IndexByte(s[i:], '\n')
+			if eol < 0 {
+				return -1
+			}
+			i += eol
+			continue
+		case depth == 0:
+			end := strings.IndexAny(s[i:], "#\n")
+			if end < 0 {
+				end = len(s) - i
+			}
+			return i + len(strings.TrimRight(s[i:i+end], " \t\r"))
+		}
+		i++
+	}
+	if depth > 0 {
+		return -1
+	}
+	return len(s)
+}
+
+// scanTOMLString returns the length of the string literal at the beginning of s, or -1 if it is not closed.
+func scanTOMLString(s string) int {
+	quote := s[0]
+	if strings.HasPrefix(s, strings.Repeat(string(quote), 3)) {
+		delim := s[:3]
+		for i := 3; i < len(s); i++ {
+			switch {
+			case quote == '"' && s[i] == '\\':
+				i++
+			case strings.HasPrefix(s[i:], delim):
+				end := i + 3
+				// up to two quotes are allowed right before the closing delimiter
+				for k := 0; k < 2 && end < len(s) && s[end] == quote; k++ {
+					end++
+				}
+				return end
+			}
+		}
+		return -1
+	}
+	for i := 1; i < len(s) && s[i] != '\n'; i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			i++
+		case s[i] == quote:
+			return i + 1
+		}
+	}
+	return -1
+}
+
+func unquoteTOMLString(s string) string {
+	var v map[string]string
+	if err := toml.Unmarshal([]byte("v = "+s), &v); err != nil {
+		return s[1 : len(s)-1]
+	}
+	return v["v"]
+}
+
+func formatTOMLKey(key []string) string {
+	parts := make([]string, len(key))
+	for i, k := range key {
+		if tomlBareKeyRegex.MatchString(k) {
+			parts[i] = k
+		} else {
+			parts[i] = quoteTOMLString(k)
+		}
+	}
+	return strings.Join(parts, DelimiterDot)
+}
+
+func quoteTOMLString(s string) string {
+	buffer := &strings.Builder{}
+	buffer.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buffer.WriteString(`\"`)
+		case '\\':
+			buffer.WriteString(`\\`)
+		case '\n':
+			buffer.WriteString(`\n`)
+		case '\r':
+			buffer.WriteString(`\r`)
+		case '\t':
+			buffer.WriteString(`\t`)
+		case '\b':
+			buffer.WriteString(`\b`)
+		case '\f':
+			buffer.WriteString(`\f`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(buffer, `\u%04X`, r)
+			} else {
+				buffer.WriteRune(r)
+			}
+		}
+	}
+	buffer.WriteByte('"')
+	return buffer.String()
+}
+
+// encodeTOMLValue returns the toml literal of the value. A string replacing a non-string literal
+// (e.g. an integer or a boolean) keeps the literal type if it is valid toml, and a string replacing
+// a literal string keeps the quote style.
+func encodeTOMLValue(value any, oldRaw *string) (string, error) {
+	if s, ok := value.(string); ok {
+		switch {
+		case oldRaw != nil && !strings.HasPrefix(*oldRaw, `"`) && !strings.HasPrefix(*oldRaw, "'"):
+			var v map[string]interface{}
+			if s != "" && toml.Unmarshal([]byte("v = "+s), &v) == nil {
+				return s, nil
+			}
+		case oldRaw != nil && strings.HasPrefix(*oldRaw, "'") && !strings.HasPrefix(*oldRaw, "'''") && !strings.ContainsAny(s, "'\n\r"):
+			return "'" + s + "'", nil
+		}
+		return quoteTOMLString(s), nil
+	}
+
+	b, err := toml.Marshal(map[string]interface{}{"v": value})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(strings.TrimPrefix(string(b), "v = ")), nil
+}
+
+func lowerCaseKeys(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			m[strings.ToLower(k)] = lowerCaseKeys(e)
+		}
+		return m
+	case []interface{}:
+		for i := range t {
+			t[i] = lowerCaseKeys(t[i])
+		}
+		return t
+	default:
+		return v
+	}
+}
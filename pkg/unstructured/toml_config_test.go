@@ -0,0 +1,87 @@
+/*
+Copyright (C) 2022-2026 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package unstructured
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
+)
+
+func TestTOMLConfig(t *testing.T) {
+	const tomlContext = `# comment of the file
+
+[server]
+labels = { zone = "z1", "host.name" = 'h1' }
+grpc-concurrency = 4
+desc = """
+multi-line
+text"""
+
+[[storage.engines]]
+name = "rocksdb"
+`
+
+	tomlConfigObj, err := LoadConfig("toml_test", tomlContext, parametersv1alpha1.TOML)
+	assert.Nil(t, err)
+
+	assert.EqualValues(t, "z1", tomlConfigObj.Get("server.labels.zone"))
+	assert.EqualValues(t, 4, tomlConfigObj.Get("server.grpc-concurrency"))
+	assert.EqualValues(t, "multi-line\ntext", tomlConfigObj.Get("server.desc"))
+	assert.EqualValues(t, []interface{}{map[string]interface{}{"name": "rocksdb"}}, tomlConfigObj.Get("storage.engines"))
+
+	subConfigObj := tomlConfigObj.SubConfig("server")
+	assert.NotNil(t, subConfigObj)
+	assert.Nil(t, tomlConfigObj.SubConfig("not_exist"))
+	assert.Nil(t, subConfigObj.Update("grpc-concurrency", "8"))
+	assert.Nil(t, subConfigObj.Update("desc", "single line"))
+	assert.Nil(t, tomlConfigObj.Update("server.status-port", 20180))
+	assert.Nil(t, tomlConfigObj.Update("cluster-id", "c1"))
+
+	dumpContext, err := tomlConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, `# comment of the file
+
+cluster-id = "c1"
+
+[server]
+labels = { zone = "z1", "host.name" = 'h1' }
+grpc-concurrency = 8
+desc = "single line"
+status-port = 20180
+
+[[storage.engines]]
+name = "rocksdb"
+`, dumpContext)
+
+	// the keys of an inline table or an array of tables are set by marshaling the file again
+	assert.Nil(t, subConfigObj.Update("labels.rack", "r1"))
+	assert.EqualValues(t, "r1", tomlConfigObj.Get("server.labels.rack"))
+	assert.EqualValues(t, "z1", tomlConfigObj.Get("server.labels.zone"))
+	assert.EqualValues(t, 8, tomlConfigObj.Get("server.grpc-concurrency"))
+	assert.Nil(t, tomlConfigObj.Update("storage.engines.name", "titan"))
+	assert.EqualValues(t, "titan", tomlConfigObj.Get("storage.engines.name"))
+	assert.EqualValues(t, "c1", tomlConfigObj.Get("cluster-id"))
+
+	_, err = LoadConfig("toml_test", "[server\n", parametersv1alpha1.TOML)
+	assert.NotNil(t, err)
+}
@@ -27,7 +27,6 @@ import (
 
 	"github.com/spf13/cast"
 	oviper "github.com/spf13/viper"
-	"gopkg.in/ini.v1"
 
 	parametersv1alpha1 "github.com/apecloud/kubeblocks/apis/parameters/v1alpha1"
 )
@@ -39,13 +38,11 @@ type viperWrap struct {
 	format parametersv1alpha1.CfgFileFormat
 }
 
+// Ini, TOML, Properties and Dotenv have their own ConfigObject implementations which keep
+// the comments and the layout of the file, see ini_config.go, toml_config.go and key_value_config.go.
 func init() {
-	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.Ini, createViper(parametersv1alpha1.Ini))
 	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.JSON, createViper(parametersv1alpha1.JSON))
-	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.Dotenv, createViper(parametersv1alpha1.Dotenv))
 	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.HCL, createViper(parametersv1alpha1.HCL))
-	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.TOML, createViper(parametersv1alpha1.TOML))
-	CfgObjectRegistry().RegisterConfigCreator(parametersv1alpha1.Properties, createViper(parametersv1alpha1.Properties))
 }
 
 func (v *viperWrap) GetString(key string) (string, error) {
@@ -110,15 +107,7 @@ func (v *viperWrap) Unmarshal(str string) error {
 }
 
 func newCfgViper(cfgType parametersv1alpha1.CfgFileFormat) *oviper.Viper {
-	defaultKeySep := DelimiterDot
-	if cfgType == parametersv1alpha1.Properties || cfgType == parametersv1alpha1.Dotenv {
-		defaultKeySep = CfgDelimiterPlaceholder
-	}
-	// TODO config constraint support LoadOptions
-	v := oviper.NewWithOptions(oviper.KeyDelimiter(defaultKeySep), oviper.IniLoadOptions(ini.LoadOptions{
-		SpaceBeforeInlineComment: true,
-		PreserveSurroundedQuote:  true,
-	}))
+	v := oviper.NewWithOptions(oviper.KeyDelimiter(DelimiterDot))
 	v.SetConfigType(strings.ToLower(string(cfgType)))
 	return v
 }
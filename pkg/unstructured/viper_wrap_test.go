@@ -48,9 +48,9 @@ port=3306
 	assert.EqualValues(t, iniConfigObj.Get("mysqld.log_error"), "/data/mysql/log/mysqld.err")
 	assert.EqualValues(t, iniConfigObj.Get("client.socket"), "/data/mysql/tmp/mysqld.sock")
 
-	// dumpContext, err := iniConfigObj.Marshal()
-	// assert.Nil(t, err)
-	// assert.EqualValues(t, dumpContext, iniContext[1:]) // trim "\n"
+	dumpContext, err := iniConfigObj.Marshal()
+	assert.Nil(t, err)
+	assert.EqualValues(t, dumpContext, iniContext)
 
 	// test sub
 	subConfigObj := iniConfigObj.SubConfig("mysqld")
@@ -87,7 +87,7 @@ autovacuum_naptime = '1min'
 
 	dumpContext, err := propConfigObj.Marshal()
 	assert.Nil(t, err)
-	assert.EqualValues(t, dumpContext, propertiesContext[1:]) // trim "\n"
+	assert.EqualValues(t, dumpContext, propertiesContext)
 
 	assert.Nil(t, propConfigObj.Update("autovacuum_naptime", "'6min'"))
 	assert.EqualValues(t, propConfigObj.Get("autovacuum_naptime"), "'6min'")